@@ -32,14 +32,16 @@ func WrapSubCommand(name string, cmd common.Command, opt fx.Option) *cobra.Comma
 			)
 			ctx := context.Background()
 			app := fx.New(opt, opts)
-			err := app.Start(ctx)
-			defer func(app *fx.App, ctx context.Context) {
-				err := app.Stop(ctx)
-				if err != nil {
-					panic(err)
-				}
-			}(app, ctx)
-			if err != nil {
+			if err := app.Start(ctx); err != nil {
+				panic(err)
+			}
+
+			// Block until the application is asked to stop, e.g. by SIGINT or SIGTERM
+			<-app.Done()
+
+			stopCtx, cancel := context.WithTimeout(ctx, app.StopTimeout())
+			defer cancel()
+			if err := app.Stop(stopCtx); err != nil {
 				panic(err)
 			}
 		},
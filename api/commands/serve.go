@@ -1,12 +1,16 @@
 package commands
 
 import (
+	"context"
+	"errors"
 	"github.com/gin-gonic/gin"
 	"github.com/h4rdc0m/aurora-api/api/http/middlewares"
 	"github.com/h4rdc0m/aurora-api/api/http/routes"
 	"github.com/h4rdc0m/aurora-api/domain/common"
 	"github.com/h4rdc0m/aurora-api/infrastructure/config"
 	"github.com/spf13/cobra"
+	"go.uber.org/fx"
+	"net/http"
 )
 
 // ServeCommand test command
@@ -20,6 +24,8 @@ func (s *ServeCommand) Setup(_ *cobra.Command) {}
 
 func (s *ServeCommand) Run() common.CommandRunner {
 	return func(
+		lc fx.Lifecycle,
+		shutdowner fx.Shutdowner,
 		middleware middlewares.Middlewares,
 		env *config.Env,
 		router *gin.Engine,
@@ -29,12 +35,30 @@ func (s *ServeCommand) Run() common.CommandRunner {
 	) {
 		middleware.Setup()
 		route.Setup()
-		logger.Info("Starting Aurora API server")
-		if env.ServerPort == "" {
-			_ = router.Run()
-		} else {
-			_ = router.Run(":" + env.ServerPort)
+
+		addr := ":8080"
+		if env.ServerPort != "" {
+			addr = ":" + env.ServerPort
 		}
+		server := &http.Server{Addr: addr, Handler: router}
+
+		// The server runs from the lifecycle, so the start hooks of the background jobs run as well
+		lc.Append(fx.Hook{
+			OnStart: func(_ context.Context) error {
+				logger.Info("Starting Aurora API server", "addr", addr)
+				go func() {
+					if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+						logger.Error("Aurora API server stopped", "error", err)
+						_ = shutdowner.Shutdown(fx.ExitCode(1))
+					}
+				}()
+				return nil
+			},
+			OnStop: func(ctx context.Context) error {
+				logger.Info("Stopping Aurora API server")
+				return server.Shutdown(ctx)
+			},
+		})
 	}
 }
 
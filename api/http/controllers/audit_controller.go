@@ -0,0 +1,51 @@
+package controllers
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/h4rdc0m/aurora-api/application/dto"
+	"github.com/h4rdc0m/aurora-api/application/use_cases"
+	"github.com/h4rdc0m/aurora-api/domain/common"
+	"net/http"
+	"strconv"
+)
+
+// AuditController handles HTTP requests for the audit logs of tenants.
+type AuditController struct {
+	BaseController
+	auditUseCase *use_cases.AuditUseCase
+	logger       common.Logger
+}
+
+// NewAuditController creates a new instance of AuditController with the provided use case and logger.
+func NewAuditController(auditUseCase *use_cases.AuditUseCase, logger common.Logger) *AuditController {
+	return &AuditController{
+		auditUseCase: auditUseCase,
+		logger:       logger,
+	}
+}
+
+// GetAuditLog retrieves the latest entries of the audit log of a tenant, at most the number in the "limit" query
+// parameter.
+func (a *AuditController) GetAuditLog(c *gin.Context) {
+	tenantID, err := a.ParseUIntParam(c, "tenantId")
+	if err != nil {
+		a.logger.Error("Failed to parse tenant ID", "error", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid tenant ID"})
+		return
+	}
+
+	limit, err := strconv.ParseUint(c.DefaultQuery("limit", "0"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid limit"})
+		return
+	}
+
+	entries, err := a.auditUseCase.GetAuditLog(uint64(tenantID), limit)
+	if err != nil {
+		a.logger.Error("Failed to get audit log", "error", err)
+		a.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": dto.NewAuditEntryResponses(entries)})
+}
@@ -1,11 +1,139 @@
 package controllers
 
 import (
+	stderrors "errors"
 	"github.com/gin-gonic/gin"
+	"github.com/h4rdc0m/aurora-api/constants"
+	"github.com/h4rdc0m/aurora-api/domain/entities"
 	"github.com/h4rdc0m/aurora-api/domain/errors"
+	"net/http"
 	"strconv"
+	"strings"
 )
 
+// unauthorizedErrors are domain errors reported as 401 Unauthorized
+var unauthorizedErrors = []error{
+	errors.ErrPreviewTokenInvalid,
+}
+
+// notFoundErrors are domain errors reported as 404 Not Found
+var notFoundErrors = []error{
+	errors.ErrSiteNotFound,
+	errors.ErrSitemapNotFound,
+	errors.ErrFeedNotFound,
+	errors.ErrTenantNotFound,
+	errors.ErrPageNotFound,
+	errors.ErrPageVersionNotFound,
+	errors.ErrContentTypeNotFound,
+	errors.ErrRedirectNotFound,
+	errors.ErrPreviewTokenNotFound,
+	errors.ErrEditingLeaseNotFound,
+	errors.ErrTemplateNotFound,
+}
+
+// conflictErrors are domain errors reported as 409 Conflict
+var conflictErrors = []error{
+	errors.ErrPagePathAlreadyExists,
+	errors.ErrSiteDomainAlreadyExists,
+	errors.ErrPageVersionTransitionNotAllowed,
+	errors.ErrPageVersionNotEditable,
+	errors.ErrPageVersionNotSchedulable,
+	errors.ErrPageSnippetInUse,
+	errors.ErrContentTypeAlreadyExists,
+	errors.ErrRedirectSourceAlreadyExists,
+	errors.ErrPageParentTrashed,
+	errors.ErrTemplateNameAlreadyExists,
+	errors.ErrTemplateFilePathAlreadyExists,
+}
+
+// lockedErrors are domain errors reported as 423 Locked
+var lockedErrors = []error{
+	errors.ErrPageVersionLocked,
+}
+
+// preconditionFailedErrors are domain errors reported as 412 Precondition Failed
+var preconditionFailedErrors = []error{
+	errors.ErrRevisionMismatch,
+	errors.ErrConcurrentModification,
+}
+
+// validationErrors are domain errors reported as 422 Unprocessable Entity
+var validationErrors = []error{
+	errors.ErrDomainNameEmpty,
+	errors.ErrDomainNameTooLong,
+	errors.ErrDomainNameInvalid,
+	errors.ErrSiteNameEmpty,
+	errors.ErrSiteDomainEmpty,
+	errors.ErrTenantNameEmpty,
+	errors.ErrPageKeyEmpty,
+	errors.ErrPageKeyTooLong,
+	errors.ErrPageKeyInvalid,
+	errors.ErrPageTypeInvalid,
+	errors.ErrPageParentNotFound,
+	errors.ErrPageLinkURLRequired,
+	errors.ErrPageLinkURLInvalid,
+	errors.ErrPageHardLinkTargetRequired,
+	errors.ErrPageHardLinkTargetNotFound,
+	errors.ErrPageHardLinkSelfReference,
+	errors.ErrPageHardLinkCycle,
+	errors.ErrPageHardLinkDangling,
+	errors.ErrPageHardLinkCrossTenant,
+	errors.ErrPageTargetNotAllowed,
+	errors.ErrPageVersionTitleEmpty,
+	errors.ErrPageMoveCycle,
+	errors.ErrPagePositionInvalid,
+	errors.ErrPageDuplicateVersionsInvalid,
+	errors.ErrPageDuplicateExternalHardLink,
+	errors.ErrPageVersionStatusInvalid,
+	errors.ErrPageVersionScheduleInvalid,
+	errors.ErrInvalidBlockKey,
+	errors.ErrInvalidContentType,
+	errors.ErrPageBlockKeyDuplicate,
+	errors.ErrPagePathInvalid,
+	errors.ErrSearchQueryTooLong,
+	errors.ErrPageFeedRootTypeInvalid,
+	errors.ErrPageBlockSnippetInvalid,
+	errors.ErrPageSnippetNotFound,
+	errors.ErrPageSnippetTypeInvalid,
+	errors.ErrPageSnippetCrossTenant,
+	errors.ErrPageSnippetCycle,
+	errors.ErrContentTypeNameEmpty,
+	errors.ErrContentTypeNameTooLong,
+	errors.ErrContentTypeNameInvalid,
+	errors.ErrContentTypeNameReserved,
+	errors.ErrContentTypeSchemaEmpty,
+	errors.ErrContentTypeSchemaInvalid,
+	errors.ErrBlockContentInvalid,
+	errors.ErrRedirectSourceInvalid,
+	errors.ErrRedirectStatusInvalid,
+	errors.ErrRedirectTargetRequired,
+	errors.ErrRedirectTargetNotAllowed,
+	errors.ErrRedirectTargetURLInvalid,
+	errors.ErrRedirectTargetPageNotFound,
+	errors.ErrRedirectLoop,
+	errors.ErrRedirectImportInvalid,
+	errors.ErrRedirectImportTooLarge,
+	errors.ErrLocaleInvalid,
+	errors.ErrLocaleNotEnabled,
+	errors.ErrSiteLocalesEmpty,
+	errors.ErrSiteLocalesTooMany,
+	errors.ErrSiteLocaleDuplicate,
+	errors.ErrSiteDefaultLocaleNotEnabled,
+	errors.ErrSiteFallbackLocaleNotEnabled,
+	errors.ErrSiteTitleTemplateTooLong,
+	errors.ErrSiteTitleTemplatePlaceholderUnknown,
+	errors.ErrPageSEOTitleTooLong,
+	errors.ErrPageSEODescriptionTooLong,
+	errors.ErrPageSEOCanonicalURLInvalid,
+	errors.ErrPageSEOImageInvalid,
+	errors.ErrPageSEORobotsInvalid,
+	errors.ErrPageSEOTwitterCardInvalid,
+	errors.ErrPreviewScopeInvalid,
+	errors.ErrPreviewTokenVersionRequired,
+	errors.ErrPreviewTokenVersionNotAllowed,
+	errors.ErrPreviewTokenLifetimeInvalid,
+}
+
 type BaseController struct {
 }
 
@@ -27,6 +155,15 @@ func (b *BaseController) GetUserEmail(c *gin.Context) (string, bool) {
 	return userEmail.(string), true
 }
 
+// GetUserName returns the display name of the authenticated user, falling back to their username when the token
+// carries no name
+func (b *BaseController) GetUserName(c *gin.Context) string {
+	if name := c.GetString("user_name"); name != "" {
+		return name
+	}
+	return c.GetString("user_username")
+}
+
 func (b *BaseController) GetUserRoles(c *gin.Context) ([]string, bool) {
 	roles, exists := c.Get("user_roles")
 	if !exists {
@@ -36,6 +173,16 @@ func (b *BaseController) GetUserRoles(c *gin.Context) ([]string, bool) {
 	return roles.([]string), true
 }
 
+// GetCurrentUser returns the local user resolved by the tenant access middleware
+func (b *BaseController) GetCurrentUser(c *gin.Context) (*entities.User, bool) {
+	user, exists := c.Get(constants.CurrentUser)
+	if !exists {
+		return nil, false
+	}
+
+	return user.(*entities.User), true
+}
+
 func (b *BaseController) HasRole(c *gin.Context, role string) bool {
 	roles, exists := b.GetUserRoles(c)
 	if !exists {
@@ -63,3 +210,97 @@ func (b *BaseController) ParseUIntParam(c *gin.Context, param string) (uint, err
 
 	return uint(id), nil
 }
+
+// SetETag writes the revision of the returned resource as its strong ETag, e.g. "3"
+func (b *BaseController) SetETag(c *gin.Context, revision uint64) {
+	c.Header("ETag", strconv.Quote(strconv.FormatUint(revision, 10)))
+}
+
+// IfMatchRevision parses the revision of the resource the client read from the If-Match header. Writes a 428
+// Precondition Required response when the header is missing and a 412 Precondition Failed response when it is not
+// a single ETag of this API, as changes must always name the revision they apply to.
+func (b *BaseController) IfMatchRevision(c *gin.Context) (uint64, bool) {
+	header := strings.TrimSpace(c.GetHeader("If-Match"))
+	if header == "" {
+		c.JSON(http.StatusPreconditionRequired, gin.H{"error": "If-Match header with the ETag of the resource is required"})
+		return 0, false
+	}
+
+	value, quoted := strings.CutPrefix(header, `"`)
+	value, closed := strings.CutSuffix(value, `"`)
+	if quoted && closed {
+		if revision, err := strconv.ParseUint(value, 10, 64); err == nil {
+			return revision, true
+		}
+	}
+
+	c.JSON(http.StatusPreconditionFailed, gin.H{"error": "If-Match header does not match an ETag of the resource"})
+	return 0, false
+}
+
+// HandleError writes the error response matching a domain error, falling back to 500 Internal Server Error.
+// Invalid block content additionally lists the violations with their field paths, rejected redirect imports
+// the CSV line and locked page versions the editor holding the editing lease.
+func (b *BaseController) HandleError(c *gin.Context, err error) {
+	var contentErr *errors.BlockContentError
+	if stderrors.As(err, &contentErr) {
+		violations := make([]gin.H, 0, len(contentErr.Violations))
+		for _, violation := range contentErr.Violations {
+			violations = append(violations, gin.H{"path": violation.Path, "message": violation.Message})
+		}
+		c.JSON(http.StatusUnprocessableEntity, gin.H{
+			"error":        errors.ErrBlockContentInvalid.Error(),
+			"block_key":    contentErr.BlockKey,
+			"content_type": contentErr.ContentType,
+			"violations":   violations,
+		})
+		return
+	}
+
+	var importErr *errors.RedirectImportError
+	if stderrors.As(err, &importErr) {
+		c.JSON(errorStatus(importErr.Err), gin.H{"error": importErr.Err.Error(), "line": importErr.Line})
+		return
+	}
+
+	var lockedErr *errors.PageVersionLockedError
+	if stderrors.As(err, &lockedErr) {
+		c.JSON(http.StatusLocked, gin.H{
+			"error":      errors.ErrPageVersionLocked.Error(),
+			"holder":     gin.H{"subject": lockedErr.HolderSubject, "name": lockedErr.HolderName},
+			"expires_at": lockedErr.ExpiresAt,
+		})
+		return
+	}
+
+	c.JSON(errorStatus(err), gin.H{"error": err.Error()})
+}
+
+// errorStatus maps a domain error to its HTTP status code
+func errorStatus(err error) int {
+	switch {
+	case matchesAny(err, unauthorizedErrors):
+		return http.StatusUnauthorized
+	case matchesAny(err, notFoundErrors):
+		return http.StatusNotFound
+	case matchesAny(err, conflictErrors):
+		return http.StatusConflict
+	case matchesAny(err, lockedErrors):
+		return http.StatusLocked
+	case matchesAny(err, preconditionFailedErrors):
+		return http.StatusPreconditionFailed
+	case matchesAny(err, validationErrors):
+		return http.StatusUnprocessableEntity
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func matchesAny(err error, targets []error) bool {
+	for _, target := range targets {
+		if stderrors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
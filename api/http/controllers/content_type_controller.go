@@ -0,0 +1,182 @@
+package controllers
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/h4rdc0m/aurora-api/application/dto"
+	"github.com/h4rdc0m/aurora-api/application/use_cases"
+	"github.com/h4rdc0m/aurora-api/domain/common"
+	"net/http"
+)
+
+// ContentTypeController handles HTTP requests related to the block content type registry.
+// Routes with a tenantId parameter address the content types of that tenant, the others the global content types.
+type ContentTypeController struct {
+	BaseController
+	contentTypeUseCase *use_cases.ContentTypeUseCase
+	logger             common.Logger
+}
+
+// NewContentTypeController creates a new instance of ContentTypeController with the provided use case and logger.
+func NewContentTypeController(contentTypeUseCase *use_cases.ContentTypeUseCase, logger common.Logger) *ContentTypeController {
+	return &ContentTypeController{
+		contentTypeUseCase: contentTypeUseCase,
+		logger:             logger,
+	}
+}
+
+// GetContentTypes retrieves the content types available in the scope.
+func (ct *ContentTypeController) GetContentTypes(c *gin.Context) {
+	tenantID, ok := ct.parseScope(c)
+	if !ok {
+		return
+	}
+
+	contentTypes, err := ct.contentTypeUseCase.GetContentTypes(tenantID)
+	if err != nil {
+		ct.logger.Error("Failed to get content types", "error", err)
+		ct.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": dto.NewContentTypeResponses(contentTypes)})
+}
+
+// GetContentType retrieves a single content type.
+func (ct *ContentTypeController) GetContentType(c *gin.Context) {
+	tenantID, id, ok := ct.parseContentTypeParams(c)
+	if !ok {
+		return
+	}
+
+	contentType, err := ct.contentTypeUseCase.GetContentType(tenantID, id)
+	if err != nil {
+		ct.logger.Error("Failed to get content type", "error", err)
+		ct.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": dto.NewContentTypeResponse(contentType)})
+}
+
+// CreateContentType registers a new content type.
+func (ct *ContentTypeController) CreateContentType(c *gin.Context) {
+	tenantID, ok := ct.parseScope(c)
+	if !ok {
+		return
+	}
+
+	var req dto.CreateContentTypeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		ct.logger.Error("Failed to bind JSON to content type request", "error", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	contentType, err := ct.contentTypeUseCase.CreateContentType(tenantID, req)
+	if err != nil {
+		ct.logger.Error("Failed to create content type", "error", err)
+		ct.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"data": dto.NewContentTypeResponse(contentType)})
+}
+
+// UpdateContentType replaces the description and schema of a content type.
+func (ct *ContentTypeController) UpdateContentType(c *gin.Context) {
+	tenantID, id, ok := ct.parseContentTypeParams(c)
+	if !ok {
+		return
+	}
+
+	var req dto.UpdateContentTypeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		ct.logger.Error("Failed to bind JSON to content type request", "error", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	contentType, err := ct.contentTypeUseCase.UpdateContentType(tenantID, id, req)
+	if err != nil {
+		ct.logger.Error("Failed to update content type", "error", err)
+		ct.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": dto.NewContentTypeResponse(contentType)})
+}
+
+// DeleteContentType removes a content type.
+func (ct *ContentTypeController) DeleteContentType(c *gin.Context) {
+	tenantID, id, ok := ct.parseContentTypeParams(c)
+	if !ok {
+		return
+	}
+
+	if err := ct.contentTypeUseCase.DeleteContentType(tenantID, id); err != nil {
+		ct.logger.Error("Failed to delete content type", "error", err)
+		ct.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": "Content type deleted successfully"})
+}
+
+// ValidateBlockContent checks block content against the content types of the tenant without saving it.
+func (ct *ContentTypeController) ValidateBlockContent(c *gin.Context) {
+	tenantID, err := ct.ParseUIntParam(c, "tenantId")
+	if err != nil {
+		ct.logger.Error("Failed to parse tenant ID", "error", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid tenant ID"})
+		return
+	}
+
+	var req dto.ValidateBlockContentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		ct.logger.Error("Failed to bind JSON to validate block content request", "error", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := ct.contentTypeUseCase.ValidateBlockContent(uint64(tenantID), req); err != nil {
+		ct.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": gin.H{"valid": true}})
+}
+
+// parseScope parses the optional tenant ID from the route, writing a 400 response when invalid.
+// A nil tenant ID addresses the global content types.
+func (ct *ContentTypeController) parseScope(c *gin.Context) (*uint64, bool) {
+	if c.Param("tenantId") == "" {
+		return nil, true
+	}
+
+	tenantID, err := ct.ParseUIntParam(c, "tenantId")
+	if err != nil {
+		ct.logger.Error("Failed to parse tenant ID", "error", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid tenant ID"})
+		return nil, false
+	}
+
+	id := uint64(tenantID)
+	return &id, true
+}
+
+// parseContentTypeParams parses the scope and the content type ID from the route, writing a 400 response when invalid.
+func (ct *ContentTypeController) parseContentTypeParams(c *gin.Context) (*uint64, uint64, bool) {
+	tenantID, ok := ct.parseScope(c)
+	if !ok {
+		return nil, 0, false
+	}
+
+	id, err := ct.ParseUIntParam(c, "contentTypeId")
+	if err != nil {
+		ct.logger.Error("Failed to parse content type ID", "error", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid content type ID"})
+		return nil, 0, false
+	}
+
+	return tenantID, uint64(id), true
+}
@@ -0,0 +1,250 @@
+package controllers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"github.com/gin-gonic/gin"
+	"github.com/h4rdc0m/aurora-api/application/dto"
+	"github.com/h4rdc0m/aurora-api/application/use_cases"
+	"github.com/h4rdc0m/aurora-api/domain/common"
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"github.com/h4rdc0m/aurora-api/domain/errors"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// previewTokenHeader is the request header carrying a preview token
+const previewTokenHeader = "X-Preview-Token"
+
+// DeliveryController handles the public, unauthenticated requests for published content.
+type DeliveryController struct {
+	BaseController
+	deliveryUseCase *use_cases.DeliveryUseCase
+	logger          common.Logger
+}
+
+// NewDeliveryController creates a new instance of DeliveryController with the provided use case and logger.
+func NewDeliveryController(deliveryUseCase *use_cases.DeliveryUseCase, logger common.Logger) *DeliveryController {
+	return &DeliveryController{
+		deliveryUseCase: deliveryUseCase,
+		logger:          logger,
+	}
+}
+
+// GetPage retrieves the published page at the "path" query parameter of the site serving the request host.
+// The locale is taken from a locale prefix of the path, the "locale" query parameter or the Accept-Language header.
+// A preview token in the X-Preview-Token header or the "preview" query parameter delivers unpublished content;
+// such responses are never cached or indexed.
+func (d *DeliveryController) GetPage(c *gin.Context) {
+	c.Header("Vary", "Accept-Language, "+previewTokenHeader)
+	previewToken := c.GetHeader(previewTokenHeader)
+	if previewToken == "" {
+		previewToken = c.Query("preview")
+	}
+
+	page, err := d.deliveryUseCase.GetPage(requestHost(c), c.DefaultQuery("path", "/"), c.Query("locale"), c.GetHeader("Accept-Language"), previewToken)
+	if err != nil {
+		d.logger.Debug("Failed to deliver page", "host", c.Request.Host, "path", c.Query("path"), "error", err)
+		d.HandleError(c, err)
+		return
+	}
+
+	if page.IsRedirect() {
+		d.writeRedirect(c, page.Redirect)
+		return
+	}
+
+	if page.Preview {
+		c.Header("Cache-Control", "private, no-store")
+		c.Header("X-Robots-Tag", "noindex, nofollow")
+	}
+	c.Header("Content-Language", string(page.ContentLocale()))
+	c.JSON(http.StatusOK, gin.H{"data": dto.NewDeliveredPageResponse(page)})
+}
+
+// writeRedirect answers with the status code of a redirect. The Location header holds the public location of the
+// target, which frontends pass on to the visitor rather than follow against this API. Gone redirects have no location.
+func (d *DeliveryController) writeRedirect(c *gin.Context, redirect *entities.DeliveredRedirect) {
+	if redirect.StatusCode == entities.RedirectStatusGone {
+		c.JSON(http.StatusGone, gin.H{"error": "page has been removed"})
+		return
+	}
+
+	c.Header("Location", redirect.Location)
+	c.JSON(redirect.StatusCode, gin.H{"data": dto.NewDeliveredRedirectResponse(redirect)})
+}
+
+// GetSearch searches the published pages of the site serving the request host for the "q" query parameter.
+// The locale is taken from the "locale" query parameter or the Accept-Language header. The "page" and "per_page"
+// query parameters select the page of hits.
+func (d *DeliveryController) GetSearch(c *gin.Context) {
+	c.Header("Vary", "Accept-Language")
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid page"})
+		return
+	}
+	perPage, err := strconv.Atoi(c.DefaultQuery("per_page", "0"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid per_page"})
+		return
+	}
+
+	result, err := d.deliveryUseCase.Search(requestHost(c), c.Query("q"), c.Query("locale"), c.GetHeader("Accept-Language"), page, perPage)
+	if err != nil {
+		d.logger.Debug("Failed to search pages", "host", c.Request.Host, "error", err)
+		d.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": dto.NewSearchResultResponse(result)})
+}
+
+// GetSitemap serves the sitemap of the site serving the request host, as a sitemap index when it is too large
+// for a single file.
+func (d *DeliveryController) GetSitemap(c *gin.Context) {
+	sitemap, err := d.deliveryUseCase.GetSitemap(requestHost(c))
+	if err != nil {
+		d.logger.Debug("Failed to build sitemap", "host", c.Request.Host, "error", err)
+		d.HandleError(c, err)
+		return
+	}
+
+	if sitemap.IsIndex() {
+		d.writeXML(c, dto.NewSitemapIndexResponse(sitemap))
+		return
+	}
+	d.writeXML(c, dto.NewSitemapURLSetResponse(sitemap.Site, sitemap.URLs))
+}
+
+// GetSitemapPart serves a numbered part of a sitemap index, e.g. "/sitemaps/2.xml".
+func (d *DeliveryController) GetSitemapPart(c *gin.Context) {
+	number, err := strconv.Atoi(strings.TrimSuffix(c.Param("part"), ".xml"))
+	if err != nil {
+		d.HandleError(c, errors.ErrSitemapNotFound)
+		return
+	}
+
+	part, err := d.deliveryUseCase.GetSitemapPart(requestHost(c), number)
+	if err != nil {
+		d.logger.Debug("Failed to build sitemap part", "host", c.Request.Host, "part", number, "error", err)
+		d.HandleError(c, err)
+		return
+	}
+
+	d.writeXML(c, dto.NewSitemapURLSetResponse(part.Site, part.URLs))
+}
+
+// GetRobotsTxt serves the robots.txt of the site serving the request host.
+func (d *DeliveryController) GetRobotsTxt(c *gin.Context) {
+	robots, err := d.deliveryUseCase.GetRobotsTxt(requestHost(c))
+	if err != nil {
+		d.logger.Debug("Failed to get robots.txt", "host", c.Request.Host, "error", err)
+		d.HandleError(c, err)
+		return
+	}
+
+	c.String(http.StatusOK, robots)
+}
+
+// GetRSSFeed serves the RSS 2.0 feed of the feed root page at the "path" query parameter.
+func (d *DeliveryController) GetRSSFeed(c *gin.Context) {
+	d.serveFeed(c, "application/rss+xml; charset=utf-8", func(feed *entities.Feed) any {
+		return dto.NewRSSFeedResponse(feed)
+	})
+}
+
+// GetAtomFeed serves the Atom feed of the feed root page at the "path" query parameter.
+func (d *DeliveryController) GetAtomFeed(c *gin.Context) {
+	d.serveFeed(c, "application/atom+xml; charset=utf-8", func(feed *entities.Feed) any {
+		return dto.NewAtomFeedResponse(feed)
+	})
+}
+
+// serveFeed writes a feed in the given representation. The ETag is derived from the document and the
+// Last-Modified time from the feed, answering conditional requests with 304 Not Modified.
+func (d *DeliveryController) serveFeed(c *gin.Context, contentType string, represent func(feed *entities.Feed) any) {
+	c.Header("Vary", "Accept-Language")
+	feed, err := d.deliveryUseCase.GetFeed(requestHost(c), c.Query("path"), c.Query("locale"), c.GetHeader("Accept-Language"))
+	if err != nil {
+		d.logger.Debug("Failed to build feed", "host", c.Request.Host, "path", c.Query("path"), "error", err)
+		d.HandleError(c, err)
+		return
+	}
+
+	body, err := marshalXML(represent(feed))
+	if err != nil {
+		d.logger.Error("Failed to marshal feed", "error", err)
+		d.HandleError(c, err)
+		return
+	}
+
+	sum := sha256.Sum256(body)
+	etag := `"` + hex.EncodeToString(sum[:16]) + `"`
+	lastModified := feed.UpdatedAt().UTC().Truncate(time.Second)
+	c.Header("ETag", etag)
+	c.Header("Last-Modified", lastModified.Format(http.TimeFormat))
+	c.Header("Content-Language", string(feed.Version.Locale()))
+
+	if notModified(c.Request, etag, lastModified) {
+		c.Status(http.StatusNotModified)
+		return
+	}
+
+	c.Data(http.StatusOK, contentType, body)
+}
+
+// writeXML writes the value as an XML document including the XML declaration
+func (d *DeliveryController) writeXML(c *gin.Context, value any) {
+	body, err := marshalXML(value)
+	if err != nil {
+		d.logger.Error("Failed to marshal XML response", "error", err)
+		d.HandleError(c, err)
+		return
+	}
+
+	c.Data(http.StatusOK, "application/xml; charset=utf-8", body)
+}
+
+// marshalXML encodes the value as an XML document including the XML declaration
+func marshalXML(value any) ([]byte, error) {
+	body, err := xml.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), body...), nil
+}
+
+// notModified evaluates the conditional headers of a GET request. If-None-Match takes precedence over
+// If-Modified-Since, as required by RFC 9110.
+func notModified(r *http.Request, etag string, lastModified time.Time) bool {
+	if match := r.Header.Get("If-None-Match"); match != "" {
+		for _, candidate := range strings.Split(match, ",") {
+			candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+			if candidate == "*" || candidate == etag {
+				return true
+			}
+		}
+		return false
+	}
+
+	if since := r.Header.Get("If-Modified-Since"); since != "" {
+		if t, err := http.ParseTime(since); err == nil {
+			return !lastModified.After(t)
+		}
+	}
+	return false
+}
+
+// requestHost returns the host the request was sent to, without its port
+func requestHost(c *gin.Context) string {
+	host := c.Request.Host
+	if name, _, err := net.SplitHostPort(host); err == nil {
+		host = name
+	}
+	return host
+}
@@ -0,0 +1,387 @@
+package controllers
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/h4rdc0m/aurora-api/application/use_cases"
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"github.com/h4rdc0m/aurora-api/domain/value_objects"
+	"github.com/h4rdc0m/aurora-api/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+type deliveryTestRepos struct {
+	sites     *mocks.MockSiteRepository
+	pages     *mocks.MockPageRepository
+	versions  *mocks.MockPageVersionRepository
+	blocks    *mocks.MockPageBlockRepository
+	redirects *mocks.MockRedirectRepository
+	resolver  *mocks.MockPageResolver
+	snippets  *mocks.MockSnippetService
+}
+
+// newTestLogger creates a logger mock accepting messages with up to five key-value pairs at every level
+func newTestLogger() *mocks.Logger {
+	logger := &mocks.Logger{}
+	for _, level := range []string{"Debug", "Info", "Warn", "Error"} {
+		args := []interface{}{mock.Anything}
+		for i := 0; i <= 10; i++ {
+			logger.On(level, args...).Return()
+			args = append(args, mock.Anything)
+		}
+	}
+	return logger
+}
+
+// newTestDeliveryRouter routes the delivery endpoints to a controller backed by repository mocks
+func newTestDeliveryRouter() (*gin.Engine, deliveryTestRepos) {
+	gin.SetMode(gin.TestMode)
+	repos := deliveryTestRepos{
+		sites:     &mocks.MockSiteRepository{},
+		pages:     &mocks.MockPageRepository{},
+		versions:  &mocks.MockPageVersionRepository{},
+		blocks:    &mocks.MockPageBlockRepository{},
+		redirects: &mocks.MockRedirectRepository{},
+		resolver:  &mocks.MockPageResolver{},
+		snippets:  &mocks.MockSnippetService{},
+	}
+	logger := newTestLogger()
+	useCase := use_cases.NewDeliveryUseCase(repos.sites, repos.pages, repos.versions, repos.blocks, repos.redirects, repos.resolver, repos.snippets, nil, nil, logger)
+	controller := NewDeliveryController(useCase, logger)
+
+	router := gin.New()
+	router.GET("/delivery/page", controller.GetPage)
+	router.GET("/delivery/feeds/rss", controller.GetRSSFeed)
+	router.GET("/delivery/feeds/atom", controller.GetAtomFeed)
+	router.GET("/sitemap.xml", controller.GetSitemap)
+	router.GET("/sitemaps/:part", controller.GetSitemapPart)
+	return router, repos
+}
+
+// newDeliverySite creates an enabled site serving the domain and registers it with the repository mock
+func (r deliveryTestRepos) newDeliverySite(t *testing.T, host string) *entities.Site {
+	domain, err := value_objects.NewDomainName(host)
+	assert.NoError(t, err)
+	site, err := entities.NewSite("Site", nil, domain, entities.NewTemplateID(1), entities.NewTenantID(1))
+	assert.NoError(t, err)
+	assert.NoError(t, site.SetID(entities.NewSiteID(1)))
+	r.sites.On("FindByDomain", site.Domain()).Return(site, nil)
+	return site
+}
+
+// newDeliveryPage creates a persisted root page of the site at the path
+func newDeliveryPage(t *testing.T, id uint64, site *entities.Site, pagePath string) *entities.Page {
+	key, err := value_objects.NewPageKey("page")
+	assert.NoError(t, err)
+	page, err := entities.NewPage(key, &pagePath, site.ID(), entities.PageTypeContent)
+	assert.NoError(t, err)
+	page.SetID(entities.NewPageID(id))
+	return page
+}
+
+// publish registers a version of the page published in the default locale
+func (r deliveryTestRepos) publish(t *testing.T, page *entities.Page, title string) *entities.PageVersion {
+	version, err := entities.NewPageVersion(page.ID(), 1, entities.DefaultLocale, title, nil)
+	assert.NoError(t, err)
+	version.SetID(entities.NewPageVersionID(page.ID().Value()))
+	version.SetStatus(entities.PageVersionStatusPublished, nil, nil)
+
+	r.versions.On("FindPublishedByPageID", page.ID(), entities.DefaultLocale).Return(version, nil)
+	r.versions.On("FindByPageID", page.ID()).Return([]*entities.PageVersion{version}, nil)
+	r.blocks.On("FindByPageVersionID", version.ID()).Return([]*entities.PageBlock{}, nil)
+	r.snippets.On("Render", page, entities.DefaultLocale, []*entities.PageBlock{}).Return([]*entities.RenderedBlock{}, nil)
+	r.resolver.On("Resolve", page).Return(&entities.ResolvedPage{Page: page, Chain: []entities.PageID{page.ID()}}, nil)
+	return version
+}
+
+func TestDeliveryController_GetPage(t *testing.T) {
+	t.Run("unknown host is not found", func(t *testing.T) {
+		router, repos := newTestDeliveryRouter()
+		repos.sites.On("FindByDomain", mock.Anything).Return(nil, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/delivery/page?path=/", nil)
+		req.Host = "unknown.example.com:8080"
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("disabled host is not found", func(t *testing.T) {
+		router, repos := newTestDeliveryRouter()
+		site := repos.newDeliverySite(t, "example.com")
+		site.Disable()
+
+		req := httptest.NewRequest(http.MethodGet, "/delivery/page?path=/", nil)
+		req.Host = "example.com"
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("delivers the published page at the path", func(t *testing.T) {
+		router, repos := newTestDeliveryRouter()
+		site := repos.newDeliverySite(t, "example.com")
+		page := newDeliveryPage(t, 1, site, "/about")
+		repos.pages.On("FindByPath", "/about", site.ID()).Return(page, nil)
+		repos.publish(t, page, "About us")
+
+		req := httptest.NewRequest(http.MethodGet, "/delivery/page?path=/about/", nil)
+		req.Host = "example.com"
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "en", w.Header().Get("Content-Language"))
+		assert.Contains(t, w.Body.String(), "About us")
+	})
+
+	t.Run("snippet requested directly is not found", func(t *testing.T) {
+		router, repos := newTestDeliveryRouter()
+		site := repos.newDeliverySite(t, "example.com")
+		key, err := value_objects.NewPageKey("footer")
+		assert.NoError(t, err)
+		snippetPath := "/footer"
+		snippet, err := entities.NewPage(key, &snippetPath, site.ID(), entities.PageTypeSnippet)
+		assert.NoError(t, err)
+		snippet.SetID(entities.NewPageID(1))
+		repos.pages.On("FindByPath", "/footer", site.ID()).Return(snippet, nil)
+		repos.resolver.On("Resolve", snippet).Return(&entities.ResolvedPage{Page: snippet, Chain: []entities.PageID{snippet.ID()}}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/delivery/page?path=/footer", nil)
+		req.Host = "example.com"
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("redirect answers with its status and location", func(t *testing.T) {
+		router, repos := newTestDeliveryRouter()
+		site := repos.newDeliverySite(t, "example.com")
+		target := newDeliveryPage(t, 2, site, "/new")
+		redirect, err := entities.NewPageRedirect(site.ID(), "/old", target.ID())
+		assert.NoError(t, err)
+		repos.pages.On("FindByPath", "/old", site.ID()).Return(nil, nil)
+		repos.pages.On("FindByID", target.ID()).Return(target, nil)
+		repos.redirects.On("FindBySourcePath", site.ID(), "/old").Return(redirect, nil)
+		repos.redirects.On("RecordHit", mock.Anything).Return(nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/delivery/page?path=/old", nil)
+		req.Host = "example.com"
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusMovedPermanently, w.Code)
+		assert.Equal(t, "/new", w.Header().Get("Location"))
+	})
+}
+
+// newDeliveryFeed registers a published feed root at /news of the site with one published child per update time
+func (r deliveryTestRepos) newDeliveryFeed(t *testing.T, site *entities.Site, rootUpdatedAt time.Time, itemsUpdatedAt ...time.Time) {
+	root := newDeliveryPage(t, 1, site, "/news")
+	assert.NoError(t, root.SetFeedRoot(true))
+	r.pages.On("FindByPath", "/news", site.ID()).Return(root, nil)
+	r.publishAt(t, root, rootUpdatedAt)
+
+	children := make([]*entities.Page, 0, len(itemsUpdatedAt))
+	for i, updatedAt := range itemsUpdatedAt {
+		child := newDeliveryPage(t, uint64(i+2), site, "/news/item")
+		r.publishAt(t, child, updatedAt)
+		children = append(children, child)
+	}
+	r.pages.On("FindChildrenByParentID", root.ID()).Return(children, nil)
+}
+
+// publishAt registers a version of the page published and last updated at the given time
+func (r deliveryTestRepos) publishAt(t *testing.T, page *entities.Page, updatedAt time.Time) {
+	version, err := entities.NewPageVersion(page.ID(), 1, entities.DefaultLocale, "News", nil)
+	assert.NoError(t, err)
+	version.SetID(entities.NewPageVersionID(page.ID().Value()))
+	version.SetStatus(entities.PageVersionStatusPublished, nil, &updatedAt)
+	version.SetTimestamps(updatedAt, updatedAt)
+	r.versions.On("FindPublishedByPageID", page.ID(), entities.DefaultLocale).Return(version, nil)
+}
+
+// getFeed requests the RSS feed at /news of example.com with the given request headers
+func getFeed(router *gin.Engine, headers map[string]string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, "/delivery/feeds/rss?path=/news", nil)
+	req.Host = "example.com"
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestDeliveryController_GetFeed(t *testing.T) {
+	rootUpdatedAt := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	newestUpdatedAt := time.Date(2025, 6, 3, 8, 30, 15, 500000000, time.UTC)
+	lastModified := "Tue, 03 Jun 2025 08:30:15 GMT"
+
+	newFeedRouter := func(t *testing.T) *gin.Engine {
+		router, repos := newTestDeliveryRouter()
+		site := repos.newDeliverySite(t, "example.com")
+		repos.newDeliveryFeed(t, site, rootUpdatedAt, rootUpdatedAt.Add(time.Hour), newestUpdatedAt, rootUpdatedAt.Add(2*time.Hour))
+		return router
+	}
+
+	t.Run("serves the feed with validators", func(t *testing.T) {
+		w := getFeed(newFeedRouter(t), nil)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "application/rss+xml; charset=utf-8", w.Header().Get("Content-Type"))
+		assert.Regexp(t, `^"[0-9a-f]{32}"$`, w.Header().Get("ETag"))
+		assert.Equal(t, lastModified, w.Header().Get("Last-Modified"))
+		assert.Equal(t, "Accept-Language", w.Header().Get("Vary"))
+		assert.Contains(t, w.Body.String(), "<rss")
+	})
+
+	t.Run("serves the atom representation", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/delivery/feeds/atom?path=/news", nil)
+		req.Host = "example.com"
+		w := httptest.NewRecorder()
+		newFeedRouter(t).ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "application/atom+xml; charset=utf-8", w.Header().Get("Content-Type"))
+		assert.Equal(t, lastModified, w.Header().Get("Last-Modified"))
+		assert.Contains(t, w.Body.String(), "<feed")
+	})
+
+	etag := getFeed(newFeedRouter(t), nil).Header().Get("ETag")
+	testCases := []struct {
+		name     string
+		headers  map[string]string
+		expected int
+	}{
+		{name: "matching etag", headers: map[string]string{"If-None-Match": etag}, expected: http.StatusNotModified},
+		{name: "weak etag", headers: map[string]string{"If-None-Match": "W/" + etag}, expected: http.StatusNotModified},
+		{name: "etag in a list", headers: map[string]string{"If-None-Match": `"other", W/"stale",` + etag}, expected: http.StatusNotModified},
+		{name: "wildcard", headers: map[string]string{"If-None-Match": "*"}, expected: http.StatusNotModified},
+		{name: "other etag", headers: map[string]string{"If-None-Match": `"other", W/"stale"`}, expected: http.StatusOK},
+		{name: "matching etag wins over an older date", headers: map[string]string{
+			"If-None-Match":     etag,
+			"If-Modified-Since": "Mon, 02 Jun 2025 00:00:00 GMT",
+		}, expected: http.StatusNotModified},
+		{name: "other etag wins over a current date", headers: map[string]string{
+			"If-None-Match":     `"other"`,
+			"If-Modified-Since": lastModified,
+		}, expected: http.StatusOK},
+		{name: "unchanged since the date", headers: map[string]string{"If-Modified-Since": lastModified}, expected: http.StatusNotModified},
+		{name: "changed since the date", headers: map[string]string{"If-Modified-Since": "Tue, 03 Jun 2025 08:30:14 GMT"}, expected: http.StatusOK},
+		{name: "invalid date", headers: map[string]string{"If-Modified-Since": "yesterday"}, expected: http.StatusOK},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := getFeed(newFeedRouter(t), tc.headers)
+
+			assert.Equal(t, tc.expected, w.Code)
+			assert.Equal(t, etag, w.Header().Get("ETag"))
+			assert.Equal(t, lastModified, w.Header().Get("Last-Modified"))
+			if tc.expected == http.StatusNotModified {
+				assert.Empty(t, w.Body.Bytes())
+			} else {
+				assert.NotEmpty(t, w.Body.Bytes())
+			}
+		})
+	}
+
+	t.Run("page that is no feed root is not found", func(t *testing.T) {
+		router, repos := newTestDeliveryRouter()
+		site := repos.newDeliverySite(t, "example.com")
+		page := newDeliveryPage(t, 1, site, "/news")
+		repos.pages.On("FindByPath", "/news", site.ID()).Return(page, nil)
+
+		w := getFeed(router, nil)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestDeliveryController_GetSitemapPart(t *testing.T) {
+	lastModified := time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name     string
+		part     string
+		offset   uint64
+		pages    []entities.SitemapPage
+		expected int
+	}{
+		{name: "existing part", part: "2.xml", offset: entities.SitemapMaxURLs, pages: []entities.SitemapPage{{Path: "/zoo", Locale: entities.DefaultLocale, LastModified: lastModified}}, expected: http.StatusOK},
+		{name: "part beyond the end", part: "3.xml", offset: 2 * entities.SitemapMaxURLs, pages: []entities.SitemapPage{}, expected: http.StatusNotFound},
+		{name: "part zero", part: "0.xml", expected: http.StatusNotFound},
+		{name: "part that is no number", part: "latest.xml", expected: http.StatusNotFound},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			router, repos := newTestDeliveryRouter()
+			site := repos.newDeliverySite(t, "example.com")
+			repos.versions.On("FindSitemapPages", site.ID(), site.Locales(), tc.offset, uint64(entities.SitemapMaxURLs)).Return(tc.pages, nil)
+
+			req := httptest.NewRequest(http.MethodGet, "/sitemaps/"+tc.part, nil)
+			req.Host = "example.com"
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tc.expected, w.Code)
+			if tc.expected == http.StatusOK {
+				assert.Equal(t, "application/xml; charset=utf-8", w.Header().Get("Content-Type"))
+				assert.Contains(t, w.Body.String(), "<loc>https://example.com/zoo</loc>")
+				assert.Contains(t, w.Body.String(), "<lastmod>2025-07-01T00:00:00Z</lastmod>")
+			}
+		})
+	}
+}
+
+func TestDeliveryController_GetSitemap(t *testing.T) {
+	lastModified := time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("large site is served as an index of its parts", func(t *testing.T) {
+		router, repos := newTestDeliveryRouter()
+		site := repos.newDeliverySite(t, "example.com")
+		repos.versions.On("FindSitemapParts", site.ID(), site.Locales(), uint64(entities.SitemapMaxURLs)).Return([]entities.SitemapPartSummary{
+			{Number: 1, URLCount: entities.SitemapMaxURLs, LastModified: lastModified},
+			{Number: 2, URLCount: 3, LastModified: lastModified.Add(time.Hour)},
+		}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/sitemap.xml", nil)
+		req.Host = "example.com"
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), "<sitemapindex")
+		assert.Contains(t, w.Body.String(), "<loc>https://example.com/sitemaps/2.xml</loc><lastmod>2025-07-01T01:00:00Z</lastmod>")
+		repos.versions.AssertNotCalled(t, "FindSitemapPages", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("small site is served as a single file", func(t *testing.T) {
+		router, repos := newTestDeliveryRouter()
+		site := repos.newDeliverySite(t, "example.com")
+		repos.versions.On("FindSitemapParts", site.ID(), site.Locales(), uint64(entities.SitemapMaxURLs)).Return([]entities.SitemapPartSummary{
+			{Number: 1, URLCount: 1, LastModified: lastModified},
+		}, nil)
+		repos.versions.On("FindSitemapPages", site.ID(), site.Locales(), uint64(0), uint64(entities.SitemapMaxURLs)).Return([]entities.SitemapPage{
+			{Path: "/about", Locale: entities.DefaultLocale, LastModified: lastModified},
+		}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/sitemap.xml", nil)
+		req.Host = "example.com"
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), "<urlset")
+		assert.Contains(t, w.Body.String(), "<loc>https://example.com/about</loc>")
+	})
+}
@@ -0,0 +1,50 @@
+package controllers
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/h4rdc0m/aurora-api/application/dto"
+	"github.com/h4rdc0m/aurora-api/application/use_cases"
+	"github.com/h4rdc0m/aurora-api/domain/common"
+	"net/http"
+)
+
+// LinkReportController handles HTTP requests for the broken link reports of sites.
+type LinkReportController struct {
+	BaseController
+	linkCheckUseCase *use_cases.LinkCheckUseCase
+	logger           common.Logger
+}
+
+// NewLinkReportController creates a new instance of LinkReportController with the provided use case and logger.
+func NewLinkReportController(linkCheckUseCase *use_cases.LinkCheckUseCase, logger common.Logger) *LinkReportController {
+	return &LinkReportController{
+		linkCheckUseCase: linkCheckUseCase,
+		logger:           logger,
+	}
+}
+
+// GetLinkReport retrieves the broken links found by the last link check of a site.
+func (l *LinkReportController) GetLinkReport(c *gin.Context) {
+	tenantID, err := l.ParseUIntParam(c, "tenantId")
+	if err != nil {
+		l.logger.Error("Failed to parse tenant ID", "error", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid tenant ID"})
+		return
+	}
+
+	siteID, err := l.ParseUIntParam(c, "siteId")
+	if err != nil {
+		l.logger.Error("Failed to parse site ID", "error", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid site ID"})
+		return
+	}
+
+	links, err := l.linkCheckUseCase.GetReport(uint64(tenantID), uint64(siteID))
+	if err != nil {
+		l.logger.Error("Failed to get link report", "error", err)
+		l.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": dto.NewBrokenLinkResponses(links)})
+}
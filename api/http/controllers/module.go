@@ -4,6 +4,16 @@ import "go.uber.org/fx"
 
 var Module = fx.Options(
 	fx.Provide(NewHealthController),
+	fx.Provide(NewAuditController),
 	fx.Provide(NewAuthController),
+	fx.Provide(NewContentTypeController),
+	fx.Provide(NewDeliveryController),
 	fx.Provide(NewTenantController),
+	fx.Provide(NewLinkReportController),
+	fx.Provide(NewPageController),
+	fx.Provide(NewPageVersionController),
+	fx.Provide(NewPreviewTokenController),
+	fx.Provide(NewRedirectController),
+	fx.Provide(NewSiteController),
+	fx.Provide(NewTrashController),
 )
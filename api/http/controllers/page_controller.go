@@ -1,136 +1,295 @@
 package controllers
 
-//
-//import (
-//	"aurora-api/internal/dto"
-//	"aurora-api/internal/services"
-//	"aurora-api/internal/utils/httpx"
-//	"github.com/gin-gonic/gin"
-//	"github.com/go-playground/validator"
-//	"net/http"
-//)
-//
-//type PageController struct {
-//	pageService services.PageService
-//	validate    *validator.Validate
-//}
-//
-//func NewPageController(pageService services.PageService, group *gin.RouterGroup) *PageController {
-//	c := &PageController{
-//		pageService: pageService,
-//		validate:    validator.New(),
-//	}
-//	c.registerRoutes(group)
-//	return c
-//}
-//
-//func (c *PageController) registerRoutes(r *gin.RouterGroup) {
-//	pageApi := r.Group("/pages")
-//	pageApi.GET("", c.GetPages)
-//	pageApi.GET("/:id", c.GetPage)
-//	pageApi.POST("", c.CreatePage)
-//	pageApi.PUT("/:id", c.UpdatePage)
-//	pageApi.DELETE("/:id", c.DeletePage)
-//}
-//
-//func (c *PageController) GetPages(ctx *gin.Context) {
-//	// Logic to get pages
-//	pages, err := c.pageService.GetAll(ctx.Request.Context())
-//	if err != nil {
-//		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-//		return
-//	}
-//
-//	ctx.JSON(http.StatusOK, gin.H{"pages": pages})
-//}
-//
-//func (c *PageController) GetPage(ctx *gin.Context) {
-//	id, err := httpx.ParseIntID(ctx)
-//	if err != nil {
-//		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid page ID"})
-//		return
-//	}
-//
-//	page, err := c.pageService.GetByID(ctx.Request.Context(), id)
-//	if err != nil {
-//		httpx.HandleError(ctx, err)
-//		return
-//	}
-//
-//	ctx.JSON(http.StatusOK, page)
-//}
-//
-//func (c *PageController) CreatePage(ctx *gin.Context) {
-//	// Logic to create a page
-//	var input dto.CreatePageRequest
-//	if err := ctx.ShouldBindJSON(&input); err != nil {
-//		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input"})
-//		return
-//	}
-//
-//	// Validate the input
-//	if err := c.validate.Struct(&input); err != nil {
-//		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Validation failed", "details": err.Error()})
-//		return
-//	}
-//
-//	if err := c.pageService.Create(ctx.Request.Context(), &input); err != nil {
-//		httpx.HandleError(ctx, err)
-//		return
-//	}
-//
-//	ctx.Status(http.StatusCreated)
-//}
-//
-//func (c *PageController) UpdatePage(ctx *gin.Context) {
-//	id, err := httpx.ParseIntID(ctx)
-//	if err != nil {
-//		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid page ID"})
-//		return
-//	}
-//
-//	// Validate the ID
-//	if id <= 0 {
-//		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid page ID"})
-//		return
-//	}
-//
-//	var input dto.UpdatePageRequest
-//	if err := ctx.ShouldBindJSON(&input); err != nil {
-//		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input"})
-//		return
-//	}
-//	// Validate the input
-//	if err := c.validate.Struct(&input); err != nil {
-//		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Validation failed", "details": err.Error()})
-//		return
-//	}
-//
-//	if err := c.pageService.Update(ctx.Request.Context(), &input); err != nil {
-//		httpx.HandleError(ctx, err)
-//		return
-//	}
-//
-//	ctx.Status(http.StatusNoContent)
-//}
-//
-//func (c *PageController) DeletePage(ctx *gin.Context) {
-//	id, err := httpx.ParseIntID(ctx)
-//	if err != nil {
-//		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid page ID"})
-//		return
-//	}
-//
-//	// Validate the ID
-//	if c.validate.Var(id, "gt=0") != nil {
-//		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid page ID"})
-//		return
-//	}
-//
-//	if err := c.pageService.Delete(ctx.Request.Context(), id); err != nil {
-//		httpx.HandleError(ctx, err)
-//		return
-//	}
-//
-//	ctx.Status(http.StatusNoContent)
-//}
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/h4rdc0m/aurora-api/application/dto"
+	"github.com/h4rdc0m/aurora-api/application/use_cases"
+	"github.com/h4rdc0m/aurora-api/constants"
+	"github.com/h4rdc0m/aurora-api/domain/common"
+	"github.com/jmoiron/sqlx"
+	"net/http"
+)
+
+// PageController handles HTTP requests related to the page tree of a site.
+type PageController struct {
+	BaseController
+	pageUseCase *use_cases.PageUseCase
+	logger      common.Logger
+}
+
+// NewPageController creates a new instance of PageController with the provided use case and logger.
+func NewPageController(pageUseCase *use_cases.PageUseCase, logger common.Logger) *PageController {
+	return &PageController{
+		pageUseCase: pageUseCase,
+		logger:      logger,
+	}
+}
+
+// GetPageTree retrieves all pages of a site as a nested tree.
+func (p *PageController) GetPageTree(c *gin.Context) {
+	tenantID, siteID, ok := p.parseSiteParams(c)
+	if !ok {
+		return
+	}
+
+	pages, err := p.pageUseCase.GetPageTree(tenantID, siteID)
+	if err != nil {
+		p.logger.Error("Failed to get page tree", "error", err)
+		p.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": dto.NewPageResponses(pages)})
+}
+
+// GetPage retrieves a single page including its versions.
+func (p *PageController) GetPage(c *gin.Context) {
+	tenantID, siteID, ok := p.parseSiteParams(c)
+	if !ok {
+		return
+	}
+
+	pageID, ok := p.parsePageParam(c)
+	if !ok {
+		return
+	}
+
+	page, err := p.pageUseCase.GetPage(tenantID, siteID, pageID)
+	if err != nil {
+		p.logger.Error("Failed to get page", "error", err)
+		p.HandleError(c, err)
+		return
+	}
+
+	p.SetETag(c, page.Revision())
+	c.JSON(http.StatusOK, gin.H{"data": dto.NewPageResponse(page)})
+}
+
+// ResolvePage follows the hard links of a page to the page whose content it shows.
+func (p *PageController) ResolvePage(c *gin.Context) {
+	tenantID, siteID, ok := p.parseSiteParams(c)
+	if !ok {
+		return
+	}
+
+	pageID, ok := p.parsePageParam(c)
+	if !ok {
+		return
+	}
+
+	resolved, err := p.pageUseCase.ResolvePage(tenantID, siteID, pageID)
+	if err != nil {
+		p.logger.Error("Failed to resolve page", "error", err)
+		p.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": dto.NewResolvedPageResponse(resolved)})
+}
+
+// GetSnippetUsages lists the page versions whose blocks embed the snippet page.
+func (p *PageController) GetSnippetUsages(c *gin.Context) {
+	tenantID, siteID, ok := p.parseSiteParams(c)
+	if !ok {
+		return
+	}
+
+	pageID, ok := p.parsePageParam(c)
+	if !ok {
+		return
+	}
+
+	usages, err := p.pageUseCase.GetSnippetUsages(tenantID, siteID, pageID)
+	if err != nil {
+		p.logger.Error("Failed to get snippet usages", "error", err)
+		p.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": dto.NewSnippetUsageResponses(usages)})
+}
+
+// CreatePage creates a new page in a site.
+func (p *PageController) CreatePage(c *gin.Context) {
+	tenantID, siteID, ok := p.parseSiteParams(c)
+	if !ok {
+		return
+	}
+
+	var req dto.CreatePageRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		p.logger.Error("Failed to bind JSON to page request", "error", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	page, err := p.useCase(c).CreatePage(tenantID, siteID, req)
+	if err != nil {
+		p.logger.Error("Failed to create page", "error", err)
+		p.HandleError(c, err)
+		return
+	}
+
+	p.SetETag(c, page.Revision())
+	c.JSON(http.StatusCreated, gin.H{"data": dto.NewPageResponse(page)})
+}
+
+// UpdatePage updates an existing page.
+func (p *PageController) UpdatePage(c *gin.Context) {
+	tenantID, siteID, ok := p.parseSiteParams(c)
+	if !ok {
+		return
+	}
+
+	pageID, ok := p.parsePageParam(c)
+	if !ok {
+		return
+	}
+
+	revision, ok := p.IfMatchRevision(c)
+	if !ok {
+		return
+	}
+
+	var req dto.UpdatePageRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		p.logger.Error("Failed to bind JSON to page request", "error", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	page, err := p.useCase(c).UpdatePage(tenantID, siteID, pageID, revision, req)
+	if err != nil {
+		p.logger.Error("Failed to update page", "error", err)
+		p.HandleError(c, err)
+		return
+	}
+
+	p.SetETag(c, page.Revision())
+	c.JSON(http.StatusOK, gin.H{"data": dto.NewPageResponse(page)})
+}
+
+// DeletePage moves a page together with its subtree to the trash.
+func (p *PageController) DeletePage(c *gin.Context) {
+	tenantID, siteID, ok := p.parseSiteParams(c)
+	if !ok {
+		return
+	}
+
+	pageID, ok := p.parsePageParam(c)
+	if !ok {
+		return
+	}
+
+	revision, ok := p.IfMatchRevision(c)
+	if !ok {
+		return
+	}
+
+	if err := p.useCase(c).DeletePage(tenantID, siteID, pageID, revision); err != nil {
+		p.logger.Error("Failed to delete page", "error", err)
+		p.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": "Page deleted successfully"})
+}
+
+// MovePage moves a page to a new parent, position or site.
+func (p *PageController) MovePage(c *gin.Context) {
+	tenantID, siteID, ok := p.parseSiteParams(c)
+	if !ok {
+		return
+	}
+
+	pageID, ok := p.parsePageParam(c)
+	if !ok {
+		return
+	}
+
+	var req dto.MovePageRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		p.logger.Error("Failed to bind JSON to move page request", "error", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	page, err := p.useCase(c).MovePage(tenantID, siteID, pageID, req)
+	if err != nil {
+		p.logger.Error("Failed to move page", "error", err)
+		p.HandleError(c, err)
+		return
+	}
+
+	p.SetETag(c, page.Revision())
+	c.JSON(http.StatusOK, gin.H{"data": dto.NewPageResponse(page)})
+}
+
+// DuplicatePage copies a page and its subtree below a new parent, optionally into another site.
+func (p *PageController) DuplicatePage(c *gin.Context) {
+	tenantID, siteID, ok := p.parseSiteParams(c)
+	if !ok {
+		return
+	}
+
+	pageID, ok := p.parsePageParam(c)
+	if !ok {
+		return
+	}
+
+	var req dto.DuplicatePageRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		p.logger.Error("Failed to bind JSON to duplicate page request", "error", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	page, err := p.useCase(c).DuplicatePage(tenantID, siteID, pageID, req)
+	if err != nil {
+		p.logger.Error("Failed to duplicate page", "error", err)
+		p.HandleError(c, err)
+		return
+	}
+
+	p.SetETag(c, page.Revision())
+	c.JSON(http.StatusCreated, gin.H{"data": dto.NewPageResponse(page)})
+}
+
+// useCase returns the page use case bound to the transaction of the request, when one is running.
+func (p *PageController) useCase(c *gin.Context) *use_cases.PageUseCase {
+	if trx, exists := c.Get(constants.DBTransaction); exists {
+		return p.pageUseCase.WithTrx(trx.(*sqlx.Tx))
+	}
+	return p.pageUseCase
+}
+
+// parseSiteParams parses the tenant and site IDs from the route, writing a 400 response when invalid.
+func (p *PageController) parseSiteParams(c *gin.Context) (uint64, uint64, bool) {
+	tenantID, err := p.ParseUIntParam(c, "tenantId")
+	if err != nil {
+		p.logger.Error("Failed to parse tenant ID", "error", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid tenant ID"})
+		return 0, 0, false
+	}
+
+	siteID, err := p.ParseUIntParam(c, "siteId")
+	if err != nil {
+		p.logger.Error("Failed to parse site ID", "error", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid site ID"})
+		return 0, 0, false
+	}
+
+	return uint64(tenantID), uint64(siteID), true
+}
+
+// parsePageParam parses the page ID from the route, writing a 400 response when invalid.
+func (p *PageController) parsePageParam(c *gin.Context) (uint64, bool) {
+	pageID, err := p.ParseUIntParam(c, "pageId")
+	if err != nil {
+		p.logger.Error("Failed to parse page ID", "error", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid page ID"})
+		return 0, false
+	}
+
+	return uint64(pageID), true
+}
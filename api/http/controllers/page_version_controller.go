@@ -0,0 +1,477 @@
+package controllers
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/h4rdc0m/aurora-api/application/dto"
+	"github.com/h4rdc0m/aurora-api/application/use_cases"
+	"github.com/h4rdc0m/aurora-api/constants"
+	"github.com/h4rdc0m/aurora-api/domain/common"
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"github.com/jmoiron/sqlx"
+	"net/http"
+	"strconv"
+)
+
+// versionTransition is a workflow step of the page version use case.
+type versionTransition func(uc *use_cases.PageVersionUseCase, tenantID, siteID, pageID, versionID uint64, user *entities.User) (*entities.PageVersion, error)
+
+// leaseChange is a change to the editing lease of a page version.
+type leaseChange func(uc *use_cases.PageVersionUseCase, tenantID, siteID, pageID, versionID uint64, user *entities.User) (*entities.EditingLease, error)
+
+// PageVersionController handles HTTP requests related to the versions of a page and their workflow.
+type PageVersionController struct {
+	BaseController
+	pageVersionUseCase *use_cases.PageVersionUseCase
+	logger             common.Logger
+}
+
+// NewPageVersionController creates a new instance of PageVersionController with the provided use case and logger.
+func NewPageVersionController(pageVersionUseCase *use_cases.PageVersionUseCase, logger common.Logger) *PageVersionController {
+	return &PageVersionController{
+		pageVersionUseCase: pageVersionUseCase,
+		logger:             logger,
+	}
+}
+
+// GetVersions retrieves all versions of a page, or only those in the locale of the "locale" query parameter.
+func (p *PageVersionController) GetVersions(c *gin.Context) {
+	tenantID, siteID, pageID, ok := p.parsePageParams(c)
+	if !ok {
+		return
+	}
+
+	versions, err := p.pageVersionUseCase.GetVersions(tenantID, siteID, pageID, c.Query("locale"))
+	if err != nil {
+		p.logger.Error("Failed to get page versions", "error", err)
+		p.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": dto.NewPageVersionResponses(versions)})
+}
+
+// GetVersion retrieves a single version of a page.
+func (p *PageVersionController) GetVersion(c *gin.Context) {
+	tenantID, siteID, pageID, ok := p.parsePageParams(c)
+	if !ok {
+		return
+	}
+
+	versionID, ok := p.parseVersionParam(c)
+	if !ok {
+		return
+	}
+
+	version, err := p.pageVersionUseCase.GetVersion(tenantID, siteID, pageID, versionID)
+	if err != nil {
+		p.logger.Error("Failed to get page version", "error", err)
+		p.HandleError(c, err)
+		return
+	}
+
+	p.SetETag(c, version.Revision())
+	c.JSON(http.StatusOK, gin.H{"data": dto.NewPageVersionResponse(version)})
+}
+
+// RenderVersion returns the blocks of a version as they are delivered, with embedded snippets inlined.
+func (p *PageVersionController) RenderVersion(c *gin.Context) {
+	tenantID, siteID, pageID, ok := p.parsePageParams(c)
+	if !ok {
+		return
+	}
+
+	versionID, ok := p.parseVersionParam(c)
+	if !ok {
+		return
+	}
+
+	blocks, err := p.pageVersionUseCase.RenderVersion(tenantID, siteID, pageID, versionID)
+	if err != nil {
+		p.logger.Error("Failed to render page version", "error", err)
+		p.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": dto.NewRenderedBlockResponses(blocks)})
+}
+
+// CompareVersions compares a version with the version given by the "from" query parameter,
+// or with the published version when it is omitted.
+func (p *PageVersionController) CompareVersions(c *gin.Context) {
+	tenantID, siteID, pageID, ok := p.parsePageParams(c)
+	if !ok {
+		return
+	}
+
+	versionID, ok := p.parseVersionParam(c)
+	if !ok {
+		return
+	}
+
+	var fromVersionID *uint64
+	if from := c.Query("from"); from != "" {
+		id, err := strconv.ParseUint(from, 10, 64)
+		if err != nil || id == 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid from version ID"})
+			return
+		}
+		fromVersionID = &id
+	}
+
+	diff, err := p.pageVersionUseCase.CompareVersions(tenantID, siteID, pageID, versionID, fromVersionID)
+	if err != nil {
+		p.logger.Error("Failed to compare page versions", "error", err)
+		p.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": dto.NewPageVersionDiffResponse(diff)})
+}
+
+// CreateVersion creates a new draft version of a page.
+func (p *PageVersionController) CreateVersion(c *gin.Context) {
+	tenantID, siteID, pageID, ok := p.parsePageParams(c)
+	if !ok {
+		return
+	}
+
+	var req dto.CreatePageVersionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		p.logger.Error("Failed to bind JSON to page version request", "error", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	version, err := p.useCase(c).CreateVersion(tenantID, siteID, pageID, req)
+	if err != nil {
+		p.logger.Error("Failed to create page version", "error", err)
+		p.HandleError(c, err)
+		return
+	}
+
+	p.SetETag(c, version.Revision())
+	c.JSON(http.StatusCreated, gin.H{"data": dto.NewPageVersionResponse(version)})
+}
+
+// UpdateVersion updates a draft version of a page.
+func (p *PageVersionController) UpdateVersion(c *gin.Context) {
+	tenantID, siteID, pageID, ok := p.parsePageParams(c)
+	if !ok {
+		return
+	}
+
+	versionID, ok := p.parseVersionParam(c)
+	if !ok {
+		return
+	}
+
+	revision, ok := p.IfMatchRevision(c)
+	if !ok {
+		return
+	}
+
+	var req dto.UpdatePageVersionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		p.logger.Error("Failed to bind JSON to page version request", "error", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	user, exists := p.GetCurrentUser(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	version, err := p.useCase(c).UpdateVersion(tenantID, siteID, pageID, versionID, revision, req, user)
+	if err != nil {
+		p.logger.Error("Failed to update page version", "error", err)
+		p.HandleError(c, err)
+		return
+	}
+
+	p.SetETag(c, version.Revision())
+	c.JSON(http.StatusOK, gin.H{"data": dto.NewPageVersionResponse(version)})
+}
+
+// ReplaceBlocks saves the complete ordered list of blocks of a draft version.
+func (p *PageVersionController) ReplaceBlocks(c *gin.Context) {
+	tenantID, siteID, pageID, ok := p.parsePageParams(c)
+	if !ok {
+		return
+	}
+
+	versionID, ok := p.parseVersionParam(c)
+	if !ok {
+		return
+	}
+
+	revision, ok := p.IfMatchRevision(c)
+	if !ok {
+		return
+	}
+
+	var req dto.ReplacePageBlocksRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		p.logger.Error("Failed to bind JSON to page blocks request", "error", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	// A missing list is rejected rather than read as a request to remove every block
+	if req.Blocks == nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "blocks is required"})
+		return
+	}
+
+	user, exists := p.GetCurrentUser(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	version, err := p.useCase(c).ReplaceBlocks(tenantID, siteID, pageID, versionID, revision, req, user)
+	if err != nil {
+		p.logger.Error("Failed to save page blocks", "error", err)
+		p.HandleError(c, err)
+		return
+	}
+
+	p.SetETag(c, version.Revision())
+	c.JSON(http.StatusOK, gin.H{"data": dto.NewPageVersionResponse(version)})
+}
+
+// RestoreVersion copies a version and its blocks into a new draft version.
+func (p *PageVersionController) RestoreVersion(c *gin.Context) {
+	tenantID, siteID, pageID, ok := p.parsePageParams(c)
+	if !ok {
+		return
+	}
+
+	versionID, ok := p.parseVersionParam(c)
+	if !ok {
+		return
+	}
+
+	version, err := p.useCase(c).RestoreVersion(tenantID, siteID, pageID, versionID)
+	if err != nil {
+		p.logger.Error("Failed to restore page version", "error", err)
+		p.HandleError(c, err)
+		return
+	}
+
+	p.SetETag(c, version.Revision())
+	c.JSON(http.StatusCreated, gin.H{"data": dto.NewPageVersionResponse(version)})
+}
+
+// GetLease retrieves the editing lease of a version, telling editors who is editing it.
+func (p *PageVersionController) GetLease(c *gin.Context) {
+	tenantID, siteID, pageID, ok := p.parsePageParams(c)
+	if !ok {
+		return
+	}
+
+	versionID, ok := p.parseVersionParam(c)
+	if !ok {
+		return
+	}
+
+	lease, err := p.pageVersionUseCase.GetLease(tenantID, siteID, pageID, versionID)
+	if err != nil {
+		p.logger.Error("Failed to get editing lease", "versionID", versionID, "error", err)
+		p.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": dto.NewEditingLeaseResponse(lease)})
+}
+
+// AcquireLease gives the current user the editing lease of a draft version.
+func (p *PageVersionController) AcquireLease(c *gin.Context) {
+	p.lease(c, func(uc *use_cases.PageVersionUseCase, tenantID, siteID, pageID, versionID uint64, user *entities.User) (*entities.EditingLease, error) {
+		return uc.AcquireLease(tenantID, siteID, pageID, versionID, user, p.GetUserName(c))
+	})
+}
+
+// RenewLease extends the editing lease the current user holds on a version.
+func (p *PageVersionController) RenewLease(c *gin.Context) {
+	p.lease(c, (*use_cases.PageVersionUseCase).RenewLease)
+}
+
+// BreakLease ends the editing lease of another user on a version. The broken lease is returned.
+func (p *PageVersionController) BreakLease(c *gin.Context) {
+	p.lease(c, (*use_cases.PageVersionUseCase).BreakLease)
+}
+
+// ReleaseLease ends the editing lease the current user holds on a version.
+func (p *PageVersionController) ReleaseLease(c *gin.Context) {
+	tenantID, siteID, pageID, ok := p.parsePageParams(c)
+	if !ok {
+		return
+	}
+
+	versionID, ok := p.parseVersionParam(c)
+	if !ok {
+		return
+	}
+
+	user, exists := p.GetCurrentUser(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	if err := p.pageVersionUseCase.ReleaseLease(tenantID, siteID, pageID, versionID, user); err != nil {
+		p.logger.Error("Failed to release editing lease", "versionID", versionID, "error", err)
+		p.HandleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ScheduleVersion sets when a version is published and unpublished automatically.
+func (p *PageVersionController) ScheduleVersion(c *gin.Context) {
+	revision, ok := p.IfMatchRevision(c)
+	if !ok {
+		return
+	}
+
+	var req dto.SchedulePageVersionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		p.logger.Error("Failed to bind JSON to schedule page version request", "error", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	p.transition(c, func(uc *use_cases.PageVersionUseCase, tenantID, siteID, pageID, versionID uint64, user *entities.User) (*entities.PageVersion, error) {
+		return uc.ScheduleVersion(tenantID, siteID, pageID, versionID, revision, req, user)
+	})
+}
+
+// SubmitVersion submits a draft version for review.
+func (p *PageVersionController) SubmitVersion(c *gin.Context) {
+	p.transition(c, (*use_cases.PageVersionUseCase).SubmitVersion)
+}
+
+// ApproveVersion approves a version that is in review.
+func (p *PageVersionController) ApproveVersion(c *gin.Context) {
+	p.transition(c, (*use_cases.PageVersionUseCase).ApproveVersion)
+}
+
+// RejectVersion sends a version back to draft.
+func (p *PageVersionController) RejectVersion(c *gin.Context) {
+	p.transition(c, (*use_cases.PageVersionUseCase).RejectVersion)
+}
+
+// PublishVersion publishes an approved version, unpublishing the previous one.
+func (p *PageVersionController) PublishVersion(c *gin.Context) {
+	p.transition(c, (*use_cases.PageVersionUseCase).PublishVersion)
+}
+
+// ArchiveVersion archives a version.
+func (p *PageVersionController) ArchiveVersion(c *gin.Context) {
+	p.transition(c, (*use_cases.PageVersionUseCase).ArchiveVersion)
+}
+
+// transition applies a workflow step to the version in the route on behalf of the current user.
+func (p *PageVersionController) transition(c *gin.Context, step versionTransition) {
+	tenantID, siteID, pageID, ok := p.parsePageParams(c)
+	if !ok {
+		return
+	}
+
+	versionID, ok := p.parseVersionParam(c)
+	if !ok {
+		return
+	}
+
+	user, exists := p.GetCurrentUser(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	version, err := step(p.useCase(c), tenantID, siteID, pageID, versionID, user)
+	if err != nil {
+		p.logger.Error("Failed to change page version status", "versionID", versionID, "error", err)
+		p.HandleError(c, err)
+		return
+	}
+
+	p.SetETag(c, version.Revision())
+	c.JSON(http.StatusOK, gin.H{"data": dto.NewPageVersionResponse(version)})
+}
+
+// lease applies a change to the editing lease of the version in the route on behalf of the current user.
+func (p *PageVersionController) lease(c *gin.Context, change leaseChange) {
+	tenantID, siteID, pageID, ok := p.parsePageParams(c)
+	if !ok {
+		return
+	}
+
+	versionID, ok := p.parseVersionParam(c)
+	if !ok {
+		return
+	}
+
+	user, exists := p.GetCurrentUser(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	lease, err := change(p.useCase(c), tenantID, siteID, pageID, versionID, user)
+	if err != nil {
+		p.logger.Error("Failed to change editing lease", "versionID", versionID, "error", err)
+		p.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": dto.NewEditingLeaseResponse(lease)})
+}
+
+// useCase returns the page version use case bound to the transaction of the request, when one is running.
+func (p *PageVersionController) useCase(c *gin.Context) *use_cases.PageVersionUseCase {
+	if trx, exists := c.Get(constants.DBTransaction); exists {
+		return p.pageVersionUseCase.WithTrx(trx.(*sqlx.Tx))
+	}
+	return p.pageVersionUseCase
+}
+
+// parsePageParams parses the tenant, site and page IDs from the route, writing a 400 response when invalid.
+func (p *PageVersionController) parsePageParams(c *gin.Context) (uint64, uint64, uint64, bool) {
+	tenantID, err := p.ParseUIntParam(c, "tenantId")
+	if err != nil {
+		p.logger.Error("Failed to parse tenant ID", "error", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid tenant ID"})
+		return 0, 0, 0, false
+	}
+
+	siteID, err := p.ParseUIntParam(c, "siteId")
+	if err != nil {
+		p.logger.Error("Failed to parse site ID", "error", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid site ID"})
+		return 0, 0, 0, false
+	}
+
+	pageID, err := p.ParseUIntParam(c, "pageId")
+	if err != nil {
+		p.logger.Error("Failed to parse page ID", "error", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid page ID"})
+		return 0, 0, 0, false
+	}
+
+	return uint64(tenantID), uint64(siteID), uint64(pageID), true
+}
+
+// parseVersionParam parses the version ID from the route, writing a 400 response when invalid.
+func (p *PageVersionController) parseVersionParam(c *gin.Context) (uint64, bool) {
+	versionID, err := p.ParseUIntParam(c, "versionId")
+	if err != nil {
+		p.logger.Error("Failed to parse page version ID", "error", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid page version ID"})
+		return 0, false
+	}
+
+	return uint64(versionID), true
+}
@@ -0,0 +1,109 @@
+package controllers
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/h4rdc0m/aurora-api/application/dto"
+	"github.com/h4rdc0m/aurora-api/application/use_cases"
+	"github.com/h4rdc0m/aurora-api/domain/common"
+	"net/http"
+)
+
+// PreviewTokenController handles HTTP requests related to the preview tokens of a site.
+type PreviewTokenController struct {
+	BaseController
+	previewTokenUseCase *use_cases.PreviewTokenUseCase
+	logger              common.Logger
+}
+
+// NewPreviewTokenController creates a new instance of PreviewTokenController with the provided use case and logger.
+func NewPreviewTokenController(previewTokenUseCase *use_cases.PreviewTokenUseCase, logger common.Logger) *PreviewTokenController {
+	return &PreviewTokenController{
+		previewTokenUseCase: previewTokenUseCase,
+		logger:              logger,
+	}
+}
+
+// GetPreviewTokens retrieves the active preview tokens of a site.
+func (p *PreviewTokenController) GetPreviewTokens(c *gin.Context) {
+	tenantID, siteID, ok := p.parseSiteParams(c)
+	if !ok {
+		return
+	}
+
+	tokens, err := p.previewTokenUseCase.GetPreviewTokens(tenantID, siteID)
+	if err != nil {
+		p.logger.Error("Failed to get preview tokens", "error", err)
+		p.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": dto.NewPreviewTokenResponses(tokens)})
+}
+
+// CreatePreviewToken issues a preview token for a page version or the whole site.
+func (p *PreviewTokenController) CreatePreviewToken(c *gin.Context) {
+	tenantID, siteID, ok := p.parseSiteParams(c)
+	if !ok {
+		return
+	}
+
+	var req dto.CreatePreviewTokenRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		p.logger.Error("Failed to bind JSON to preview token request", "error", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	user, exists := p.GetCurrentUser(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	token, signed, err := p.previewTokenUseCase.CreatePreviewToken(tenantID, siteID, req, user)
+	if err != nil {
+		p.logger.Error("Failed to create preview token", "error", err)
+		p.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"data": dto.CreatedPreviewTokenResponse{
+		PreviewTokenResponse: dto.NewPreviewTokenResponse(token),
+		Token:                signed,
+	}})
+}
+
+// RevokePreviewToken withdraws a preview token, after which the delivery API no longer accepts it.
+func (p *PreviewTokenController) RevokePreviewToken(c *gin.Context) {
+	tenantID, siteID, ok := p.parseSiteParams(c)
+	if !ok {
+		return
+	}
+
+	if err := p.previewTokenUseCase.RevokePreviewToken(tenantID, siteID, c.Param("tokenId")); err != nil {
+		p.logger.Error("Failed to revoke preview token", "error", err)
+		p.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": "Preview token revoked successfully"})
+}
+
+// parseSiteParams parses the tenant and site IDs from the route, writing a 400 response when invalid.
+func (p *PreviewTokenController) parseSiteParams(c *gin.Context) (uint64, uint64, bool) {
+	tenantID, err := p.ParseUIntParam(c, "tenantId")
+	if err != nil {
+		p.logger.Error("Failed to parse tenant ID", "error", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid tenant ID"})
+		return 0, 0, false
+	}
+
+	siteID, err := p.ParseUIntParam(c, "siteId")
+	if err != nil {
+		p.logger.Error("Failed to parse site ID", "error", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid site ID"})
+		return 0, 0, false
+	}
+
+	return uint64(tenantID), uint64(siteID), true
+}
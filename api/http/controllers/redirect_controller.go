@@ -0,0 +1,211 @@
+package controllers
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/h4rdc0m/aurora-api/application/dto"
+	"github.com/h4rdc0m/aurora-api/application/use_cases"
+	"github.com/h4rdc0m/aurora-api/constants"
+	"github.com/h4rdc0m/aurora-api/domain/common"
+	"github.com/jmoiron/sqlx"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// maxRedirectImportSize bounds the size of an uploaded redirect CSV file
+const maxRedirectImportSize = 5 << 20
+
+// RedirectController handles HTTP requests related to the redirects of a site.
+type RedirectController struct {
+	BaseController
+	redirectUseCase *use_cases.RedirectUseCase
+	logger          common.Logger
+}
+
+// NewRedirectController creates a new instance of RedirectController with the provided use case and logger.
+func NewRedirectController(redirectUseCase *use_cases.RedirectUseCase, logger common.Logger) *RedirectController {
+	return &RedirectController{
+		redirectUseCase: redirectUseCase,
+		logger:          logger,
+	}
+}
+
+// GetRedirects retrieves all redirects of a site.
+func (r *RedirectController) GetRedirects(c *gin.Context) {
+	tenantID, siteID, ok := r.parseSiteParams(c)
+	if !ok {
+		return
+	}
+
+	redirects, err := r.redirectUseCase.GetRedirects(tenantID, siteID)
+	if err != nil {
+		r.logger.Error("Failed to get redirects", "error", err)
+		r.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": dto.NewRedirectResponses(redirects)})
+}
+
+// GetRedirect retrieves a single redirect.
+func (r *RedirectController) GetRedirect(c *gin.Context) {
+	tenantID, siteID, redirectID, ok := r.parseRedirectParams(c)
+	if !ok {
+		return
+	}
+
+	redirect, err := r.redirectUseCase.GetRedirect(tenantID, siteID, redirectID)
+	if err != nil {
+		r.logger.Error("Failed to get redirect", "error", err)
+		r.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": dto.NewRedirectResponse(redirect)})
+}
+
+// CreateRedirect creates a manual redirect.
+func (r *RedirectController) CreateRedirect(c *gin.Context) {
+	tenantID, siteID, ok := r.parseSiteParams(c)
+	if !ok {
+		return
+	}
+
+	var req dto.RedirectRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		r.logger.Error("Failed to bind JSON to redirect request", "error", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	redirect, err := r.useCase(c).CreateRedirect(tenantID, siteID, req)
+	if err != nil {
+		r.logger.Error("Failed to create redirect", "error", err)
+		r.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"data": dto.NewRedirectResponse(redirect)})
+}
+
+// UpdateRedirect replaces the source, target and status code of a redirect.
+func (r *RedirectController) UpdateRedirect(c *gin.Context) {
+	tenantID, siteID, redirectID, ok := r.parseRedirectParams(c)
+	if !ok {
+		return
+	}
+
+	var req dto.RedirectRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		r.logger.Error("Failed to bind JSON to redirect request", "error", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	redirect, err := r.useCase(c).UpdateRedirect(tenantID, siteID, redirectID, req)
+	if err != nil {
+		r.logger.Error("Failed to update redirect", "error", err)
+		r.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": dto.NewRedirectResponse(redirect)})
+}
+
+// DeleteRedirect deletes a redirect.
+func (r *RedirectController) DeleteRedirect(c *gin.Context) {
+	tenantID, siteID, redirectID, ok := r.parseRedirectParams(c)
+	if !ok {
+		return
+	}
+
+	if err := r.useCase(c).DeleteRedirect(tenantID, siteID, redirectID); err != nil {
+		r.logger.Error("Failed to delete redirect", "error", err)
+		r.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": "Redirect deleted successfully"})
+}
+
+// ImportRedirects creates or replaces redirects from CSV, uploaded as the "file" field of a multipart form or sent
+// as the request body. Nothing is imported when a row is invalid.
+func (r *RedirectController) ImportRedirects(c *gin.Context) {
+	tenantID, siteID, ok := r.parseSiteParams(c)
+	if !ok {
+		return
+	}
+
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxRedirectImportSize)
+
+	var input io.Reader = c.Request.Body
+	if strings.HasPrefix(c.ContentType(), "multipart/form-data") {
+		header, err := c.FormFile("file")
+		if err != nil {
+			r.logger.Error("Failed to read redirect import file", "error", err)
+			c.JSON(http.StatusBadRequest, gin.H{"error": "A CSV file is required"})
+			return
+		}
+		file, err := header.Open()
+		if err != nil {
+			r.logger.Error("Failed to open redirect import file", "error", err)
+			c.JSON(http.StatusBadRequest, gin.H{"error": "A CSV file is required"})
+			return
+		}
+		defer file.Close()
+		input = file
+	}
+
+	result, err := r.useCase(c).ImportRedirects(tenantID, siteID, input)
+	if err != nil {
+		r.logger.Error("Failed to import redirects", "error", err)
+		r.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": dto.NewRedirectImportResponse(result)})
+}
+
+// useCase returns the redirect use case bound to the transaction of the request, when one is running.
+func (r *RedirectController) useCase(c *gin.Context) *use_cases.RedirectUseCase {
+	if trx, exists := c.Get(constants.DBTransaction); exists {
+		return r.redirectUseCase.WithTrx(trx.(*sqlx.Tx))
+	}
+	return r.redirectUseCase
+}
+
+// parseSiteParams parses the tenant and site IDs from the route, writing a 400 response when invalid.
+func (r *RedirectController) parseSiteParams(c *gin.Context) (uint64, uint64, bool) {
+	tenantID, err := r.ParseUIntParam(c, "tenantId")
+	if err != nil {
+		r.logger.Error("Failed to parse tenant ID", "error", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid tenant ID"})
+		return 0, 0, false
+	}
+
+	siteID, err := r.ParseUIntParam(c, "siteId")
+	if err != nil {
+		r.logger.Error("Failed to parse site ID", "error", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid site ID"})
+		return 0, 0, false
+	}
+
+	return uint64(tenantID), uint64(siteID), true
+}
+
+// parseRedirectParams parses the tenant, site and redirect IDs from the route, writing a 400 response when invalid.
+func (r *RedirectController) parseRedirectParams(c *gin.Context) (uint64, uint64, uint64, bool) {
+	tenantID, siteID, ok := r.parseSiteParams(c)
+	if !ok {
+		return 0, 0, 0, false
+	}
+
+	redirectID, err := r.ParseUIntParam(c, "redirectId")
+	if err != nil {
+		r.logger.Error("Failed to parse redirect ID", "error", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid redirect ID"})
+		return 0, 0, 0, false
+	}
+
+	return tenantID, siteID, uint64(redirectID), true
+}
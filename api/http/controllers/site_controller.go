@@ -1,116 +1,300 @@
 package controllers
 
-//import (
-//	"aurora-api/internal/dto"
-//	"aurora-api/internal/services"
-//	"aurora-api/internal/utils/httpx"
-//	"github.com/gin-gonic/gin"
-//	"github.com/go-playground/validator"
-//	"github.com/rs/zerolog/log"
-//	"net/http"
-//)
-//
-//type SiteController struct {
-//	siteService services.SiteService
-//	validate    *validator.Validate
-//}
-//
-//func NewSiteController(siteService services.SiteService, group *gin.RouterGroup) *SiteController {
-//	log.Info().Msg("Initializing site controller")
-//	c := &SiteController{
-//		siteService: siteService,
-//		validate:    validator.New(),
-//	}
-//	c.registerRoutes(group)
-//	return c
-//}
-//
-//func (c *SiteController) registerRoutes(r *gin.RouterGroup) {
-//	siteApi := r.Group("/sites")
-//	siteApi.GET("", c.getAllSites)
-//	siteApi.GET("/:id", c.getSiteById)
-//	siteApi.POST("", c.createSite)
-//	siteApi.PUT("/:id", c.updateSite)
-//	siteApi.DELETE("/:id", c.deleteSite)
-//}
-//
-//func (c *SiteController) getAllSites(ctx *gin.Context) {
-//	sites, err := c.siteService.GetAll(ctx.Request.Context())
-//	if err != nil {
-//		log.Error().Err(err).Msg("Failed to retrieve sites")
-//		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-//		return
-//	}
-//	ctx.JSON(http.StatusOK, gin.H{"sites": sites})
-//}
-//
-//func (c *SiteController) getSiteById(ctx *gin.Context) {
-//	id, err := httpx.ParseIntID(ctx)
-//	if err != nil {
-//		return
-//	}
-//
-//	s, err := c.siteService.GetByID(ctx.Request.Context(), id)
-//	if err != nil {
-//		httpx.HandleError(ctx, err)
-//	}
-//
-//	ctx.JSON(http.StatusOK, s)
-//}
-//
-//func (c *SiteController) createSite(ctx *gin.Context) {
-//	var input dto.CreateSiteRequest
-//	if err := ctx.ShouldBindJSON(&input); err != nil {
-//		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid JSON", "details": err.Error()})
-//		return
-//	}
-//
-//	if err := c.validate.Struct(input); err != nil {
-//		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Validation failed", "details": err.Error()})
-//		return
-//	}
-//
-//	if err := c.siteService.Create(ctx.Request.Context(), &input); err != nil {
-//		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create site"})
-//	}
-//
-//	ctx.JSON(http.StatusCreated, gin.H{"message": "Site created successfully"})
-//}
-//
-//func (c *SiteController) updateSite(ctx *gin.Context) {
-//	id, err := httpx.ParseIntID(ctx)
-//	if err != nil {
-//		return
-//	}
-//
-//	var input dto.UpdateSiteRequest
-//	if err := ctx.ShouldBindJSON(&input); err != nil {
-//		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid JSON", "details": err.Error()})
-//		return
-//	}
-//
-//	if err := c.validate.Struct(input); err != nil {
-//		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Validation failed", "details": err.Error()})
-//		return
-//	}
-//	if err := c.siteService.Update(ctx.Request.Context(), id, &input); err != nil {
-//		httpx.HandleError(ctx, err)
-//		return
-//	}
-//
-//	ctx.JSON(http.StatusNoContent, gin.H{})
-//}
-//
-//func (c *SiteController) deleteSite(context *gin.Context) {
-//	id, err := httpx.ParseIntID(context)
-//	if err != nil {
-//		return
-//	}
-//
-//	if err := c.siteService.Delete(context.Request.Context(), id); err != nil {
-//		httpx.HandleError(context, err)
-//		return
-//	}
-//
-//	context.JSON(http.StatusNoContent, gin.H{})
-//}
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/h4rdc0m/aurora-api/application/dto"
+	"github.com/h4rdc0m/aurora-api/application/use_cases"
+	"github.com/h4rdc0m/aurora-api/constants"
+	"github.com/h4rdc0m/aurora-api/domain/common"
+	"github.com/jmoiron/sqlx"
+	"net/http"
+)
+
+// SiteController handles HTTP requests related to the sites of a tenant.
+type SiteController struct {
+	BaseController
+	siteUseCase *use_cases.SiteUseCase
+	logger      common.Logger
+}
+
+// NewSiteController creates a new instance of SiteController with the provided use case and logger.
+func NewSiteController(siteUseCase *use_cases.SiteUseCase, logger common.Logger) *SiteController {
+	return &SiteController{
+		siteUseCase: siteUseCase,
+		logger:      logger,
+	}
+}
+
+// GetAllSites retrieves all sites of a tenant.
+func (s *SiteController) GetAllSites(c *gin.Context) {
+	tenantID, ok := s.parseTenantParam(c)
+	if !ok {
+		return
+	}
+
+	sites, err := s.siteUseCase.GetSitesByTenant(tenantID)
+	if err != nil {
+		s.logger.Error("Failed to get sites", "error", err)
+		s.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": dto.NewSiteResponses(sites)})
+}
+
+// GetOneSite retrieves a single site of a tenant.
+func (s *SiteController) GetOneSite(c *gin.Context) {
+	tenantID, siteID, ok := s.parseSiteParams(c)
+	if !ok {
+		return
+	}
+
+	site, err := s.siteUseCase.GetSite(tenantID, siteID)
+	if err != nil {
+		s.logger.Error("Failed to get site", "error", err)
+		s.HandleError(c, err)
+		return
+	}
+
+	s.SetETag(c, site.Revision())
+	c.JSON(http.StatusOK, gin.H{"data": dto.NewSiteResponse(site)})
+}
+
+// CreateSite creates a new site for a tenant.
+func (s *SiteController) CreateSite(c *gin.Context) {
+	tenantID, ok := s.parseTenantParam(c)
+	if !ok {
+		return
+	}
+
+	var req dto.CreateSiteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		s.logger.Error("Failed to bind JSON to site request", "error", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	site, err := s.siteUseCase.CreateSite(req.Name, req.Description, req.Domain, req.TemplateID, tenantID)
+	if err != nil {
+		s.logger.Error("Failed to create site", "error", err)
+		s.HandleError(c, err)
+		return
+	}
+
+	s.SetETag(c, site.Revision())
+	c.JSON(http.StatusCreated, gin.H{"data": dto.NewSiteResponse(site)})
+}
+
+// UpdateSite updates an existing site of a tenant.
+func (s *SiteController) UpdateSite(c *gin.Context) {
+	tenantID, siteID, ok := s.parseSiteParams(c)
+	if !ok {
+		return
+	}
+
+	revision, ok := s.IfMatchRevision(c)
+	if !ok {
+		return
+	}
+
+	var req dto.UpdateSiteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		s.logger.Error("Failed to bind JSON to site request", "error", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	site, err := s.siteUseCase.UpdateSite(tenantID, siteID, revision, req.Name, req.Description, req.Domain, req.TemplateID)
+	if err != nil {
+		s.logger.Error("Failed to update site", "error", err)
+		s.HandleError(c, err)
+		return
+	}
+
+	s.SetETag(c, site.Revision())
+	c.JSON(http.StatusOK, gin.H{"data": dto.NewSiteResponse(site)})
+}
+
+// UpdateRobotsTxt sets the robots.txt rules of a site of a tenant.
+func (s *SiteController) UpdateRobotsTxt(c *gin.Context) {
+	tenantID, siteID, ok := s.parseSiteParams(c)
+	if !ok {
+		return
+	}
+
+	revision, ok := s.IfMatchRevision(c)
+	if !ok {
+		return
+	}
+
+	var req dto.UpdateSiteRobotsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		s.logger.Error("Failed to bind JSON to site robots request", "error", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	site, err := s.siteUseCase.UpdateRobotsTxt(tenantID, siteID, revision, req.RobotsTxt)
+	if err != nil {
+		s.logger.Error("Failed to update site robots.txt", "error", err)
+		s.HandleError(c, err)
+		return
+	}
+
+	s.SetETag(c, site.Revision())
+	c.JSON(http.StatusOK, gin.H{"data": dto.NewSiteResponse(site)})
+}
+
+// UpdateTitleTemplate sets the template the page titles of a site of a tenant are rendered with.
+func (s *SiteController) UpdateTitleTemplate(c *gin.Context) {
+	tenantID, siteID, ok := s.parseSiteParams(c)
+	if !ok {
+		return
+	}
+
+	revision, ok := s.IfMatchRevision(c)
+	if !ok {
+		return
+	}
+
+	var req dto.UpdateSiteTitleTemplateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		s.logger.Error("Failed to bind JSON to site title template request", "error", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	site, err := s.siteUseCase.UpdateTitleTemplate(tenantID, siteID, revision, req.TitleTemplate)
+	if err != nil {
+		s.logger.Error("Failed to update site title template", "error", err)
+		s.HandleError(c, err)
+		return
+	}
+
+	s.SetETag(c, site.Revision())
+	c.JSON(http.StatusOK, gin.H{"data": dto.NewSiteResponse(site)})
+}
+
+// UpdateLocales sets the enabled locales, the default locale and the fallback chain of a site of a tenant.
+func (s *SiteController) UpdateLocales(c *gin.Context) {
+	tenantID, siteID, ok := s.parseSiteParams(c)
+	if !ok {
+		return
+	}
+
+	revision, ok := s.IfMatchRevision(c)
+	if !ok {
+		return
+	}
+
+	var req dto.UpdateSiteLocalesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		s.logger.Error("Failed to bind JSON to site locales request", "error", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	site, err := s.siteUseCase.UpdateLocales(tenantID, siteID, revision, req.DefaultLocale, req.Locales, req.FallbackLocales)
+	if err != nil {
+		s.logger.Error("Failed to update site locales", "error", err)
+		s.HandleError(c, err)
+		return
+	}
+
+	s.SetETag(c, site.Revision())
+	c.JSON(http.StatusOK, gin.H{"data": dto.NewSiteResponse(site)})
+}
+
+// EnableSite enables a site of a tenant.
+func (s *SiteController) EnableSite(c *gin.Context) {
+	tenantID, siteID, ok := s.parseSiteParams(c)
+	if !ok {
+		return
+	}
+
+	site, err := s.siteUseCase.EnableSite(tenantID, siteID)
+	if err != nil {
+		s.logger.Error("Failed to enable site", "error", err)
+		s.HandleError(c, err)
+		return
+	}
+
+	s.SetETag(c, site.Revision())
+	c.JSON(http.StatusOK, gin.H{"data": dto.NewSiteResponse(site)})
+}
+
+// DisableSite disables a site of a tenant.
+func (s *SiteController) DisableSite(c *gin.Context) {
+	tenantID, siteID, ok := s.parseSiteParams(c)
+	if !ok {
+		return
+	}
+
+	site, err := s.siteUseCase.DisableSite(tenantID, siteID)
+	if err != nil {
+		s.logger.Error("Failed to disable site", "error", err)
+		s.HandleError(c, err)
+		return
+	}
+
+	s.SetETag(c, site.Revision())
+	c.JSON(http.StatusOK, gin.H{"data": dto.NewSiteResponse(site)})
+}
+
+// DeleteSite moves a site of a tenant to the trash.
+func (s *SiteController) DeleteSite(c *gin.Context) {
+	tenantID, siteID, ok := s.parseSiteParams(c)
+	if !ok {
+		return
+	}
+
+	revision, ok := s.IfMatchRevision(c)
+	if !ok {
+		return
+	}
+
+	if err := s.useCase(c).DeleteSite(tenantID, siteID, revision); err != nil {
+		s.logger.Error("Failed to delete site", "error", err)
+		s.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": "Site deleted successfully"})
+}
+
+// useCase returns the site use case bound to the transaction of the request, when one is running.
+func (s *SiteController) useCase(c *gin.Context) *use_cases.SiteUseCase {
+	if trx, exists := c.Get(constants.DBTransaction); exists {
+		return s.siteUseCase.WithTrx(trx.(*sqlx.Tx))
+	}
+	return s.siteUseCase
+}
+
+// parseTenantParam parses the tenant ID from the route, writing a 400 response when invalid.
+func (s *SiteController) parseTenantParam(c *gin.Context) (uint64, bool) {
+	tenantID, err := s.ParseUIntParam(c, "tenantId")
+	if err != nil {
+		s.logger.Error("Failed to parse tenant ID", "error", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid tenant ID"})
+		return 0, false
+	}
+
+	return uint64(tenantID), true
+}
+
+// parseSiteParams parses the tenant and site IDs from the route, writing a 400 response when invalid.
+func (s *SiteController) parseSiteParams(c *gin.Context) (uint64, uint64, bool) {
+	tenantID, ok := s.parseTenantParam(c)
+	if !ok {
+		return 0, 0, false
+	}
+
+	siteID, err := s.ParseUIntParam(c, "siteId")
+	if err != nil {
+		s.logger.Error("Failed to parse site ID", "error", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid site ID"})
+		return 0, 0, false
+	}
+
+	return tenantID, uint64(siteID), true
+}
@@ -25,7 +25,7 @@ func NewTenantController(tenantUseCase *use_cases.TenantUseCase, logger common.L
 
 // GetOneTenant retrieves a single tenant by its ID.
 func (t *TenantController) GetOneTenant(c *gin.Context) {
-	id, err := t.ParseUIntParam(c, "id")
+	id, err := t.ParseUIntParam(c, "tenantId")
 	if err != nil {
 		t.logger.Error("Failed to parse tenant ID", err)
 		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid tenant ID"})
@@ -45,32 +45,45 @@ func (t *TenantController) GetOneTenant(c *gin.Context) {
 		return
 	}
 
+	t.SetETag(c, tenant.Revision())
 	c.JSON(http.StatusOK, gin.H{
-		"data": tenant,
+		"data": dto.NewTenantResponse(tenant),
 	})
 }
 
-// GetAllTenants retrieves all tenants from the database.
+// GetAllTenants retrieves all tenants the current user can manage.
 func (t *TenantController) GetAllTenants(c *gin.Context) {
-	tenants, err := t.tenantUseCase.GetAllTenants()
+	user, ok := t.GetCurrentUser(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	tenants, err := t.tenantUseCase.GetTenantsForUser(user)
 	if err != nil {
 		t.logger.Error("Failed to get tenants", err)
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
-	c.JSON(http.StatusOK, gin.H{"data": tenants})
+	c.JSON(http.StatusOK, gin.H{"data": dto.NewTenantResponses(tenants)})
 }
 
-// GetActiveTenants retrieves only active tenants
+// GetActiveTenants retrieves only active tenants the current user can manage
 func (t *TenantController) GetActiveTenants(c *gin.Context) {
-	tenants, err := t.tenantUseCase.GetActiveTenants()
+	user, ok := t.GetCurrentUser(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	tenants, err := t.tenantUseCase.GetActiveTenantsForUser(user)
 	if err != nil {
 		t.logger.Error("Failed to get active tenants", err)
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{"data": tenants})
+	c.JSON(http.StatusOK, gin.H{"data": dto.NewTenantResponses(tenants)})
 }
 
 // CreateTenant creates a new tenant
@@ -85,22 +98,28 @@ func (t *TenantController) CreateTenant(c *gin.Context) {
 	tenant, err := t.tenantUseCase.CreateTenant(req.Name, &req.Description)
 	if err != nil {
 		t.logger.Error("Failed to create tenant", err)
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		t.HandleError(c, err)
 		return
 	}
 
-	c.JSON(http.StatusCreated, gin.H{"data": tenant})
+	t.SetETag(c, tenant.Revision())
+	c.JSON(http.StatusCreated, gin.H{"data": dto.NewTenantResponse(tenant)})
 }
 
 // UpdateTenant updates an existing tenant
 func (t *TenantController) UpdateTenant(c *gin.Context) {
-	id, err := t.ParseUIntParam(c, "id")
+	id, err := t.ParseUIntParam(c, "tenantId")
 	if err != nil {
 		t.logger.Error("Failed to parse tenant ID", err)
 		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid tenant ID"})
 		return
 	}
 
+	revision, ok := t.IfMatchRevision(c)
+	if !ok {
+		return
+	}
+
 	var req dto.UpdateTenantRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		t.logger.Error("Failed to bind JSON to tenant request", err)
@@ -108,29 +127,35 @@ func (t *TenantController) UpdateTenant(c *gin.Context) {
 		return
 	}
 
-	tenant, err := t.tenantUseCase.UpdateTenant(uint64(id), req.Name, &req.Description)
+	tenant, err := t.tenantUseCase.UpdateTenant(uint64(id), revision, req.Name, &req.Description)
 	if err != nil {
 		t.logger.Error("Failed to update tenant", err)
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		t.HandleError(c, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{"data": tenant})
+	t.SetETag(c, tenant.Revision())
+	c.JSON(http.StatusOK, gin.H{"data": dto.NewTenantResponse(tenant)})
 }
 
 // DeleteTenant deletes a tenant by its ID
 func (t *TenantController) DeleteTenant(c *gin.Context) {
-	id, err := t.ParseUIntParam(c, "id")
+	id, err := t.ParseUIntParam(c, "tenantId")
 	if err != nil {
 		t.logger.Error("Failed to parse tenant ID", err)
 		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid tenant ID"})
 		return
 	}
 
-	err = t.tenantUseCase.DeleteTenant(uint64(id))
+	revision, ok := t.IfMatchRevision(c)
+	if !ok {
+		return
+	}
+
+	err = t.tenantUseCase.DeleteTenant(uint64(id), revision)
 	if err != nil {
 		t.logger.Error("Failed to delete tenant", err)
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		t.HandleError(c, err)
 		return
 	}
 
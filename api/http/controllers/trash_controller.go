@@ -0,0 +1,147 @@
+package controllers
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/h4rdc0m/aurora-api/application/dto"
+	"github.com/h4rdc0m/aurora-api/application/use_cases"
+	"github.com/h4rdc0m/aurora-api/constants"
+	"github.com/h4rdc0m/aurora-api/domain/common"
+	"github.com/jmoiron/sqlx"
+	"net/http"
+)
+
+// TrashController handles HTTP requests related to the trash of a tenant and the global template trash.
+type TrashController struct {
+	BaseController
+	trashUseCase *use_cases.TrashUseCase
+	logger       common.Logger
+}
+
+// NewTrashController creates a new instance of TrashController with the provided use case and logger.
+func NewTrashController(trashUseCase *use_cases.TrashUseCase, logger common.Logger) *TrashController {
+	return &TrashController{
+		trashUseCase: trashUseCase,
+		logger:       logger,
+	}
+}
+
+// GetTrash retrieves the trashed sites and pages of a tenant.
+func (t *TrashController) GetTrash(c *gin.Context) {
+	tenantID, err := t.ParseUIntParam(c, "tenantId")
+	if err != nil {
+		t.logger.Error("Failed to parse tenant ID", "error", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid tenant ID"})
+		return
+	}
+
+	sites, pages, err := t.trashUseCase.GetTrash(uint64(tenantID))
+	if err != nil {
+		t.logger.Error("Failed to get trash", "error", err)
+		t.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": dto.NewTrashResponse(sites, pages)})
+}
+
+// RestoreSite takes a site of a tenant out of the trash together with its pages.
+func (t *TrashController) RestoreSite(c *gin.Context) {
+	tenantID, siteID, ok := t.parseSiteParams(c)
+	if !ok {
+		return
+	}
+
+	site, err := t.useCase(c).RestoreSite(tenantID, siteID)
+	if err != nil {
+		t.logger.Error("Failed to restore site", "error", err)
+		t.HandleError(c, err)
+		return
+	}
+
+	t.SetETag(c, site.Revision())
+	c.JSON(http.StatusOK, gin.H{"data": dto.NewSiteResponse(site)})
+}
+
+// RestorePage takes a page out of the trash together with its subtree.
+func (t *TrashController) RestorePage(c *gin.Context) {
+	tenantID, siteID, ok := t.parseSiteParams(c)
+	if !ok {
+		return
+	}
+
+	pageID, err := t.ParseUIntParam(c, "pageId")
+	if err != nil {
+		t.logger.Error("Failed to parse page ID", "error", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid page ID"})
+		return
+	}
+
+	page, err := t.useCase(c).RestorePage(tenantID, siteID, uint64(pageID))
+	if err != nil {
+		t.logger.Error("Failed to restore page", "error", err)
+		t.HandleError(c, err)
+		return
+	}
+
+	t.SetETag(c, page.Revision())
+	c.JSON(http.StatusOK, gin.H{"data": dto.NewPageResponse(page)})
+}
+
+// GetTemplateTrash retrieves the trashed templates.
+func (t *TrashController) GetTemplateTrash(c *gin.Context) {
+	templates, err := t.trashUseCase.GetTemplateTrash()
+	if err != nil {
+		t.logger.Error("Failed to get template trash", "error", err)
+		t.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": dto.NewTemplateTrashResponse(templates)})
+}
+
+// RestoreTemplate takes a template out of the trash.
+func (t *TrashController) RestoreTemplate(c *gin.Context) {
+	templateID, err := t.ParseUIntParam(c, "templateId")
+	if err != nil {
+		t.logger.Error("Failed to parse template ID", "error", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid template ID"})
+		return
+	}
+
+	template, err := t.useCase(c).RestoreTemplate(uint64(templateID))
+	if err != nil {
+		t.logger.Error("Failed to restore template", "error", err)
+		t.HandleError(c, err)
+		return
+	}
+
+	t.SetETag(c, template.Revision())
+	c.JSON(http.StatusOK, gin.H{"data": dto.NewTemplateResponse(template)})
+}
+
+// useCase returns the trash use case bound to the transaction of the request, when one is running.
+func (t *TrashController) useCase(c *gin.Context) *use_cases.TrashUseCase {
+	if trx, exists := c.Get(constants.DBTransaction); exists {
+		return t.trashUseCase.WithTrx(trx.(*sqlx.Tx))
+	}
+	return t.trashUseCase
+}
+
+// parseSiteParams parses the tenant and site IDs from the route, writing a 400 response when invalid.
+func (t *TrashController) parseSiteParams(c *gin.Context) (uint64, uint64, bool) {
+	tenantID, err := t.ParseUIntParam(c, "tenantId")
+	if err != nil {
+		t.logger.Error("Failed to parse tenant ID", "error", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid tenant ID"})
+		return 0, 0, false
+	}
+
+	siteID, err := t.ParseUIntParam(c, "siteId")
+	if err != nil {
+		t.logger.Error("Failed to parse site ID", "error", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid site ID"})
+		return 0, 0, false
+	}
+
+	return uint64(tenantID), uint64(siteID), true
+}
@@ -7,6 +7,7 @@ var Module = fx.Options(
 	fx.Provide(NewCorsMiddleware),
 	fx.Provide(NewDatabaseTrx),
 	fx.Provide(NewKeycloakMiddleware),
+	fx.Provide(NewTenantAccessMiddleware),
 	fx.Provide(NewMiddlewares),
 )
 
@@ -23,11 +24,13 @@ func NewMiddlewares(
 	corsMiddleware *CorsMiddleware,
 	dbTrxMiddleware *DatabaseTrx,
 	keycloakMiddleware *KeycloakMiddleware,
+	tenantAccessMiddleware *TenantAccessMiddleware,
 ) Middlewares {
 	return Middlewares{
 		corsMiddleware,
 		dbTrxMiddleware,
 		keycloakMiddleware,
+		tenantAccessMiddleware,
 	}
 }
 
@@ -0,0 +1,123 @@
+package middlewares
+
+import (
+	stderrors "errors"
+	"github.com/gin-gonic/gin"
+	"github.com/h4rdc0m/aurora-api/application/use_cases"
+	"github.com/h4rdc0m/aurora-api/constants"
+	"github.com/h4rdc0m/aurora-api/domain/common"
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"github.com/h4rdc0m/aurora-api/domain/errors"
+	"net/http"
+	"strconv"
+)
+
+// TenantAccessMiddleware provides middleware for authorizing the authenticated user against a tenant.
+// It must run after KeycloakMiddleware.AuthRequired, which sets the Keycloak subject on the context.
+type TenantAccessMiddleware struct {
+	logger      common.Logger
+	userUseCase *use_cases.UserUseCase
+}
+
+// NewTenantAccessMiddleware creates a new instance of TenantAccessMiddleware with the provided logger and user use case.
+func NewTenantAccessMiddleware(logger common.Logger, userUseCase *use_cases.UserUseCase) *TenantAccessMiddleware {
+	return &TenantAccessMiddleware{
+		logger:      logger,
+		userUseCase: userUseCase,
+	}
+}
+
+// Setup initializes the tenant access middleware. NOOP, as it is applied per route group.
+func (t *TenantAccessMiddleware) Setup() {}
+
+// RequireUser is a Gin middleware that resolves the local user of the authenticated request.
+func (t *TenantAccessMiddleware) RequireUser() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if _, ok := t.currentUser(c); !ok {
+			return
+		}
+		c.Next()
+	}
+}
+
+// RequireGlobalAdmin is a Gin middleware that checks if the local user is a super admin or admin.
+func (t *TenantAccessMiddleware) RequireGlobalAdmin() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		user, ok := t.currentUser(c)
+		if !ok {
+			return
+		}
+
+		if !user.IsGlobalAdmin() {
+			t.logger.Error("User is not a global admin", "userID", user.ID().Value())
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "Forbidden: insufficient roles"})
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// CanManageTenant is a Gin middleware that checks if the user can manage the tenant identified by the route parameter.
+func (t *TenantAccessMiddleware) CanManageTenant(param string) gin.HandlerFunc {
+	return t.requireTenantPermission(param, (*entities.User).CanManageTenant)
+}
+
+// CanEditContent is a Gin middleware that checks if the user can edit content of the tenant identified by the route parameter.
+func (t *TenantAccessMiddleware) CanEditContent(param string) gin.HandlerFunc {
+	return t.requireTenantPermission(param, (*entities.User).CanEditContent)
+}
+
+// requireTenantPermission resolves the current user and the tenant route parameter and checks the given permission.
+func (t *TenantAccessMiddleware) requireTenantPermission(param string, allowed func(*entities.User, entities.TenantID) bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		tenantID, err := strconv.ParseUint(c.Param(param), 10, 64)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Invalid tenant ID"})
+			return
+		}
+
+		user, ok := t.currentUser(c)
+		if !ok {
+			return
+		}
+
+		if !allowed(user, entities.NewTenantID(tenantID)) {
+			t.logger.Error("User is not allowed to access tenant", "userID", user.ID().Value(), "tenantID", tenantID)
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "Forbidden: no access to tenant"})
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// currentUser resolves the local user of the Keycloak subject and stores it on the context.
+// It writes the error response and returns false when the user cannot be resolved.
+func (t *TenantAccessMiddleware) currentUser(c *gin.Context) (*entities.User, bool) {
+	if user, exists := c.Get(constants.CurrentUser); exists {
+		return user.(*entities.User), true
+	}
+
+	subject := c.GetString("user_id")
+	if subject == "" {
+		t.logger.Error("User ID not found in context")
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return nil, false
+	}
+
+	user, err := t.userUseCase.GetUserByKeycloakID(subject)
+	if stderrors.Is(err, errors.ErrUserNotFound) || stderrors.Is(err, errors.ErrKeycloakIDInvalid) {
+		t.logger.Error("User is not registered", "keycloakID", subject)
+		c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "Forbidden: user is not registered"})
+		return nil, false
+	}
+	if err != nil {
+		t.logger.Error("Failed to resolve user", "keycloakID", subject, "error", err)
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return nil, false
+	}
+
+	c.Set(constants.CurrentUser, user)
+	return user, true
+}
@@ -0,0 +1,44 @@
+package routes
+
+import (
+	"github.com/h4rdc0m/aurora-api/api/http/controllers"
+	"github.com/h4rdc0m/aurora-api/api/http/middlewares"
+	"github.com/h4rdc0m/aurora-api/domain/common"
+)
+
+type AuditRoutes struct {
+	logger           common.Logger
+	handler          common.Router
+	controller       *controllers.AuditController
+	middleware       *middlewares.KeycloakMiddleware
+	tenantMiddleware *middlewares.TenantAccessMiddleware
+}
+
+func NewAuditRoutes(
+	logger common.Logger,
+	handler common.Router,
+	controller *controllers.AuditController,
+	middleware *middlewares.KeycloakMiddleware,
+	tenantMiddleware *middlewares.TenantAccessMiddleware,
+) *AuditRoutes {
+	return &AuditRoutes{
+		logger:           logger,
+		handler:          handler,
+		controller:       controller,
+		middleware:       middleware,
+		tenantMiddleware: tenantMiddleware,
+	}
+}
+
+func (r *AuditRoutes) Setup() {
+	r.logger.Info("Setting up audit routes")
+
+	audit := r.handler.Group(
+		"/tenants/:tenantId",
+		r.middleware.AuthRequired(),
+		r.tenantMiddleware.CanManageTenant("tenantId"),
+	)
+	{
+		audit.GET("/audit-log", r.controller.GetAuditLog)
+	}
+}
@@ -0,0 +1,54 @@
+package routes
+
+import (
+	"github.com/h4rdc0m/aurora-api/api/http/controllers"
+	"github.com/h4rdc0m/aurora-api/api/http/middlewares"
+	"github.com/h4rdc0m/aurora-api/domain/common"
+)
+
+type ContentTypeRoutes struct {
+	logger           common.Logger
+	handler          common.Router
+	controller       *controllers.ContentTypeController
+	middleware       *middlewares.KeycloakMiddleware
+	tenantMiddleware *middlewares.TenantAccessMiddleware
+}
+
+func NewContentTypeRoutes(
+	logger common.Logger,
+	handler common.Router,
+	controller *controllers.ContentTypeController,
+	middleware *middlewares.KeycloakMiddleware,
+	tenantMiddleware *middlewares.TenantAccessMiddleware,
+) *ContentTypeRoutes {
+	return &ContentTypeRoutes{
+		logger:           logger,
+		handler:          handler,
+		controller:       controller,
+		middleware:       middleware,
+		tenantMiddleware: tenantMiddleware,
+	}
+}
+
+func (r *ContentTypeRoutes) Setup() {
+	r.logger.Info("Setting up content type routes")
+
+	global := r.handler.Group("/content-types", r.middleware.AuthRequired())
+	{
+		global.GET("", r.tenantMiddleware.RequireUser(), r.controller.GetContentTypes)
+		global.GET("/:contentTypeId", r.tenantMiddleware.RequireUser(), r.controller.GetContentType)
+		global.POST("", r.tenantMiddleware.RequireGlobalAdmin(), r.controller.CreateContentType)
+		global.PUT("/:contentTypeId", r.tenantMiddleware.RequireGlobalAdmin(), r.controller.UpdateContentType)
+		global.DELETE("/:contentTypeId", r.tenantMiddleware.RequireGlobalAdmin(), r.controller.DeleteContentType)
+	}
+
+	tenant := r.handler.Group("/tenants/:tenantId/content-types", r.middleware.AuthRequired())
+	{
+		tenant.GET("", r.tenantMiddleware.CanEditContent("tenantId"), r.controller.GetContentTypes)
+		tenant.GET("/:contentTypeId", r.tenantMiddleware.CanEditContent("tenantId"), r.controller.GetContentType)
+		tenant.POST("/validate", r.tenantMiddleware.CanEditContent("tenantId"), r.controller.ValidateBlockContent)
+		tenant.POST("", r.tenantMiddleware.CanManageTenant("tenantId"), r.controller.CreateContentType)
+		tenant.PUT("/:contentTypeId", r.tenantMiddleware.CanManageTenant("tenantId"), r.controller.UpdateContentType)
+		tenant.DELETE("/:contentTypeId", r.tenantMiddleware.CanManageTenant("tenantId"), r.controller.DeleteContentType)
+	}
+}
@@ -0,0 +1,44 @@
+package routes
+
+import (
+	"github.com/h4rdc0m/aurora-api/api/http/controllers"
+	"github.com/h4rdc0m/aurora-api/domain/common"
+)
+
+// DeliveryRoutes configures the public content delivery routes, which require no authentication
+type DeliveryRoutes struct {
+	logger     common.Logger
+	handler    common.Router
+	controller *controllers.DeliveryController
+}
+
+// NewDeliveryRoutes creates a new DeliveryRoutes instance
+func NewDeliveryRoutes(
+	logger common.Logger,
+	handler common.Router,
+	controller *controllers.DeliveryController,
+) *DeliveryRoutes {
+	return &DeliveryRoutes{
+		logger:     logger,
+		handler:    handler,
+		controller: controller,
+	}
+}
+
+// Setup sets up the content delivery routes
+func (r *DeliveryRoutes) Setup() {
+	r.logger.Info("Setting up delivery routes")
+
+	delivery := r.handler.Group("/delivery")
+	{
+		delivery.GET("/pages", r.controller.GetPage)
+		delivery.GET("/search", r.controller.GetSearch)
+		delivery.GET("/feeds/rss", r.controller.GetRSSFeed)
+		delivery.GET("/feeds/atom", r.controller.GetAtomFeed)
+	}
+
+	// Crawler files are served from the site root of every hosted domain
+	r.handler.GET("/robots.txt", r.controller.GetRobotsTxt)
+	r.handler.GET("/sitemap.xml", r.controller.GetSitemap)
+	r.handler.GET("/sitemaps/:part", r.controller.GetSitemapPart)
+}
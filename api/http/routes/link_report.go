@@ -0,0 +1,44 @@
+package routes
+
+import (
+	"github.com/h4rdc0m/aurora-api/api/http/controllers"
+	"github.com/h4rdc0m/aurora-api/api/http/middlewares"
+	"github.com/h4rdc0m/aurora-api/domain/common"
+)
+
+type LinkReportRoutes struct {
+	logger           common.Logger
+	handler          common.Router
+	controller       *controllers.LinkReportController
+	middleware       *middlewares.KeycloakMiddleware
+	tenantMiddleware *middlewares.TenantAccessMiddleware
+}
+
+func NewLinkReportRoutes(
+	logger common.Logger,
+	handler common.Router,
+	controller *controllers.LinkReportController,
+	middleware *middlewares.KeycloakMiddleware,
+	tenantMiddleware *middlewares.TenantAccessMiddleware,
+) *LinkReportRoutes {
+	return &LinkReportRoutes{
+		logger:           logger,
+		handler:          handler,
+		controller:       controller,
+		middleware:       middleware,
+		tenantMiddleware: tenantMiddleware,
+	}
+}
+
+func (r *LinkReportRoutes) Setup() {
+	r.logger.Info("Setting up link report routes")
+
+	reports := r.handler.Group(
+		"/tenants/:tenantId/sites/:siteId",
+		r.middleware.AuthRequired(),
+		r.tenantMiddleware.CanEditContent("tenantId"),
+	)
+	{
+		reports.GET("/link-report", r.controller.GetLinkReport)
+	}
+}
@@ -6,6 +6,17 @@ import "go.uber.org/fx"
 var Module = fx.Options(
 	fx.Provide(NewHealthRoutes),
 	fx.Provide(NewAuthRoutes),
+	fx.Provide(NewAuditRoutes),
+	fx.Provide(NewContentTypeRoutes),
+	fx.Provide(NewDeliveryRoutes),
+	fx.Provide(NewLinkReportRoutes),
+	fx.Provide(NewPageRoutes),
+	fx.Provide(NewPageVersionRoutes),
+	fx.Provide(NewPreviewTokenRoutes),
+	fx.Provide(NewRedirectRoutes),
+	fx.Provide(NewSiteRoutes),
+	fx.Provide(NewTenantRoutes),
+	fx.Provide(NewTrashRoutes),
 	fx.Provide(NewRoutes),
 )
 
@@ -21,10 +32,32 @@ type Route interface {
 func NewRoutes(
 	healthRoutes *HealthRoutes,
 	authRoutes *AuthRoutes,
+	auditRoutes *AuditRoutes,
+	contentTypeRoutes *ContentTypeRoutes,
+	deliveryRoutes *DeliveryRoutes,
+	linkReportRoutes *LinkReportRoutes,
+	pageRoutes *PageRoutes,
+	pageVersionRoutes *PageVersionRoutes,
+	previewTokenRoutes *PreviewTokenRoutes,
+	redirectRoutes *RedirectRoutes,
+	siteRoutes *SiteRoutes,
+	tenantRoutes *TenantRoutes,
+	trashRoutes *TrashRoutes,
 ) Routes {
 	return Routes{
 		healthRoutes,
 		authRoutes,
+		auditRoutes,
+		contentTypeRoutes,
+		deliveryRoutes,
+		linkReportRoutes,
+		pageRoutes,
+		pageVersionRoutes,
+		previewTokenRoutes,
+		redirectRoutes,
+		siteRoutes,
+		tenantRoutes,
+		trashRoutes,
 	}
 }
 
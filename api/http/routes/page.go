@@ -0,0 +1,52 @@
+package routes
+
+import (
+	"github.com/h4rdc0m/aurora-api/api/http/controllers"
+	"github.com/h4rdc0m/aurora-api/api/http/middlewares"
+	"github.com/h4rdc0m/aurora-api/domain/common"
+)
+
+type PageRoutes struct {
+	logger           common.Logger
+	handler          common.Router
+	controller       *controllers.PageController
+	middleware       *middlewares.KeycloakMiddleware
+	tenantMiddleware *middlewares.TenantAccessMiddleware
+}
+
+func NewPageRoutes(
+	logger common.Logger,
+	handler common.Router,
+	controller *controllers.PageController,
+	middleware *middlewares.KeycloakMiddleware,
+	tenantMiddleware *middlewares.TenantAccessMiddleware,
+) *PageRoutes {
+	return &PageRoutes{
+		logger:           logger,
+		handler:          handler,
+		controller:       controller,
+		middleware:       middleware,
+		tenantMiddleware: tenantMiddleware,
+	}
+}
+
+func (r *PageRoutes) Setup() {
+	r.logger.Info("Setting up page routes")
+
+	pages := r.handler.Group(
+		"/tenants/:tenantId/sites/:siteId/pages",
+		r.middleware.AuthRequired(),
+		r.tenantMiddleware.CanEditContent("tenantId"),
+	)
+	{
+		pages.GET("", r.controller.GetPageTree)
+		pages.POST("", r.controller.CreatePage)
+		pages.GET("/:pageId", r.controller.GetPage)
+		pages.GET("/:pageId/resolve", r.controller.ResolvePage)
+		pages.GET("/:pageId/usages", r.controller.GetSnippetUsages)
+		pages.PUT("/:pageId", r.controller.UpdatePage)
+		pages.DELETE("/:pageId", r.controller.DeletePage)
+		pages.POST("/:pageId/move", r.controller.MovePage)
+		pages.POST("/:pageId/duplicate", r.controller.DuplicatePage)
+	}
+}
@@ -0,0 +1,66 @@
+package routes
+
+import (
+	"github.com/h4rdc0m/aurora-api/api/http/controllers"
+	"github.com/h4rdc0m/aurora-api/api/http/middlewares"
+	"github.com/h4rdc0m/aurora-api/domain/common"
+)
+
+type PageVersionRoutes struct {
+	logger           common.Logger
+	handler          common.Router
+	controller       *controllers.PageVersionController
+	middleware       *middlewares.KeycloakMiddleware
+	tenantMiddleware *middlewares.TenantAccessMiddleware
+}
+
+func NewPageVersionRoutes(
+	logger common.Logger,
+	handler common.Router,
+	controller *controllers.PageVersionController,
+	middleware *middlewares.KeycloakMiddleware,
+	tenantMiddleware *middlewares.TenantAccessMiddleware,
+) *PageVersionRoutes {
+	return &PageVersionRoutes{
+		logger:           logger,
+		handler:          handler,
+		controller:       controller,
+		middleware:       middleware,
+		tenantMiddleware: tenantMiddleware,
+	}
+}
+
+func (r *PageVersionRoutes) Setup() {
+	r.logger.Info("Setting up page version routes")
+
+	versions := r.handler.Group(
+		"/tenants/:tenantId/sites/:siteId/pages/:pageId/versions",
+		r.middleware.AuthRequired(),
+		r.tenantMiddleware.CanEditContent("tenantId"),
+	)
+	{
+		versions.GET("", r.controller.GetVersions)
+		versions.POST("", r.controller.CreateVersion)
+		versions.GET("/:versionId", r.controller.GetVersion)
+		versions.PUT("/:versionId", r.controller.UpdateVersion)
+		versions.GET("/:versionId/diff", r.controller.CompareVersions)
+		versions.GET("/:versionId/render", r.controller.RenderVersion)
+		versions.PUT("/:versionId/blocks", r.controller.ReplaceBlocks)
+		versions.POST("/:versionId/restore", r.controller.RestoreVersion)
+		versions.POST("/:versionId/submit", r.controller.SubmitVersion)
+		versions.POST("/:versionId/reject", r.controller.RejectVersion)
+		versions.POST("/:versionId/archive", r.controller.ArchiveVersion)
+		versions.GET("/:versionId/lease", r.controller.GetLease)
+		versions.POST("/:versionId/lease", r.controller.AcquireLease)
+		versions.PUT("/:versionId/lease", r.controller.RenewLease)
+		versions.DELETE("/:versionId/lease", r.controller.ReleaseLease)
+
+		// Approving, publishing and scheduling is reserved for reviewers who manage the tenant
+		versions.POST("/:versionId/approve", r.tenantMiddleware.CanManageTenant("tenantId"), r.controller.ApproveVersion)
+		versions.POST("/:versionId/publish", r.tenantMiddleware.CanManageTenant("tenantId"), r.controller.PublishVersion)
+		versions.PUT("/:versionId/schedule", r.tenantMiddleware.CanManageTenant("tenantId"), r.controller.ScheduleVersion)
+
+		// Tenant admins can take over a version from an editor who holds its lease; breaking a lease is audited
+		versions.POST("/:versionId/lease/break", r.tenantMiddleware.CanManageTenant("tenantId"), r.controller.BreakLease)
+	}
+}
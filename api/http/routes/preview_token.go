@@ -0,0 +1,46 @@
+package routes
+
+import (
+	"github.com/h4rdc0m/aurora-api/api/http/controllers"
+	"github.com/h4rdc0m/aurora-api/api/http/middlewares"
+	"github.com/h4rdc0m/aurora-api/domain/common"
+)
+
+type PreviewTokenRoutes struct {
+	logger           common.Logger
+	handler          common.Router
+	controller       *controllers.PreviewTokenController
+	middleware       *middlewares.KeycloakMiddleware
+	tenantMiddleware *middlewares.TenantAccessMiddleware
+}
+
+func NewPreviewTokenRoutes(
+	logger common.Logger,
+	handler common.Router,
+	controller *controllers.PreviewTokenController,
+	middleware *middlewares.KeycloakMiddleware,
+	tenantMiddleware *middlewares.TenantAccessMiddleware,
+) *PreviewTokenRoutes {
+	return &PreviewTokenRoutes{
+		logger:           logger,
+		handler:          handler,
+		controller:       controller,
+		middleware:       middleware,
+		tenantMiddleware: tenantMiddleware,
+	}
+}
+
+func (r *PreviewTokenRoutes) Setup() {
+	r.logger.Info("Setting up preview token routes")
+
+	tokens := r.handler.Group(
+		"/tenants/:tenantId/sites/:siteId/preview-tokens",
+		r.middleware.AuthRequired(),
+		r.tenantMiddleware.CanEditContent("tenantId"),
+	)
+	{
+		tokens.GET("", r.controller.GetPreviewTokens)
+		tokens.POST("", r.controller.CreatePreviewToken)
+		tokens.DELETE("/:tokenId", r.controller.RevokePreviewToken)
+	}
+}
@@ -0,0 +1,45 @@
+package routes
+
+import (
+	"github.com/h4rdc0m/aurora-api/api/http/controllers"
+	"github.com/h4rdc0m/aurora-api/api/http/middlewares"
+	"github.com/h4rdc0m/aurora-api/domain/common"
+)
+
+type RedirectRoutes struct {
+	logger           common.Logger
+	handler          common.Router
+	controller       *controllers.RedirectController
+	middleware       *middlewares.KeycloakMiddleware
+	tenantMiddleware *middlewares.TenantAccessMiddleware
+}
+
+func NewRedirectRoutes(
+	logger common.Logger,
+	handler common.Router,
+	controller *controllers.RedirectController,
+	middleware *middlewares.KeycloakMiddleware,
+	tenantMiddleware *middlewares.TenantAccessMiddleware,
+) *RedirectRoutes {
+	return &RedirectRoutes{
+		logger:           logger,
+		handler:          handler,
+		controller:       controller,
+		middleware:       middleware,
+		tenantMiddleware: tenantMiddleware,
+	}
+}
+
+func (r *RedirectRoutes) Setup() {
+	r.logger.Info("Setting up redirect routes")
+
+	redirects := r.handler.Group("/tenants/:tenantId/sites/:siteId/redirects", r.middleware.AuthRequired())
+	{
+		redirects.GET("", r.tenantMiddleware.CanEditContent("tenantId"), r.controller.GetRedirects)
+		redirects.GET("/:redirectId", r.tenantMiddleware.CanEditContent("tenantId"), r.controller.GetRedirect)
+		redirects.POST("", r.tenantMiddleware.CanManageTenant("tenantId"), r.controller.CreateRedirect)
+		redirects.POST("/import", r.tenantMiddleware.CanManageTenant("tenantId"), r.controller.ImportRedirects)
+		redirects.PUT("/:redirectId", r.tenantMiddleware.CanManageTenant("tenantId"), r.controller.UpdateRedirect)
+		redirects.DELETE("/:redirectId", r.tenantMiddleware.CanManageTenant("tenantId"), r.controller.DeleteRedirect)
+	}
+}
@@ -0,0 +1,53 @@
+package routes
+
+import (
+	"github.com/h4rdc0m/aurora-api/api/http/controllers"
+	"github.com/h4rdc0m/aurora-api/api/http/middlewares"
+	"github.com/h4rdc0m/aurora-api/domain/common"
+)
+
+type SiteRoutes struct {
+	logger           common.Logger
+	handler          common.Router
+	controller       *controllers.SiteController
+	middleware       *middlewares.KeycloakMiddleware
+	tenantMiddleware *middlewares.TenantAccessMiddleware
+}
+
+func NewSiteRoutes(
+	logger common.Logger,
+	handler common.Router,
+	controller *controllers.SiteController,
+	middleware *middlewares.KeycloakMiddleware,
+	tenantMiddleware *middlewares.TenantAccessMiddleware,
+) *SiteRoutes {
+	return &SiteRoutes{
+		logger:           logger,
+		handler:          handler,
+		controller:       controller,
+		middleware:       middleware,
+		tenantMiddleware: tenantMiddleware,
+	}
+}
+
+func (r *SiteRoutes) Setup() {
+	r.logger.Info("Setting up site routes")
+
+	sites := r.handler.Group(
+		"/tenants/:tenantId/sites",
+		r.middleware.AuthRequired(),
+		r.tenantMiddleware.CanManageTenant("tenantId"),
+	)
+	{
+		sites.GET("", r.controller.GetAllSites)
+		sites.POST("", r.controller.CreateSite)
+		sites.GET("/:siteId", r.controller.GetOneSite)
+		sites.PUT("/:siteId", r.controller.UpdateSite)
+		sites.DELETE("/:siteId", r.controller.DeleteSite)
+		sites.PUT("/:siteId/robots", r.controller.UpdateRobotsTxt)
+		sites.PUT("/:siteId/title-template", r.controller.UpdateTitleTemplate)
+		sites.PUT("/:siteId/locales", r.controller.UpdateLocales)
+		sites.POST("/:siteId/enable", r.controller.EnableSite)
+		sites.POST("/:siteId/disable", r.controller.DisableSite)
+	}
+}
@@ -2,6 +2,7 @@ package routes
 
 import (
 	"github.com/h4rdc0m/aurora-api/api/http/controllers"
+	"github.com/h4rdc0m/aurora-api/api/http/middlewares"
 	"github.com/h4rdc0m/aurora-api/domain/common"
 )
 
@@ -9,4 +10,36 @@ type TenantRoutes struct {
 	logger           common.Logger
 	handler          common.Router
 	tenantController *controllers.TenantController
+	middleware       *middlewares.KeycloakMiddleware
+	tenantMiddleware *middlewares.TenantAccessMiddleware
+}
+
+func NewTenantRoutes(
+	logger common.Logger,
+	handler common.Router,
+	tenantController *controllers.TenantController,
+	middleware *middlewares.KeycloakMiddleware,
+	tenantMiddleware *middlewares.TenantAccessMiddleware,
+) *TenantRoutes {
+	return &TenantRoutes{
+		logger:           logger,
+		handler:          handler,
+		tenantController: tenantController,
+		middleware:       middleware,
+		tenantMiddleware: tenantMiddleware,
+	}
+}
+
+func (r *TenantRoutes) Setup() {
+	r.logger.Info("Setting up tenant routes")
+
+	tenants := r.handler.Group("/tenants", r.middleware.AuthRequired())
+	{
+		tenants.GET("", r.tenantMiddleware.RequireUser(), r.tenantController.GetAllTenants)
+		tenants.GET("/active", r.tenantMiddleware.RequireUser(), r.tenantController.GetActiveTenants)
+		tenants.POST("", r.tenantMiddleware.RequireGlobalAdmin(), r.tenantController.CreateTenant)
+		tenants.GET("/:tenantId", r.tenantMiddleware.CanManageTenant("tenantId"), r.tenantController.GetOneTenant)
+		tenants.PUT("/:tenantId", r.tenantMiddleware.CanManageTenant("tenantId"), r.tenantController.UpdateTenant)
+		tenants.DELETE("/:tenantId", r.tenantMiddleware.RequireGlobalAdmin(), r.tenantController.DeleteTenant)
+	}
 }
@@ -0,0 +1,57 @@
+package routes
+
+import (
+	"github.com/h4rdc0m/aurora-api/api/http/controllers"
+	"github.com/h4rdc0m/aurora-api/api/http/middlewares"
+	"github.com/h4rdc0m/aurora-api/domain/common"
+)
+
+type TrashRoutes struct {
+	logger           common.Logger
+	handler          common.Router
+	controller       *controllers.TrashController
+	middleware       *middlewares.KeycloakMiddleware
+	tenantMiddleware *middlewares.TenantAccessMiddleware
+}
+
+func NewTrashRoutes(
+	logger common.Logger,
+	handler common.Router,
+	controller *controllers.TrashController,
+	middleware *middlewares.KeycloakMiddleware,
+	tenantMiddleware *middlewares.TenantAccessMiddleware,
+) *TrashRoutes {
+	return &TrashRoutes{
+		logger:           logger,
+		handler:          handler,
+		controller:       controller,
+		middleware:       middleware,
+		tenantMiddleware: tenantMiddleware,
+	}
+}
+
+func (r *TrashRoutes) Setup() {
+	r.logger.Info("Setting up trash routes")
+
+	trash := r.handler.Group(
+		"/tenants/:tenantId/trash",
+		r.middleware.AuthRequired(),
+		r.tenantMiddleware.CanManageTenant("tenantId"),
+	)
+	{
+		trash.GET("", r.controller.GetTrash)
+		trash.POST("/sites/:siteId/restore", r.controller.RestoreSite)
+		trash.POST("/sites/:siteId/pages/:pageId/restore", r.controller.RestorePage)
+	}
+
+	// Templates are shared by all tenants, so only global admins manage their trash
+	templates := r.handler.Group(
+		"/trash/templates",
+		r.middleware.AuthRequired(),
+		r.tenantMiddleware.RequireGlobalAdmin(),
+	)
+	{
+		templates.GET("", r.controller.GetTemplateTrash)
+		templates.POST("/:templateId/restore", r.controller.RestoreTemplate)
+	}
+}
@@ -0,0 +1,152 @@
+package jobs
+
+import (
+	"github.com/h4rdc0m/aurora-api/application/use_cases"
+	"github.com/h4rdc0m/aurora-api/domain/common"
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"github.com/h4rdc0m/aurora-api/domain/services"
+	"github.com/h4rdc0m/aurora-api/infrastructure/config"
+	"time"
+)
+
+// linkCheckerLock is the name of the lock that keeps the link checker on a single instance at a time
+const linkCheckerLock = "jobs:link-checker"
+
+// defaultLinkCheckInterval is used when AURORA_LINK_CHECK_INTERVAL is empty or invalid
+const defaultLinkCheckInterval = 24 * time.Hour
+
+// LinkChecker periodically scans every enabled site for broken links and updates the link report of the site.
+// Every run holds a shared lock, so only one of several API instances checks the links at a time.
+type LinkChecker struct {
+	linkCheckUseCase *use_cases.LinkCheckUseCase
+	db               common.Database
+	lock             services.LockService
+	timeProvider     common.TimeProvider
+	logger           common.Logger
+	interval         time.Duration
+	stop             chan struct{}
+	done             chan struct{}
+}
+
+// NewLinkChecker creates a new LinkChecker running at the interval configured in the environment.
+func NewLinkChecker(
+	linkCheckUseCase *use_cases.LinkCheckUseCase,
+	db common.Database,
+	lock services.LockService,
+	timeProvider common.TimeProvider,
+	env *config.Env,
+	logger common.Logger,
+) *LinkChecker {
+	interval, err := time.ParseDuration(env.LinkCheckInterval)
+	if err != nil || interval <= 0 {
+		interval = defaultLinkCheckInterval
+	}
+
+	return &LinkChecker{
+		linkCheckUseCase: linkCheckUseCase,
+		db:               db,
+		lock:             lock,
+		timeProvider:     timeProvider,
+		logger:           logger,
+		interval:         interval,
+	}
+}
+
+// Start runs the link checker in the background until Stop is called. The first pass runs right away rather than
+// a full interval after every restart.
+func (j *LinkChecker) Start() {
+	j.stop = make(chan struct{})
+	j.done = make(chan struct{})
+
+	j.logger.Info("Starting link checker", "interval", j.interval.String())
+
+	go func() {
+		defer close(j.done)
+
+		j.Run()
+
+		ticker := time.NewTicker(j.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				j.Run()
+			case <-j.stop:
+				return
+			}
+		}
+	}()
+}
+
+// Stop stops the link checker and waits for a running pass to finish.
+func (j *LinkChecker) Stop() {
+	if j.stop == nil {
+		return
+	}
+
+	j.logger.Info("Stopping link checker")
+	close(j.stop)
+	<-j.done
+}
+
+// Run checks the links of all enabled sites once, if no other instance is doing so. Returns the number of broken
+// links found.
+func (j *LinkChecker) Run() int {
+	acquired, err := j.lock.TryLock(linkCheckerLock, j.interval)
+	if err != nil || !acquired {
+		return 0
+	}
+	defer func() {
+		_ = j.lock.Unlock(linkCheckerLock)
+	}()
+
+	sites, err := j.linkCheckUseCase.GetSitesToCheck()
+	if err != nil {
+		j.logger.Error("Failed to get sites to check links of", "error", err)
+		return 0
+	}
+
+	broken := 0
+	for _, site := range sites {
+		count, err := j.check(site)
+		if err != nil {
+			j.logger.Error("Failed to check links of site", "siteID", site.ID().Value(), "error", err)
+			continue
+		}
+		broken += count
+	}
+
+	j.logger.Info("Checked links", "sites", len(sites), "broken", broken)
+
+	return broken
+}
+
+// check checks the links of a single site and saves its report in its own transaction. The links are checked
+// before the transaction starts, so no transaction is held open while external servers are requested.
+func (j *LinkChecker) check(site *entities.Site) (int, error) {
+	checkedAt := j.timeProvider.Now()
+
+	findings, err := j.linkCheckUseCase.CheckSite(site)
+	if err != nil {
+		return 0, err
+	}
+
+	trx, err := j.db.Begin()
+	if err != nil {
+		return 0, err
+	}
+
+	if err := j.linkCheckUseCase.WithTrx(trx).SaveReport(site.ID(), findings, checkedAt); err != nil {
+		if rollbackErr := trx.Rollback(); rollbackErr != nil {
+			j.logger.Error("Failed to rollback link report", "siteID", site.ID().Value(), "error", rollbackErr)
+		}
+		return 0, err
+	}
+
+	if err := trx.Commit(); err != nil {
+		return 0, err
+	}
+
+	return len(findings), nil
+}
@@ -0,0 +1,203 @@
+package jobs
+
+import (
+	"errors"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/h4rdc0m/aurora-api/application/use_cases"
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"github.com/h4rdc0m/aurora-api/infrastructure/config"
+	"github.com/h4rdc0m/aurora-api/infrastructure/persistence/models"
+	"github.com/h4rdc0m/aurora-api/infrastructure/persistence/repositories"
+	"github.com/h4rdc0m/aurora-api/tests/mocks"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+// stubLinkChecker reports the configured URLs as broken and records every checked URL
+type stubLinkChecker struct {
+	broken  map[string]int
+	checked []string
+}
+
+func (s *stubLinkChecker) Check(url string) entities.LinkCheck {
+	s.checked = append(s.checked, url)
+	check := entities.LinkCheck{URL: url}
+	if status, ok := s.broken[url]; ok {
+		check.Broken = true
+		check.StatusCode = &status
+		check.Reason = "Not Found"
+	}
+	return check
+}
+
+func newTestLinkChecker(db *mocks.Database, lock *mockLockService, logger *mocks.Logger, checker *stubLinkChecker, now time.Time) *LinkChecker {
+	useCase := use_cases.NewLinkCheckUseCase(
+		repositories.NewSiteRepository(db, logger),
+		repositories.NewPageRepository(db, logger),
+		repositories.NewPageVersionRepository(db, logger),
+		repositories.NewPageBlockRepository(db, logger),
+		repositories.NewRedirectRepository(db, logger),
+		repositories.NewBrokenLinkRepository(db, logger),
+		checker,
+		logger,
+	)
+
+	return NewLinkChecker(useCase, db, lock, fixedTimeProvider{now: now}, &config.Env{LinkCheckInterval: "1h"}, logger)
+}
+
+func TestNewLinkChecker_Interval(t *testing.T) {
+	tests := []struct {
+		name     string
+		interval string
+		want     time.Duration
+	}{
+		{"configured", "6h", 6 * time.Hour},
+		{"empty", "", defaultLinkCheckInterval},
+		{"invalid", "daily", defaultLinkCheckInterval},
+		{"negative", "-1h", defaultLinkCheckInterval},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checker := NewLinkChecker(nil, nil, nil, nil, &config.Env{LinkCheckInterval: tt.interval}, &mocks.Logger{})
+			assert.Equal(t, tt.want, checker.interval)
+		})
+	}
+}
+
+func TestLinkChecker_Run_LockHeldElsewhere(t *testing.T) {
+	db := &mocks.Database{}
+	lock := &mockLockService{}
+	lock.On("TryLock", linkCheckerLock, time.Hour).Return(false, nil)
+
+	checker := newTestLinkChecker(db, lock, &mocks.Logger{}, &stubLinkChecker{}, time.Now())
+
+	assert.Equal(t, 0, checker.Run())
+	lock.AssertNotCalled(t, "Unlock", mock.Anything)
+	db.AssertNotCalled(t, "Select", mock.Anything, mock.Anything)
+}
+
+func TestLinkChecker_Run(t *testing.T) {
+	now := time.Date(2025, 7, 24, 3, 0, 0, 0, time.UTC)
+	homePath, aboutPath, docsPath, draftPath := "/home", "/home/about", "/docs", "/draft"
+	docsURL := "https://docs.example.org/guide"
+	homeID := uint64(1)
+
+	db := &mocks.Database{}
+	lock := &mockLockService{}
+	logger := &mocks.Logger{}
+	logger.On("Info", "Checked links", "sites", 1, "broken", 5).Return()
+	lock.On("TryLock", linkCheckerLock, time.Hour).Return(true, nil)
+	lock.On("Unlock", linkCheckerLock).Return(nil)
+
+	db.On("Select", mock.AnythingOfType("*[]*models.Site"), mock.Anything).Run(func(args mock.Arguments) {
+		*args.Get(0).(*[]*models.Site) = []*models.Site{
+			{Base: models.Base{ID: 1}, Name: "Example", Domain: "example.com", DefaultLocale: "en", Locales: "en,nl", Enabled: true, TenantID: 1, TemplateID: 1},
+			{Base: models.Base{ID: 2}, Name: "Disabled", Domain: "disabled.example.com", TenantID: 1, TemplateID: 1},
+		}
+	}).Return(nil)
+	db.On("Select", mock.AnythingOfType("*[]*models.Page"), mock.Anything, uint64(1)).Run(func(args mock.Arguments) {
+		*args.Get(0).(*[]*models.Page) = []*models.Page{
+			{Base: models.Base{ID: 1}, Key: "home", Path: &homePath, Type: models.PageTypeContent, SiteID: 1},
+			{Base: models.Base{ID: 2}, Key: "about", Path: &aboutPath, Type: models.PageTypeContent, SiteID: 1, ParentID: &homeID},
+			{Base: models.Base{ID: 3}, Key: "docs", Path: &docsPath, Index: 1, Type: models.PageTypeLink, SiteID: 1, LinkURL: &docsURL},
+			{Base: models.Base{ID: 4}, Key: "draft", Path: &draftPath, Index: 2, Type: models.PageTypeContent, SiteID: 1},
+		}
+	}).Return(nil)
+	db.On("Select", mock.AnythingOfType("*[]*models.PageVersion"), mock.Anything, "published", uint64(1)).Run(func(args mock.Arguments) {
+		*args.Get(0).(*[]*models.PageVersion) = []*models.PageVersion{
+			{Base: models.Base{ID: 10}, PageID: 1, Locale: "en", Version: 1, Title: "Home", Status: "published"},
+			{Base: models.Base{ID: 11}, PageID: 2, Locale: "en", Version: 1, Title: "About", Status: "published"},
+			{Base: models.Base{ID: 12}, PageID: 1, Locale: "nl", Version: 2, Title: "Thuis", Status: "published"},
+		}
+	}).Return(nil)
+	db.On("Select", mock.AnythingOfType("*[]*models.Redirect"), mock.Anything, uint64(1)).Run(func(args mock.Arguments) {
+		*args.Get(0).(*[]*models.Redirect) = []*models.Redirect{
+			{Base: models.Base{ID: 20}, SiteID: 1, SourcePath: "/old-about", TargetPageID: func() *uint64 { id := uint64(2); return &id }(), StatusCode: 301},
+			{Base: models.Base{ID: 21}, SiteID: 1, SourcePath: "/retired", StatusCode: 410},
+		}
+	}).Return(nil)
+	db.On("Select", mock.AnythingOfType("*[]*models.PageBlock"), mock.Anything, uint64(10)).Run(func(args mock.Arguments) {
+		*args.Get(0).(*[]*models.PageBlock) = []*models.PageBlock{
+			{Base: models.Base{ID: 30}, PageVersionID: 10, BlockKey: "intro", ContentType: "html", Content: `<a href="home/about">About</a> <a href="https://example.com/old-about">Old</a>
+<a href="/retired">Retired</a> <a href="/missing">Missing</a> <a href="mailto:info@example.com">Mail</a>`},
+			{Base: models.Base{ID: 31}, PageVersionID: 10, BlockKey: "more", ContentType: "markdown", Content: "[Guide](https://docs.example.org/guide) and [draft](/draft) and [home](/)"},
+		}
+	}).Return(nil)
+	db.On("Select", mock.AnythingOfType("*[]*models.PageBlock"), mock.Anything, uint64(11)).Return(nil)
+	// The Dutch home page repeats a broken link, which is reported once, and links to the English fallback of about
+	db.On("Select", mock.AnythingOfType("*[]*models.PageBlock"), mock.Anything, uint64(12)).Run(func(args mock.Arguments) {
+		*args.Get(0).(*[]*models.PageBlock) = []*models.PageBlock{
+			{Base: models.Base{ID: 32}, PageVersionID: 12, BlockKey: "intro", ContentType: "html", Content: `<a href="/missing">Ontbreekt</a> <a href="/nl/home/about">Over</a>`},
+		}
+	}).Return(nil)
+
+	sqlDB, sqlMock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer func() { _ = sqlDB.Close() }()
+	sqlMock.ExpectBegin()
+	tx, err := sqlx.NewDb(sqlDB, "mysql").Beginx()
+	assert.NoError(t, err)
+	db.On("Begin").Return(tx, nil)
+	sqlMock.ExpectQuery("SELECT \\* FROM broken_links WHERE site_id = \\?").WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	for i := 0; i < 5; i++ {
+		sqlMock.ExpectExec("INSERT INTO broken_links").WillReturnResult(sqlmock.NewResult(int64(i+1), 1))
+	}
+	sqlMock.ExpectExec("DELETE FROM broken_links WHERE site_id = \\? AND last_checked_at < \\?").WithArgs(1, now).WillReturnResult(sqlmock.NewResult(0, 0))
+	sqlMock.ExpectCommit()
+
+	linkChecker := &stubLinkChecker{broken: map[string]int{docsURL: 404}}
+	job := newTestLinkChecker(db, lock, logger, linkChecker, now)
+
+	// Broken: /retired (gone), /missing, the docs URL in the block and on the link page, and the unpublished /draft
+	assert.Equal(t, 5, job.Run())
+	// External links are requested once per run, whether they occur in blocks or on link pages
+	assert.Equal(t, []string{docsURL}, linkChecker.checked)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+	lock.AssertExpectations(t)
+	logger.AssertExpectations(t)
+}
+
+func TestLinkChecker_Run_SiteError(t *testing.T) {
+	db := &mocks.Database{}
+	lock := &mockLockService{}
+	logger := &mocks.Logger{}
+	lock.On("TryLock", linkCheckerLock, time.Hour).Return(true, nil)
+	lock.On("Unlock", linkCheckerLock).Return(nil)
+	db.On("Select", mock.AnythingOfType("*[]*models.Site"), mock.Anything).Run(func(args mock.Arguments) {
+		*args.Get(0).(*[]*models.Site) = []*models.Site{
+			{Base: models.Base{ID: 1}, Name: "Example", Domain: "example.com", DefaultLocale: "en", Locales: "en,nl", Enabled: true, TenantID: 1, TemplateID: 1},
+		}
+	}).Return(nil)
+	dbErr := errors.New("connection lost")
+	db.On("Select", mock.AnythingOfType("*[]*models.Page"), mock.Anything, uint64(1)).Return(dbErr)
+	logger.On("Error", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+	logger.On("Info", "Checked links", "sites", 1, "broken", 0).Return()
+
+	job := newTestLinkChecker(db, lock, logger, &stubLinkChecker{}, time.Now())
+
+	assert.Equal(t, 0, job.Run())
+	logger.AssertCalled(t, "Error", "Failed to check links of site", "siteID", uint64(1), "error", dbErr)
+	db.AssertNotCalled(t, "Begin")
+	lock.AssertExpectations(t)
+}
+
+func TestLinkChecker_StartStop(t *testing.T) {
+	logger := &mocks.Logger{}
+	logger.On("Info", "Starting link checker", "interval", "1h0m0s").Return()
+	logger.On("Info", "Stopping link checker").Return()
+	lock := &mockLockService{}
+	lock.On("TryLock", linkCheckerLock, time.Hour).Return(false, nil)
+
+	checker := newTestLinkChecker(&mocks.Database{}, lock, logger, &stubLinkChecker{}, time.Now())
+
+	checker.Start()
+	checker.Stop()
+
+	logger.AssertExpectations(t)
+	// The first pass runs on start, before the first tick
+	lock.AssertNumberOfCalls(t, "TryLock", 1)
+}
@@ -0,0 +1,58 @@
+package jobs
+
+import (
+	"context"
+	"go.uber.org/fx"
+)
+
+// Module provides the background jobs of the application, started and stopped with the fx lifecycle.
+var Module = fx.Options(
+	fx.Provide(NewPublishScheduler),
+	fx.Invoke(RegisterPublishScheduler),
+	fx.Provide(NewLinkChecker),
+	fx.Invoke(RegisterLinkChecker),
+	fx.Provide(NewTrashPurger),
+	fx.Invoke(RegisterTrashPurger),
+)
+
+// RegisterPublishScheduler hooks the publish scheduler into the application lifecycle.
+func RegisterPublishScheduler(lc fx.Lifecycle, scheduler *PublishScheduler) {
+	lc.Append(fx.Hook{
+		OnStart: func(_ context.Context) error {
+			scheduler.Start()
+			return nil
+		},
+		OnStop: func(_ context.Context) error {
+			scheduler.Stop()
+			return nil
+		},
+	})
+}
+
+// RegisterLinkChecker hooks the link checker into the application lifecycle.
+func RegisterLinkChecker(lc fx.Lifecycle, checker *LinkChecker) {
+	lc.Append(fx.Hook{
+		OnStart: func(_ context.Context) error {
+			checker.Start()
+			return nil
+		},
+		OnStop: func(_ context.Context) error {
+			checker.Stop()
+			return nil
+		},
+	})
+}
+
+// RegisterTrashPurger hooks the trash purger into the application lifecycle.
+func RegisterTrashPurger(lc fx.Lifecycle, purger *TrashPurger) {
+	lc.Append(fx.Hook{
+		OnStart: func(_ context.Context) error {
+			purger.Start()
+			return nil
+		},
+		OnStop: func(_ context.Context) error {
+			purger.Stop()
+			return nil
+		},
+	})
+}
@@ -0,0 +1,146 @@
+package jobs
+
+import (
+	"github.com/h4rdc0m/aurora-api/application/use_cases"
+	"github.com/h4rdc0m/aurora-api/domain/common"
+	"github.com/h4rdc0m/aurora-api/domain/services"
+	"github.com/h4rdc0m/aurora-api/infrastructure/config"
+	"time"
+)
+
+// publishSchedulerLock is the name of the lock that keeps the scheduler on a single instance at a time
+const publishSchedulerLock = "jobs:publish-scheduler"
+
+// defaultSchedulerInterval is used when AURORA_SCHEDULER_INTERVAL is empty or invalid
+const defaultSchedulerInterval = 30 * time.Second
+
+// PublishScheduler periodically publishes and unpublishes page versions whose scheduled time has been reached.
+// Every run holds a shared lock, so only one of several API instances applies the schedules at a time.
+type PublishScheduler struct {
+	pageVersionUseCase *use_cases.PageVersionUseCase
+	db                 common.Database
+	lock               services.LockService
+	timeProvider       common.TimeProvider
+	logger             common.Logger
+	interval           time.Duration
+	stop               chan struct{}
+	done               chan struct{}
+}
+
+// NewPublishScheduler creates a new PublishScheduler running at the interval configured in the environment.
+func NewPublishScheduler(
+	pageVersionUseCase *use_cases.PageVersionUseCase,
+	db common.Database,
+	lock services.LockService,
+	timeProvider common.TimeProvider,
+	env *config.Env,
+	logger common.Logger,
+) *PublishScheduler {
+	interval, err := time.ParseDuration(env.SchedulerInterval)
+	if err != nil || interval <= 0 {
+		interval = defaultSchedulerInterval
+	}
+
+	return &PublishScheduler{
+		pageVersionUseCase: pageVersionUseCase,
+		db:                 db,
+		lock:               lock,
+		timeProvider:       timeProvider,
+		logger:             logger,
+		interval:           interval,
+	}
+}
+
+// Start runs the scheduler in the background until Stop is called.
+func (s *PublishScheduler) Start() {
+	s.stop = make(chan struct{})
+	s.done = make(chan struct{})
+
+	s.logger.Info("Starting publish scheduler", "interval", s.interval.String())
+
+	go func() {
+		defer close(s.done)
+
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				s.Run()
+			case <-s.stop:
+				return
+			}
+		}
+	}()
+}
+
+// Stop stops the scheduler and waits for a running pass to finish.
+func (s *PublishScheduler) Stop() {
+	if s.stop == nil {
+		return
+	}
+
+	s.logger.Info("Stopping publish scheduler")
+	close(s.stop)
+	<-s.done
+}
+
+// Run applies all due schedules once, if no other instance is doing so. Returns the number of changed versions.
+func (s *PublishScheduler) Run() int {
+	acquired, err := s.lock.TryLock(publishSchedulerLock, s.interval)
+	if err != nil || !acquired {
+		return 0
+	}
+	defer func() {
+		_ = s.lock.Unlock(publishSchedulerLock)
+	}()
+
+	now := s.timeProvider.Now()
+
+	versions, err := s.pageVersionUseCase.GetDueScheduledVersions(now)
+	if err != nil {
+		s.logger.Error("Failed to get due page version schedules", "error", err)
+		return 0
+	}
+
+	applied := 0
+	for _, version := range versions {
+		changed, err := s.apply(version.ID().Value(), now)
+		if err != nil {
+			s.logger.Error("Failed to apply page version schedule", "versionID", version.ID().Value(), "error", err)
+			continue
+		}
+		if changed {
+			applied++
+		}
+	}
+
+	if applied > 0 {
+		s.logger.Info("Applied page version schedules", "count", applied)
+	}
+
+	return applied
+}
+
+// apply applies the schedule of a single version in its own transaction, so one failure does not hold back the others.
+func (s *PublishScheduler) apply(versionID uint64, now time.Time) (bool, error) {
+	trx, err := s.db.Begin()
+	if err != nil {
+		return false, err
+	}
+
+	changed, err := s.pageVersionUseCase.WithTrx(trx).ApplySchedule(versionID, now)
+	if err != nil {
+		if rollbackErr := trx.Rollback(); rollbackErr != nil {
+			s.logger.Error("Failed to rollback page version schedule", "versionID", versionID, "error", rollbackErr)
+		}
+		return false, err
+	}
+
+	if err := trx.Commit(); err != nil {
+		return false, err
+	}
+
+	return changed, nil
+}
@@ -0,0 +1,160 @@
+package jobs
+
+import (
+	"errors"
+	"github.com/h4rdc0m/aurora-api/application/use_cases"
+	"github.com/h4rdc0m/aurora-api/infrastructure/config"
+	"github.com/h4rdc0m/aurora-api/infrastructure/persistence/models"
+	"github.com/h4rdc0m/aurora-api/infrastructure/persistence/repositories"
+	"github.com/h4rdc0m/aurora-api/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+// fixedTimeProvider is a TimeProvider that always returns the same moment
+type fixedTimeProvider struct {
+	now time.Time
+}
+
+func (f fixedTimeProvider) Now() time.Time {
+	return f.now
+}
+
+func (f fixedTimeProvider) Parse(layout, value string) (time.Time, error) {
+	return time.Parse(layout, value)
+}
+
+func (f fixedTimeProvider) Format(t time.Time, layout string) string {
+	return t.Format(layout)
+}
+
+// mockLockService is a mock implementation of the LockService interface
+type mockLockService struct {
+	mock.Mock
+}
+
+func (m *mockLockService) TryLock(name string, ttl time.Duration) (bool, error) {
+	args := m.Called(name, ttl)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *mockLockService) Unlock(name string) error {
+	args := m.Called(name)
+	return args.Error(0)
+}
+
+func newTestScheduler(db *mocks.Database, lock *mockLockService, logger *mocks.Logger, now time.Time) *PublishScheduler {
+	pageVersionRepo := repositories.NewPageVersionRepository(db, logger)
+	useCase := use_cases.NewPageVersionUseCase(nil, pageVersionRepo, nil, nil, nil, nil, nil, nil, nil, logger)
+
+	return NewPublishScheduler(useCase, db, lock, fixedTimeProvider{now: now}, &config.Env{SchedulerInterval: "1m"}, logger)
+}
+
+func TestNewPublishScheduler_Interval(t *testing.T) {
+	tests := []struct {
+		name     string
+		interval string
+		want     time.Duration
+	}{
+		{"configured", "5s", 5 * time.Second},
+		{"empty", "", defaultSchedulerInterval},
+		{"invalid", "soon", defaultSchedulerInterval},
+		{"negative", "-1m", defaultSchedulerInterval},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scheduler := NewPublishScheduler(nil, nil, nil, nil, &config.Env{SchedulerInterval: tt.interval}, &mocks.Logger{})
+			assert.Equal(t, tt.want, scheduler.interval)
+		})
+	}
+}
+
+func TestPublishScheduler_Run_LockHeldElsewhere(t *testing.T) {
+	db := &mocks.Database{}
+	lock := &mockLockService{}
+	logger := &mocks.Logger{}
+	lock.On("TryLock", publishSchedulerLock, time.Minute).Return(false, nil)
+
+	scheduler := newTestScheduler(db, lock, logger, time.Now())
+
+	assert.Equal(t, 0, scheduler.Run())
+	lock.AssertExpectations(t)
+	lock.AssertNotCalled(t, "Unlock", mock.Anything)
+	db.AssertNotCalled(t, "Select", mock.Anything, mock.Anything)
+}
+
+func TestPublishScheduler_Run_LockError(t *testing.T) {
+	db := &mocks.Database{}
+	lock := &mockLockService{}
+	logger := &mocks.Logger{}
+	lock.On("TryLock", publishSchedulerLock, time.Minute).Return(false, errors.New("redis down"))
+
+	scheduler := newTestScheduler(db, lock, logger, time.Now())
+
+	assert.Equal(t, 0, scheduler.Run())
+	lock.AssertNotCalled(t, "Unlock", mock.Anything)
+	db.AssertNotCalled(t, "Select", mock.Anything, mock.Anything)
+}
+
+func TestPublishScheduler_Run_NothingDue(t *testing.T) {
+	now := time.Date(2025, 7, 17, 0, 0, 0, 0, time.UTC)
+	db := &mocks.Database{}
+	lock := &mockLockService{}
+	logger := &mocks.Logger{}
+	lock.On("TryLock", publishSchedulerLock, time.Minute).Return(true, nil)
+	lock.On("Unlock", publishSchedulerLock).Return(nil)
+	// The due query must use the time of the provider, not the wall clock
+	db.On("Select", mock.Anything, mock.Anything, "approved", now, "published", now).Return(nil)
+
+	scheduler := newTestScheduler(db, lock, logger, now)
+
+	assert.Equal(t, 0, scheduler.Run())
+	lock.AssertExpectations(t)
+	db.AssertExpectations(t)
+}
+
+func TestPublishScheduler_Run_ApplyError(t *testing.T) {
+	now := time.Date(2025, 7, 17, 0, 0, 0, 0, time.UTC)
+	publishAt := now.Add(-time.Minute)
+	scheduledBy := uint64(7)
+	db := &mocks.Database{}
+	lock := &mockLockService{}
+	logger := &mocks.Logger{}
+	lock.On("TryLock", publishSchedulerLock, time.Minute).Return(true, nil)
+	lock.On("Unlock", publishSchedulerLock).Return(nil)
+	db.On("Select", mock.Anything, mock.Anything, "approved", now, "published", now).
+		Run(func(args mock.Arguments) {
+			dest := args.Get(0).(*[]*models.PageVersion)
+			*dest = []*models.PageVersion{
+				{Base: models.Base{ID: 1}, PageID: 2, Locale: "en", Version: 1, Title: "Launch", Status: "approved", PublishAt: &publishAt, ScheduledBy: &scheduledBy},
+				{Base: models.Base{ID: 3}, PageID: 4, Locale: "en", Version: 1, Title: "Sale", Status: "approved", PublishAt: &publishAt, ScheduledBy: &scheduledBy},
+			}
+		}).
+		Return(nil)
+	db.On("Begin").Return(nil, errors.New("connection lost"))
+	logger.On("Error", "Failed to apply page version schedule", "versionID", mock.Anything, "error", mock.Anything).Return()
+
+	scheduler := newTestScheduler(db, lock, logger, now)
+
+	// A failing version is logged and the remaining versions are still attempted
+	assert.Equal(t, 0, scheduler.Run())
+	db.AssertNumberOfCalls(t, "Begin", 2)
+	logger.AssertNumberOfCalls(t, "Error", 2)
+	lock.AssertExpectations(t)
+}
+
+func TestPublishScheduler_StartStop(t *testing.T) {
+	logger := &mocks.Logger{}
+	logger.On("Info", "Starting publish scheduler", "interval", "1m0s").Return()
+	logger.On("Info", "Stopping publish scheduler").Return()
+
+	scheduler := newTestScheduler(&mocks.Database{}, &mockLockService{}, logger, time.Now())
+
+	scheduler.Start()
+	scheduler.Stop()
+
+	logger.AssertExpectations(t)
+}
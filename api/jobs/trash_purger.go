@@ -0,0 +1,170 @@
+package jobs
+
+import (
+	"github.com/h4rdc0m/aurora-api/application/use_cases"
+	"github.com/h4rdc0m/aurora-api/domain/common"
+	"github.com/h4rdc0m/aurora-api/domain/services"
+	"github.com/h4rdc0m/aurora-api/infrastructure/config"
+	"time"
+)
+
+// trashPurgerLock is the name of the lock that keeps the trash purger on a single instance at a time
+const trashPurgerLock = "jobs:trash-purger"
+
+// Defaults used when AURORA_TRASH_PURGE_INTERVAL or AURORA_TRASH_RETENTION is empty or invalid
+const (
+	defaultTrashPurgeInterval = 24 * time.Hour
+	defaultTrashRetention     = 30 * 24 * time.Hour
+)
+
+// TrashPurger periodically deletes the sites, pages and templates that have been in the trash for longer than the
+// configured retention. Every run holds a shared lock, so only one of several API instances purges at a time.
+type TrashPurger struct {
+	trashUseCase *use_cases.TrashUseCase
+	db           common.Database
+	lock         services.LockService
+	timeProvider common.TimeProvider
+	logger       common.Logger
+	interval     time.Duration
+	retention    time.Duration
+	stop         chan struct{}
+	done         chan struct{}
+}
+
+// NewTrashPurger creates a new TrashPurger running at the interval and with the retention configured in the
+// environment.
+func NewTrashPurger(
+	trashUseCase *use_cases.TrashUseCase,
+	db common.Database,
+	lock services.LockService,
+	timeProvider common.TimeProvider,
+	env *config.Env,
+	logger common.Logger,
+) *TrashPurger {
+	interval, err := time.ParseDuration(env.TrashPurgeInterval)
+	if err != nil || interval <= 0 {
+		interval = defaultTrashPurgeInterval
+	}
+	retention, err := time.ParseDuration(env.TrashRetention)
+	if err != nil || retention <= 0 {
+		retention = defaultTrashRetention
+	}
+
+	return &TrashPurger{
+		trashUseCase: trashUseCase,
+		db:           db,
+		lock:         lock,
+		timeProvider: timeProvider,
+		logger:       logger,
+		interval:     interval,
+		retention:    retention,
+	}
+}
+
+// Start runs the trash purger in the background until Stop is called.
+func (j *TrashPurger) Start() {
+	j.stop = make(chan struct{})
+	j.done = make(chan struct{})
+
+	j.logger.Info("Starting trash purger", "interval", j.interval.String(), "retention", j.retention.String())
+
+	go func() {
+		defer close(j.done)
+
+		ticker := time.NewTicker(j.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				j.Run()
+			case <-j.stop:
+				return
+			}
+		}
+	}()
+}
+
+// Stop stops the trash purger and waits for a running pass to finish.
+func (j *TrashPurger) Stop() {
+	if j.stop == nil {
+		return
+	}
+
+	j.logger.Info("Stopping trash purger")
+	close(j.stop)
+	<-j.done
+}
+
+// Run purges the expired trash once, if no other instance is doing so. Returns the number of purged items.
+// Pages are purged before sites, as the pages of a trashed site went into the trash along with it.
+func (j *TrashPurger) Run() int {
+	acquired, err := j.lock.TryLock(trashPurgerLock, j.interval)
+	if err != nil || !acquired {
+		return 0
+	}
+	defer func() {
+		_ = j.lock.Unlock(trashPurgerLock)
+	}()
+
+	sites, pages, templates, err := j.trashUseCase.GetExpiredTrash(j.timeProvider.Now().Add(-j.retention))
+	if err != nil {
+		j.logger.Error("Failed to get expired trash", "error", err)
+		return 0
+	}
+
+	purged := 0
+	for _, page := range pages {
+		err := j.purge(func(useCase *use_cases.TrashUseCase) error {
+			return useCase.PurgePage(page)
+		})
+		if err != nil {
+			j.logger.Error("Failed to purge page", "pageID", page.ID().Value(), "error", err)
+			continue
+		}
+		purged++
+	}
+	for _, site := range sites {
+		err := j.purge(func(useCase *use_cases.TrashUseCase) error {
+			return useCase.PurgeSite(site)
+		})
+		if err != nil {
+			j.logger.Error("Failed to purge site", "siteID", site.ID().Value(), "error", err)
+			continue
+		}
+		purged++
+	}
+	for _, template := range templates {
+		err := j.purge(func(useCase *use_cases.TrashUseCase) error {
+			return useCase.PurgeTemplate(template)
+		})
+		if err != nil {
+			j.logger.Error("Failed to purge template", "templateID", template.ID().Value(), "error", err)
+			continue
+		}
+		purged++
+	}
+
+	if purged > 0 {
+		j.logger.Info("Purged trash", "count", purged)
+	}
+
+	return purged
+}
+
+// purge runs a single purge in its own transaction, so one failure does not hold back the others.
+func (j *TrashPurger) purge(purge func(useCase *use_cases.TrashUseCase) error) error {
+	trx, err := j.db.Begin()
+	if err != nil {
+		return err
+	}
+
+	if err := purge(j.trashUseCase.WithTrx(trx)); err != nil {
+		if rollbackErr := trx.Rollback(); rollbackErr != nil {
+			j.logger.Error("Failed to rollback trash purge", "error", rollbackErr)
+		}
+		return err
+	}
+
+	return trx.Commit()
+}
@@ -0,0 +1,197 @@
+package jobs
+
+import (
+	"errors"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/h4rdc0m/aurora-api/application/use_cases"
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	domainServices "github.com/h4rdc0m/aurora-api/domain/services"
+	"github.com/h4rdc0m/aurora-api/infrastructure/config"
+	"github.com/h4rdc0m/aurora-api/infrastructure/persistence/models"
+	"github.com/h4rdc0m/aurora-api/infrastructure/persistence/repositories"
+	"github.com/h4rdc0m/aurora-api/tests/mocks"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+// stubSearchIndexer is a SearchIndexer that indexes nothing
+type stubSearchIndexer struct{}
+
+func (s stubSearchIndexer) Reindex(_ *entities.Page) error {
+	return nil
+}
+
+func (s stubSearchIndexer) Remove(_ entities.PageID) error {
+	return nil
+}
+
+func (s stubSearchIndexer) WithTrx(_ *sqlx.Tx) domainServices.SearchIndexer {
+	return s
+}
+
+func newTestTrashPurger(db *mocks.Database, lock *mockLockService, logger *mocks.Logger, now time.Time) *TrashPurger {
+	useCase := use_cases.NewTrashUseCase(
+		repositories.NewSiteRepository(db, logger),
+		repositories.NewPageRepository(db, logger),
+		repositories.NewPageVersionRepository(db, logger),
+		repositories.NewPageBlockRepository(db, logger),
+		repositories.NewTemplateRepository(db, logger),
+		stubSearchIndexer{},
+		logger,
+	)
+
+	env := &config.Env{TrashPurgeInterval: "1h", TrashRetention: "720h"}
+	return NewTrashPurger(useCase, db, lock, fixedTimeProvider{now: now}, env, logger)
+}
+
+func TestNewTrashPurger_Config(t *testing.T) {
+	tests := []struct {
+		name          string
+		interval      string
+		retention     string
+		wantInterval  time.Duration
+		wantRetention time.Duration
+	}{
+		{"configured", "6h", "168h", 6 * time.Hour, 7 * 24 * time.Hour},
+		{"empty", "", "", defaultTrashPurgeInterval, defaultTrashRetention},
+		{"invalid", "daily", "a month", defaultTrashPurgeInterval, defaultTrashRetention},
+		{"negative", "-1h", "-24h", defaultTrashPurgeInterval, defaultTrashRetention},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := &config.Env{TrashPurgeInterval: tt.interval, TrashRetention: tt.retention}
+			purger := NewTrashPurger(nil, nil, nil, nil, env, &mocks.Logger{})
+			assert.Equal(t, tt.wantInterval, purger.interval)
+			assert.Equal(t, tt.wantRetention, purger.retention)
+		})
+	}
+}
+
+func TestTrashPurger_Run_LockHeldElsewhere(t *testing.T) {
+	db := &mocks.Database{}
+	lock := &mockLockService{}
+	lock.On("TryLock", trashPurgerLock, time.Hour).Return(false, nil)
+
+	purger := newTestTrashPurger(db, lock, &mocks.Logger{}, time.Now())
+
+	assert.Equal(t, 0, purger.Run())
+	lock.AssertNotCalled(t, "Unlock", mock.Anything)
+	db.AssertNotCalled(t, "Select", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestTrashPurger_Run(t *testing.T) {
+	now := time.Date(2025, 7, 29, 3, 0, 0, 0, time.UTC)
+	before := now.Add(-720 * time.Hour)
+	deletedAt := before.Add(-time.Hour)
+	homePath, aboutPath := "/home", "/home/about"
+	homeID := uint64(1)
+
+	db := &mocks.Database{}
+	lock := &mockLockService{}
+	logger := &mocks.Logger{}
+	logger.On("Info", "Purged trash", "count", 3).Return()
+	lock.On("TryLock", trashPurgerLock, time.Hour).Return(true, nil)
+	lock.On("Unlock", trashPurgerLock).Return(nil)
+
+	db.On("Select", mock.AnythingOfType("*[]*models.Site"), "SELECT * FROM sites WHERE deleted_at < ?", before).Run(func(args mock.Arguments) {
+		*args.Get(0).(*[]*models.Site) = []*models.Site{
+			{Base: models.Base{ID: 1, DeletedAt: &deletedAt}, Name: "Example", Domain: "example.com", DefaultLocale: "en", Locales: "en", TenantID: 1, TemplateID: 1},
+		}
+	}).Return(nil)
+	// The parent is returned first, yet purged after its child
+	db.On("Select", mock.AnythingOfType("*[]*models.Page"), "SELECT * FROM pages WHERE deleted_at < ?", before).Run(func(args mock.Arguments) {
+		*args.Get(0).(*[]*models.Page) = []*models.Page{
+			{Base: models.Base{ID: 1, DeletedAt: &deletedAt}, Key: "home", Path: &homePath, Type: models.PageTypeContent, SiteID: 1},
+			{Base: models.Base{ID: 2, DeletedAt: &deletedAt}, Key: "about", Path: &aboutPath, Type: models.PageTypeContent, SiteID: 1, ParentID: &homeID},
+		}
+	}).Return(nil)
+	db.On("Select", mock.AnythingOfType("*[]*models.Template"), "SELECT * FROM templates WHERE deleted_at < ?", before).Return(nil)
+
+	sqlDB, sqlMock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer func() { _ = sqlDB.Close() }()
+	sqlxDB := sqlx.NewDb(sqlDB, "mysql")
+	for i := 0; i < 3; i++ {
+		sqlMock.ExpectBegin()
+		tx, err := sqlxDB.Beginx()
+		assert.NoError(t, err)
+		db.On("Begin").Return(tx, nil).Once()
+	}
+
+	sqlMock.ExpectQuery("SELECT \\* FROM page_versions WHERE page_id = \\? AND deleted_at IS NOT NULL").WithArgs(2).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "locale", "version", "title", "status"}).AddRow(20, "en", 1, "About", "draft"))
+	sqlMock.ExpectExec("DELETE FROM page_blocks WHERE page_version_id = \\?").WithArgs(20).WillReturnResult(sqlmock.NewResult(0, 2))
+	sqlMock.ExpectExec("DELETE FROM page_versions WHERE id = \\?").WithArgs(20).WillReturnResult(sqlmock.NewResult(0, 1))
+	sqlMock.ExpectExec("DELETE FROM pages WHERE id = \\?").WithArgs(2).WillReturnResult(sqlmock.NewResult(0, 1))
+	sqlMock.ExpectCommit()
+	sqlMock.ExpectQuery("SELECT \\* FROM page_versions WHERE page_id = \\? AND deleted_at IS NOT NULL").WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	sqlMock.ExpectExec("DELETE FROM pages WHERE id = \\?").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+	sqlMock.ExpectCommit()
+	sqlMock.ExpectExec("DELETE FROM sites WHERE id = \\?").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+	sqlMock.ExpectCommit()
+
+	purger := newTestTrashPurger(db, lock, logger, now)
+
+	assert.Equal(t, 3, purger.Run())
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+	lock.AssertExpectations(t)
+	logger.AssertExpectations(t)
+}
+
+func TestTrashPurger_Run_PurgeError(t *testing.T) {
+	now := time.Date(2025, 7, 29, 3, 0, 0, 0, time.UTC)
+	before := now.Add(-720 * time.Hour)
+	deletedAt := before.Add(-time.Hour)
+
+	db := &mocks.Database{}
+	lock := &mockLockService{}
+	logger := &mocks.Logger{}
+	lock.On("TryLock", trashPurgerLock, time.Hour).Return(true, nil)
+	lock.On("Unlock", trashPurgerLock).Return(nil)
+	db.On("Select", mock.AnythingOfType("*[]*models.Site"), mock.Anything, before).Return(nil)
+	db.On("Select", mock.AnythingOfType("*[]*models.Page"), mock.Anything, before).Return(nil)
+	db.On("Select", mock.AnythingOfType("*[]*models.Template"), mock.Anything, before).Run(func(args mock.Arguments) {
+		*args.Get(0).(*[]*models.Template) = []*models.Template{
+			{Base: models.Base{ID: 5, DeletedAt: &deletedAt}, Name: "Classic", FilePath: "templates/classic"},
+		}
+	}).Return(nil)
+
+	sqlDB, sqlMock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer func() { _ = sqlDB.Close() }()
+	sqlMock.ExpectBegin()
+	tx, err := sqlx.NewDb(sqlDB, "mysql").Beginx()
+	assert.NoError(t, err)
+	db.On("Begin").Return(tx, nil)
+	// A site still uses the template
+	fkErr := errors.New("foreign key constraint fails")
+	sqlMock.ExpectExec("DELETE FROM templates WHERE id = \\?").WithArgs(5).WillReturnError(fkErr)
+	sqlMock.ExpectRollback()
+	logger.On("Error", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+	logger.On("Error", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+
+	purger := newTestTrashPurger(db, lock, logger, now)
+
+	assert.Equal(t, 0, purger.Run())
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+	logger.AssertCalled(t, "Error", "Failed to purge template", "templateID", uint64(5), "error", mock.Anything)
+	logger.AssertNotCalled(t, "Info", "Purged trash", mock.Anything, mock.Anything)
+}
+
+func TestTrashPurger_StartStop(t *testing.T) {
+	logger := &mocks.Logger{}
+	logger.On("Info", "Starting trash purger", "interval", "1h0m0s", "retention", "720h0m0s").Return()
+	logger.On("Info", "Stopping trash purger").Return()
+
+	purger := newTestTrashPurger(&mocks.Database{}, &mockLockService{}, logger, time.Now())
+
+	purger.Start()
+	purger.Stop()
+
+	logger.AssertExpectations(t)
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/h4rdc0m/aurora-api/api/http/controllers"
 	"github.com/h4rdc0m/aurora-api/api/http/middlewares"
 	"github.com/h4rdc0m/aurora-api/api/http/routes"
+	"github.com/h4rdc0m/aurora-api/api/jobs"
 	"go.uber.org/fx"
 )
 
@@ -11,4 +12,5 @@ var Module = fx.Options(
 	controllers.Module,
 	routes.Module,
 	middlewares.Module,
+	jobs.Module,
 )
@@ -0,0 +1,34 @@
+package dto
+
+import (
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"time"
+)
+
+// AuditEntryResponse is the API representation of an entry of the audit log of a tenant
+type AuditEntryResponse struct {
+	ID         uint64            `json:"id"`
+	ActorID    uint64            `json:"actor_id"`
+	Action     string            `json:"action"`
+	Resource   string            `json:"resource"`
+	ResourceID uint64            `json:"resource_id"`
+	Details    map[string]string `json:"details"`
+	CreatedAt  time.Time         `json:"created_at"`
+}
+
+// NewAuditEntryResponses maps a slice of audit entry entities to AuditEntryResponses.
+func NewAuditEntryResponses(entries []*entities.AuditEntry) []AuditEntryResponse {
+	responses := make([]AuditEntryResponse, 0, len(entries))
+	for _, entry := range entries {
+		responses = append(responses, AuditEntryResponse{
+			ID:         entry.ID().Value(),
+			ActorID:    entry.ActorID().Value(),
+			Action:     string(entry.Action()),
+			Resource:   entry.Resource(),
+			ResourceID: entry.ResourceID(),
+			Details:    entry.Details(),
+			CreatedAt:  entry.CreatedAt(),
+		})
+	}
+	return responses
+}
@@ -0,0 +1,61 @@
+package dto
+
+import (
+	"encoding/json"
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"time"
+)
+
+type CreateContentTypeRequest struct {
+	Name        string          `json:"name" validate:"required,max=100"`
+	Description *string         `json:"description,omitempty" validate:"max=255"`
+	Schema      json.RawMessage `json:"schema" validate:"required"`
+}
+
+type UpdateContentTypeRequest struct {
+	Description *string         `json:"description,omitempty" validate:"max=255"`
+	Schema      json.RawMessage `json:"schema" validate:"required"`
+}
+
+// ValidateBlockContentRequest checks block content against the registered content type without saving it.
+type ValidateBlockContentRequest struct {
+	ContentType string `json:"content_type" validate:"required"`
+	Content     string `json:"content"`
+}
+
+// ContentTypeResponse is the API representation of a block content type. TenantID is nil for global content types.
+type ContentTypeResponse struct {
+	ID          uint64          `json:"id"`
+	TenantID    *uint64         `json:"tenant_id"`
+	Name        string          `json:"name"`
+	Description *string         `json:"description"`
+	Schema      json.RawMessage `json:"schema"`
+	CreatedAt   time.Time       `json:"created_at"`
+	UpdatedAt   time.Time       `json:"updated_at"`
+}
+
+// NewContentTypeResponse maps a content type entity to a ContentTypeResponse.
+func NewContentTypeResponse(contentType *entities.ContentType) ContentTypeResponse {
+	response := ContentTypeResponse{
+		ID:          contentType.ID().Value(),
+		Name:        contentType.Name(),
+		Description: contentType.Description(),
+		Schema:      json.RawMessage(contentType.Schema()),
+		CreatedAt:   contentType.CreatedAt(),
+		UpdatedAt:   contentType.UpdatedAt(),
+	}
+	if contentType.TenantID() != nil {
+		tenantID := contentType.TenantID().Value()
+		response.TenantID = &tenantID
+	}
+	return response
+}
+
+// NewContentTypeResponses maps a slice of content type entities to ContentTypeResponses.
+func NewContentTypeResponses(contentTypes []*entities.ContentType) []ContentTypeResponse {
+	responses := make([]ContentTypeResponse, 0, len(contentTypes))
+	for _, contentType := range contentTypes {
+		responses = append(responses, NewContentTypeResponse(contentType))
+	}
+	return responses
+}
@@ -0,0 +1,134 @@
+package dto
+
+import (
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"time"
+)
+
+// DeliveredPageResponse is the public representation of a published page. Link pages only carry their
+// link URL; content pages carry the published title, description and rendered blocks. Locale is the locale of the
+// content, which differs from RequestedLocale when the page is delivered in a fallback locale. Head holds the fully
+// computed head metadata of content pages. Preview is set when a preview token was accepted.
+type DeliveredPageResponse struct {
+	Site            DeliveredSiteResponse   `json:"site"`
+	Path            string                  `json:"path"`
+	Type            string                  `json:"type"`
+	ContentPath     string                  `json:"content_path"`
+	Locale          string                  `json:"locale"`
+	RequestedLocale string                  `json:"requested_locale"`
+	Preview         bool                    `json:"preview,omitempty"`
+	LinkURL         *string                 `json:"link_url,omitempty"`
+	Title           string                  `json:"title,omitempty"`
+	Description     *string                 `json:"description,omitempty"`
+	PublishedAt     *time.Time              `json:"published_at,omitempty"`
+	UpdatedAt       *time.Time              `json:"updated_at,omitempty"`
+	Head            *PageHeadResponse       `json:"head,omitempty"`
+	Blocks          []RenderedBlockResponse `json:"blocks,omitempty"`
+}
+
+// PageHeadResponse is the public representation of the head metadata of a delivered page. The title is rendered
+// with the title template of the site and all URLs are absolute.
+type PageHeadResponse struct {
+	Title        string                  `json:"title"`
+	Description  *string                 `json:"description"`
+	CanonicalURL string                  `json:"canonical_url"`
+	Robots       *string                 `json:"robots"`
+	OpenGraph    OpenGraphResponse       `json:"open_graph"`
+	Twitter      TwitterCardResponse     `json:"twitter"`
+	Alternates   []AlternateLinkResponse `json:"alternates"`
+}
+
+// OpenGraphResponse is the public representation of the Open Graph data of a delivered page.
+type OpenGraphResponse struct {
+	Type        string  `json:"type"`
+	Title       string  `json:"title"`
+	Description *string `json:"description"`
+	Image       *string `json:"image"`
+	URL         string  `json:"url"`
+	SiteName    string  `json:"site_name"`
+	Locale      string  `json:"locale"`
+}
+
+// TwitterCardResponse is the public representation of the Twitter card data of a delivered page.
+type TwitterCardResponse struct {
+	Card        string  `json:"card"`
+	Title       string  `json:"title"`
+	Description *string `json:"description"`
+	Image       *string `json:"image"`
+}
+
+// AlternateLinkResponse is the public representation of a translation of a delivered page.
+type AlternateLinkResponse struct {
+	HrefLang string `json:"hreflang"`
+	URL      string `json:"url"`
+}
+
+// DeliveredSiteResponse is the public representation of the site serving a delivered page.
+type DeliveredSiteResponse struct {
+	Name   string `json:"name"`
+	Domain string `json:"domain"`
+}
+
+// NewDeliveredPageResponse maps a delivered page to a DeliveredPageResponse.
+func NewDeliveredPageResponse(delivered *entities.DeliveredPage) DeliveredPageResponse {
+	response := DeliveredPageResponse{
+		Site: DeliveredSiteResponse{
+			Name:   delivered.Site.Name(),
+			Domain: delivered.Site.Domain().Value(),
+		},
+		Path:            delivered.Page.FullPath(),
+		Type:            string(delivered.Resolved.Page.Type()),
+		ContentPath:     delivered.Resolved.Page.FullPath(),
+		Locale:          string(delivered.ContentLocale()),
+		RequestedLocale: string(delivered.Locale),
+		Preview:         delivered.Preview,
+		LinkURL:         delivered.Resolved.LinkURL(),
+	}
+
+	if delivered.Version != nil {
+		updatedAt := delivered.Version.UpdatedAt()
+		response.Title = delivered.Version.Title()
+		response.Description = delivered.Version.Description()
+		response.PublishedAt = delivered.Version.StatusChangedAt()
+		response.UpdatedAt = &updatedAt
+		response.Blocks = NewRenderedBlockResponses(delivered.Blocks)
+	}
+	if delivered.Head != nil {
+		head := NewPageHeadResponse(delivered.Head)
+		response.Head = &head
+	}
+
+	return response
+}
+
+// NewPageHeadResponse maps the head metadata of a delivered page to a PageHeadResponse.
+func NewPageHeadResponse(head *entities.PageHead) PageHeadResponse {
+	response := PageHeadResponse{
+		Title:        head.Title,
+		Description:  head.Description,
+		CanonicalURL: head.CanonicalURL,
+		Robots:       head.Robots,
+		OpenGraph: OpenGraphResponse{
+			Type:        head.OpenGraph.Type,
+			Title:       head.OpenGraph.Title,
+			Description: head.OpenGraph.Description,
+			Image:       head.OpenGraph.Image,
+			URL:         head.OpenGraph.URL,
+			SiteName:    head.OpenGraph.SiteName,
+			Locale:      head.OpenGraph.Locale,
+		},
+		Twitter: TwitterCardResponse{
+			Card:        string(head.Twitter.Card),
+			Title:       head.Twitter.Title,
+			Description: head.Twitter.Description,
+			Image:       head.Twitter.Image,
+		},
+		Alternates: make([]AlternateLinkResponse, 0, len(head.Alternates)),
+	}
+
+	for _, alternate := range head.Alternates {
+		response.Alternates = append(response.Alternates, AlternateLinkResponse{HrefLang: alternate.HrefLang, URL: alternate.URL})
+	}
+
+	return response
+}
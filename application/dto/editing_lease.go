@@ -0,0 +1,33 @@
+package dto
+
+import (
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"time"
+)
+
+// EditingLeaseHolderResponse identifies the editor holding an editing lease
+type EditingLeaseHolderResponse struct {
+	Subject string `json:"subject"`
+	Name    string `json:"name"`
+}
+
+// EditingLeaseResponse is the API representation of the editing lease of a page version
+type EditingLeaseResponse struct {
+	VersionID  uint64                     `json:"version_id"`
+	Holder     EditingLeaseHolderResponse `json:"holder"`
+	AcquiredAt time.Time                  `json:"acquired_at"`
+	ExpiresAt  time.Time                  `json:"expires_at"`
+}
+
+// NewEditingLeaseResponse maps an editing lease entity to an EditingLeaseResponse.
+func NewEditingLeaseResponse(lease *entities.EditingLease) EditingLeaseResponse {
+	return EditingLeaseResponse{
+		VersionID: lease.VersionID().Value(),
+		Holder: EditingLeaseHolderResponse{
+			Subject: lease.HolderSubject(),
+			Name:    lease.HolderName(),
+		},
+		AcquiredAt: lease.AcquiredAt(),
+		ExpiresAt:  lease.ExpiresAt(),
+	}
+}
@@ -0,0 +1,136 @@
+package dto
+
+import (
+	"encoding/xml"
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"time"
+)
+
+// atomNamespace is the XML namespace of Atom feeds
+const atomNamespace = "http://www.w3.org/2005/Atom"
+
+// RSSFeedResponse is an RSS 2.0 document.
+type RSSFeedResponse struct {
+	XMLName xml.Name           `xml:"rss"`
+	Version string             `xml:"version,attr"`
+	Channel RSSChannelResponse `xml:"channel"`
+}
+
+// RSSChannelResponse is the channel of an RSS 2.0 document.
+type RSSChannelResponse struct {
+	Title         string            `xml:"title"`
+	Link          string            `xml:"link"`
+	Description   string            `xml:"description"`
+	Language      string            `xml:"language,omitempty"`
+	LastBuildDate string            `xml:"lastBuildDate"`
+	Items         []RSSItemResponse `xml:"item"`
+}
+
+// RSSItemResponse is a single item of an RSS 2.0 channel.
+type RSSItemResponse struct {
+	Title       string          `xml:"title"`
+	Link        string          `xml:"link"`
+	Description string          `xml:"description,omitempty"`
+	GUID        RSSGUIDResponse `xml:"guid"`
+	PubDate     string          `xml:"pubDate"`
+}
+
+// RSSGUIDResponse is the unique identifier of an RSS item.
+type RSSGUIDResponse struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+// AtomFeedResponse is an Atom feed document.
+type AtomFeedResponse struct {
+	XMLName  xml.Name            `xml:"feed"`
+	Xmlns    string              `xml:"xmlns,attr"`
+	ID       string              `xml:"id"`
+	Title    string              `xml:"title"`
+	Subtitle string              `xml:"subtitle,omitempty"`
+	Updated  string              `xml:"updated"`
+	Link     AtomLinkResponse    `xml:"link"`
+	Author   AtomAuthorResponse  `xml:"author"`
+	Entries  []AtomEntryResponse `xml:"entry"`
+}
+
+// AtomEntryResponse is a single entry of an Atom feed.
+type AtomEntryResponse struct {
+	ID        string           `xml:"id"`
+	Title     string           `xml:"title"`
+	Link      AtomLinkResponse `xml:"link"`
+	Published string           `xml:"published"`
+	Updated   string           `xml:"updated"`
+	Summary   string           `xml:"summary,omitempty"`
+}
+
+// AtomLinkResponse links an Atom feed or entry to its page.
+type AtomLinkResponse struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr"`
+}
+
+// AtomAuthorResponse is the author of an Atom feed.
+type AtomAuthorResponse struct {
+	Name string `xml:"name"`
+}
+
+// NewRSSFeedResponse maps a feed to an RSS 2.0 document.
+func NewRSSFeedResponse(feed *entities.Feed) RSSFeedResponse {
+	channel := RSSChannelResponse{
+		Title:         feed.Version.Title(),
+		Link:          feed.URL(feed.Page),
+		Description:   stringValue(feed.Version.Description()),
+		Language:      string(feed.Version.Locale()),
+		LastBuildDate: feed.UpdatedAt().UTC().Format(time.RFC1123Z),
+		Items:         make([]RSSItemResponse, 0, len(feed.Items)),
+	}
+	for _, item := range feed.Items {
+		link := feed.URL(item.Page)
+		channel.Items = append(channel.Items, RSSItemResponse{
+			Title:       item.Version.Title(),
+			Link:        link,
+			Description: stringValue(item.Version.Description()),
+			GUID:        RSSGUIDResponse{IsPermaLink: true, Value: link},
+			PubDate:     item.PublishedAt().UTC().Format(time.RFC1123Z),
+		})
+	}
+
+	return RSSFeedResponse{Version: "2.0", Channel: channel}
+}
+
+// NewAtomFeedResponse maps a feed to an Atom feed document.
+func NewAtomFeedResponse(feed *entities.Feed) AtomFeedResponse {
+	link := feed.URL(feed.Page)
+	response := AtomFeedResponse{
+		Xmlns:    atomNamespace,
+		ID:       link,
+		Title:    feed.Version.Title(),
+		Subtitle: stringValue(feed.Version.Description()),
+		Updated:  feed.UpdatedAt().UTC().Format(time.RFC3339),
+		Link:     AtomLinkResponse{Href: link, Rel: "alternate"},
+		Author:   AtomAuthorResponse{Name: feed.Site.Name()},
+		Entries:  make([]AtomEntryResponse, 0, len(feed.Items)),
+	}
+	for _, item := range feed.Items {
+		itemLink := feed.URL(item.Page)
+		response.Entries = append(response.Entries, AtomEntryResponse{
+			ID:        itemLink,
+			Title:     item.Version.Title(),
+			Link:      AtomLinkResponse{Href: itemLink, Rel: "alternate"},
+			Published: item.PublishedAt().UTC().Format(time.RFC3339),
+			Updated:   item.Version.UpdatedAt().UTC().Format(time.RFC3339),
+			Summary:   stringValue(item.Version.Description()),
+		})
+	}
+
+	return response
+}
+
+// stringValue returns the value of an optional string, or an empty string when it is nil
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
@@ -0,0 +1,46 @@
+package dto
+
+import (
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"time"
+)
+
+// BrokenLinkResponse is the API representation of an entry of the link report of a site.
+type BrokenLinkResponse struct {
+	ID            uint64    `json:"id"`
+	PageID        uint64    `json:"page_id"`
+	BlockKey      *string   `json:"block_key"`
+	URL           string    `json:"url"`
+	StatusCode    *int      `json:"status_code"`
+	Reason        string    `json:"reason"`
+	FirstSeenAt   time.Time `json:"first_seen_at"`
+	LastCheckedAt time.Time `json:"last_checked_at"`
+}
+
+// NewBrokenLinkResponse maps a broken link entity to a BrokenLinkResponse. The block key is null for the URL of a
+// link page.
+func NewBrokenLinkResponse(link *entities.BrokenLink) BrokenLinkResponse {
+	response := BrokenLinkResponse{
+		ID:            link.ID().Value(),
+		PageID:        link.PageID().Value(),
+		URL:           link.URL(),
+		StatusCode:    link.StatusCode(),
+		Reason:        link.Reason(),
+		FirstSeenAt:   link.FirstSeenAt(),
+		LastCheckedAt: link.LastCheckedAt(),
+	}
+	if link.BlockKey() != "" {
+		blockKey := link.BlockKey()
+		response.BlockKey = &blockKey
+	}
+	return response
+}
+
+// NewBrokenLinkResponses maps a slice of broken link entities to BrokenLinkResponses.
+func NewBrokenLinkResponses(links []*entities.BrokenLink) []BrokenLinkResponse {
+	responses := make([]BrokenLinkResponse, 0, len(links))
+	for _, link := range links {
+		responses = append(responses, NewBrokenLinkResponse(link))
+	}
+	return responses
+}
@@ -0,0 +1,438 @@
+package dto
+
+import (
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"github.com/h4rdc0m/aurora-api/utils/textdiff"
+	"time"
+)
+
+type CreatePageRequest struct {
+	Key            string  `json:"key" validate:"required,max=100"`
+	ParentID       *uint64 `json:"parent_id,omitempty"`
+	Type           string  `json:"type" validate:"required"`
+	LinkURL        *string `json:"link_url,omitempty"`
+	HardLinkPageID *uint64 `json:"hard_link_page_id,omitempty"`
+	FeedRoot       bool    `json:"feed_root,omitempty"`
+	Title          string  `json:"title,omitempty" validate:"max=255"`
+	Description    *string `json:"description,omitempty" validate:"max=255"`
+}
+
+type UpdatePageRequest struct {
+	Key            string  `json:"key" validate:"required,max=100"`
+	Type           string  `json:"type" validate:"required"`
+	LinkURL        *string `json:"link_url,omitempty"`
+	HardLinkPageID *uint64 `json:"hard_link_page_id,omitempty"`
+	// FeedRoot marks or unmarks the page as feed root; it is left unchanged when omitted
+	FeedRoot *bool `json:"feed_root,omitempty"`
+}
+
+// MovePageRequest moves a page below a new parent, or to the root when ParentID is nil.
+// Position is the zero-based index among the new siblings and defaults to the end.
+type MovePageRequest struct {
+	ParentID     *uint64 `json:"parent_id"`
+	Position     *int    `json:"position,omitempty"`
+	TargetSiteID *uint64 `json:"target_site_id,omitempty"`
+}
+
+// DuplicatePageRequest copies a page and its descendants below a new parent, or to the root when ParentID is nil.
+// Versions selects which version of every page is copied in each locale: "latest" (default) or "published".
+// Hard links of pages copied into another site must point to pages within the copied subtree.
+type DuplicatePageRequest struct {
+	ParentID     *uint64 `json:"parent_id"`
+	Position     *int    `json:"position,omitempty"`
+	TargetSiteID *uint64 `json:"target_site_id,omitempty"`
+	Versions     string  `json:"versions,omitempty" validate:"omitempty,oneof=latest published"`
+}
+
+type CreatePageVersionRequest struct {
+	Title       string  `json:"title" validate:"required,max=255"`
+	Description *string `json:"description,omitempty" validate:"max=255"`
+	// Locale defaults to the default locale of the site
+	Locale *string         `json:"locale,omitempty" validate:"omitempty,max=35"`
+	SEO    *PageSEORequest `json:"seo,omitempty"`
+}
+
+type UpdatePageVersionRequest struct {
+	Title       string  `json:"title" validate:"required,max=255"`
+	Description *string `json:"description,omitempty" validate:"max=255"`
+	// SEO replaces all SEO metadata of the version; it is left unchanged when omitted
+	SEO *PageSEORequest `json:"seo,omitempty"`
+}
+
+// PageSEORequest is the search engine and social sharing metadata of a page version. URLs are absolute http(s)
+// URLs or paths relative to the site root, robots holds comma separated directives such as "noindex, nofollow".
+type PageSEORequest struct {
+	MetaTitle     *string `json:"meta_title" validate:"omitempty,max=255"`
+	CanonicalURL  *string `json:"canonical_url" validate:"omitempty,max=2048"`
+	Robots        *string `json:"robots" validate:"omitempty,max=255"`
+	OGTitle       *string `json:"og_title" validate:"omitempty,max=255"`
+	OGDescription *string `json:"og_description" validate:"omitempty,max=500"`
+	OGImage       *string `json:"og_image" validate:"omitempty,max=2048"`
+	TwitterCard   *string `json:"twitter_card" validate:"omitempty,oneof=summary summary_large_image"`
+}
+
+// ReplacePageBlocksRequest is the complete ordered list of blocks of a page version.
+type ReplacePageBlocksRequest struct {
+	Blocks []PageBlockRequest `json:"blocks" validate:"dive"`
+}
+
+// PageBlockRequest is a single block of a ReplacePageBlocksRequest, identified by its block key.
+type PageBlockRequest struct {
+	BlockKey    string `json:"block_key" validate:"required,max=255"`
+	ContentType string `json:"content_type" validate:"required,max=255"`
+	Content     string `json:"content"`
+}
+
+// SchedulePageVersionRequest sets when a version goes live and when it is taken offline again.
+// Omitted times clear the corresponding schedule.
+type SchedulePageVersionRequest struct {
+	PublishAt   *time.Time `json:"publish_at"`
+	UnpublishAt *time.Time `json:"unpublish_at"`
+}
+
+// PageResponse is the API representation of a page, optionally including its children and versions.
+type PageResponse struct {
+	ID             uint64                `json:"id"`
+	SiteID         uint64                `json:"site_id"`
+	ParentID       *uint64               `json:"parent_id"`
+	Key            string                `json:"key"`
+	Path           string                `json:"path"`
+	Index          int                   `json:"index"`
+	Type           string                `json:"type"`
+	LinkURL        *string               `json:"link_url,omitempty"`
+	HardLinkPageID *uint64               `json:"hard_link_page_id,omitempty"`
+	FeedRoot       bool                  `json:"feed_root"`
+	Revision       uint64                `json:"revision"`
+	CreatedAt      time.Time             `json:"created_at"`
+	UpdatedAt      time.Time             `json:"updated_at"`
+	Children       []PageResponse        `json:"children,omitempty"`
+	Versions       []PageVersionResponse `json:"versions,omitempty"`
+}
+
+// PageVersionResponse is the API representation of a page version.
+type PageVersionResponse struct {
+	ID              uint64              `json:"id"`
+	PageID          uint64              `json:"page_id"`
+	Locale          string              `json:"locale"`
+	Version         uint                `json:"version"`
+	Title           string              `json:"title"`
+	Description     *string             `json:"description"`
+	SEO             PageSEOResponse     `json:"seo"`
+	Status          string              `json:"status"`
+	IsPublished     bool                `json:"is_published"`
+	StatusChangedBy *uint64             `json:"status_changed_by"`
+	StatusChangedAt *time.Time          `json:"status_changed_at"`
+	PublishAt       *time.Time          `json:"publish_at"`
+	UnpublishAt     *time.Time          `json:"unpublish_at"`
+	ScheduledBy     *uint64             `json:"scheduled_by"`
+	Revision        uint64              `json:"revision"`
+	CreatedAt       time.Time           `json:"created_at"`
+	UpdatedAt       time.Time           `json:"updated_at"`
+	Blocks          []PageBlockResponse `json:"blocks,omitempty"`
+}
+
+// PageSEOResponse is the API representation of the SEO metadata of a page version.
+type PageSEOResponse struct {
+	MetaTitle     *string `json:"meta_title"`
+	CanonicalURL  *string `json:"canonical_url"`
+	Robots        *string `json:"robots"`
+	OGTitle       *string `json:"og_title"`
+	OGDescription *string `json:"og_description"`
+	OGImage       *string `json:"og_image"`
+	TwitterCard   *string `json:"twitter_card"`
+}
+
+// PageBlockResponse is the API representation of a content block of a page version.
+type PageBlockResponse struct {
+	ID          uint64    `json:"id"`
+	BlockKey    string    `json:"block_key"`
+	Index       int       `json:"index"`
+	ContentType string    `json:"content_type"`
+	Content     string    `json:"content"`
+	Revision    uint64    `json:"revision"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// RenderedBlockResponse is the API representation of a block prepared for delivery.
+// Snippet blocks carry the inlined published blocks of the embedded snippet page.
+type RenderedBlockResponse struct {
+	BlockKey    string                   `json:"block_key"`
+	Index       int                      `json:"index"`
+	ContentType string                   `json:"content_type"`
+	Content     string                   `json:"content"`
+	Snippet     *RenderedSnippetResponse `json:"snippet,omitempty"`
+}
+
+// RenderedSnippetResponse is the API representation of the published content of an embedded snippet page.
+type RenderedSnippetResponse struct {
+	PageID    uint64                  `json:"page_id"`
+	VersionID uint64                  `json:"version_id"`
+	Blocks    []RenderedBlockResponse `json:"blocks"`
+}
+
+// SnippetUsageResponse is the API representation of a block that embeds a snippet page.
+type SnippetUsageResponse struct {
+	PageID        uint64 `json:"page_id"`
+	SiteID        uint64 `json:"site_id"`
+	Path          string `json:"path"`
+	VersionID     uint64 `json:"version_id"`
+	Version       uint   `json:"version"`
+	VersionStatus string `json:"version_status"`
+	BlockKey      string `json:"block_key"`
+}
+
+// ResolvedPageResponse is the API representation of a page resolved through its hard links.
+type ResolvedPageResponse struct {
+	Page    PageResponse `json:"page"`
+	Chain   []uint64     `json:"chain"`
+	LinkURL *string      `json:"link_url,omitempty"`
+}
+
+// PageVersionDiffResponse is the API representation of the differences between two versions of a page.
+type PageVersionDiffResponse struct {
+	FromVersionID uint64                  `json:"from_version_id"`
+	ToVersionID   uint64                  `json:"to_version_id"`
+	HasChanges    bool                    `json:"has_changes"`
+	Title         *StringChange           `json:"title"`
+	Description   *StringChange           `json:"description"`
+	SEO           []FieldChange           `json:"seo"`
+	Blocks        []PageBlockDiffResponse `json:"blocks"`
+}
+
+// StringChange is the old and new value of a changed field.
+type StringChange struct {
+	From *string `json:"from"`
+	To   *string `json:"to"`
+}
+
+// FieldChange is the old and new value of a changed field named by Field, e.g. "meta_title".
+type FieldChange struct {
+	Field string  `json:"field"`
+	From  *string `json:"from"`
+	To    *string `json:"to"`
+}
+
+// PageBlockDiffResponse is the API representation of the differences of a single block, matched by its block key.
+type PageBlockDiffResponse struct {
+	BlockKey        string          `json:"block_key"`
+	Changes         []string        `json:"changes"`
+	FromIndex       *int            `json:"from_index"`
+	ToIndex         *int            `json:"to_index"`
+	FromContentType string          `json:"from_content_type,omitempty"`
+	ToContentType   string          `json:"to_content_type,omitempty"`
+	ContentDiff     []textdiff.Line `json:"content_diff,omitempty"`
+}
+
+// NewPageResponse maps a page entity, including its attached children and versions, to a PageResponse.
+func NewPageResponse(page *entities.Page) PageResponse {
+	response := PageResponse{
+		ID:        page.ID().Value(),
+		SiteID:    page.SiteID().Value(),
+		Key:       page.Key().Value(),
+		Path:      page.FullPath(),
+		Index:     page.Index(),
+		Type:      string(page.Type()),
+		LinkURL:   page.LinkURL(),
+		FeedRoot:  page.IsFeedRoot(),
+		Revision:  page.Revision(),
+		CreatedAt: page.CreatedAt(),
+		UpdatedAt: page.UpdatedAt(),
+	}
+
+	if page.ParentID() != nil {
+		response.ParentID = page.ParentID().ValuePtr()
+	}
+	if page.HardLinkPageID() != nil {
+		response.HardLinkPageID = page.HardLinkPageID().ValuePtr()
+	}
+
+	for _, child := range page.Children() {
+		response.Children = append(response.Children, NewPageResponse(child))
+	}
+	for _, version := range page.Versions() {
+		response.Versions = append(response.Versions, NewPageVersionResponse(version))
+	}
+
+	return response
+}
+
+// NewPageResponses maps a slice of page entities to PageResponses.
+func NewPageResponses(pages []*entities.Page) []PageResponse {
+	responses := make([]PageResponse, 0, len(pages))
+	for _, page := range pages {
+		responses = append(responses, NewPageResponse(page))
+	}
+	return responses
+}
+
+// NewPageVersionResponse maps a page version entity to a PageVersionResponse.
+func NewPageVersionResponse(version *entities.PageVersion) PageVersionResponse {
+	response := PageVersionResponse{
+		ID:              version.ID().Value(),
+		PageID:          version.PageID().Value(),
+		Locale:          string(version.Locale()),
+		Version:         version.Version(),
+		Title:           version.Title(),
+		Description:     version.Description(),
+		SEO:             NewPageSEOResponse(version.SEO()),
+		Status:          string(version.Status()),
+		IsPublished:     version.IsPublished(),
+		StatusChangedAt: version.StatusChangedAt(),
+		PublishAt:       version.PublishAt(),
+		UnpublishAt:     version.UnpublishAt(),
+		Revision:        version.Revision(),
+		CreatedAt:       version.CreatedAt(),
+		UpdatedAt:       version.UpdatedAt(),
+	}
+
+	if version.StatusChangedBy() != nil {
+		changedBy := version.StatusChangedBy().Value()
+		response.StatusChangedBy = &changedBy
+	}
+	if version.ScheduledBy() != nil {
+		scheduledBy := version.ScheduledBy().Value()
+		response.ScheduledBy = &scheduledBy
+	}
+	for _, block := range version.Blocks() {
+		response.Blocks = append(response.Blocks, NewPageBlockResponse(block))
+	}
+
+	return response
+}
+
+// NewPageSEOResponse maps the SEO metadata of a page version to a PageSEOResponse.
+func NewPageSEOResponse(seo entities.PageSEO) PageSEOResponse {
+	response := PageSEOResponse{
+		MetaTitle:     seo.MetaTitle,
+		CanonicalURL:  seo.CanonicalURL,
+		Robots:        seo.Robots,
+		OGTitle:       seo.OGTitle,
+		OGDescription: seo.OGDescription,
+		OGImage:       seo.OGImage,
+	}
+	if seo.TwitterCard != nil {
+		card := string(*seo.TwitterCard)
+		response.TwitterCard = &card
+	}
+	return response
+}
+
+// NewPageVersionResponses maps a slice of page version entities to PageVersionResponses.
+func NewPageVersionResponses(versions []*entities.PageVersion) []PageVersionResponse {
+	responses := make([]PageVersionResponse, 0, len(versions))
+	for _, version := range versions {
+		responses = append(responses, NewPageVersionResponse(version))
+	}
+	return responses
+}
+
+// NewPageBlockResponse maps a page block entity to a PageBlockResponse.
+func NewPageBlockResponse(block *entities.PageBlock) PageBlockResponse {
+	return PageBlockResponse{
+		ID:          block.ID().Value(),
+		BlockKey:    block.BlockKey(),
+		Index:       block.Index(),
+		ContentType: block.ContentType(),
+		Content:     block.Content(),
+		Revision:    block.Revision(),
+		CreatedAt:   block.CreatedAt(),
+		UpdatedAt:   block.UpdatedAt(),
+	}
+}
+
+// NewRenderedBlockResponses maps rendered blocks, including inlined snippets, to RenderedBlockResponses.
+func NewRenderedBlockResponses(blocks []*entities.RenderedBlock) []RenderedBlockResponse {
+	responses := make([]RenderedBlockResponse, 0, len(blocks))
+	for _, rendered := range blocks {
+		response := RenderedBlockResponse{
+			BlockKey:    rendered.Block.BlockKey(),
+			Index:       rendered.Block.Index(),
+			ContentType: rendered.Block.ContentType(),
+			Content:     rendered.Block.Content(),
+		}
+		if rendered.Snippet != nil {
+			response.Snippet = &RenderedSnippetResponse{
+				PageID:    rendered.Snippet.PageID.Value(),
+				VersionID: rendered.Snippet.VersionID.Value(),
+				Blocks:    NewRenderedBlockResponses(rendered.Snippet.Blocks),
+			}
+		}
+		responses = append(responses, response)
+	}
+	return responses
+}
+
+// NewSnippetUsageResponses maps snippet usages to SnippetUsageResponses.
+func NewSnippetUsageResponses(usages []*entities.SnippetUsage) []SnippetUsageResponse {
+	responses := make([]SnippetUsageResponse, 0, len(usages))
+	for _, usage := range usages {
+		responses = append(responses, SnippetUsageResponse{
+			PageID:        usage.Page.ID().Value(),
+			SiteID:        usage.Page.SiteID().Value(),
+			Path:          usage.Page.FullPath(),
+			VersionID:     usage.Version.ID().Value(),
+			Version:       usage.Version.Version(),
+			VersionStatus: string(usage.Version.Status()),
+			BlockKey:      usage.BlockKey,
+		})
+	}
+	return responses
+}
+
+// NewResolvedPageResponse maps a resolved page to a ResolvedPageResponse.
+func NewResolvedPageResponse(resolved *entities.ResolvedPage) ResolvedPageResponse {
+	chain := make([]uint64, 0, len(resolved.Chain))
+	for _, id := range resolved.Chain {
+		chain = append(chain, id.Value())
+	}
+
+	return ResolvedPageResponse{
+		Page:    NewPageResponse(resolved.Page),
+		Chain:   chain,
+		LinkURL: resolved.LinkURL(),
+	}
+}
+
+// NewPageVersionDiffResponse maps a page version diff to a PageVersionDiffResponse.
+func NewPageVersionDiffResponse(diff *entities.PageVersionDiff) PageVersionDiffResponse {
+	response := PageVersionDiffResponse{
+		FromVersionID: diff.FromVersionID.Value(),
+		ToVersionID:   diff.ToVersionID.Value(),
+		HasChanges:    diff.HasChanges(),
+		Title:         newStringChange(diff.Title),
+		Description:   newStringChange(diff.Description),
+		SEO:           make([]FieldChange, 0, len(diff.SEO)),
+		Blocks:        make([]PageBlockDiffResponse, 0, len(diff.Blocks)),
+	}
+
+	for _, change := range diff.SEO {
+		response.SEO = append(response.SEO, FieldChange{Field: change.Field, From: change.From, To: change.To})
+	}
+
+	for _, block := range diff.Blocks {
+		changes := make([]string, 0, len(block.Changes))
+		for _, change := range block.Changes {
+			changes = append(changes, string(change))
+		}
+		response.Blocks = append(response.Blocks, PageBlockDiffResponse{
+			BlockKey:        block.BlockKey,
+			Changes:         changes,
+			FromIndex:       block.FromIndex,
+			ToIndex:         block.ToIndex,
+			FromContentType: block.FromContentType,
+			ToContentType:   block.ToContentType,
+			ContentDiff:     block.ContentDiff,
+		})
+	}
+
+	return response
+}
+
+// newStringChange maps an optional field change to a StringChange.
+func newStringChange(change *entities.StringChange) *StringChange {
+	if change == nil {
+		return nil
+	}
+	return &StringChange{From: change.From, To: change.To}
+}
@@ -0,0 +1,68 @@
+package dto
+
+import (
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"time"
+)
+
+// CreatePreviewTokenRequest creates a preview token. Version scoped tokens share the page version given by
+// VersionID; site scoped tokens share the latest version of every page. ExpiresIn is the lifetime in seconds and
+// defaults to 7 days.
+type CreatePreviewTokenRequest struct {
+	Scope     string  `json:"scope" validate:"required,oneof=version site"`
+	VersionID *uint64 `json:"version_id,omitempty"`
+	Label     *string `json:"label,omitempty" validate:"omitempty,max=255"`
+	ExpiresIn *int64  `json:"expires_in,omitempty"`
+}
+
+// PreviewTokenResponse is the API representation of a preview token, without its signed form.
+type PreviewTokenResponse struct {
+	ID        string    `json:"id"`
+	SiteID    uint64    `json:"site_id"`
+	Scope     string    `json:"scope"`
+	PageID    *uint64   `json:"page_id"`
+	VersionID *uint64   `json:"version_id"`
+	Label     *string   `json:"label"`
+	CreatedBy uint64    `json:"created_by"`
+	CreatedAt time.Time `json:"created_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// CreatedPreviewTokenResponse is the API representation of a newly issued preview token. Token is the signed form
+// passed to the delivery API, which is only returned once.
+type CreatedPreviewTokenResponse struct {
+	PreviewTokenResponse
+	Token string `json:"token"`
+}
+
+// NewPreviewTokenResponse maps a preview token entity to a PreviewTokenResponse.
+func NewPreviewTokenResponse(token *entities.PreviewToken) PreviewTokenResponse {
+	response := PreviewTokenResponse{
+		ID:        token.ID(),
+		SiteID:    token.SiteID().Value(),
+		Scope:     string(token.Scope()),
+		Label:     token.Label(),
+		CreatedBy: token.CreatedBy().Value(),
+		CreatedAt: token.CreatedAt(),
+		ExpiresAt: token.ExpiresAt(),
+	}
+
+	if token.PageID() != nil {
+		response.PageID = token.PageID().ValuePtr()
+	}
+	if token.VersionID() != nil {
+		versionID := token.VersionID().Value()
+		response.VersionID = &versionID
+	}
+
+	return response
+}
+
+// NewPreviewTokenResponses maps a slice of preview token entities to PreviewTokenResponses.
+func NewPreviewTokenResponses(tokens []*entities.PreviewToken) []PreviewTokenResponse {
+	responses := make([]PreviewTokenResponse, 0, len(tokens))
+	for _, token := range tokens {
+		responses = append(responses, NewPreviewTokenResponse(token))
+	}
+	return responses
+}
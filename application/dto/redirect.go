@@ -0,0 +1,80 @@
+package dto
+
+import (
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"time"
+)
+
+// RedirectRequest creates or replaces a redirect. A status code of 0 selects 301 for redirects with a target and
+// 410 for redirects without one.
+type RedirectRequest struct {
+	SourcePath   string  `json:"source_path" validate:"required,max=768"`
+	TargetPageID *uint64 `json:"target_page_id,omitempty"`
+	TargetURL    *string `json:"target_url,omitempty" validate:"omitempty,max=2048"`
+	StatusCode   int     `json:"status_code,omitempty"`
+}
+
+// RedirectResponse is the API representation of a redirect.
+type RedirectResponse struct {
+	ID           uint64    `json:"id"`
+	SiteID       uint64    `json:"site_id"`
+	SourcePath   string    `json:"source_path"`
+	TargetPageID *uint64   `json:"target_page_id"`
+	TargetURL    *string   `json:"target_url"`
+	StatusCode   int       `json:"status_code"`
+	Automatic    bool      `json:"automatic"`
+	Hits         uint64    `json:"hits"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// RedirectImportResponse reports the outcome of a redirect import.
+type RedirectImportResponse struct {
+	Created int `json:"created"`
+	Updated int `json:"updated"`
+}
+
+// DeliveredRedirectResponse is the public representation of a redirect found for a requested path.
+type DeliveredRedirectResponse struct {
+	StatusCode int    `json:"status_code"`
+	Location   string `json:"location"`
+}
+
+// NewRedirectResponse maps a redirect entity to a RedirectResponse.
+func NewRedirectResponse(redirect *entities.Redirect) RedirectResponse {
+	response := RedirectResponse{
+		ID:         redirect.ID().Value(),
+		SiteID:     redirect.SiteID().Value(),
+		SourcePath: redirect.SourcePath(),
+		TargetURL:  redirect.TargetURL(),
+		StatusCode: redirect.StatusCode(),
+		Automatic:  redirect.IsAutomatic(),
+		Hits:       redirect.Hits(),
+		CreatedAt:  redirect.CreatedAt(),
+		UpdatedAt:  redirect.UpdatedAt(),
+	}
+	if redirect.TargetPageID() != nil {
+		targetPageID := redirect.TargetPageID().Value()
+		response.TargetPageID = &targetPageID
+	}
+	return response
+}
+
+// NewRedirectResponses maps a slice of redirect entities to RedirectResponses.
+func NewRedirectResponses(redirects []*entities.Redirect) []RedirectResponse {
+	responses := make([]RedirectResponse, 0, len(redirects))
+	for _, redirect := range redirects {
+		responses = append(responses, NewRedirectResponse(redirect))
+	}
+	return responses
+}
+
+// NewRedirectImportResponse maps the outcome of a redirect import to a RedirectImportResponse.
+func NewRedirectImportResponse(result *entities.RedirectImport) RedirectImportResponse {
+	return RedirectImportResponse{Created: result.Created, Updated: result.Updated}
+}
+
+// NewDeliveredRedirectResponse maps a delivered redirect to a DeliveredRedirectResponse.
+func NewDeliveredRedirectResponse(redirect *entities.DeliveredRedirect) DeliveredRedirectResponse {
+	return DeliveredRedirectResponse{StatusCode: redirect.StatusCode, Location: redirect.Location}
+}
@@ -0,0 +1,45 @@
+package dto
+
+import "github.com/h4rdc0m/aurora-api/domain/entities"
+
+// SearchResultResponse is a page of search hits. Title and excerpt of a hit are HTML with the matched words
+// wrapped in <mark>.
+type SearchResultResponse struct {
+	Query   string              `json:"query"`
+	Total   int                 `json:"total"`
+	Page    int                 `json:"page"`
+	PerPage int                 `json:"per_page"`
+	Hits    []SearchHitResponse `json:"hits"`
+}
+
+// SearchHitResponse is a page matching a search query.
+type SearchHitResponse struct {
+	PageID  uint64  `json:"page_id"`
+	Locale  string  `json:"locale"`
+	Path    string  `json:"path"`
+	Title   string  `json:"title"`
+	Excerpt string  `json:"excerpt"`
+	Score   float64 `json:"score"`
+}
+
+// NewSearchResultResponse maps a search result to a SearchResultResponse.
+func NewSearchResultResponse(result *entities.SearchResult) SearchResultResponse {
+	response := SearchResultResponse{
+		Query:   result.Query.Text,
+		Total:   result.Total,
+		Page:    result.Query.Page,
+		PerPage: result.Query.PerPage,
+		Hits:    make([]SearchHitResponse, 0, len(result.Hits)),
+	}
+	for _, hit := range result.Hits {
+		response.Hits = append(response.Hits, SearchHitResponse{
+			PageID:  hit.PageID.Value(),
+			Locale:  string(hit.Locale),
+			Path:    hit.Path,
+			Title:   hit.Title,
+			Excerpt: hit.Excerpt,
+			Score:   hit.Score,
+		})
+	}
+	return response
+}
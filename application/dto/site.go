@@ -0,0 +1,97 @@
+package dto
+
+import (
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"time"
+)
+
+type CreateSiteRequest struct {
+	Name        string  `json:"name" validate:"required,max=255"`
+	Description *string `json:"description,omitempty"`
+	Domain      string  `json:"domain" validate:"required,max=253"`
+	TemplateID  uint64  `json:"template_id" validate:"required"`
+}
+
+type UpdateSiteRequest struct {
+	Name        string  `json:"name,omitempty" validate:"max=255"`
+	Description *string `json:"description,omitempty"`
+	Domain      string  `json:"domain,omitempty" validate:"max=253"`
+	TemplateID  uint64  `json:"template_id,omitempty"`
+}
+
+// UpdateSiteRobotsRequest sets the robots.txt rules of a site. A null value restores the default rules.
+type UpdateSiteRobotsRequest struct {
+	RobotsTxt *string `json:"robots_txt" validate:"omitempty,max=65535"`
+}
+
+// UpdateSiteTitleTemplateRequest sets the template page titles are rendered with, e.g. "{page.title} | {site.name}".
+// Available placeholders are {page.title}, {page.description}, {parent.title}, {site.name} and {site.description}.
+// A null value renders plain page titles.
+type UpdateSiteTitleTemplateRequest struct {
+	TitleTemplate *string `json:"title_template" validate:"omitempty,max=255"`
+}
+
+// UpdateSiteLocalesRequest sets the locales of a site. The default and fallback locales must be among the enabled locales.
+type UpdateSiteLocalesRequest struct {
+	DefaultLocale   string   `json:"default_locale" validate:"required,max=35"`
+	Locales         []string `json:"locales" validate:"required,min=1,dive,max=35"`
+	FallbackLocales []string `json:"fallback_locales" validate:"dive,max=35"`
+}
+
+// SiteResponse is the API representation of a site.
+type SiteResponse struct {
+	ID              uint64    `json:"id"`
+	TenantID        uint64    `json:"tenant_id"`
+	TemplateID      uint64    `json:"template_id"`
+	Name            string    `json:"name"`
+	Description     *string   `json:"description"`
+	Domain          string    `json:"domain"`
+	TitleTemplate   *string   `json:"title_template"`
+	RobotsTxt       *string   `json:"robots_txt"`
+	DefaultLocale   string    `json:"default_locale"`
+	Locales         []string  `json:"locales"`
+	FallbackLocales []string  `json:"fallback_locales"`
+	Enabled         bool      `json:"enabled"`
+	Revision        uint64    `json:"revision"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+// NewSiteResponse maps a site entity to a SiteResponse.
+func NewSiteResponse(site *entities.Site) SiteResponse {
+	return SiteResponse{
+		ID:              site.ID().Value(),
+		TenantID:        site.TenantID().Value(),
+		TemplateID:      site.TemplateID().Value(),
+		Name:            site.Name(),
+		Description:     site.Description(),
+		Domain:          site.Domain().Value(),
+		TitleTemplate:   site.TitleTemplate(),
+		RobotsTxt:       site.RobotsTxt(),
+		DefaultLocale:   string(site.DefaultLocale()),
+		Locales:         localeStrings(site.Locales()),
+		FallbackLocales: localeStrings(site.FallbackLocales()),
+		Enabled:         site.IsEnabled(),
+		Revision:        site.Revision(),
+		CreatedAt:       site.CreatedAt(),
+		UpdatedAt:       site.UpdatedAt(),
+	}
+}
+
+// NewSiteResponses maps a slice of site entities to SiteResponses.
+func NewSiteResponses(sites []*entities.Site) []SiteResponse {
+	responses := make([]SiteResponse, 0, len(sites))
+	for _, site := range sites {
+		responses = append(responses, NewSiteResponse(site))
+	}
+	return responses
+}
+
+// localeStrings converts locales to their language tags, never returning nil
+func localeStrings(locales []entities.Locale) []string {
+	values := make([]string, 0, len(locales))
+	for _, locale := range locales {
+		values = append(values, string(locale))
+	}
+	return values
+}
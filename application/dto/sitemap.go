@@ -0,0 +1,63 @@
+package dto
+
+import (
+	"encoding/xml"
+	"fmt"
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"time"
+)
+
+// sitemapNamespace is the XML namespace of the sitemap protocol
+const sitemapNamespace = "http://www.sitemaps.org/schemas/sitemap/0.9"
+
+// SitemapURLSetResponse is a sitemap file listing page URLs.
+type SitemapURLSetResponse struct {
+	XMLName xml.Name               `xml:"urlset"`
+	Xmlns   string                 `xml:"xmlns,attr"`
+	URLs    []SitemapEntryResponse `xml:"url"`
+}
+
+// SitemapIndexResponse is a sitemap index referencing the parts of a large sitemap.
+type SitemapIndexResponse struct {
+	XMLName  xml.Name               `xml:"sitemapindex"`
+	Xmlns    string                 `xml:"xmlns,attr"`
+	Sitemaps []SitemapEntryResponse `xml:"sitemap"`
+}
+
+// SitemapEntryResponse is a single location in a sitemap or sitemap index.
+type SitemapEntryResponse struct {
+	Loc     string `xml:"loc"`
+	LastMod string `xml:"lastmod,omitempty"`
+}
+
+// NewSitemapURLSetResponse maps the URLs of a site to a SitemapURLSetResponse.
+func NewSitemapURLSetResponse(site *entities.Site, urls []entities.SitemapURL) SitemapURLSetResponse {
+	response := SitemapURLSetResponse{Xmlns: sitemapNamespace, URLs: make([]SitemapEntryResponse, 0, len(urls))}
+	for _, url := range urls {
+		response.URLs = append(response.URLs, SitemapEntryResponse{
+			Loc:     site.BaseURL() + url.Path,
+			LastMod: formatLastMod(url.LastModified),
+		})
+	}
+	return response
+}
+
+// NewSitemapIndexResponse maps a sitemap to a SitemapIndexResponse referencing each of its parts.
+func NewSitemapIndexResponse(sitemap *entities.Sitemap) SitemapIndexResponse {
+	response := SitemapIndexResponse{Xmlns: sitemapNamespace}
+	for _, part := range sitemap.Parts {
+		response.Sitemaps = append(response.Sitemaps, SitemapEntryResponse{
+			Loc:     fmt.Sprintf("%s/sitemaps/%d.xml", sitemap.Site.BaseURL(), part.Number),
+			LastMod: formatLastMod(part.LastModified),
+		})
+	}
+	return response
+}
+
+// formatLastMod formats a modification time in the W3C datetime format used by sitemaps
+func formatLastMod(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
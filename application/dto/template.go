@@ -0,0 +1,32 @@
+package dto
+
+import (
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"time"
+)
+
+// TemplateResponse is the API representation of a template.
+type TemplateResponse struct {
+	ID          uint64    `json:"id"`
+	Name        string    `json:"name"`
+	Description *string   `json:"description"`
+	FilePath    string    `json:"file_path"`
+	Enabled     bool      `json:"enabled"`
+	Revision    uint64    `json:"revision"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// NewTemplateResponse maps a template entity to a TemplateResponse.
+func NewTemplateResponse(template *entities.Template) TemplateResponse {
+	return TemplateResponse{
+		ID:          template.ID().Value(),
+		Name:        template.Name(),
+		Description: template.Description(),
+		FilePath:    template.FilePath(),
+		Enabled:     template.IsEnabled(),
+		Revision:    template.Revision(),
+		CreatedAt:   template.CreatedAt(),
+		UpdatedAt:   template.UpdatedAt(),
+	}
+}
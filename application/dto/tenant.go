@@ -1,5 +1,10 @@
 package dto
 
+import (
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"time"
+)
+
 type CreateTenantRequest struct {
 	Name        string `json:"name" validate:"required,min=3,max=100"`
 	Description string `json:"description,omitempty" validate:"max=500"`
@@ -9,3 +14,38 @@ type UpdateTenantRequest struct {
 	Name        string `json:"name" validate:"required,min=3,max=100"`
 	Description string `json:"description,omitempty" validate:"max=500"`
 }
+
+// TenantResponse is the API representation of a tenant.
+type TenantResponse struct {
+	ID             uint64    `json:"id"`
+	Name           string    `json:"name"`
+	Description    *string   `json:"description"`
+	Active         bool      `json:"active"`
+	BillingEnabled bool      `json:"billing_enabled"`
+	Revision       uint64    `json:"revision"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+// NewTenantResponse maps a tenant entity to a TenantResponse.
+func NewTenantResponse(tenant *entities.Tenant) TenantResponse {
+	return TenantResponse{
+		ID:             tenant.ID().Value(),
+		Name:           tenant.Name(),
+		Description:    tenant.Description(),
+		Active:         tenant.IsActive(),
+		BillingEnabled: tenant.IsBillingEnabled(),
+		Revision:       tenant.Revision(),
+		CreatedAt:      tenant.CreatedAt(),
+		UpdatedAt:      tenant.UpdatedAt(),
+	}
+}
+
+// NewTenantResponses maps a slice of tenant entities to TenantResponses.
+func NewTenantResponses(tenants []*entities.Tenant) []TenantResponse {
+	responses := make([]TenantResponse, 0, len(tenants))
+	for _, tenant := range tenants {
+		responses = append(responses, NewTenantResponse(tenant))
+	}
+	return responses
+}
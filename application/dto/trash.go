@@ -0,0 +1,88 @@
+package dto
+
+import (
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"time"
+)
+
+// TrashedSiteResponse is the API representation of a site in the trash of a tenant.
+type TrashedSiteResponse struct {
+	ID        uint64    `json:"id"`
+	Name      string    `json:"name"`
+	Domain    string    `json:"domain"`
+	DeletedAt time.Time `json:"deleted_at"`
+}
+
+// TrashedPageResponse is the API representation of a page in the trash of a tenant. Its descendants are not
+// listed; they are restored along with it.
+type TrashedPageResponse struct {
+	ID        uint64    `json:"id"`
+	SiteID    uint64    `json:"site_id"`
+	ParentID  *uint64   `json:"parent_id"`
+	Key       string    `json:"key"`
+	Path      string    `json:"path"`
+	Type      string    `json:"type"`
+	DeletedAt time.Time `json:"deleted_at"`
+}
+
+// TrashedTemplateResponse is the API representation of a template in the trash.
+type TrashedTemplateResponse struct {
+	ID        uint64    `json:"id"`
+	Name      string    `json:"name"`
+	FilePath  string    `json:"file_path"`
+	DeletedAt time.Time `json:"deleted_at"`
+}
+
+// TrashResponse is the API representation of the trash of a tenant.
+type TrashResponse struct {
+	Sites []TrashedSiteResponse `json:"sites"`
+	Pages []TrashedPageResponse `json:"pages"`
+}
+
+// NewTrashResponse maps the trashed sites and pages of a tenant to a TrashResponse.
+func NewTrashResponse(sites []*entities.Site, pages []*entities.Page) TrashResponse {
+	response := TrashResponse{
+		Sites: make([]TrashedSiteResponse, 0, len(sites)),
+		Pages: make([]TrashedPageResponse, 0, len(pages)),
+	}
+
+	for _, site := range sites {
+		response.Sites = append(response.Sites, TrashedSiteResponse{
+			ID:        site.ID().Value(),
+			Name:      site.Name(),
+			Domain:    site.Domain().Value(),
+			DeletedAt: *site.DeletedAt(),
+		})
+	}
+
+	for _, page := range pages {
+		trashed := TrashedPageResponse{
+			ID:        page.ID().Value(),
+			SiteID:    page.SiteID().Value(),
+			Key:       page.Key().Value(),
+			Path:      page.FullPath(),
+			Type:      string(page.Type()),
+			DeletedAt: *page.DeletedAt(),
+		}
+		if page.ParentID() != nil {
+			trashed.ParentID = page.ParentID().ValuePtr()
+		}
+		response.Pages = append(response.Pages, trashed)
+	}
+
+	return response
+}
+
+// NewTemplateTrashResponse maps the trashed templates to TrashedTemplateResponses.
+func NewTemplateTrashResponse(templates []*entities.Template) []TrashedTemplateResponse {
+	response := make([]TrashedTemplateResponse, 0, len(templates))
+	for _, template := range templates {
+		response = append(response, TrashedTemplateResponse{
+			ID:        template.ID().Value(),
+			Name:      template.Name(),
+			FilePath:  template.FilePath(),
+			DeletedAt: *template.DeletedAt(),
+		})
+	}
+	return response
+}
@@ -0,0 +1,61 @@
+package use_cases
+
+import (
+	"github.com/h4rdc0m/aurora-api/domain/common"
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"github.com/h4rdc0m/aurora-api/domain/errors"
+	"github.com/h4rdc0m/aurora-api/domain/repositories"
+)
+
+// Number of audit entries returned at once
+const (
+	defaultAuditLogLimit = 100
+	maxAuditLogLimit     = 500
+)
+
+// AuditUseCase gives tenant admins access to the audit log of their tenant
+type AuditUseCase struct {
+	tenantRepo repositories.TenantRepository
+	auditRepo  repositories.AuditEntryRepository
+	logger     common.Logger
+}
+
+// NewAuditUseCase creates a new AuditUseCase
+func NewAuditUseCase(
+	tenantRepo repositories.TenantRepository,
+	auditRepo repositories.AuditEntryRepository,
+	logger common.Logger,
+) *AuditUseCase {
+	return &AuditUseCase{
+		tenantRepo: tenantRepo,
+		auditRepo:  auditRepo,
+		logger:     logger,
+	}
+}
+
+// GetAuditLog retrieves the latest entries of the audit log of a tenant. A limit of 0 returns the default number
+// of entries; larger limits are capped.
+func (u *AuditUseCase) GetAuditLog(tenantID uint64, limit uint64) ([]*entities.AuditEntry, error) {
+	tenant, err := u.tenantRepo.FindByID(entities.NewTenantID(tenantID))
+	if err != nil {
+		u.logger.Error("Failed to get tenant", "id", tenantID, "error", err)
+		return nil, err
+	}
+	if tenant == nil {
+		return nil, errors.ErrTenantNotFound
+	}
+
+	switch {
+	case limit == 0:
+		limit = defaultAuditLogLimit
+	case limit > maxAuditLogLimit:
+		limit = maxAuditLogLimit
+	}
+
+	entries, err := u.auditRepo.FindByTenantID(tenant.ID(), limit)
+	if err != nil {
+		u.logger.Error("Failed to get audit log", "tenantID", tenantID, "error", err)
+		return nil, err
+	}
+	return entries, nil
+}
@@ -0,0 +1,207 @@
+package use_cases
+
+import (
+	"github.com/h4rdc0m/aurora-api/application/dto"
+	"github.com/h4rdc0m/aurora-api/domain/common"
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"github.com/h4rdc0m/aurora-api/domain/errors"
+	"github.com/h4rdc0m/aurora-api/domain/repositories"
+	"github.com/h4rdc0m/aurora-api/domain/services"
+	"sort"
+)
+
+// ContentTypeUseCase manages the registry of block content types.
+// A nil tenantID addresses the global content types, otherwise the content types of that tenant.
+type ContentTypeUseCase struct {
+	contentTypeRepo  repositories.ContentTypeRepository
+	tenantRepo       repositories.TenantRepository
+	contentValidator services.ContentValidator
+	logger           common.Logger
+}
+
+// NewContentTypeUseCase creates a new ContentTypeUseCase
+func NewContentTypeUseCase(
+	contentTypeRepo repositories.ContentTypeRepository,
+	tenantRepo repositories.TenantRepository,
+	contentValidator services.ContentValidator,
+	logger common.Logger,
+) *ContentTypeUseCase {
+	return &ContentTypeUseCase{
+		contentTypeRepo:  contentTypeRepo,
+		tenantRepo:       tenantRepo,
+		contentValidator: contentValidator,
+		logger:           logger,
+	}
+}
+
+// GetContentTypes retrieves the content types available in the scope. For a tenant these are its own content types
+// together with the global ones it does not replace, ordered by name.
+func (u *ContentTypeUseCase) GetContentTypes(tenantID *uint64) ([]*entities.ContentType, error) {
+	globals, err := u.contentTypeRepo.FindByTenantID(nil)
+	if err != nil {
+		return nil, err
+	}
+	if tenantID == nil {
+		return globals, nil
+	}
+
+	if _, err := u.findTenant(*tenantID); err != nil {
+		return nil, err
+	}
+	id := entities.NewTenantID(*tenantID)
+	contentTypes, err := u.contentTypeRepo.FindByTenantID(&id)
+	if err != nil {
+		return nil, err
+	}
+
+	replaced := make(map[string]bool, len(contentTypes))
+	for _, contentType := range contentTypes {
+		replaced[contentType.Name()] = true
+	}
+	for _, global := range globals {
+		if !replaced[global.Name()] {
+			contentTypes = append(contentTypes, global)
+		}
+	}
+	sort.SliceStable(contentTypes, func(i, j int) bool {
+		return contentTypes[i].Name() < contentTypes[j].Name()
+	})
+
+	return contentTypes, nil
+}
+
+// GetContentType retrieves a content type of the scope; tenants can read global content types as well
+func (u *ContentTypeUseCase) GetContentType(tenantID *uint64, id uint64) (*entities.ContentType, error) {
+	contentType, err := u.contentTypeRepo.FindByID(entities.NewContentTypeID(id))
+	if err != nil {
+		u.logger.Error("Failed to find content type", "id", id, "error", err)
+		return nil, err
+	}
+	if contentType == nil || !(contentType.IsGlobal() || inScope(contentType, tenantID)) {
+		return nil, errors.ErrContentTypeNotFound
+	}
+	return contentType, nil
+}
+
+// CreateContentType registers a new content type in the scope
+func (u *ContentTypeUseCase) CreateContentType(tenantID *uint64, req dto.CreateContentTypeRequest) (*entities.ContentType, error) {
+	var owner *entities.TenantID
+	if tenantID != nil {
+		tenant, err := u.findTenant(*tenantID)
+		if err != nil {
+			return nil, err
+		}
+		id := tenant.ID()
+		owner = &id
+	}
+
+	contentType, err := entities.NewContentType(owner, req.Name, req.Description, string(req.Schema))
+	if err != nil {
+		return nil, err
+	}
+	if err := u.contentValidator.ValidateSchema(contentType.Schema()); err != nil {
+		return nil, err
+	}
+
+	existing, err := u.contentTypeRepo.FindByName(owner, contentType.Name())
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, errors.ErrContentTypeAlreadyExists
+	}
+
+	if err := u.contentTypeRepo.Save(contentType); err != nil {
+		u.logger.Error("Failed to save content type", "name", req.Name, "error", err)
+		return nil, err
+	}
+
+	return contentType, nil
+}
+
+// UpdateContentType replaces the description and schema of a content type of the scope.
+// Existing blocks are checked against the new schema the next time they are saved.
+func (u *ContentTypeUseCase) UpdateContentType(tenantID *uint64, id uint64, req dto.UpdateContentTypeRequest) (*entities.ContentType, error) {
+	contentType, err := u.findOwnContentType(tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.contentValidator.ValidateSchema(string(req.Schema)); err != nil {
+		return nil, err
+	}
+	if err := contentType.UpdateSchema(string(req.Schema)); err != nil {
+		return nil, err
+	}
+	contentType.UpdateDescription(req.Description)
+
+	if err := u.contentTypeRepo.Save(contentType); err != nil {
+		u.logger.Error("Failed to save updated content type", "id", id, "error", err)
+		return nil, err
+	}
+
+	return contentType, nil
+}
+
+// DeleteContentType removes a content type of the scope; blocks of that type are no longer validated
+func (u *ContentTypeUseCase) DeleteContentType(tenantID *uint64, id uint64) error {
+	contentType, err := u.findOwnContentType(tenantID, id)
+	if err != nil {
+		return err
+	}
+
+	if err := u.contentTypeRepo.Delete(contentType.ID()); err != nil {
+		u.logger.Error("Failed to delete content type", "id", id, "error", err)
+		return err
+	}
+
+	return nil
+}
+
+// ValidateBlockContent checks content against the content type the tenant would validate a block of that type with
+func (u *ContentTypeUseCase) ValidateBlockContent(tenantID uint64, req dto.ValidateBlockContentRequest) error {
+	if _, err := u.findTenant(tenantID); err != nil {
+		return err
+	}
+
+	block, err := entities.NewPageBlock(entities.NewPageVersionID(0), "content", 0, req.ContentType, req.Content)
+	if err != nil {
+		return err
+	}
+
+	return u.contentValidator.Validate(entities.NewTenantID(tenantID), block)
+}
+
+// findOwnContentType retrieves a content type that belongs to exactly the given scope
+func (u *ContentTypeUseCase) findOwnContentType(tenantID *uint64, id uint64) (*entities.ContentType, error) {
+	contentType, err := u.contentTypeRepo.FindByID(entities.NewContentTypeID(id))
+	if err != nil {
+		u.logger.Error("Failed to find content type", "id", id, "error", err)
+		return nil, err
+	}
+	if contentType == nil || !inScope(contentType, tenantID) {
+		return nil, errors.ErrContentTypeNotFound
+	}
+	return contentType, nil
+}
+
+// findTenant retrieves a tenant, returning ErrTenantNotFound when it does not exist
+func (u *ContentTypeUseCase) findTenant(tenantID uint64) (*entities.Tenant, error) {
+	tenant, err := u.tenantRepo.FindByID(entities.NewTenantID(tenantID))
+	if err != nil {
+		u.logger.Error("Failed to find tenant", "tenantID", tenantID, "error", err)
+		return nil, err
+	}
+	if tenant == nil {
+		return nil, errors.ErrTenantNotFound
+	}
+	return tenant, nil
+}
+
+// inScope reports whether the content type belongs to the tenant, or is global when tenantID is nil
+func inScope(contentType *entities.ContentType, tenantID *uint64) bool {
+	if tenantID == nil || contentType.TenantID() == nil {
+		return tenantID == nil && contentType.TenantID() == nil
+	}
+	return contentType.TenantID().Value() == *tenantID
+}
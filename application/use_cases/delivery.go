@@ -0,0 +1,661 @@
+package use_cases
+
+import (
+	stderrors "errors"
+	"github.com/h4rdc0m/aurora-api/domain/common"
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"github.com/h4rdc0m/aurora-api/domain/errors"
+	"github.com/h4rdc0m/aurora-api/domain/repositories"
+	"github.com/h4rdc0m/aurora-api/domain/services"
+	"github.com/h4rdc0m/aurora-api/domain/value_objects"
+	"github.com/h4rdc0m/aurora-api/utils/acceptlang"
+	"path"
+	"regexp"
+	"strings"
+)
+
+// maxDeliveryPathLength bounds the length of a requested page path
+const maxDeliveryPathLength = 2048
+
+// defaultRobotsTxt is served for sites without configured robots rules
+const defaultRobotsTxt = "User-agent: *\nAllow: /\n"
+
+// Limits of delivered search queries
+const (
+	maxSearchQueryLength  = 256
+	defaultSearchPageSize = 10
+	maxSearchPageSize     = 50
+)
+
+// previewRobots are the robots directives of previewed pages
+const previewRobots = "noindex, nofollow"
+
+// robotsSitemapPattern matches a sitemap directive in robots rules
+var robotsSitemapPattern = regexp.MustCompile(`(?im)^\s*sitemap\s*:`)
+
+// deliveryLocale is the locale negotiated for a delivery request. Path is the requested page path without the
+// locale prefix; Prefixed reports whether the path carried one.
+type deliveryLocale struct {
+	Locale   entities.Locale
+	Path     string
+	Prefixed bool
+}
+
+// DeliveryUseCase serves the published content of enabled sites to anonymous clients
+type DeliveryUseCase struct {
+	siteRepo        repositories.SiteRepository
+	pageRepo        repositories.PageRepository
+	pageVersionRepo repositories.PageVersionRepository
+	pageBlockRepo   repositories.PageBlockRepository
+	redirectRepo    repositories.RedirectRepository
+	pageResolver    services.PageResolver
+	snippetService  services.SnippetService
+	searchIndex     services.SearchIndex
+	previewTokens   services.PreviewTokenService
+	logger          common.Logger
+}
+
+// NewDeliveryUseCase creates a new DeliveryUseCase
+func NewDeliveryUseCase(
+	siteRepo repositories.SiteRepository,
+	pageRepo repositories.PageRepository,
+	pageVersionRepo repositories.PageVersionRepository,
+	pageBlockRepo repositories.PageBlockRepository,
+	redirectRepo repositories.RedirectRepository,
+	pageResolver services.PageResolver,
+	snippetService services.SnippetService,
+	searchIndex services.SearchIndex,
+	previewTokens services.PreviewTokenService,
+	logger common.Logger,
+) *DeliveryUseCase {
+	return &DeliveryUseCase{
+		siteRepo:        siteRepo,
+		pageRepo:        pageRepo,
+		pageVersionRepo: pageVersionRepo,
+		pageBlockRepo:   pageBlockRepo,
+		redirectRepo:    redirectRepo,
+		pageResolver:    pageResolver,
+		snippetService:  snippetService,
+		searchIndex:     searchIndex,
+		previewTokens:   previewTokens,
+		logger:          logger,
+	}
+}
+
+// GetPage returns the published page at the path of the site serving the host. Hard links are followed to the
+// page whose content they show; link pages are returned without content. The root path "/" delivers the first
+// root page of the site. Paths without a page are looked up in the redirects of the site before they are reported
+// as not found. Disabled sites, unpublished pages and snippet pages are reported as not found.
+// The locale is negotiated from the path, the requested locale and the Accept-Language header, see negotiateLocale;
+// pages without a version published in that locale fall back along the locale chain of the site.
+// Delivered content comes with its head metadata, see entities.NewPageHead.
+// A preview token of the site, when given, delivers the unpublished content it grants access to instead, see
+// findDeliveredVersion; invalid, expired and revoked tokens are rejected.
+func (u *DeliveryUseCase) GetPage(host, pagePath, locale, acceptLanguage, previewToken string) (*entities.DeliveredPage, error) {
+	site, err := u.findSite(host)
+	if err != nil {
+		return nil, err
+	}
+
+	preview, err := u.verifyPreview(site, previewToken)
+	if err != nil {
+		return nil, err
+	}
+
+	negotiated, err := negotiateLocale(site, pagePath, locale, acceptLanguage)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := u.findPage(site, negotiated.Path)
+	if stderrors.Is(err, errors.ErrPageNotFound) {
+		return u.findRedirect(site, negotiated)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	resolved, err := u.pageResolver.Resolve(page)
+	if err != nil {
+		if stderrors.Is(err, errors.ErrPageHardLinkDangling) || stderrors.Is(err, errors.ErrPageHardLinkCycle) {
+			u.logger.Warn("Delivered page has a broken hard link", "pageID", page.ID().Value(), "error", err)
+			return nil, errors.ErrPageNotFound
+		}
+		return nil, err
+	}
+
+	delivered := &entities.DeliveredPage{Site: site, Page: page, Resolved: resolved, Locale: negotiated.Locale, Preview: preview != nil}
+	switch resolved.Page.Type() {
+	case entities.PageTypeLink:
+		return delivered, nil
+	case entities.PageTypeSnippet:
+		// Snippets are only delivered inlined into the pages that embed them
+		return nil, errors.ErrPageNotFound
+	}
+
+	contentSite, err := u.contentSite(site, resolved.Page)
+	if err != nil {
+		return nil, err
+	}
+	version, err := u.findDeliveredVersion(resolved.Page, contentSite.LocaleChain(negotiated.Locale), preview)
+	if err != nil {
+		return nil, err
+	}
+	if version == nil {
+		return nil, errors.ErrPageNotFound
+	}
+
+	blocks, err := u.pageBlockRepo.FindByPageVersionID(version.ID())
+	if err != nil {
+		u.logger.Error("Failed to get page blocks", "versionID", version.ID().Value(), "error", err)
+		return nil, err
+	}
+
+	delivered.Version = version
+	delivered.Blocks, err = u.snippetService.Render(resolved.Page, version.Locale(), blocks)
+	if err != nil {
+		return nil, err
+	}
+
+	delivered.Head, err = u.pageHead(delivered, contentSite)
+	if err != nil {
+		return nil, err
+	}
+	if delivered.Preview {
+		// Previews are shared with people, not with search engines
+		robots := previewRobots
+		delivered.Head.Robots = &robots
+	}
+
+	return delivered, nil
+}
+
+// GetSitemap lists the content pages of the site serving the host that have a published version, ordered by path.
+// Every enabled locale a page is published in is listed at its localized path, see Site.LocalizedPath.
+// The last modification of a page is the most recent update of the page or its published version. Only the
+// parts are summarized when the sitemap needs more than one file; its URLs are then served by GetSitemapPart.
+func (u *DeliveryUseCase) GetSitemap(host string) (*entities.Sitemap, error) {
+	site, err := u.findSite(host)
+	if err != nil {
+		return nil, err
+	}
+
+	parts, err := u.pageVersionRepo.FindSitemapParts(site.ID(), site.Locales(), entities.SitemapMaxURLs)
+	if err != nil {
+		u.logger.Error("Failed to summarize sitemap parts", "siteID", site.ID().Value(), "error", err)
+		return nil, err
+	}
+
+	sitemap := &entities.Sitemap{Site: site, Parts: parts}
+	if sitemap.IsIndex() {
+		return sitemap, nil
+	}
+
+	sitemap.URLs, err = u.sitemapURLs(site, 0)
+	if err != nil {
+		return nil, err
+	}
+	return sitemap, nil
+}
+
+// GetSitemapPart lists the URLs of the 1-based part of the sitemap of the site serving the host, loading only the
+// pages of that part. Returns ErrSitemapNotFound for parts beyond the end of the sitemap; the first part always
+// exists.
+func (u *DeliveryUseCase) GetSitemapPart(host string, number int) (*entities.SitemapPart, error) {
+	if number < 1 {
+		return nil, errors.ErrSitemapNotFound
+	}
+
+	site, err := u.findSite(host)
+	if err != nil {
+		return nil, err
+	}
+
+	urls, err := u.sitemapURLs(site, uint64(number-1)*entities.SitemapMaxURLs)
+	if err != nil {
+		return nil, err
+	}
+	if len(urls) == 0 && number > 1 {
+		return nil, errors.ErrSitemapNotFound
+	}
+
+	return &entities.SitemapPart{Site: site, Number: number, URLs: urls}, nil
+}
+
+// GetRobotsTxt returns the robots.txt of the site serving the host: its configured rules, or rules allowing all
+// crawlers when none are configured. A reference to the sitemap is added unless the rules already name one.
+func (u *DeliveryUseCase) GetRobotsTxt(host string) (string, error) {
+	site, err := u.findSite(host)
+	if err != nil {
+		return "", err
+	}
+
+	rules := defaultRobotsTxt
+	if site.RobotsTxt() != nil {
+		rules = strings.TrimRight(*site.RobotsTxt(), "\r\n") + "\n"
+	}
+	if !robotsSitemapPattern.MatchString(rules) {
+		rules += "\nSitemap: " + site.BaseURL() + "/sitemap.xml\n"
+	}
+
+	return rules, nil
+}
+
+// GetFeed returns the feed of the feed root page at the path of the site serving the host, listing the published
+// content pages among its direct children. The feed root itself must be published. The locale is negotiated as
+// for GetPage and every page falls back along the locale chain of the site on its own.
+func (u *DeliveryUseCase) GetFeed(host, pagePath, locale, acceptLanguage string) (*entities.Feed, error) {
+	site, err := u.findSite(host)
+	if err != nil {
+		return nil, err
+	}
+
+	negotiated, err := negotiateLocale(site, pagePath, locale, acceptLanguage)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := u.findPage(site, negotiated.Path)
+	if err != nil {
+		return nil, err
+	}
+	if !page.IsFeedRoot() {
+		return nil, errors.ErrFeedNotFound
+	}
+
+	chain := site.LocaleChain(negotiated.Locale)
+	version, err := u.findPublishedVersion(page, chain)
+	if err != nil {
+		return nil, err
+	}
+	if version == nil {
+		return nil, errors.ErrFeedNotFound
+	}
+
+	children, err := u.pageRepo.FindChildrenByParentID(page.ID())
+	if err != nil {
+		u.logger.Error("Failed to get child pages", "pageID", page.ID().Value(), "error", err)
+		return nil, err
+	}
+
+	items := make([]entities.FeedItem, 0, len(children))
+	for _, child := range children {
+		if child.Type() != entities.PageTypeContent {
+			continue
+		}
+		childVersion, err := u.findPublishedVersion(child, chain)
+		if err != nil {
+			return nil, err
+		}
+		if childVersion != nil {
+			items = append(items, entities.FeedItem{Page: child, Version: childVersion})
+		}
+	}
+
+	return entities.NewFeed(site, page, negotiated.Locale, version, items), nil
+}
+
+// Search runs a full-text query against the published pages of the site serving the host. The locale is negotiated
+// as for GetPage and every page is searched in the first locale of the locale chain of the site it is published in.
+// The page number is 1-based; out of range page numbers and page sizes fall back to the first page and the default
+// or maximum size.
+func (u *DeliveryUseCase) Search(host, text, locale, acceptLanguage string, page, perPage int) (*entities.SearchResult, error) {
+	if len(text) > maxSearchQueryLength {
+		return nil, errors.ErrSearchQueryTooLong
+	}
+
+	site, err := u.findSite(host)
+	if err != nil {
+		return nil, err
+	}
+
+	negotiated, err := negotiateLocale(site, "/", locale, acceptLanguage)
+	if err != nil {
+		return nil, err
+	}
+
+	query := entities.SearchQuery{
+		SiteID:  site.ID(),
+		Locales: site.LocaleChain(negotiated.Locale),
+		Text:    strings.TrimSpace(text),
+		Page:    page,
+		PerPage: perPage,
+	}
+	if query.Page < 1 {
+		query.Page = 1
+	}
+	if query.PerPage < 1 {
+		query.PerPage = defaultSearchPageSize
+	}
+	query.PerPage = min(query.PerPage, maxSearchPageSize)
+
+	result, err := u.searchIndex.Search(query)
+	if err != nil {
+		u.logger.Error("Failed to search pages", "siteID", site.ID().Value(), "error", err)
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// findSite finds the enabled site serving the host
+func (u *DeliveryUseCase) findSite(host string) (*entities.Site, error) {
+	domain, err := value_objects.NewDomainName(host)
+	if err != nil {
+		return nil, errors.ErrSiteNotFound
+	}
+
+	site, err := u.siteRepo.FindByDomain(domain)
+	if err != nil {
+		u.logger.Error("Failed to find site by domain", "domain", domain.Value(), "error", err)
+		return nil, err
+	}
+	if site == nil || !site.IsEnabled() {
+		return nil, errors.ErrSiteNotFound
+	}
+
+	return site, nil
+}
+
+// findPage finds the page at the path of the site
+func (u *DeliveryUseCase) findPage(site *entities.Site, pagePath string) (*entities.Page, error) {
+	pagePath, err := normalizePagePath(pagePath)
+	if err != nil {
+		return nil, err
+	}
+
+	if pagePath == "/" {
+		return u.findHomePage(site)
+	}
+
+	page, err := u.pageRepo.FindByPath(pagePath, site.ID())
+	if err != nil {
+		u.logger.Error("Failed to find page by path", "siteID", site.ID().Value(), "path", pagePath, "error", err)
+		return nil, err
+	}
+	if page == nil {
+		return nil, errors.ErrPageNotFound
+	}
+
+	return page, nil
+}
+
+// findRedirect delivers the redirect of the site for a path without a page and counts the hit. Redirects to
+// pages that are gone or belong to a disabled site are reported as not found. Sources are matched without the
+// locale prefix of the path, which is kept on the location of target pages of the same site.
+func (u *DeliveryUseCase) findRedirect(site *entities.Site, negotiated deliveryLocale) (*entities.DeliveredPage, error) {
+	pagePath, err := normalizePagePath(negotiated.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	redirect, err := u.redirectRepo.FindBySourcePath(site.ID(), pagePath)
+	if err != nil {
+		u.logger.Error("Failed to find redirect", "siteID", site.ID().Value(), "path", pagePath, "error", err)
+		return nil, err
+	}
+	if redirect == nil {
+		return nil, errors.ErrPageNotFound
+	}
+
+	delivered := &entities.DeliveredRedirect{StatusCode: redirect.StatusCode()}
+	switch {
+	case redirect.TargetURL() != nil:
+		delivered.Location = *redirect.TargetURL()
+	case redirect.TargetPageID() != nil:
+		delivered.Location, err = u.pageLocation(site, *redirect.TargetPageID())
+		if err != nil {
+			return nil, err
+		}
+		if negotiated.Prefixed && strings.HasPrefix(delivered.Location, "/") {
+			delivered.Location = site.LocalizedPath(negotiated.Locale, delivered.Location)
+		}
+	}
+
+	// A lost hit must not fail the request
+	if err := u.redirectRepo.RecordHit(redirect.ID()); err != nil {
+		u.logger.Warn("Failed to record redirect hit", "redirectID", redirect.ID().Value(), "error", err)
+	}
+
+	return &entities.DeliveredPage{Site: site, Locale: negotiated.Locale, Redirect: delivered}, nil
+}
+
+// pageLocation returns the path of the page, or its absolute URL when the page belongs to another site
+func (u *DeliveryUseCase) pageLocation(site *entities.Site, pageID entities.PageID) (string, error) {
+	page, err := u.pageRepo.FindByID(pageID)
+	if err != nil {
+		u.logger.Error("Failed to find redirect target page", "pageID", pageID.Value(), "error", err)
+		return "", err
+	}
+	if page == nil {
+		return "", errors.ErrPageNotFound
+	}
+	if page.SiteID().Value() == site.ID().Value() {
+		return page.FullPath(), nil
+	}
+
+	targetSite, err := u.siteRepo.FindByID(page.SiteID())
+	if err != nil {
+		u.logger.Error("Failed to find redirect target site", "siteID", page.SiteID().Value(), "error", err)
+		return "", err
+	}
+	if targetSite == nil || !targetSite.IsEnabled() {
+		return "", errors.ErrPageNotFound
+	}
+	return targetSite.BaseURL() + page.FullPath(), nil
+}
+
+// contentSite returns the site the page belongs to: the site serving the request, or the site a hard link led into.
+// Pages reached through a hard link into another site follow the locale chain of that site; a hard link into a
+// disabled site is reported as not found, as the page would be on that site itself.
+func (u *DeliveryUseCase) contentSite(site *entities.Site, page *entities.Page) (*entities.Site, error) {
+	if page.SiteID().Value() == site.ID().Value() {
+		return site, nil
+	}
+
+	pageSite, err := u.siteRepo.FindByID(page.SiteID())
+	if err != nil {
+		u.logger.Error("Failed to find site of hard link target", "siteID", page.SiteID().Value(), "error", err)
+		return nil, err
+	}
+	if pageSite == nil || !pageSite.IsEnabled() {
+		return nil, errors.ErrPageNotFound
+	}
+	return pageSite, nil
+}
+
+// pageHead computes the head metadata of delivered content. The parent title is the title of the published parent
+// of the requested page in the negotiated locale chain, and alternate links list the locales the content is
+// published in.
+func (u *DeliveryUseCase) pageHead(delivered *entities.DeliveredPage, contentSite *entities.Site) (*entities.PageHead, error) {
+	var parentTitle *string
+	if parentID := delivered.Page.ParentID(); parentID != nil {
+		parent, err := u.pageRepo.FindByID(*parentID)
+		if err != nil {
+			u.logger.Error("Failed to find parent page", "pageID", parentID.Value(), "error", err)
+			return nil, err
+		}
+		if parent != nil {
+			parentVersion, err := u.findPublishedVersion(parent, delivered.Site.LocaleChain(delivered.Locale))
+			if err != nil {
+				return nil, err
+			}
+			if parentVersion != nil {
+				title := parentVersion.Title()
+				parentTitle = &title
+			}
+		}
+	}
+
+	versions, err := u.pageVersionRepo.FindByPageID(delivered.Resolved.Page.ID())
+	if err != nil {
+		u.logger.Error("Failed to get page versions", "pageID", delivered.Resolved.Page.ID().Value(), "error", err)
+		return nil, err
+	}
+	var translations []entities.Locale
+	for _, version := range versions {
+		if version.IsPublished() {
+			translations = append(translations, version.Locale())
+		}
+	}
+
+	return entities.NewPageHead(delivered, contentSite, parentTitle, translations), nil
+}
+
+// sitemapURLs returns the sitemap URLs of at most one sitemap part of the site, starting at the offset
+func (u *DeliveryUseCase) sitemapURLs(site *entities.Site, offset uint64) ([]entities.SitemapURL, error) {
+	pages, err := u.pageVersionRepo.FindSitemapPages(site.ID(), site.Locales(), offset, entities.SitemapMaxURLs)
+	if err != nil {
+		u.logger.Error("Failed to get sitemap pages", "siteID", site.ID().Value(), "offset", offset, "error", err)
+		return nil, err
+	}
+
+	urls := make([]entities.SitemapURL, 0, len(pages))
+	for _, page := range pages {
+		urls = append(urls, entities.SitemapURL{Path: site.LocalizedPath(page.Locale, page.Path), LastModified: page.LastModified})
+	}
+	return urls, nil
+}
+
+// findPublishedVersion returns the version of the page published in the first locale of the chain that has one,
+// or nil when the page is not published in any of them
+func (u *DeliveryUseCase) findPublishedVersion(page *entities.Page, chain []entities.Locale) (*entities.PageVersion, error) {
+	for _, locale := range chain {
+		version, err := u.pageVersionRepo.FindPublishedByPageID(page.ID(), locale)
+		if err != nil {
+			u.logger.Error("Failed to find published page version", "pageID", page.ID().Value(), "locale", locale, "error", err)
+			return nil, err
+		}
+		if version != nil {
+			return version, nil
+		}
+	}
+	return nil, nil
+}
+
+// verifyPreview returns the preview token of the signed form, or nil when no token is given. Returns
+// ErrPreviewTokenInvalid if the token is not valid for the site.
+func (u *DeliveryUseCase) verifyPreview(site *entities.Site, signed string) (*entities.PreviewToken, error) {
+	if signed == "" {
+		return nil, nil
+	}
+
+	preview, err := u.previewTokens.Verify(signed)
+	if err != nil {
+		return nil, err
+	}
+	if preview.SiteID().Value() != site.ID().Value() {
+		return nil, errors.ErrPreviewTokenInvalid
+	}
+
+	return preview, nil
+}
+
+// findDeliveredVersion returns the version of the page to deliver in the first locale of the chain that has one.
+// Without a preview token granting access to the page that is the published version. A version scoped token
+// delivers the shared version in its locale instead, and a site scoped token the latest version that is not
+// archived, published or not.
+func (u *DeliveryUseCase) findDeliveredVersion(page *entities.Page, chain []entities.Locale, preview *entities.PreviewToken) (*entities.PageVersion, error) {
+	if preview == nil || !preview.Previews(page) {
+		return u.findPublishedVersion(page, chain)
+	}
+
+	if preview.Scope() == entities.PreviewScopeVersion {
+		shared, err := u.pageVersionRepo.FindByID(*preview.VersionID())
+		if err != nil {
+			u.logger.Error("Failed to find previewed page version", "versionID", preview.VersionID().Value(), "error", err)
+			return nil, err
+		}
+		for _, locale := range chain {
+			if shared != nil && shared.Locale() == locale {
+				return shared, nil
+			}
+			version, err := u.findPublishedVersion(page, []entities.Locale{locale})
+			if err != nil || version != nil {
+				return version, err
+			}
+		}
+		return nil, nil
+	}
+
+	// Versions are ordered newest first
+	versions, err := u.pageVersionRepo.FindByPageID(page.ID())
+	if err != nil {
+		u.logger.Error("Failed to get page versions", "pageID", page.ID().Value(), "error", err)
+		return nil, err
+	}
+	for _, locale := range chain {
+		for _, version := range versions {
+			if version.Locale() == locale && version.Status() != entities.PageVersionStatusArchived {
+				return version, nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+// findHomePage returns the first root page of the site that is not a snippet
+func (u *DeliveryUseCase) findHomePage(site *entities.Site) (*entities.Page, error) {
+	pages, err := u.pageRepo.FindRootPagesBySiteID(site.ID())
+	if err != nil {
+		u.logger.Error("Failed to get root pages", "siteID", site.ID().Value(), "error", err)
+		return nil, err
+	}
+	for _, page := range pages {
+		if page.Type() != entities.PageTypeSnippet {
+			return page, nil
+		}
+	}
+	return nil, errors.ErrPageNotFound
+}
+
+// normalizePagePath turns a requested path into the materialized form stored on pages, e.g. "about/team/" into "/about/team"
+func normalizePagePath(pagePath string) (string, error) {
+	pagePath = strings.TrimSpace(pagePath)
+	if len(pagePath) > maxDeliveryPathLength {
+		return "", errors.ErrPagePathInvalid
+	}
+	if !strings.HasPrefix(pagePath, "/") {
+		pagePath = "/" + pagePath
+	}
+	return path.Clean(pagePath), nil
+}
+
+// negotiateLocale selects the locale of a delivery request from, in order of precedence: a first path segment naming
+// an enabled locale, which is removed from the path; the requested locale; the most preferred language of the
+// Accept-Language header that an enabled locale serves; and the default locale of the site.
+// A requested locale that is not enabled is kept, so that delivery falls back along the locale chain of the site.
+func negotiateLocale(site *entities.Site, pagePath, requested, acceptLanguage string) (deliveryLocale, error) {
+	pagePath, err := normalizePagePath(pagePath)
+	if err != nil {
+		return deliveryLocale{}, err
+	}
+
+	segment, rest, _ := strings.Cut(strings.TrimPrefix(pagePath, "/"), "/")
+	if locale, err := entities.NewLocale(segment); err == nil && site.HasLocale(locale) {
+		return deliveryLocale{Locale: locale, Path: "/" + rest, Prefixed: true}, nil
+	}
+
+	if requested != "" {
+		locale, err := entities.NewLocale(requested)
+		if err != nil {
+			return deliveryLocale{}, err
+		}
+		if matched, ok := site.MatchLocale(locale); ok {
+			locale = matched
+		}
+		return deliveryLocale{Locale: locale, Path: pagePath}, nil
+	}
+
+	for _, tag := range acceptlang.Parse(acceptLanguage) {
+		locale, err := entities.NewLocale(tag)
+		if err != nil {
+			continue
+		}
+		if matched, ok := site.MatchLocale(locale); ok {
+			return deliveryLocale{Locale: matched, Path: pagePath}, nil
+		}
+	}
+
+	return deliveryLocale{Locale: site.DefaultLocale(), Path: pagePath}, nil
+}
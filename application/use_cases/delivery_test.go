@@ -0,0 +1,748 @@
+package use_cases
+
+import (
+	"fmt"
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	domainErrors "github.com/h4rdc0m/aurora-api/domain/errors"
+	"github.com/h4rdc0m/aurora-api/domain/value_objects"
+	"github.com/h4rdc0m/aurora-api/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+type deliveryTestRepos struct {
+	sites     *mocks.MockSiteRepository
+	pages     *mocks.MockPageRepository
+	versions  *mocks.MockPageVersionRepository
+	blocks    *mocks.MockPageBlockRepository
+	redirects *mocks.MockRedirectRepository
+	resolver  *mocks.MockPageResolver
+	snippets  *mocks.MockSnippetService
+	search    *mocks.MockSearchIndex
+}
+
+// newTestLogger creates a logger mock accepting messages with up to five key-value pairs at every level
+func newTestLogger() *mocks.Logger {
+	logger := &mocks.Logger{}
+	for _, level := range []string{"Debug", "Info", "Warn", "Error"} {
+		args := []interface{}{mock.Anything}
+		for i := 0; i <= 10; i++ {
+			logger.On(level, args...).Return()
+			args = append(args, mock.Anything)
+		}
+	}
+	return logger
+}
+
+func newTestDeliveryUseCase() (*DeliveryUseCase, deliveryTestRepos) {
+	repos := deliveryTestRepos{
+		sites:     &mocks.MockSiteRepository{},
+		pages:     &mocks.MockPageRepository{},
+		versions:  &mocks.MockPageVersionRepository{},
+		blocks:    &mocks.MockPageBlockRepository{},
+		redirects: &mocks.MockRedirectRepository{},
+		resolver:  &mocks.MockPageResolver{},
+		snippets:  &mocks.MockSnippetService{},
+		search:    &mocks.MockSearchIndex{},
+	}
+	useCase := NewDeliveryUseCase(repos.sites, repos.pages, repos.versions, repos.blocks, repos.redirects, repos.resolver, repos.snippets, repos.search, nil, newTestLogger())
+	return useCase, repos
+}
+
+// newDeliverySite creates an enabled site serving the domain in the given locales, the first being the default;
+// the default locale is the fallback of the others
+func newDeliverySite(t *testing.T, id uint64, host string, locales ...entities.Locale) *entities.Site {
+	domain, err := value_objects.NewDomainName(host)
+	assert.NoError(t, err)
+	site, err := entities.NewSite("Site", nil, domain, entities.NewTemplateID(1), entities.NewTenantID(1))
+	assert.NoError(t, err)
+	assert.NoError(t, site.SetID(entities.NewSiteID(id)))
+	if len(locales) > 0 {
+		assert.NoError(t, site.UpdateLocales(locales[0], locales, locales[:1]))
+	}
+	return site
+}
+
+// newDeliveryPage creates a persisted root page of the site at the path
+func newDeliveryPage(t *testing.T, id, siteID uint64, pagePath string, pageType entities.PageType) *entities.Page {
+	key, err := value_objects.NewPageKey("page")
+	assert.NoError(t, err)
+	page, err := entities.NewPage(key, &pagePath, entities.NewSiteID(siteID), pageType)
+	assert.NoError(t, err)
+	page.SetID(entities.NewPageID(id))
+	return page
+}
+
+// serveSite registers the site as the one serving its domain
+func (r deliveryTestRepos) serveSite(site *entities.Site) {
+	r.sites.On("FindByDomain", site.Domain()).Return(site, nil)
+	r.sites.On("FindByID", site.ID()).Return(site, nil)
+}
+
+// publish registers a version of the page published in each of the locales, resolving the page to itself
+func (r deliveryTestRepos) publish(t *testing.T, page *entities.Page, locales ...entities.Locale) []*entities.PageVersion {
+	versions := make([]*entities.PageVersion, 0, len(locales))
+	for i, locale := range locales {
+		version, err := entities.NewPageVersion(page.ID(), 1, locale, "Title "+string(locale), nil)
+		assert.NoError(t, err)
+		version.SetID(entities.NewPageVersionID(page.ID().Value()*10 + uint64(i)))
+		version.SetStatus(entities.PageVersionStatusPublished, nil, nil)
+		versions = append(versions, version)
+
+		r.versions.On("FindPublishedByPageID", page.ID(), locale).Return(version, nil)
+		r.blocks.On("FindByPageVersionID", version.ID()).Return([]*entities.PageBlock{}, nil)
+		r.snippets.On("Render", page, locale, []*entities.PageBlock{}).Return([]*entities.RenderedBlock{}, nil)
+	}
+	r.versions.On("FindPublishedByPageID", page.ID(), mock.Anything).Return(nil, nil)
+	r.versions.On("FindByPageID", page.ID()).Return(versions, nil)
+	r.resolver.On("Resolve", page).Return(&entities.ResolvedPage{Page: page, Chain: []entities.PageID{page.ID()}}, nil)
+	return versions
+}
+
+func TestDeliveryUseCase_GetPage_Site(t *testing.T) {
+	t.Run("unknown host is not found", func(t *testing.T) {
+		useCase, repos := newTestDeliveryUseCase()
+		repos.sites.On("FindByDomain", mock.Anything).Return(nil, nil)
+
+		page, err := useCase.GetPage("unknown.example.com", "/", "", "", "")
+
+		assert.Nil(t, page)
+		assert.ErrorIs(t, err, domainErrors.ErrSiteNotFound)
+	})
+
+	t.Run("invalid host is not found without a lookup", func(t *testing.T) {
+		useCase, repos := newTestDeliveryUseCase()
+
+		page, err := useCase.GetPage("not a host", "/", "", "", "")
+
+		assert.Nil(t, page)
+		assert.ErrorIs(t, err, domainErrors.ErrSiteNotFound)
+		repos.sites.AssertNotCalled(t, "FindByDomain", mock.Anything)
+	})
+
+	t.Run("disabled site is not found", func(t *testing.T) {
+		useCase, repos := newTestDeliveryUseCase()
+		site := newDeliverySite(t, 1, "example.com")
+		site.Disable()
+		repos.serveSite(site)
+
+		page, err := useCase.GetPage("example.com", "/", "", "", "")
+
+		assert.Nil(t, page)
+		assert.ErrorIs(t, err, domainErrors.ErrSiteNotFound)
+		repos.pages.AssertNotCalled(t, "FindRootPagesBySiteID", mock.Anything)
+	})
+}
+
+func TestDeliveryUseCase_GetPage_Root(t *testing.T) {
+	t.Run("root path delivers the first root page that is not a snippet", func(t *testing.T) {
+		useCase, repos := newTestDeliveryUseCase()
+		site := newDeliverySite(t, 1, "example.com")
+		repos.serveSite(site)
+		snippet := newDeliveryPage(t, 1, 1, "/footer", entities.PageTypeSnippet)
+		home := newDeliveryPage(t, 2, 1, "/home", entities.PageTypeContent)
+		other := newDeliveryPage(t, 3, 1, "/other", entities.PageTypeContent)
+		repos.pages.On("FindRootPagesBySiteID", site.ID()).Return([]*entities.Page{snippet, home, other}, nil)
+		repos.publish(t, home, entities.DefaultLocale)
+
+		page, err := useCase.GetPage("example.com", "/", "", "", "")
+
+		assert.NoError(t, err)
+		assert.Same(t, home, page.Page)
+		assert.Equal(t, "Title en", page.Version.Title())
+		repos.pages.AssertNotCalled(t, "FindByPath", mock.Anything, mock.Anything)
+	})
+
+	t.Run("site with only snippet root pages is not found", func(t *testing.T) {
+		useCase, repos := newTestDeliveryUseCase()
+		site := newDeliverySite(t, 1, "example.com")
+		repos.serveSite(site)
+		snippet := newDeliveryPage(t, 1, 1, "/footer", entities.PageTypeSnippet)
+		repos.pages.On("FindRootPagesBySiteID", site.ID()).Return([]*entities.Page{snippet}, nil)
+		repos.redirects.On("FindBySourcePath", site.ID(), "/").Return(nil, nil)
+
+		page, err := useCase.GetPage("example.com", "/", "", "", "")
+
+		assert.Nil(t, page)
+		assert.ErrorIs(t, err, domainErrors.ErrPageNotFound)
+	})
+}
+
+func TestDeliveryUseCase_GetPage_PathNormalization(t *testing.T) {
+	testCases := map[string]string{
+		"about":        "/about",
+		"/about/":      "/about",
+		"//about":      "/about",
+		"/about//team": "/about/team",
+		" /about/ ":    "/about",
+		"/about/./":    "/about",
+		"":             "/",
+	}
+
+	for requested, expected := range testCases {
+		t.Run(requested, func(t *testing.T) {
+			useCase, repos := newTestDeliveryUseCase()
+			site := newDeliverySite(t, 1, "example.com")
+			repos.serveSite(site)
+			page := newDeliveryPage(t, 1, 1, expected, entities.PageTypeContent)
+			repos.pages.On("FindByPath", expected, site.ID()).Return(page, nil)
+			repos.pages.On("FindRootPagesBySiteID", site.ID()).Return([]*entities.Page{page}, nil)
+			repos.publish(t, page, entities.DefaultLocale)
+
+			delivered, err := useCase.GetPage("example.com", requested, "", "", "")
+
+			assert.NoError(t, err)
+			assert.Same(t, page, delivered.Page)
+		})
+	}
+}
+
+func TestDeliveryUseCase_GetPage_NotDelivered(t *testing.T) {
+	t.Run("snippet requested directly is not found", func(t *testing.T) {
+		useCase, repos := newTestDeliveryUseCase()
+		site := newDeliverySite(t, 1, "example.com")
+		repos.serveSite(site)
+		snippet := newDeliveryPage(t, 1, 1, "/footer", entities.PageTypeSnippet)
+		repos.pages.On("FindByPath", "/footer", site.ID()).Return(snippet, nil)
+		repos.publish(t, snippet, entities.DefaultLocale)
+
+		page, err := useCase.GetPage("example.com", "/footer", "", "", "")
+
+		assert.Nil(t, page)
+		assert.ErrorIs(t, err, domainErrors.ErrPageNotFound)
+		repos.blocks.AssertNotCalled(t, "FindByPageVersionID", mock.Anything)
+	})
+
+	t.Run("hard link to a missing target is not found", func(t *testing.T) {
+		useCase, repos := newTestDeliveryUseCase()
+		site := newDeliverySite(t, 1, "example.com")
+		repos.serveSite(site)
+		link := newDeliveryPage(t, 1, 1, "/link", entities.PageTypeHardLink)
+		repos.pages.On("FindByPath", "/link", site.ID()).Return(link, nil)
+		repos.resolver.On("Resolve", link).Return(nil, domainErrors.ErrPageHardLinkDangling)
+
+		page, err := useCase.GetPage("example.com", "/link", "", "", "")
+
+		assert.Nil(t, page)
+		assert.ErrorIs(t, err, domainErrors.ErrPageNotFound)
+	})
+
+	t.Run("hard link cycle is not found", func(t *testing.T) {
+		useCase, repos := newTestDeliveryUseCase()
+		site := newDeliverySite(t, 1, "example.com")
+		repos.serveSite(site)
+		link := newDeliveryPage(t, 1, 1, "/link", entities.PageTypeHardLink)
+		repos.pages.On("FindByPath", "/link", site.ID()).Return(link, nil)
+		repos.resolver.On("Resolve", link).Return(nil, domainErrors.ErrPageHardLinkCycle)
+
+		page, err := useCase.GetPage("example.com", "/link", "", "", "")
+
+		assert.Nil(t, page)
+		assert.ErrorIs(t, err, domainErrors.ErrPageNotFound)
+	})
+
+	t.Run("hard link into a disabled site is not found", func(t *testing.T) {
+		useCase, repos := newTestDeliveryUseCase()
+		site := newDeliverySite(t, 1, "example.com")
+		repos.serveSite(site)
+		targetSite := newDeliverySite(t, 2, "other.example.com")
+		targetSite.Disable()
+		repos.sites.On("FindByID", targetSite.ID()).Return(targetSite, nil)
+		link := newDeliveryPage(t, 1, 1, "/link", entities.PageTypeHardLink)
+		target := newDeliveryPage(t, 2, 2, "/target", entities.PageTypeContent)
+		repos.pages.On("FindByPath", "/link", site.ID()).Return(link, nil)
+		repos.resolver.On("Resolve", link).Return(&entities.ResolvedPage{Page: target, Chain: []entities.PageID{link.ID(), target.ID()}}, nil)
+
+		page, err := useCase.GetPage("example.com", "/link", "", "", "")
+
+		assert.Nil(t, page)
+		assert.ErrorIs(t, err, domainErrors.ErrPageNotFound)
+		repos.versions.AssertNotCalled(t, "FindPublishedByPageID", mock.Anything, mock.Anything)
+	})
+
+	t.Run("hard link into an enabled site delivers the target", func(t *testing.T) {
+		useCase, repos := newTestDeliveryUseCase()
+		site := newDeliverySite(t, 1, "example.com")
+		repos.serveSite(site)
+		targetSite := newDeliverySite(t, 2, "other.example.com")
+		repos.sites.On("FindByID", targetSite.ID()).Return(targetSite, nil)
+		link := newDeliveryPage(t, 1, 1, "/link", entities.PageTypeHardLink)
+		target := newDeliveryPage(t, 2, 2, "/target", entities.PageTypeContent)
+		repos.pages.On("FindByPath", "/link", site.ID()).Return(link, nil)
+		repos.resolver.On("Resolve", link).Return(&entities.ResolvedPage{Page: target, Chain: []entities.PageID{link.ID(), target.ID()}}, nil)
+		repos.publish(t, target, entities.DefaultLocale)
+
+		page, err := useCase.GetPage("example.com", "/link", "", "", "")
+
+		assert.NoError(t, err)
+		assert.Same(t, link, page.Page)
+		assert.Same(t, target, page.Resolved.Page)
+		assert.Equal(t, "https://other.example.com/target", page.Head.CanonicalURL)
+	})
+
+	t.Run("unpublished page is not found", func(t *testing.T) {
+		useCase, repos := newTestDeliveryUseCase()
+		site := newDeliverySite(t, 1, "example.com")
+		repos.serveSite(site)
+		page := newDeliveryPage(t, 1, 1, "/draft", entities.PageTypeContent)
+		repos.pages.On("FindByPath", "/draft", site.ID()).Return(page, nil)
+		repos.publish(t, page)
+
+		delivered, err := useCase.GetPage("example.com", "/draft", "", "", "")
+
+		assert.Nil(t, delivered)
+		assert.ErrorIs(t, err, domainErrors.ErrPageNotFound)
+	})
+}
+
+func TestDeliveryUseCase_GetPage_Redirects(t *testing.T) {
+	t.Run("redirect is used when no page matches", func(t *testing.T) {
+		useCase, repos := newTestDeliveryUseCase()
+		site := newDeliverySite(t, 1, "example.com")
+		repos.serveSite(site)
+		targetURL := "https://elsewhere.example.com/"
+		redirect, err := entities.NewRedirect(site.ID(), "/old", nil, &targetURL, entities.RedirectStatusFound)
+		assert.NoError(t, err)
+		redirect.SetID(entities.NewRedirectID(7))
+		repos.pages.On("FindByPath", "/old", site.ID()).Return(nil, nil)
+		repos.redirects.On("FindBySourcePath", site.ID(), "/old").Return(redirect, nil)
+		repos.redirects.On("RecordHit", redirect.ID()).Return(nil)
+
+		page, err := useCase.GetPage("example.com", "/old/", "", "", "")
+
+		assert.NoError(t, err)
+		assert.True(t, page.IsRedirect())
+		assert.Equal(t, entities.RedirectStatusFound, page.Redirect.StatusCode)
+		assert.Equal(t, targetURL, page.Redirect.Location)
+		repos.redirects.AssertCalled(t, "RecordHit", redirect.ID())
+	})
+
+	t.Run("redirect to a page keeps the locale prefix", func(t *testing.T) {
+		useCase, repos := newTestDeliveryUseCase()
+		site := newDeliverySite(t, 1, "example.com", "en", "de")
+		repos.serveSite(site)
+		target := newDeliveryPage(t, 2, 1, "/new", entities.PageTypeContent)
+		redirect, err := entities.NewPageRedirect(site.ID(), "/old", target.ID())
+		assert.NoError(t, err)
+		redirect.SetID(entities.NewRedirectID(7))
+		repos.pages.On("FindByPath", "/old", site.ID()).Return(nil, nil)
+		repos.pages.On("FindByID", target.ID()).Return(target, nil)
+		repos.redirects.On("FindBySourcePath", site.ID(), "/old").Return(redirect, nil)
+		repos.redirects.On("RecordHit", redirect.ID()).Return(nil)
+
+		page, err := useCase.GetPage("example.com", "/de/old", "", "", "")
+
+		assert.NoError(t, err)
+		assert.Equal(t, entities.RedirectStatusMovedPermanently, page.Redirect.StatusCode)
+		assert.Equal(t, "/de/new", page.Redirect.Location)
+	})
+
+	t.Run("redirect to a page of a disabled site is not found", func(t *testing.T) {
+		useCase, repos := newTestDeliveryUseCase()
+		site := newDeliverySite(t, 1, "example.com")
+		repos.serveSite(site)
+		targetSite := newDeliverySite(t, 2, "other.example.com")
+		targetSite.Disable()
+		repos.sites.On("FindByID", targetSite.ID()).Return(targetSite, nil)
+		target := newDeliveryPage(t, 2, 2, "/new", entities.PageTypeContent)
+		redirect, err := entities.NewPageRedirect(site.ID(), "/old", target.ID())
+		assert.NoError(t, err)
+		repos.pages.On("FindByPath", "/old", site.ID()).Return(nil, nil)
+		repos.pages.On("FindByID", target.ID()).Return(target, nil)
+		repos.redirects.On("FindBySourcePath", site.ID(), "/old").Return(redirect, nil)
+
+		page, err := useCase.GetPage("example.com", "/old", "", "", "")
+
+		assert.Nil(t, page)
+		assert.ErrorIs(t, err, domainErrors.ErrPageNotFound)
+		repos.redirects.AssertNotCalled(t, "RecordHit", mock.Anything)
+	})
+
+	t.Run("path without page or redirect is not found", func(t *testing.T) {
+		useCase, repos := newTestDeliveryUseCase()
+		site := newDeliverySite(t, 1, "example.com")
+		repos.serveSite(site)
+		repos.pages.On("FindByPath", "/missing", site.ID()).Return(nil, nil)
+		repos.redirects.On("FindBySourcePath", site.ID(), "/missing").Return(nil, nil)
+
+		page, err := useCase.GetPage("example.com", "/missing", "", "", "")
+
+		assert.Nil(t, page)
+		assert.ErrorIs(t, err, domainErrors.ErrPageNotFound)
+	})
+}
+
+func TestDeliveryUseCase_GetPage_LocaleFallback(t *testing.T) {
+	testCases := []struct {
+		name           string
+		path           string
+		locale         string
+		acceptLanguage string
+		published      []entities.Locale
+		expected       entities.Locale
+	}{
+		{name: "requested locale is delivered", path: "/about", locale: "de", published: []entities.Locale{"en", "de"}, expected: "de"},
+		{name: "requested locale falls back to the default", path: "/about", locale: "de", published: []entities.Locale{"en"}, expected: "en"},
+		{name: "locale prefix is delivered", path: "/de/about", published: []entities.Locale{"en", "de"}, expected: "de"},
+		{name: "locale prefix falls back to the default", path: "/de/about", published: []entities.Locale{"en"}, expected: "en"},
+		{name: "accept language is delivered", path: "/about", acceptLanguage: "de-AT, en;q=0.5", published: []entities.Locale{"en", "de"}, expected: "de"},
+		{name: "locale not enabled falls back to the default", path: "/about", locale: "fr", published: []entities.Locale{"en", "fr"}, expected: "en"},
+		{name: "default locale without preferences", path: "/about", published: []entities.Locale{"en", "de"}, expected: "en"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			useCase, repos := newTestDeliveryUseCase()
+			site := newDeliverySite(t, 1, "example.com", "en", "de")
+			repos.serveSite(site)
+			page := newDeliveryPage(t, 1, 1, "/about", entities.PageTypeContent)
+			repos.pages.On("FindByPath", "/about", site.ID()).Return(page, nil)
+			repos.publish(t, page, tc.published...)
+
+			delivered, err := useCase.GetPage("example.com", tc.path, tc.locale, tc.acceptLanguage, "")
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, delivered.Version.Locale())
+			assert.Equal(t, tc.expected, delivered.ContentLocale())
+		})
+	}
+
+	t.Run("page published in no locale of the chain is not found", func(t *testing.T) {
+		useCase, repos := newTestDeliveryUseCase()
+		site := newDeliverySite(t, 1, "example.com", "en", "de")
+		repos.serveSite(site)
+		page := newDeliveryPage(t, 1, 1, "/about", entities.PageTypeContent)
+		repos.pages.On("FindByPath", "/about", site.ID()).Return(page, nil)
+		repos.publish(t, page, "fr")
+
+		delivered, err := useCase.GetPage("example.com", "/about", "de", "", "")
+
+		assert.Nil(t, delivered)
+		assert.ErrorIs(t, err, domainErrors.ErrPageNotFound)
+	})
+}
+
+func TestNegotiateLocale(t *testing.T) {
+	testCases := []struct {
+		name           string
+		path           string
+		requested      string
+		acceptLanguage string
+		expected       deliveryLocale
+	}{
+		{name: "locale prefix", path: "/de/about", expected: deliveryLocale{Locale: "de", Path: "/about", Prefixed: true}},
+		{name: "locale prefix of the root", path: "/de", expected: deliveryLocale{Locale: "de", Path: "/", Prefixed: true}},
+		{name: "locale prefix wins over the requested locale", path: "/de/about", requested: "en", expected: deliveryLocale{Locale: "de", Path: "/about", Prefixed: true}},
+		{name: "segment that is not an enabled locale", path: "/fr/about", expected: deliveryLocale{Locale: "en", Path: "/fr/about"}},
+		{name: "requested locale", path: "/about", requested: "de", acceptLanguage: "en", expected: deliveryLocale{Locale: "de", Path: "/about"}},
+		{name: "requested region matches its language", path: "/about", requested: "de-CH", expected: deliveryLocale{Locale: "de", Path: "/about"}},
+		{name: "requested locale that is not enabled is kept", path: "/about", requested: "fr", expected: deliveryLocale{Locale: "fr", Path: "/about"}},
+		{name: "most preferred accept language", path: "/about", acceptLanguage: "fr;q=0.9, de;q=0.8, en;q=0.1", expected: deliveryLocale{Locale: "de", Path: "/about"}},
+		{name: "accept language without an enabled locale", path: "/about", acceptLanguage: "fr, nl", expected: deliveryLocale{Locale: "en", Path: "/about"}},
+		{name: "default locale", path: "about/", expected: deliveryLocale{Locale: "en", Path: "/about"}},
+	}
+
+	site := newDeliverySite(t, 1, "example.com", "en", "de")
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			negotiated, err := negotiateLocale(site, tc.path, tc.requested, tc.acceptLanguage)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, negotiated)
+		})
+	}
+
+	t.Run("invalid requested locale", func(t *testing.T) {
+		_, err := negotiateLocale(site, "/about", "not a locale", "")
+
+		assert.Error(t, err)
+	})
+}
+
+// publishAt registers a version of the page published in every locale at the given time
+func (r deliveryTestRepos) publishAt(t *testing.T, page *entities.Page, publishedAt time.Time) *entities.PageVersion {
+	version, err := entities.NewPageVersion(page.ID(), 1, entities.DefaultLocale, fmt.Sprintf("Page %d", page.ID().Value()), nil)
+	assert.NoError(t, err)
+	version.SetID(entities.NewPageVersionID(page.ID().Value()))
+	version.SetStatus(entities.PageVersionStatusPublished, nil, &publishedAt)
+	version.SetTimestamps(publishedAt, publishedAt)
+	r.versions.On("FindPublishedByPageID", page.ID(), mock.Anything).Return(version, nil)
+	return version
+}
+
+// newFeedRoot creates a published feed root page of the site with the given children
+func (r deliveryTestRepos) newFeedRoot(t *testing.T, site *entities.Site, children ...*entities.Page) *entities.Page {
+	root := newDeliveryPage(t, 1, site.ID().Value(), "/news", entities.PageTypeContent)
+	assert.NoError(t, root.SetFeedRoot(true))
+	r.pages.On("FindByPath", "/news", site.ID()).Return(root, nil)
+	r.pages.On("FindChildrenByParentID", root.ID()).Return(children, nil)
+	r.publishAt(t, root, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	return root
+}
+
+func TestDeliveryUseCase_GetFeed(t *testing.T) {
+	base := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("lists published content children newest first", func(t *testing.T) {
+		useCase, repos := newTestDeliveryUseCase()
+		site := newDeliverySite(t, 1, "example.com")
+		repos.serveSite(site)
+		older := newDeliveryPage(t, 2, 1, "/news/older", entities.PageTypeContent)
+		newest := newDeliveryPage(t, 3, 1, "/news/newest", entities.PageTypeContent)
+		middle := newDeliveryPage(t, 4, 1, "/news/middle", entities.PageTypeContent)
+		draft := newDeliveryPage(t, 5, 1, "/news/draft", entities.PageTypeContent)
+		snippet := newDeliveryPage(t, 6, 1, "/news/snippet", entities.PageTypeSnippet)
+		repos.newFeedRoot(t, site, older, newest, middle, draft, snippet)
+		repos.publishAt(t, older, base)
+		repos.publishAt(t, newest, base.Add(2*time.Hour))
+		repos.publishAt(t, middle, base.Add(time.Hour))
+		repos.versions.On("FindPublishedByPageID", draft.ID(), mock.Anything).Return(nil, nil)
+
+		feed, err := useCase.GetFeed("example.com", "/news", "", "")
+
+		assert.NoError(t, err)
+		assert.Len(t, feed.Items, 3)
+		ids := make([]uint64, 0, len(feed.Items))
+		for _, item := range feed.Items {
+			ids = append(ids, item.Page.ID().Value())
+		}
+		assert.Equal(t, []uint64{3, 4, 2}, ids)
+		assert.True(t, base.Add(2*time.Hour).Equal(feed.UpdatedAt()))
+		repos.versions.AssertNotCalled(t, "FindPublishedByPageID", snippet.ID(), mock.Anything)
+	})
+
+	t.Run("keeps the most recent items up to the limit", func(t *testing.T) {
+		useCase, repos := newTestDeliveryUseCase()
+		site := newDeliverySite(t, 1, "example.com")
+		repos.serveSite(site)
+		children := make([]*entities.Page, 0, entities.FeedMaxItems+5)
+		for i := 0; i < entities.FeedMaxItems+5; i++ {
+			child := newDeliveryPage(t, uint64(i+2), 1, fmt.Sprintf("/news/item-%d", i), entities.PageTypeContent)
+			repos.publishAt(t, child, base.Add(time.Duration(i)*time.Minute))
+			children = append(children, child)
+		}
+		repos.newFeedRoot(t, site, children...)
+
+		feed, err := useCase.GetFeed("example.com", "/news", "", "")
+
+		assert.NoError(t, err)
+		assert.Len(t, feed.Items, entities.FeedMaxItems)
+		assert.Equal(t, children[len(children)-1].ID(), feed.Items[0].Page.ID())
+		assert.Equal(t, children[5].ID(), feed.Items[entities.FeedMaxItems-1].Page.ID())
+	})
+
+	t.Run("page that is no feed root is not found", func(t *testing.T) {
+		useCase, repos := newTestDeliveryUseCase()
+		site := newDeliverySite(t, 1, "example.com")
+		repos.serveSite(site)
+		page := newDeliveryPage(t, 1, 1, "/about", entities.PageTypeContent)
+		repos.pages.On("FindByPath", "/about", site.ID()).Return(page, nil)
+
+		feed, err := useCase.GetFeed("example.com", "/about", "", "")
+
+		assert.Nil(t, feed)
+		assert.ErrorIs(t, err, domainErrors.ErrFeedNotFound)
+	})
+
+	t.Run("unpublished feed root is not found", func(t *testing.T) {
+		useCase, repos := newTestDeliveryUseCase()
+		site := newDeliverySite(t, 1, "example.com")
+		repos.serveSite(site)
+		root := newDeliveryPage(t, 1, 1, "/news", entities.PageTypeContent)
+		assert.NoError(t, root.SetFeedRoot(true))
+		repos.pages.On("FindByPath", "/news", site.ID()).Return(root, nil)
+		repos.versions.On("FindPublishedByPageID", root.ID(), mock.Anything).Return(nil, nil)
+
+		feed, err := useCase.GetFeed("example.com", "/news", "", "")
+
+		assert.Nil(t, feed)
+		assert.ErrorIs(t, err, domainErrors.ErrFeedNotFound)
+		repos.pages.AssertNotCalled(t, "FindChildrenByParentID", mock.Anything)
+	})
+}
+
+func TestDeliveryUseCase_GetSitemap(t *testing.T) {
+	lastModified := time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("small site is a single file with localized paths", func(t *testing.T) {
+		useCase, repos := newTestDeliveryUseCase()
+		site := newDeliverySite(t, 1, "example.com", "en", "de")
+		repos.serveSite(site)
+		repos.versions.On("FindSitemapParts", site.ID(), site.Locales(), uint64(entities.SitemapMaxURLs)).Return([]entities.SitemapPartSummary{
+			{Number: 1, URLCount: 3, LastModified: lastModified},
+		}, nil)
+		repos.versions.On("FindSitemapPages", site.ID(), site.Locales(), uint64(0), uint64(entities.SitemapMaxURLs)).Return([]entities.SitemapPage{
+			{Path: "/", Locale: "de", LastModified: lastModified},
+			{Path: "/about", Locale: "de", LastModified: lastModified},
+			{Path: "/about", Locale: "en", LastModified: lastModified},
+		}, nil)
+
+		sitemap, err := useCase.GetSitemap("example.com")
+
+		assert.NoError(t, err)
+		assert.False(t, sitemap.IsIndex())
+		assert.Equal(t, []entities.SitemapURL{
+			{Path: "/de", LastModified: lastModified},
+			{Path: "/de/about", LastModified: lastModified},
+			{Path: "/about", LastModified: lastModified},
+		}, sitemap.URLs)
+		repos.versions.AssertNumberOfCalls(t, "FindSitemapPages", 1)
+	})
+
+	t.Run("site without pages is an empty file", func(t *testing.T) {
+		useCase, repos := newTestDeliveryUseCase()
+		site := newDeliverySite(t, 1, "example.com")
+		repos.serveSite(site)
+		repos.versions.On("FindSitemapParts", site.ID(), site.Locales(), uint64(entities.SitemapMaxURLs)).Return([]entities.SitemapPartSummary{}, nil)
+		repos.versions.On("FindSitemapPages", site.ID(), site.Locales(), uint64(0), uint64(entities.SitemapMaxURLs)).Return([]entities.SitemapPage{}, nil)
+
+		sitemap, err := useCase.GetSitemap("example.com")
+
+		assert.NoError(t, err)
+		assert.False(t, sitemap.IsIndex())
+		assert.Empty(t, sitemap.URLs)
+	})
+
+	t.Run("large site is an index without loading its pages", func(t *testing.T) {
+		useCase, repos := newTestDeliveryUseCase()
+		site := newDeliverySite(t, 1, "example.com")
+		repos.serveSite(site)
+		parts := []entities.SitemapPartSummary{
+			{Number: 1, URLCount: entities.SitemapMaxURLs, LastModified: lastModified},
+			{Number: 2, URLCount: 1, LastModified: lastModified.Add(time.Hour)},
+		}
+		repos.versions.On("FindSitemapParts", site.ID(), site.Locales(), uint64(entities.SitemapMaxURLs)).Return(parts, nil)
+
+		sitemap, err := useCase.GetSitemap("example.com")
+
+		assert.NoError(t, err)
+		assert.True(t, sitemap.IsIndex())
+		assert.Equal(t, parts, sitemap.Parts)
+		assert.Empty(t, sitemap.URLs)
+		repos.versions.AssertNotCalled(t, "FindSitemapPages", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestDeliveryUseCase_GetSitemapPart(t *testing.T) {
+	lastModified := time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("loads only the pages of the part", func(t *testing.T) {
+		useCase, repos := newTestDeliveryUseCase()
+		site := newDeliverySite(t, 1, "example.com", "en", "de")
+		repos.serveSite(site)
+		repos.versions.On("FindSitemapPages", site.ID(), site.Locales(), uint64(entities.SitemapMaxURLs), uint64(entities.SitemapMaxURLs)).Return([]entities.SitemapPage{
+			{Path: "/zoo", Locale: "de", LastModified: lastModified},
+		}, nil)
+
+		part, err := useCase.GetSitemapPart("example.com", 2)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, part.Number)
+		assert.Same(t, site, part.Site)
+		assert.Equal(t, []entities.SitemapURL{{Path: "/de/zoo", LastModified: lastModified}}, part.URLs)
+		repos.versions.AssertNumberOfCalls(t, "FindSitemapPages", 1)
+	})
+
+	t.Run("first part of an empty sitemap is empty", func(t *testing.T) {
+		useCase, repos := newTestDeliveryUseCase()
+		site := newDeliverySite(t, 1, "example.com")
+		repos.serveSite(site)
+		repos.versions.On("FindSitemapPages", site.ID(), site.Locales(), uint64(0), uint64(entities.SitemapMaxURLs)).Return([]entities.SitemapPage{}, nil)
+
+		part, err := useCase.GetSitemapPart("example.com", 1)
+
+		assert.NoError(t, err)
+		assert.Empty(t, part.URLs)
+	})
+
+	t.Run("part beyond the end is not found", func(t *testing.T) {
+		useCase, repos := newTestDeliveryUseCase()
+		site := newDeliverySite(t, 1, "example.com")
+		repos.serveSite(site)
+		repos.versions.On("FindSitemapPages", site.ID(), site.Locales(), uint64(2*entities.SitemapMaxURLs), uint64(entities.SitemapMaxURLs)).Return([]entities.SitemapPage{}, nil)
+
+		part, err := useCase.GetSitemapPart("example.com", 3)
+
+		assert.Nil(t, part)
+		assert.ErrorIs(t, err, domainErrors.ErrSitemapNotFound)
+	})
+
+	t.Run("part zero is not found without a lookup", func(t *testing.T) {
+		useCase, repos := newTestDeliveryUseCase()
+
+		part, err := useCase.GetSitemapPart("example.com", 0)
+
+		assert.Nil(t, part)
+		assert.ErrorIs(t, err, domainErrors.ErrSitemapNotFound)
+		repos.sites.AssertNotCalled(t, "FindByDomain", mock.Anything)
+	})
+}
+
+func TestDeliveryUseCase_Search(t *testing.T) {
+	dutch, french := entities.Locale("nl-BE"), entities.Locale("fr")
+
+	tests := []struct {
+		name           string
+		locale         string
+		acceptLanguage string
+		text           string
+		page, perPage  int
+		want           entities.SearchQuery
+	}{
+		{
+			name:    "default locale",
+			text:    " news ",
+			page:    2,
+			perPage: 5,
+			want:    entities.SearchQuery{SiteID: entities.NewSiteID(1), Locales: []entities.Locale{entities.DefaultLocale}, Text: "news", Page: 2, PerPage: 5},
+		},
+		{
+			name:    "requested locale falls back to the default",
+			locale:  "nl-BE",
+			text:    "nieuws",
+			page:    1,
+			perPage: 10,
+			want:    entities.SearchQuery{SiteID: entities.NewSiteID(1), Locales: []entities.Locale{dutch, entities.DefaultLocale}, Text: "nieuws", Page: 1, PerPage: 10},
+		},
+		{
+			name:           "Accept-Language",
+			acceptLanguage: "fr;q=0.9, de;q=0.5",
+			text:           "nouvelles",
+			want:           entities.SearchQuery{SiteID: entities.NewSiteID(1), Locales: []entities.Locale{french, entities.DefaultLocale}, Text: "nouvelles", Page: 1, PerPage: defaultSearchPageSize},
+		},
+		{
+			name:    "page size is capped",
+			text:    "news",
+			page:    1,
+			perPage: 500,
+			want:    entities.SearchQuery{SiteID: entities.NewSiteID(1), Locales: []entities.Locale{entities.DefaultLocale}, Text: "news", Page: 1, PerPage: maxSearchPageSize},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			useCase, repos := newTestDeliveryUseCase()
+			repos.serveSite(newDeliverySite(t, 1, "example.com", entities.DefaultLocale, dutch, french))
+			result := &entities.SearchResult{Query: tt.want, Hits: []entities.SearchHit{}}
+			repos.search.On("Search", tt.want).Return(result, nil)
+
+			got, err := useCase.Search("example.com", tt.text, tt.locale, tt.acceptLanguage, tt.page, tt.perPage)
+
+			assert.NoError(t, err)
+			assert.Same(t, result, got)
+			repos.search.AssertExpectations(t)
+		})
+	}
+
+	t.Run("rejects invalid locales", func(t *testing.T) {
+		useCase, repos := newTestDeliveryUseCase()
+		repos.serveSite(newDeliverySite(t, 1, "example.com", entities.DefaultLocale))
+
+		_, err := useCase.Search("example.com", "news", "not a locale", "", 1, 10)
+
+		assert.ErrorIs(t, err, domainErrors.ErrLocaleInvalid)
+		repos.search.AssertNotCalled(t, "Search", mock.Anything)
+	})
+}
@@ -0,0 +1,347 @@
+package use_cases
+
+import (
+	"github.com/h4rdc0m/aurora-api/domain/common"
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"github.com/h4rdc0m/aurora-api/domain/repositories"
+	"github.com/h4rdc0m/aurora-api/domain/services"
+	"github.com/h4rdc0m/aurora-api/utils/links"
+	"github.com/jmoiron/sqlx"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Reasons reported for broken internal links
+const (
+	linkReasonInvalidURL   = "invalid URL"
+	linkReasonPageNotFound = "page not found"
+	linkReasonUnpublished  = "page is not published"
+	linkReasonGone         = "page has been removed"
+)
+
+// LinkCheckUseCase scans the published content of sites for broken links and keeps a link report per site
+type LinkCheckUseCase struct {
+	siteRepo        repositories.SiteRepository
+	pageRepo        repositories.PageRepository
+	pageVersionRepo repositories.PageVersionRepository
+	pageBlockRepo   repositories.PageBlockRepository
+	redirectRepo    repositories.RedirectRepository
+	brokenLinkRepo  repositories.BrokenLinkRepository
+	linkChecker     services.LinkChecker
+	logger          common.Logger
+}
+
+// NewLinkCheckUseCase creates a new LinkCheckUseCase
+func NewLinkCheckUseCase(
+	siteRepo repositories.SiteRepository,
+	pageRepo repositories.PageRepository,
+	pageVersionRepo repositories.PageVersionRepository,
+	pageBlockRepo repositories.PageBlockRepository,
+	redirectRepo repositories.RedirectRepository,
+	brokenLinkRepo repositories.BrokenLinkRepository,
+	linkChecker services.LinkChecker,
+	logger common.Logger,
+) *LinkCheckUseCase {
+	return &LinkCheckUseCase{
+		siteRepo:        siteRepo,
+		pageRepo:        pageRepo,
+		pageVersionRepo: pageVersionRepo,
+		pageBlockRepo:   pageBlockRepo,
+		redirectRepo:    redirectRepo,
+		brokenLinkRepo:  brokenLinkRepo,
+		linkChecker:     linkChecker,
+		logger:          logger,
+	}
+}
+
+// WithTrx returns a copy of the use case that runs all repository calls inside the given transaction
+func (u *LinkCheckUseCase) WithTrx(trxHandle *sqlx.Tx) *LinkCheckUseCase {
+	return &LinkCheckUseCase{
+		siteRepo:        u.siteRepo.WithTrx(trxHandle),
+		pageRepo:        u.pageRepo.WithTrx(trxHandle),
+		pageVersionRepo: u.pageVersionRepo.WithTrx(trxHandle),
+		pageBlockRepo:   u.pageBlockRepo.WithTrx(trxHandle),
+		redirectRepo:    u.redirectRepo.WithTrx(trxHandle),
+		brokenLinkRepo:  u.brokenLinkRepo.WithTrx(trxHandle),
+		linkChecker:     u.linkChecker,
+		logger:          u.logger,
+	}
+}
+
+// GetReport retrieves the broken links of a site, the longest broken first
+func (u *LinkCheckUseCase) GetReport(tenantID, siteID uint64) ([]*entities.BrokenLink, error) {
+	site, err := findTenantSite(u.siteRepo, u.logger, tenantID, siteID)
+	if err != nil {
+		return nil, err
+	}
+
+	brokenLinks, err := u.brokenLinkRepo.FindBySiteID(site.ID())
+	if err != nil {
+		u.logger.Error("Failed to get broken links", "siteID", siteID, "error", err)
+		return nil, err
+	}
+	return brokenLinks, nil
+}
+
+// GetSitesToCheck retrieves the enabled sites of all tenants
+func (u *LinkCheckUseCase) GetSitesToCheck() ([]*entities.Site, error) {
+	sites, err := u.siteRepo.FindAll()
+	if err != nil {
+		u.logger.Error("Failed to get sites", "error", err)
+		return nil, err
+	}
+
+	enabled := make([]*entities.Site, 0, len(sites))
+	for _, site := range sites {
+		if site.IsEnabled() {
+			enabled = append(enabled, site)
+		}
+	}
+	return enabled, nil
+}
+
+// CheckSite checks the URLs of the link pages of the site and the links in the HTML and Markdown blocks of the
+// published versions of its pages in every locale, and returns the broken ones. Internal links are checked against the page tree
+// and the redirects of the site; external links are requested through the link checker, once per URL.
+func (u *LinkCheckUseCase) CheckSite(site *entities.Site) ([]entities.LinkFinding, error) {
+	scan, err := u.newSiteScan(site)
+	if err != nil {
+		return nil, err
+	}
+
+	findings := make([]entities.LinkFinding, 0)
+	// A link in a block of the same key is reported once, even when several locales contain it
+	reported := make(map[string]bool)
+	report := func(page *entities.Page, blockKey, link string) {
+		// Links too long to be stored in the report are not checked
+		if len(link) > entities.MaxLinkURLLength {
+			return
+		}
+		key := brokenLinkKey(page.ID(), blockKey, link)
+		if reported[key] {
+			return
+		}
+		if check := scan.check(link, page); check.Broken {
+			reported[key] = true
+			findings = append(findings, entities.LinkFinding{PageID: page.ID(), BlockKey: blockKey, Check: check})
+		}
+	}
+
+	for _, page := range scan.pages {
+		switch page.Type() {
+		case entities.PageTypeLink:
+			if page.LinkURL() != nil {
+				report(page, "", *page.LinkURL())
+			}
+		case entities.PageTypeContent, entities.PageTypeSnippet:
+			for _, version := range scan.published[page.ID().Value()] {
+				blocks, err := u.pageBlockRepo.FindByPageVersionID(version.ID())
+				if err != nil {
+					u.logger.Error("Failed to get page blocks", "pageVersionID", version.ID().Value(), "error", err)
+					return nil, err
+				}
+				for _, block := range blocks {
+					for _, link := range blockLinks(block) {
+						report(page, block.BlockKey(), link)
+					}
+				}
+			}
+		}
+	}
+
+	return findings, nil
+}
+
+// SaveReport stores the broken links found by a check of the site at checkedAt. Links that were already reported
+// keep their first seen time; reported links that were not found again are removed from the report.
+func (u *LinkCheckUseCase) SaveReport(siteID entities.SiteID, findings []entities.LinkFinding, checkedAt time.Time) error {
+	existing, err := u.brokenLinkRepo.FindBySiteID(siteID)
+	if err != nil {
+		u.logger.Error("Failed to get broken links", "siteID", siteID.Value(), "error", err)
+		return err
+	}
+	reported := make(map[string]*entities.BrokenLink, len(existing))
+	for _, link := range existing {
+		reported[brokenLinkKey(link.PageID(), link.BlockKey(), link.URL())] = link
+	}
+
+	for _, finding := range findings {
+		link, ok := reported[brokenLinkKey(finding.PageID, finding.BlockKey, finding.Check.URL)]
+		if ok {
+			link.Recheck(finding.Check, checkedAt)
+		} else {
+			link = entities.NewBrokenLink(siteID, finding.PageID, finding.BlockKey, finding.Check, checkedAt)
+		}
+		if err := u.brokenLinkRepo.Save(link); err != nil {
+			u.logger.Error("Failed to save broken link", "siteID", siteID.Value(), "url", finding.Check.URL, "error", err)
+			return err
+		}
+	}
+
+	if err := u.brokenLinkRepo.DeleteCheckedBefore(siteID, checkedAt); err != nil {
+		u.logger.Error("Failed to remove fixed broken links", "siteID", siteID.Value(), "error", err)
+		return err
+	}
+	return nil
+}
+
+// siteScan holds what a check of a site resolves internal links against, and the external links checked so far
+type siteScan struct {
+	site        *entities.Site
+	pages       []*entities.Page
+	byPath      map[string]*entities.Page
+	hasHome     bool
+	published   map[uint64][]*entities.PageVersion
+	redirects   map[string]*entities.Redirect
+	linkChecker services.LinkChecker
+	external    map[string]entities.LinkCheck
+}
+
+// newSiteScan loads the pages, published versions and redirects of the site
+func (u *LinkCheckUseCase) newSiteScan(site *entities.Site) (*siteScan, error) {
+	pages, err := u.pageRepo.FindBySiteID(site.ID())
+	if err != nil {
+		u.logger.Error("Failed to get pages", "siteID", site.ID().Value(), "error", err)
+		return nil, err
+	}
+
+	versions, err := u.pageVersionRepo.FindPublishedBySiteID(site.ID())
+	if err != nil {
+		u.logger.Error("Failed to get published page versions", "siteID", site.ID().Value(), "error", err)
+		return nil, err
+	}
+
+	redirects, err := u.redirectRepo.FindBySiteID(site.ID())
+	if err != nil {
+		u.logger.Error("Failed to get redirects", "siteID", site.ID().Value(), "error", err)
+		return nil, err
+	}
+
+	scan := &siteScan{
+		site:        site,
+		pages:       pages,
+		byPath:      make(map[string]*entities.Page, len(pages)),
+		published:   make(map[uint64][]*entities.PageVersion, len(versions)),
+		redirects:   make(map[string]*entities.Redirect, len(redirects)),
+		linkChecker: u.linkChecker,
+		external:    make(map[string]entities.LinkCheck),
+	}
+	for _, page := range pages {
+		scan.byPath[page.FullPath()] = page
+		if page.ParentID() == nil && page.Type() != entities.PageTypeSnippet {
+			scan.hasHome = true
+		}
+	}
+	for _, version := range versions {
+		scan.published[version.PageID().Value()] = append(scan.published[version.PageID().Value()], version)
+	}
+	for _, redirect := range redirects {
+		scan.redirects[redirect.SourcePath()] = redirect
+	}
+
+	return scan, nil
+}
+
+// check checks a link found on the page. Relative links are resolved against the path of the page, and absolute
+// URLs on the domain of the site are checked as internal links. Links with schemes other than http(s) are skipped.
+func (s *siteScan) check(link string, page *entities.Page) entities.LinkCheck {
+	parsed, err := url.Parse(link)
+	if err != nil {
+		return entities.LinkCheck{URL: link, Broken: true, Reason: linkReasonInvalidURL}
+	}
+
+	switch {
+	case parsed.Scheme == "" && parsed.Host == "":
+		base := &url.URL{Path: page.FullPath()}
+		return s.checkInternal(link, base.ResolveReference(parsed).Path)
+	case parsed.Scheme != "" && parsed.Scheme != "http" && parsed.Scheme != "https":
+		return entities.LinkCheck{URL: link}
+	case strings.EqualFold(parsed.Hostname(), s.site.Domain().Value()):
+		return s.checkInternal(link, parsed.Path)
+	}
+
+	if parsed.Scheme == "" {
+		// Protocol relative links are requested like the site itself is served
+		parsed.Scheme = "https"
+	}
+	parsed.Fragment = ""
+	target := parsed.String()
+	check, ok := s.external[target]
+	if !ok {
+		check = s.linkChecker.Check(target)
+		s.external[target] = check
+	}
+	check.URL = link
+	return check
+}
+
+// checkInternal checks that a path of the site delivers a page or is redirected. A locale prefix of the path
+// selects the locale chain the page must be published in.
+func (s *siteScan) checkInternal(link, pagePath string) entities.LinkCheck {
+	check := entities.LinkCheck{URL: link}
+
+	negotiated, err := negotiateLocale(s.site, pagePath, "", "")
+	if err != nil {
+		check.Broken, check.Reason = true, linkReasonInvalidURL
+		return check
+	}
+	pagePath = negotiated.Path
+	if pagePath == "/" {
+		if !s.hasHome {
+			check.Broken, check.Reason = true, linkReasonPageNotFound
+		}
+		return check
+	}
+
+	if page, ok := s.byPath[pagePath]; ok && page.Type() != entities.PageTypeSnippet {
+		if page.Type() == entities.PageTypeContent && !s.isPublished(page, s.site.LocaleChain(negotiated.Locale)) {
+			check.Broken, check.Reason = true, linkReasonUnpublished
+		}
+		return check
+	}
+
+	redirect, ok := s.redirects[pagePath]
+	switch {
+	case !ok:
+		check.Broken, check.Reason = true, linkReasonPageNotFound
+	case redirect.IsGone():
+		check.Broken, check.Reason = true, linkReasonGone
+	}
+	return check
+}
+
+// isPublished reports whether the page has a version published in one of the locales of the chain
+func (s *siteScan) isPublished(page *entities.Page, chain []entities.Locale) bool {
+	for _, version := range s.published[page.ID().Value()] {
+		for _, locale := range chain {
+			if version.Locale() == locale {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// blockLinks returns the links in the content of HTML and Markdown blocks
+func blockLinks(block *entities.PageBlock) []string {
+	contentType := strings.ToLower(strings.TrimSpace(block.ContentType()))
+	if mediaType, _, found := strings.Cut(contentType, ";"); found {
+		contentType = strings.TrimSpace(mediaType)
+	}
+
+	switch contentType {
+	case "html", "richtext", "text/html":
+		return links.FromHTML(block.Content())
+	case "markdown", "text/markdown":
+		return links.FromMarkdown(block.Content())
+	default:
+		return nil
+	}
+}
+
+// brokenLinkKey identifies a link on a page across checks
+func brokenLinkKey(pageID entities.PageID, blockKey, link string) string {
+	return strconv.FormatUint(pageID.Value(), 10) + "\x00" + blockKey + "\x00" + link
+}
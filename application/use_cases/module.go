@@ -3,8 +3,18 @@ package use_cases
 import "go.uber.org/fx"
 
 var Module = fx.Options(
+	fx.Provide(NewAuditUseCase),
 	fx.Provide(NewAuthUseCase),
+	fx.Provide(NewContentTypeUseCase),
+	fx.Provide(NewDeliveryUseCase),
 	fx.Provide(NewHealthUseCase),
+	fx.Provide(NewLinkCheckUseCase),
+	fx.Provide(NewPageUseCase),
+	fx.Provide(NewPageVersionUseCase),
+	fx.Provide(NewPreviewTokenUseCase),
+	fx.Provide(NewRedirectUseCase),
 	fx.Provide(NewSiteUseCase),
 	fx.Provide(NewTenantUseCase),
+	fx.Provide(NewTrashUseCase),
+	fx.Provide(NewUserUseCase),
 )
@@ -0,0 +1,1113 @@
+package use_cases
+
+import (
+	"fmt"
+	"github.com/h4rdc0m/aurora-api/application/dto"
+	"github.com/h4rdc0m/aurora-api/domain/common"
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"github.com/h4rdc0m/aurora-api/domain/errors"
+	"github.com/h4rdc0m/aurora-api/domain/repositories"
+	"github.com/h4rdc0m/aurora-api/domain/services"
+	"github.com/h4rdc0m/aurora-api/domain/value_objects"
+	"github.com/jmoiron/sqlx"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	duplicateLatestVersions    = "latest"
+	duplicatePublishedVersions = "published"
+	// maxDuplicateKeyAttempts bounds the number of suffixes tried for the key of a duplicated page
+	maxDuplicateKeyAttempts = 100
+	// maxPageKeyLength mirrors the length limit of value_objects.PageKey
+	maxPageKeyLength = 100
+)
+
+// PageUseCase handles the page tree of a site together with the page versions and blocks
+type PageUseCase struct {
+	pageRepo         repositories.PageRepository
+	pageVersionRepo  repositories.PageVersionRepository
+	pageBlockRepo    repositories.PageBlockRepository
+	siteRepo         repositories.SiteRepository
+	redirectRepo     repositories.RedirectRepository
+	pageResolver     services.PageResolver
+	snippetService   services.SnippetService
+	contentValidator services.ContentValidator
+	searchIndexer    services.SearchIndexer
+	logger           common.Logger
+}
+
+// NewPageUseCase creates a new PageUseCase
+func NewPageUseCase(
+	pageRepo repositories.PageRepository,
+	pageVersionRepo repositories.PageVersionRepository,
+	pageBlockRepo repositories.PageBlockRepository,
+	siteRepo repositories.SiteRepository,
+	redirectRepo repositories.RedirectRepository,
+	pageResolver services.PageResolver,
+	snippetService services.SnippetService,
+	contentValidator services.ContentValidator,
+	searchIndexer services.SearchIndexer,
+	logger common.Logger,
+) *PageUseCase {
+	return &PageUseCase{
+		pageRepo:         pageRepo,
+		pageVersionRepo:  pageVersionRepo,
+		pageBlockRepo:    pageBlockRepo,
+		siteRepo:         siteRepo,
+		redirectRepo:     redirectRepo,
+		pageResolver:     pageResolver,
+		snippetService:   snippetService,
+		contentValidator: contentValidator,
+		searchIndexer:    searchIndexer,
+		logger:           logger,
+	}
+}
+
+// WithTrx returns a copy of the use case that runs all repository calls inside the given transaction
+func (u *PageUseCase) WithTrx(trxHandle *sqlx.Tx) *PageUseCase {
+	return &PageUseCase{
+		pageRepo:         u.pageRepo.WithTrx(trxHandle),
+		pageVersionRepo:  u.pageVersionRepo.WithTrx(trxHandle),
+		pageBlockRepo:    u.pageBlockRepo.WithTrx(trxHandle),
+		siteRepo:         u.siteRepo.WithTrx(trxHandle),
+		redirectRepo:     u.redirectRepo.WithTrx(trxHandle),
+		pageResolver:     u.pageResolver.WithTrx(trxHandle),
+		snippetService:   u.snippetService.WithTrx(trxHandle),
+		contentValidator: u.contentValidator.WithTrx(trxHandle),
+		searchIndexer:    u.searchIndexer.WithTrx(trxHandle),
+		logger:           u.logger,
+	}
+}
+
+// GetPageTree retrieves all pages of a site as a nested tree of root pages
+func (u *PageUseCase) GetPageTree(tenantID, siteID uint64) ([]*entities.Page, error) {
+	site, err := u.findSite(tenantID, siteID)
+	if err != nil {
+		return nil, err
+	}
+
+	pages, err := u.pageRepo.FindBySiteID(site.ID())
+	if err != nil {
+		u.logger.Error("Failed to get pages for site", "siteID", siteID, "error", err)
+		return nil, err
+	}
+
+	return buildPageTree(pages), nil
+}
+
+// GetPage retrieves a single page of a site including its versions
+func (u *PageUseCase) GetPage(tenantID, siteID, pageID uint64) (*entities.Page, error) {
+	site, err := u.findSite(tenantID, siteID)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := u.findPage(site, pageID)
+	if err != nil {
+		return nil, err
+	}
+
+	versions, err := u.pageVersionRepo.FindByPageID(page.ID())
+	if err != nil {
+		u.logger.Error("Failed to get page versions", "pageID", pageID, "error", err)
+		return nil, err
+	}
+	for _, version := range versions {
+		if err := page.AddVersion(version); err != nil {
+			return nil, err
+		}
+	}
+
+	return page, nil
+}
+
+// CreatePage creates a new page in a site, optionally with an initial draft version
+func (u *PageUseCase) CreatePage(tenantID, siteID uint64, req dto.CreatePageRequest) (*entities.Page, error) {
+	site, err := u.findSite(tenantID, siteID)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := value_objects.NewPageKey(req.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	pageType, err := entities.NewPageType(req.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := entities.NewPage(key, nil, site.ID(), pageType)
+	if err != nil {
+		return nil, err
+	}
+
+	var parent *entities.Page
+	if req.ParentID != nil {
+		parent, err = u.findParent(site, *req.ParentID)
+		if err != nil {
+			return nil, err
+		}
+		parentID := parent.ID()
+		page.SetParent(&parentID)
+	}
+	page.BuildPath(parent)
+
+	if err := u.applyTarget(page, req.LinkURL, req.HardLinkPageID); err != nil {
+		return nil, err
+	}
+	if err := page.SetFeedRoot(req.FeedRoot); err != nil {
+		return nil, err
+	}
+
+	if err := u.ensurePathAvailable(page); err != nil {
+		return nil, err
+	}
+
+	siblings, err := u.findSiblings(site.ID(), page.ParentID())
+	if err != nil {
+		return nil, err
+	}
+	page.UpdateIndex(len(siblings))
+
+	if err := u.pageRepo.Save(page); err != nil {
+		u.logger.Error("Failed to save page", "siteID", siteID, "key", req.Key, "error", err)
+		return nil, err
+	}
+
+	if req.Title != "" {
+		version, err := entities.NewPageVersion(page.ID(), 1, site.DefaultLocale(), req.Title, req.Description)
+		if err != nil {
+			return nil, err
+		}
+		if err := u.pageVersionRepo.Save(version); err != nil {
+			u.logger.Error("Failed to save initial page version", "pageID", page.ID().Value(), "error", err)
+			return nil, err
+		}
+		if err := page.AddVersion(version); err != nil {
+			return nil, err
+		}
+	}
+
+	return page, nil
+}
+
+// UpdatePage updates the key, type, link target and feed root mark of the given revision of a page
+func (u *PageUseCase) UpdatePage(tenantID, siteID, pageID, revision uint64, req dto.UpdatePageRequest) (*entities.Page, error) {
+	site, err := u.findSite(tenantID, siteID)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := u.findPage(site, pageID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkRevision(page.Revision(), revision); err != nil {
+		return nil, err
+	}
+
+	key, err := value_objects.NewPageKey(req.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	pageType, err := entities.NewPageType(req.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	oldPath := page.FullPath()
+	keyChanged := !page.Key().Equals(*key)
+	if keyChanged {
+		if err := page.UpdateKey(key); err != nil {
+			return nil, err
+		}
+
+		var parent *entities.Page
+		if page.ParentID() != nil {
+			parent, err = u.findParent(site, page.ParentID().Value())
+			if err != nil {
+				return nil, err
+			}
+		}
+		page.BuildPath(parent)
+
+		if err := u.ensurePathAvailable(page); err != nil {
+			return nil, err
+		}
+	}
+
+	if page.Type() == entities.PageTypeSnippet && pageType != entities.PageTypeSnippet {
+		if err := u.ensureSnippetsUnused([]*entities.Page{page}); err != nil {
+			return nil, err
+		}
+	}
+
+	page.UpdateType(pageType)
+	if err := u.applyTarget(page, req.LinkURL, req.HardLinkPageID); err != nil {
+		return nil, err
+	}
+	if req.FeedRoot != nil {
+		if err := page.SetFeedRoot(*req.FeedRoot); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := u.pageRepo.Save(page); err != nil {
+		u.logger.Error("Failed to save updated page", "pageID", pageID, "error", err)
+		return nil, err
+	}
+
+	// The path and type of the page end up in the search index
+	if err := u.updateSearchIndex(page); err != nil {
+		return nil, err
+	}
+
+	if keyChanged {
+		if err := u.redirectMovedPage(page.SiteID(), oldPath, page); err != nil {
+			return nil, err
+		}
+		if err := u.rebuildSubtreePaths(page); err != nil {
+			return nil, err
+		}
+	}
+
+	return page, nil
+}
+
+// MovePage moves a page below a new parent and position, optionally into another site of the same tenant.
+// The paths of the whole subtree are rebuilt and the indexes of the old and new siblings are compacted.
+func (u *PageUseCase) MovePage(tenantID, siteID, pageID uint64, req dto.MovePageRequest) (*entities.Page, error) {
+	site, err := u.findSite(tenantID, siteID)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := u.findPage(site, pageID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Position != nil && *req.Position < 0 {
+		return nil, errors.ErrPagePositionInvalid
+	}
+
+	targetSite := site
+	if req.TargetSiteID != nil {
+		targetSite, err = u.findSite(tenantID, *req.TargetSiteID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var parent *entities.Page
+	var parentID *entities.PageID
+	if req.ParentID != nil {
+		parent, err = u.findParent(targetSite, *req.ParentID)
+		if err != nil {
+			return nil, err
+		}
+		if err := u.ensureNotDescendant(page, parent); err != nil {
+			return nil, err
+		}
+		id := parent.ID()
+		parentID = &id
+	}
+
+	oldSiblings, err := u.findSiblings(page.SiteID(), page.ParentID())
+	if err != nil {
+		return nil, err
+	}
+	newSiblings, err := u.findSiblings(targetSite.ID(), parentID)
+	if err != nil {
+		return nil, err
+	}
+	newSiblings = insertPage(withoutPage(newSiblings, page), page, req.Position)
+
+	// The old siblings only need compacting when the page leaves their list
+	if !samePageID(page.ParentID(), parentID) || page.SiteID().Value() != targetSite.ID().Value() {
+		if err := u.reindexSiblings(withoutPage(oldSiblings, page), page); err != nil {
+			return nil, err
+		}
+	}
+	if err := u.reindexSiblings(newSiblings, page); err != nil {
+		return nil, err
+	}
+
+	oldSiteID, oldPath := page.SiteID(), page.FullPath()
+	if page.SiteID().Value() != targetSite.ID().Value() {
+		page.MoveToSite(targetSite.ID())
+	}
+	page.SetParent(parentID)
+	page.BuildPath(parent)
+
+	if err := u.ensurePathAvailable(page); err != nil {
+		return nil, err
+	}
+
+	if err := u.pageRepo.Save(page); err != nil {
+		u.logger.Error("Failed to save moved page", "pageID", pageID, "error", err)
+		return nil, err
+	}
+
+	if err := u.updateSearchIndex(page); err != nil {
+		return nil, err
+	}
+
+	if err := u.redirectMovedPage(oldSiteID, oldPath, page); err != nil {
+		return nil, err
+	}
+	if err := u.rebuildSubtreePaths(page); err != nil {
+		return nil, err
+	}
+
+	return page, nil
+}
+
+// ResolvePage follows the hard links of a page to the page whose content it shows
+func (u *PageUseCase) ResolvePage(tenantID, siteID, pageID uint64) (*entities.ResolvedPage, error) {
+	site, err := u.findSite(tenantID, siteID)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := u.findPage(site, pageID)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.pageResolver.Resolve(page)
+}
+
+// GetSnippetUsages lists the blocks of all page versions that embed the snippet page
+func (u *PageUseCase) GetSnippetUsages(tenantID, siteID, pageID uint64) ([]*entities.SnippetUsage, error) {
+	site, err := u.findSite(tenantID, siteID)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := u.findPage(site, pageID)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.findSnippetUsages(page)
+}
+
+// DuplicatePage copies a page and all of its descendants below a new parent, optionally into another site of the same tenant.
+// Every copied page gets a copy of its latest or published version including the blocks, as a draft.
+// A copied root key that collides under the new parent gets a suffix, and hard links into the subtree point to the copies.
+// Copied blocks are validated like edited ones, against their content type and the snippets they embed.
+// A copy into another site must not hard link out of the copied subtree, since the copies would keep showing pages of
+// the source site; such a copy is rejected with ErrPageDuplicateExternalHardLink before anything is written.
+func (u *PageUseCase) DuplicatePage(tenantID, siteID, pageID uint64, req dto.DuplicatePageRequest) (*entities.Page, error) {
+	site, err := u.findSite(tenantID, siteID)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := u.findPage(site, pageID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Versions != "" && req.Versions != duplicateLatestVersions && req.Versions != duplicatePublishedVersions {
+		return nil, errors.ErrPageDuplicateVersionsInvalid
+	}
+	if req.Position != nil && *req.Position < 0 {
+		return nil, errors.ErrPagePositionInvalid
+	}
+
+	targetSite := site
+	if req.TargetSiteID != nil {
+		targetSite, err = u.findSite(tenantID, *req.TargetSiteID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var parent *entities.Page
+	var parentID *entities.PageID
+	if req.ParentID != nil {
+		parent, err = u.findParent(targetSite, *req.ParentID)
+		if err != nil {
+			return nil, err
+		}
+		id := parent.ID()
+		parentID = &id
+	}
+
+	// The subtree is collected before copying, so a page can be duplicated into its own subtree
+	subtree, err := u.collectSubtree(page)
+	if err != nil {
+		return nil, err
+	}
+	if targetSite.ID().Value() != site.ID().Value() {
+		if err := ensureHardLinksWithin(subtree); err != nil {
+			return nil, err
+		}
+	}
+
+	root, err := u.newPageCopy(page, targetSite.ID(), parentID, parent)
+	if err != nil {
+		return nil, err
+	}
+	if err := u.assignAvailableKey(root, parent); err != nil {
+		return nil, err
+	}
+
+	siblings, err := u.findSiblings(targetSite.ID(), parentID)
+	if err != nil {
+		return nil, err
+	}
+	if err := u.reindexSiblings(insertPage(siblings, root, req.Position), root); err != nil {
+		return nil, err
+	}
+
+	// copies maps the ID of every original page to its copy
+	copies := make(map[uint64]*entities.Page, len(subtree))
+	for _, original := range subtree {
+		duplicate := root
+		if original != page {
+			copyParent := copies[original.ParentID().Value()]
+			copyParentID := copyParent.ID()
+			duplicate, err = u.newPageCopy(original, targetSite.ID(), &copyParentID, copyParent)
+			if err != nil {
+				return nil, err
+			}
+			duplicate.UpdateIndex(original.Index())
+		}
+
+		if err := u.pageRepo.Save(duplicate); err != nil {
+			u.logger.Error("Failed to save duplicated page", "pageID", original.ID().Value(), "error", err)
+			return nil, err
+		}
+		copies[original.ID().Value()] = duplicate
+
+		if err := u.duplicateVersion(original, duplicate, req.Versions, targetSite.TenantID()); err != nil {
+			return nil, err
+		}
+	}
+
+	// Hard links can point to pages copied later in the walk, so they are remapped once all copies exist
+	for _, duplicate := range copies {
+		if duplicate.HardLinkPageID() == nil {
+			continue
+		}
+		target, ok := copies[duplicate.HardLinkPageID().Value()]
+		if !ok {
+			continue
+		}
+		targetID := target.ID()
+		if err := duplicate.SetHardLinkPageID(&targetID); err != nil {
+			return nil, err
+		}
+		if err := u.pageRepo.Save(duplicate); err != nil {
+			u.logger.Error("Failed to remap duplicated hard link", "pageID", duplicate.ID().Value(), "error", err)
+			return nil, err
+		}
+	}
+
+	return root, nil
+}
+
+// DeletePage moves the given revision of a page to the trash together with its descendants and their versions
+func (u *PageUseCase) DeletePage(tenantID, siteID, pageID, revision uint64) error {
+	site, err := u.findSite(tenantID, siteID)
+	if err != nil {
+		return err
+	}
+
+	page, err := u.findPage(site, pageID)
+	if err != nil {
+		return err
+	}
+
+	if err := checkRevision(page.Revision(), revision); err != nil {
+		return err
+	}
+
+	subtree, err := u.collectSubtree(page)
+	if err != nil {
+		return err
+	}
+
+	if err := u.ensureSnippetsUnused(subtree); err != nil {
+		return err
+	}
+
+	// The whole subtree shares the time it was trashed at, so restoring the page restores exactly these pages
+	deletedAt := time.Now()
+	for _, trashed := range subtree {
+		if err := trashPage(u.pageRepo, u.pageVersionRepo, u.searchIndexer, u.logger, trashed, deletedAt); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// newPageCopy creates an unsaved copy of the page with the same key, type, link targets and feed root mark below the given parent
+func (u *PageUseCase) newPageCopy(original *entities.Page, siteID entities.SiteID, parentID *entities.PageID, parent *entities.Page) (*entities.Page, error) {
+	duplicate, err := entities.NewPage(original.Key(), nil, siteID, original.Type())
+	if err != nil {
+		return nil, err
+	}
+
+	duplicate.SetParent(parentID)
+	duplicate.BuildPath(parent)
+
+	if original.LinkURL() != nil {
+		linkURL := *original.LinkURL()
+		if err := duplicate.SetLinkURL(&linkURL); err != nil {
+			return nil, err
+		}
+	}
+	if original.HardLinkPageID() != nil {
+		targetID := *original.HardLinkPageID()
+		if err := duplicate.SetHardLinkPageID(&targetID); err != nil {
+			return nil, err
+		}
+	}
+	if err := duplicate.SetFeedRoot(original.IsFeedRoot()); err != nil {
+		return nil, err
+	}
+
+	return duplicate, nil
+}
+
+// assignAvailableKey suffixes the key of the page with -copy, -copy-2, ... until its path is free in its site
+func (u *PageUseCase) assignAvailableKey(page *entities.Page, parent *entities.Page) error {
+	base := page.Key().Value()
+	for attempt := 0; attempt <= maxDuplicateKeyAttempts; attempt++ {
+		if attempt > 0 {
+			key, err := value_objects.NewPageKey(duplicateKey(base, attempt))
+			if err != nil {
+				return err
+			}
+			if err := page.UpdateKey(key); err != nil {
+				return err
+			}
+			page.BuildPath(parent)
+		}
+
+		existing, err := u.pageRepo.FindByPath(page.FullPath(), page.SiteID())
+		if err != nil {
+			return err
+		}
+		if existing == nil {
+			return nil
+		}
+	}
+	return errors.ErrPagePathAlreadyExists
+}
+
+// duplicateVersion copies the latest or published version of the original page in every locale, including its blocks,
+// as drafts of the copy
+func (u *PageUseCase) duplicateVersion(original, duplicate *entities.Page, versions string, tenantID entities.TenantID) error {
+	existing, err := u.pageVersionRepo.FindByPageID(original.ID())
+	if err != nil {
+		u.logger.Error("Failed to find page versions to duplicate", "pageID", original.ID().Value(), "error", err)
+		return err
+	}
+
+	// Versions are ordered newest first, so the first version seen in a locale is its latest one
+	copied := make(map[entities.Locale]bool)
+	for _, source := range existing {
+		if copied[source.Locale()] || (versions == duplicatePublishedVersions && !source.IsPublished()) {
+			continue
+		}
+		copied[source.Locale()] = true
+
+		if err := u.copyVersion(source, duplicate, uint(len(copied)), tenantID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// copyVersion copies the version and its blocks as a draft of the given page with the given version number.
+// Every block is validated against its content type of the tenant and the snippet it embeds.
+func (u *PageUseCase) copyVersion(source *entities.PageVersion, duplicate *entities.Page, number uint, tenantID entities.TenantID) error {
+	version, err := entities.NewPageVersion(duplicate.ID(), number, source.Locale(), source.Title(), source.Description())
+	if err != nil {
+		return err
+	}
+	if err := version.UpdateSEO(source.SEO()); err != nil {
+		return err
+	}
+	if err := u.pageVersionRepo.Save(version); err != nil {
+		u.logger.Error("Failed to save duplicated page version", "pageID", duplicate.ID().Value(), "error", err)
+		return err
+	}
+
+	blocks, err := u.pageBlockRepo.FindByPageVersionID(source.ID())
+	if err != nil {
+		u.logger.Error("Failed to get page blocks to duplicate", "versionID", source.ID().Value(), "error", err)
+		return err
+	}
+	for _, sourceBlock := range blocks {
+		block, err := entities.NewPageBlock(version.ID(), sourceBlock.BlockKey(), sourceBlock.Index(), sourceBlock.ContentType(), sourceBlock.Content())
+		if err != nil {
+			return err
+		}
+		if err := u.contentValidator.Validate(tenantID, block); err != nil {
+			return err
+		}
+		if err := u.snippetService.ValidateEmbed(duplicate, block); err != nil {
+			return err
+		}
+		if err := u.pageBlockRepo.Save(block); err != nil {
+			u.logger.Error("Failed to save duplicated page block", "versionID", version.ID().Value(), "error", err)
+			return err
+		}
+	}
+
+	return duplicate.AddVersion(version)
+}
+
+// findSite retrieves a site and verifies it belongs to the given tenant
+func (u *PageUseCase) findSite(tenantID, siteID uint64) (*entities.Site, error) {
+	return findTenantSite(u.siteRepo, u.logger, tenantID, siteID)
+}
+
+// findPage retrieves a page and verifies it belongs to the given site
+func (u *PageUseCase) findPage(site *entities.Site, pageID uint64) (*entities.Page, error) {
+	return findSitePage(u.pageRepo, u.logger, site, pageID)
+}
+
+// findParent retrieves the parent page of a page within the same site
+func (u *PageUseCase) findParent(site *entities.Site, parentID uint64) (*entities.Page, error) {
+	parent, err := u.findPage(site, parentID)
+	if err != nil {
+		if err == errors.ErrPageNotFound {
+			return nil, errors.ErrPageParentNotFound
+		}
+		return nil, err
+	}
+	return parent, nil
+}
+
+// findSiblings retrieves the pages sharing the given parent, or the root pages when parentID is nil
+func (u *PageUseCase) findSiblings(siteID entities.SiteID, parentID *entities.PageID) ([]*entities.Page, error) {
+	if parentID == nil {
+		return u.pageRepo.FindRootPagesBySiteID(siteID)
+	}
+	return u.pageRepo.FindChildrenByParentID(*parentID)
+}
+
+// ensurePathAvailable checks that no other page in the site uses the path of the page
+func (u *PageUseCase) ensurePathAvailable(page *entities.Page) error {
+	existing, err := u.pageRepo.FindByPath(page.FullPath(), page.SiteID())
+	if err != nil {
+		return err
+	}
+	if existing != nil && existing.ID().Value() != page.ID().Value() {
+		return errors.ErrPagePathAlreadyExists
+	}
+	return nil
+}
+
+// applyTarget sets the link URL or hard link target of a page and validates it against the page type
+func (u *PageUseCase) applyTarget(page *entities.Page, linkURL *string, hardLinkPageID *uint64) error {
+	if linkURL != nil {
+		if !isValidLinkURL(*linkURL) {
+			return errors.ErrPageLinkURLInvalid
+		}
+		if err := page.SetLinkURL(linkURL); err != nil {
+			return errors.ErrPageTargetNotAllowed
+		}
+	}
+
+	if hardLinkPageID != nil {
+		targetID := entities.NewPageID(*hardLinkPageID)
+		if err := page.SetHardLinkPageID(&targetID); err != nil {
+			return errors.ErrPageTargetNotAllowed
+		}
+	}
+
+	if err := page.ValidateTarget(); err != nil {
+		return err
+	}
+
+	if page.Type() == entities.PageTypeHardLink {
+		if err := u.pageResolver.ValidateHardLinkTarget(page, *page.HardLinkPageID()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ensureNotDescendant rejects a new parent that is the page itself or lies within its subtree
+func (u *PageUseCase) ensureNotDescendant(page, parent *entities.Page) error {
+	visited := make(map[uint64]bool)
+	for current := parent; current != nil; {
+		if current.ID().Value() == page.ID().Value() {
+			return errors.ErrPageMoveCycle
+		}
+		if current.ParentID() == nil || visited[current.ID().Value()] {
+			return nil
+		}
+		visited[current.ID().Value()] = true
+
+		next, err := u.pageRepo.FindByID(*current.ParentID())
+		if err != nil {
+			return err
+		}
+		current = next
+	}
+	return nil
+}
+
+// reindexSiblings assigns consecutive indexes to the siblings in their given order and saves the changed ones.
+// The moved page is only re-indexed, as the caller saves it together with its new parent.
+func (u *PageUseCase) reindexSiblings(siblings []*entities.Page, moved *entities.Page) error {
+	for i, sibling := range siblings {
+		if sibling.Index() == i {
+			continue
+		}
+		sibling.UpdateIndex(i)
+		if sibling == moved {
+			continue
+		}
+		if err := u.pageRepo.Save(sibling); err != nil {
+			u.logger.Error("Failed to save sibling index", "pageID", sibling.ID().Value(), "error", err)
+			return err
+		}
+	}
+	return nil
+}
+
+// rebuildSubtreePaths recomputes the paths of all descendants of the page and moves them into its site
+func (u *PageUseCase) rebuildSubtreePaths(page *entities.Page) error {
+	subtree, err := u.collectSubtree(page)
+	if err != nil {
+		return err
+	}
+
+	byID := make(map[uint64]*entities.Page, len(subtree))
+	byID[page.ID().Value()] = page
+
+	// The subtree is in breadth-first order, so every parent is rebuilt before its children
+	for _, descendant := range subtree[1:] {
+		byID[descendant.ID().Value()] = descendant
+		oldSiteID, oldPath := descendant.SiteID(), descendant.FullPath()
+		descendant.BuildPath(byID[descendant.ParentID().Value()])
+		if descendant.SiteID().Value() != page.SiteID().Value() {
+			descendant.MoveToSite(page.SiteID())
+		}
+		if err := u.pageRepo.Save(descendant); err != nil {
+			u.logger.Error("Failed to save descendant path", "pageID", descendant.ID().Value(), "error", err)
+			return err
+		}
+		if err := u.updateSearchIndex(descendant); err != nil {
+			return err
+		}
+		if err := u.redirectMovedPage(oldSiteID, oldPath, descendant); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// redirectMovedPage redirects the former path of a moved or renamed page to the page. A redirect already using
+// the former path is taken over, as the page shadowed it; redirects to the page from its new path are dropped,
+// as the page shadows them now. Snippets are never delivered on their own and get no redirects.
+func (u *PageUseCase) redirectMovedPage(oldSiteID entities.SiteID, oldPath string, page *entities.Page) error {
+	if page.Type() == entities.PageTypeSnippet ||
+		(oldSiteID.Value() == page.SiteID().Value() && oldPath == page.FullPath()) {
+		return nil
+	}
+
+	stale, err := u.redirectRepo.FindBySourcePath(page.SiteID(), page.FullPath())
+	if err != nil {
+		u.logger.Error("Failed to find redirect by source path", "siteID", page.SiteID().Value(), "error", err)
+		return err
+	}
+	if stale != nil && stale.TargetPageID() != nil && stale.TargetPageID().Value() == page.ID().Value() {
+		if err := u.redirectRepo.Delete(stale.ID()); err != nil {
+			u.logger.Error("Failed to delete redirect", "redirectID", stale.ID().Value(), "error", err)
+			return err
+		}
+	}
+
+	redirect, err := u.redirectRepo.FindBySourcePath(oldSiteID, oldPath)
+	if err != nil {
+		u.logger.Error("Failed to find redirect by source path", "siteID", oldSiteID.Value(), "error", err)
+		return err
+	}
+	if redirect == nil {
+		redirect, err = entities.NewPageRedirect(oldSiteID, oldPath, page.ID())
+		if err != nil {
+			return err
+		}
+	} else {
+		redirect.RetargetPage(page.ID())
+	}
+
+	if err := u.redirectRepo.Save(redirect); err != nil {
+		u.logger.Error("Failed to save page redirect", "pageID", page.ID().Value(), "error", err)
+		return err
+	}
+	return nil
+}
+
+// updateSearchIndex reindexes the published content of the page after its path, site or type changed
+func (u *PageUseCase) updateSearchIndex(page *entities.Page) error {
+	if err := u.searchIndexer.Reindex(page); err != nil {
+		u.logger.Error("Failed to update search index", "pageID", page.ID().Value(), "error", err)
+		return err
+	}
+	return nil
+}
+
+// collectSubtree returns the page followed by all of its descendants in breadth-first order
+func (u *PageUseCase) collectSubtree(page *entities.Page) ([]*entities.Page, error) {
+	subtree := []*entities.Page{page}
+	for i := 0; i < len(subtree); i++ {
+		children, err := u.pageRepo.FindChildrenByParentID(subtree[i].ID())
+		if err != nil {
+			u.logger.Error("Failed to find child pages", "pageID", subtree[i].ID().Value(), "error", err)
+			return nil, err
+		}
+		subtree = append(subtree, children...)
+	}
+	return subtree, nil
+}
+
+// findSnippetUsages returns the blocks embedding the page together with the version and page they belong to
+func (u *PageUseCase) findSnippetUsages(snippet *entities.Page) ([]*entities.SnippetUsage, error) {
+	blocks, err := u.pageBlockRepo.FindBySnippetPageID(snippet.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	usages := make([]*entities.SnippetUsage, 0, len(blocks))
+	versions := make(map[uint64]*entities.PageVersion)
+	pages := make(map[uint64]*entities.Page)
+	for _, block := range blocks {
+		version, found := versions[block.PageVersionID().Value()]
+		if !found {
+			version, err = u.pageVersionRepo.FindByID(block.PageVersionID())
+			if err != nil {
+				u.logger.Error("Failed to find page version of snippet usage", "versionID", block.PageVersionID().Value(), "error", err)
+				return nil, err
+			}
+			versions[block.PageVersionID().Value()] = version
+		}
+		if version == nil {
+			continue
+		}
+
+		page, found := pages[version.PageID().Value()]
+		if !found {
+			page, err = u.pageRepo.FindByID(version.PageID())
+			if err != nil {
+				u.logger.Error("Failed to find page of snippet usage", "pageID", version.PageID().Value(), "error", err)
+				return nil, err
+			}
+			pages[version.PageID().Value()] = page
+		}
+		if page == nil {
+			continue
+		}
+
+		usages = append(usages, &entities.SnippetUsage{Page: page, Version: version, BlockKey: block.BlockKey()})
+	}
+
+	return usages, nil
+}
+
+// ensureSnippetsUnused checks that no snippet among the pages is embedded by a non-archived version of a page outside of them
+func (u *PageUseCase) ensureSnippetsUnused(pages []*entities.Page) error {
+	included := make(map[uint64]bool, len(pages))
+	for _, page := range pages {
+		included[page.ID().Value()] = true
+	}
+
+	for _, page := range pages {
+		if page.Type() != entities.PageTypeSnippet {
+			continue
+		}
+		usages, err := u.findSnippetUsages(page)
+		if err != nil {
+			return err
+		}
+		for _, usage := range usages {
+			if !included[usage.Page.ID().Value()] && usage.Version.Status() != entities.PageVersionStatusArchived {
+				return errors.ErrPageSnippetInUse
+			}
+		}
+	}
+
+	return nil
+}
+
+// trashPage moves a page and its versions to the trash at the given time and removes the page from the search index
+func trashPage(
+	pageRepo repositories.PageRepository,
+	pageVersionRepo repositories.PageVersionRepository,
+	searchIndexer services.SearchIndexer,
+	logger common.Logger,
+	page *entities.Page,
+	deletedAt time.Time,
+) error {
+	versions, err := pageVersionRepo.FindByPageID(page.ID())
+	if err != nil {
+		logger.Error("Failed to find page versions", "pageID", page.ID().Value(), "error", err)
+		return err
+	}
+	for _, version := range versions {
+		version.MoveToTrash(deletedAt)
+		if err := pageVersionRepo.Save(version); err != nil {
+			logger.Error("Failed to move page version to trash", "versionID", version.ID().Value(), "error", err)
+			return err
+		}
+	}
+
+	if err := searchIndexer.Remove(page.ID()); err != nil {
+		logger.Error("Failed to remove page from search index", "pageID", page.ID().Value(), "error", err)
+		return err
+	}
+
+	page.MoveToTrash(deletedAt)
+	if err := pageRepo.Save(page); err != nil {
+		logger.Error("Failed to move page to trash", "pageID", page.ID().Value(), "error", err)
+		return err
+	}
+
+	return nil
+}
+
+// findTenantSite retrieves a site and verifies it belongs to the given tenant
+func findTenantSite(siteRepo repositories.SiteRepository, logger common.Logger, tenantID, siteID uint64) (*entities.Site, error) {
+	site, err := siteRepo.FindByID(entities.NewSiteID(siteID))
+	if err != nil {
+		logger.Error("Failed to find site", "siteID", siteID, "error", err)
+		return nil, err
+	}
+	if site == nil || site.TenantID().Value() != tenantID {
+		return nil, errors.ErrSiteNotFound
+	}
+	return site, nil
+}
+
+// findSitePage retrieves a page and verifies it belongs to the given site
+func findSitePage(pageRepo repositories.PageRepository, logger common.Logger, site *entities.Site, pageID uint64) (*entities.Page, error) {
+	page, err := pageRepo.FindByID(entities.NewPageID(pageID))
+	if err != nil {
+		logger.Error("Failed to find page", "pageID", pageID, "error", err)
+		return nil, err
+	}
+	if page == nil || page.SiteID().Value() != site.ID().Value() {
+		return nil, errors.ErrPageNotFound
+	}
+	return page, nil
+}
+
+// checkRevision verifies the client read the current revision of the resource it modifies. Returns
+// ErrRevisionMismatch when the resource was saved since.
+func checkRevision(current, expected uint64) error {
+	if current != expected {
+		return errors.ErrRevisionMismatch
+	}
+	return nil
+}
+
+// buildPageTree nests a flat, index ordered list of pages under their parents and returns the root pages
+func buildPageTree(pages []*entities.Page) []*entities.Page {
+	byID := make(map[uint64]*entities.Page, len(pages))
+	for _, page := range pages {
+		byID[page.ID().Value()] = page
+	}
+
+	roots := make([]*entities.Page, 0)
+	for _, page := range pages {
+		if page.ParentID() == nil {
+			roots = append(roots, page)
+			continue
+		}
+		parent, ok := byID[page.ParentID().Value()]
+		if !ok {
+			// Orphaned pages are shown at the root, so they can still be managed
+			roots = append(roots, page)
+			continue
+		}
+		_ = parent.AddChild(page)
+	}
+
+	return roots
+}
+
+// isValidLinkURL reports whether the link is an absolute http(s) URL or a path relative to the site root
+func isValidLinkURL(link string) bool {
+	if strings.HasPrefix(link, "/") && !strings.HasPrefix(link, "//") {
+		return true
+	}
+	parsed, err := url.Parse(link)
+	if err != nil {
+		return false
+	}
+	return (parsed.Scheme == "http" || parsed.Scheme == "https") && parsed.Host != ""
+}
+
+// withoutPage returns the pages except the one with the ID of the given page
+func withoutPage(pages []*entities.Page, page *entities.Page) []*entities.Page {
+	result := make([]*entities.Page, 0, len(pages))
+	for _, p := range pages {
+		if p.ID().Value() != page.ID().Value() {
+			result = append(result, p)
+		}
+	}
+	return result
+}
+
+// insertPage inserts the page at the given position, or at the end when the position is nil or out of range
+func insertPage(pages []*entities.Page, page *entities.Page, position *int) []*entities.Page {
+	if position == nil || *position >= len(pages) {
+		return append(pages, page)
+	}
+	pages = append(pages[:*position], append([]*entities.Page{page}, pages[*position:]...)...)
+	return pages
+}
+
+// duplicateKey returns the key suffixed for the given attempt, shortened so it stays within the key length limit
+func duplicateKey(base string, attempt int) string {
+	suffix := "-copy"
+	if attempt > 1 {
+		suffix = fmt.Sprintf("-copy-%d", attempt)
+	}
+	if len(base)+len(suffix) > maxPageKeyLength {
+		base = base[:maxPageKeyLength-len(suffix)]
+	}
+	return base + suffix
+}
+
+// ensureHardLinksWithin checks that the hard links of the pages only point to pages among them
+func ensureHardLinksWithin(pages []*entities.Page) error {
+	included := make(map[uint64]bool, len(pages))
+	for _, page := range pages {
+		included[page.ID().Value()] = true
+	}
+	for _, page := range pages {
+		if page.HardLinkPageID() != nil && !included[page.HardLinkPageID().Value()] {
+			return errors.ErrPageDuplicateExternalHardLink
+		}
+	}
+	return nil
+}
+
+// samePageID reports whether both optional page IDs are nil or hold the same value
+func samePageID(a, b *entities.PageID) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return a.Value() == b.Value()
+}
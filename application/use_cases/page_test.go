@@ -0,0 +1,433 @@
+package use_cases
+
+import (
+	"github.com/h4rdc0m/aurora-api/application/dto"
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	domainErrors "github.com/h4rdc0m/aurora-api/domain/errors"
+	"github.com/h4rdc0m/aurora-api/domain/value_objects"
+	"github.com/h4rdc0m/aurora-api/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"strings"
+	"testing"
+)
+
+type pageTestRepos struct {
+	sites     *mocks.MockSiteRepository
+	pages     *mocks.MockPageRepository
+	versions  *mocks.MockPageVersionRepository
+	blocks    *mocks.MockPageBlockRepository
+	redirects *mocks.MockRedirectRepository
+	resolver  *mocks.MockPageResolver
+	snippets  *mocks.MockSnippetService
+	validator *mocks.MockContentValidator
+	indexer   *mocks.MockSearchIndexer
+	// saved records the pages, versions and blocks saved by the use case, in order
+	savedPages    []*entities.Page
+	savedVersions []*entities.PageVersion
+	savedBlocks   []*entities.PageBlock
+}
+
+// newTestPageUseCase creates a page use case whose repository mocks assign IDs starting at 100 to new pages and
+// versions, and accept every block as valid
+func newTestPageUseCase() (*PageUseCase, *pageTestRepos) {
+	repos := &pageTestRepos{
+		sites:     &mocks.MockSiteRepository{},
+		pages:     &mocks.MockPageRepository{},
+		versions:  &mocks.MockPageVersionRepository{},
+		blocks:    &mocks.MockPageBlockRepository{},
+		redirects: &mocks.MockRedirectRepository{},
+		resolver:  &mocks.MockPageResolver{},
+		snippets:  &mocks.MockSnippetService{},
+		validator: &mocks.MockContentValidator{},
+		indexer:   &mocks.MockSearchIndexer{},
+	}
+
+	nextID := uint64(100)
+	repos.pages.On("Save", mock.Anything).Run(func(args mock.Arguments) {
+		page := args.Get(0).(*entities.Page)
+		if page.ID().IsEmpty() {
+			page.SetID(entities.NewPageID(nextID))
+			nextID++
+		}
+		repos.savedPages = append(repos.savedPages, page)
+	}).Return(nil)
+	repos.versions.On("Save", mock.Anything).Run(func(args mock.Arguments) {
+		version := args.Get(0).(*entities.PageVersion)
+		if version.ID().Value() == 0 {
+			version.SetID(entities.NewPageVersionID(nextID))
+			nextID++
+		}
+		repos.savedVersions = append(repos.savedVersions, version)
+	}).Return(nil)
+	repos.blocks.On("Save", mock.Anything).Run(func(args mock.Arguments) {
+		repos.savedBlocks = append(repos.savedBlocks, args.Get(0).(*entities.PageBlock))
+	}).Return(nil)
+	repos.validator.On("Validate", mock.Anything, mock.Anything).Return(nil)
+	repos.snippets.On("ValidateEmbed", mock.Anything, mock.Anything).Return(nil)
+
+	useCase := NewPageUseCase(repos.pages, repos.versions, repos.blocks, repos.sites, repos.redirects, repos.resolver, repos.snippets, repos.validator, repos.indexer, newTestLogger())
+	return useCase, repos
+}
+
+// newTestSite creates a persisted site of the tenant and registers it with the repository mock
+func (r *pageTestRepos) newTestSite(t *testing.T, id, tenantID uint64) *entities.Site {
+	domain, err := value_objects.NewDomainName("example.com")
+	assert.NoError(t, err)
+	site, err := entities.NewSite("Site", nil, domain, entities.NewTemplateID(1), entities.NewTenantID(tenantID))
+	assert.NoError(t, err)
+	assert.NoError(t, site.SetID(entities.NewSiteID(id)))
+	r.sites.On("FindByID", site.ID()).Return(site, nil)
+	return site
+}
+
+// newTestPage creates a persisted page below the parent and registers it with the repository mock;
+// a hard link page targets the page with the given ID
+func (r *pageTestRepos) newTestPage(t *testing.T, id uint64, site *entities.Site, key string, parent *entities.Page, target uint64) *entities.Page {
+	pageKey, err := value_objects.NewPageKey(key)
+	assert.NoError(t, err)
+	pageType := entities.PageTypeContent
+	if target != 0 {
+		pageType = entities.PageTypeHardLink
+	}
+	page, err := entities.NewPage(pageKey, nil, site.ID(), pageType)
+	assert.NoError(t, err)
+	page.SetID(entities.NewPageID(id))
+	if parent != nil {
+		parentID := parent.ID()
+		page.SetParent(&parentID)
+	}
+	page.BuildPath(parent)
+	if target != 0 {
+		targetID := entities.NewPageID(target)
+		assert.NoError(t, page.SetHardLinkPageID(&targetID))
+	}
+	r.pages.On("FindByID", page.ID()).Return(page, nil)
+	return page
+}
+
+// withChildren registers the children of the page
+func (r *pageTestRepos) withChildren(page *entities.Page, children ...*entities.Page) {
+	r.pages.On("FindChildrenByParentID", page.ID()).Return(children, nil)
+}
+
+// withVersions registers the versions of the page, newest first, each holding a single text block
+func (r *pageTestRepos) withVersions(t *testing.T, page *entities.Page, versions ...*entities.PageVersion) {
+	r.versions.On("FindByPageID", page.ID()).Return(versions, nil)
+	for _, version := range versions {
+		block, err := entities.NewPageBlock(version.ID(), "intro", 0, "text", "content of "+version.Title())
+		assert.NoError(t, err)
+		r.blocks.On("FindByPageVersionID", version.ID()).Return([]*entities.PageBlock{block}, nil)
+	}
+}
+
+// newTestVersion creates a persisted version of the page in the given status
+func newTestVersion(t *testing.T, id uint64, page *entities.Page, number uint, locale entities.Locale, title string, status entities.PageVersionStatus) *entities.PageVersion {
+	version, err := entities.NewPageVersion(page.ID(), number, locale, title, nil)
+	assert.NoError(t, err)
+	version.SetID(entities.NewPageVersionID(id))
+	version.SetStatus(status, nil, nil)
+	return version
+}
+
+// allowDuplicate registers free paths, empty sibling lists and no children or versions for every other lookup
+func (r *pageTestRepos) allowDuplicate() {
+	r.pages.On("FindByPath", mock.Anything, mock.Anything).Return(nil, nil)
+	r.pages.On("FindRootPagesBySiteID", mock.Anything).Return([]*entities.Page{}, nil)
+	r.pages.On("FindChildrenByParentID", mock.Anything).Return([]*entities.Page{}, nil)
+	r.versions.On("FindByPageID", mock.Anything).Return([]*entities.PageVersion{}, nil)
+}
+
+// savedCopy returns the last saved copy of the page with the given key
+func (r *pageTestRepos) savedCopy(t *testing.T, key string) *entities.Page {
+	for i := len(r.savedPages) - 1; i >= 0; i-- {
+		if r.savedPages[i].Key().Value() == key && r.savedPages[i].ID().Value() >= 100 {
+			return r.savedPages[i]
+		}
+	}
+	t.Fatalf("no copy of %q saved", key)
+	return nil
+}
+
+func TestPageUseCase_AssignAvailableKey(t *testing.T) {
+	testCases := []struct {
+		name     string
+		taken    []string
+		expected string
+	}{
+		{name: "free key is kept", expected: "/about"},
+		{name: "taken key gets the copy suffix", taken: []string{"/about"}, expected: "/about-copy"},
+		{name: "taken copy gets a numbered suffix", taken: []string{"/about", "/about-copy"}, expected: "/about-copy-2"},
+		{name: "numbers count up", taken: []string{"/about", "/about-copy", "/about-copy-2", "/about-copy-3"}, expected: "/about-copy-4"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			useCase, repos := newTestPageUseCase()
+			site := repos.newTestSite(t, 1, 1)
+			page := repos.newTestPage(t, 1, site, "about", nil, 0)
+			for _, taken := range tc.taken {
+				repos.pages.On("FindByPath", taken, site.ID()).Return(page, nil)
+			}
+			repos.pages.On("FindByPath", mock.Anything, site.ID()).Return(nil, nil)
+
+			err := useCase.assignAvailableKey(page, nil)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, page.FullPath())
+		})
+	}
+
+	t.Run("suffix is applied below the parent", func(t *testing.T) {
+		useCase, repos := newTestPageUseCase()
+		site := repos.newTestSite(t, 1, 1)
+		parent := repos.newTestPage(t, 1, site, "company", nil, 0)
+		page := repos.newTestPage(t, 2, site, "about", parent, 0)
+		repos.pages.On("FindByPath", "/company/about", site.ID()).Return(page, nil)
+		repos.pages.On("FindByPath", "/company/about-copy", site.ID()).Return(nil, nil)
+
+		err := useCase.assignAvailableKey(page, parent)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "about-copy", page.Key().Value())
+		assert.Equal(t, "/company/about-copy", page.FullPath())
+	})
+
+	t.Run("long key is shortened to fit the suffix", func(t *testing.T) {
+		useCase, repos := newTestPageUseCase()
+		site := repos.newTestSite(t, 1, 1)
+		page := repos.newTestPage(t, 1, site, strings.Repeat("a", maxPageKeyLength), nil, 0)
+		repos.pages.On("FindByPath", page.FullPath(), site.ID()).Return(page, nil)
+		repos.pages.On("FindByPath", mock.Anything, site.ID()).Return(nil, nil)
+
+		err := useCase.assignAvailableKey(page, nil)
+
+		assert.NoError(t, err)
+		assert.Len(t, page.Key().Value(), maxPageKeyLength)
+		assert.True(t, strings.HasSuffix(page.Key().Value(), "-copy"))
+	})
+
+	t.Run("gives up after the maximum number of attempts", func(t *testing.T) {
+		useCase, repos := newTestPageUseCase()
+		site := repos.newTestSite(t, 1, 1)
+		page := repos.newTestPage(t, 1, site, "about", nil, 0)
+		repos.pages.On("FindByPath", mock.Anything, site.ID()).Return(page, nil)
+
+		err := useCase.assignAvailableKey(page, nil)
+
+		assert.ErrorIs(t, err, domainErrors.ErrPagePathAlreadyExists)
+		repos.pages.AssertNumberOfCalls(t, "FindByPath", maxDuplicateKeyAttempts+1)
+		assert.Equal(t, "about-copy-100", page.Key().Value())
+	})
+}
+
+func TestPageUseCase_DuplicatePage_HardLinks(t *testing.T) {
+	// The subtree of "section" holds a hard link to a page inside of it and one to a page outside of it
+	setup := func(t *testing.T) (*PageUseCase, *pageTestRepos, *entities.Site) {
+		useCase, repos := newTestPageUseCase()
+		site := repos.newTestSite(t, 1, 1)
+		repos.newTestSite(t, 2, 1)
+		section := repos.newTestPage(t, 1, site, "section", nil, 0)
+		inner := repos.newTestPage(t, 2, site, "inner-link", section, 3)
+		target := repos.newTestPage(t, 3, site, "target", section, 0)
+		outer := repos.newTestPage(t, 4, site, "outer-link", section, 50)
+		repos.withChildren(section, inner, target, outer)
+		repos.pages.On("FindByPath", "/section", site.ID()).Return(section, nil)
+		repos.allowDuplicate()
+		return useCase, repos, site
+	}
+
+	t.Run("hard links into the subtree point to the copies", func(t *testing.T) {
+		useCase, repos, site := setup(t)
+
+		root, err := useCase.DuplicatePage(1, site.ID().Value(), 1, dto.DuplicatePageRequest{})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "/section-copy", root.FullPath())
+		inner := repos.savedCopy(t, "inner-link")
+		target := repos.savedCopy(t, "target")
+		assert.Equal(t, "/section-copy/inner-link", inner.FullPath())
+		assert.Equal(t, target.ID().Value(), inner.HardLinkPageID().Value())
+		assert.NotEqual(t, uint64(3), inner.HardLinkPageID().Value())
+	})
+
+	t.Run("hard links out of the subtree are kept within the site", func(t *testing.T) {
+		useCase, repos, site := setup(t)
+
+		_, err := useCase.DuplicatePage(1, site.ID().Value(), 1, dto.DuplicatePageRequest{})
+
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(50), repos.savedCopy(t, "outer-link").HardLinkPageID().Value())
+	})
+
+	t.Run("hard links out of the subtree reject a copy into another site", func(t *testing.T) {
+		useCase, repos, site := setup(t)
+		targetSiteID := uint64(2)
+
+		root, err := useCase.DuplicatePage(1, site.ID().Value(), 1, dto.DuplicatePageRequest{TargetSiteID: &targetSiteID})
+
+		assert.Nil(t, root)
+		assert.ErrorIs(t, err, domainErrors.ErrPageDuplicateExternalHardLink)
+		repos.pages.AssertNotCalled(t, "Save", mock.Anything)
+	})
+}
+
+func TestPageUseCase_DuplicatePage_OtherSite(t *testing.T) {
+	t.Run("copies the subtree below a parent of another site", func(t *testing.T) {
+		useCase, repos := newTestPageUseCase()
+		site := repos.newTestSite(t, 1, 1)
+		otherSite := repos.newTestSite(t, 2, 1)
+		section := repos.newTestPage(t, 1, site, "section", nil, 0)
+		link := repos.newTestPage(t, 2, site, "link", section, 3)
+		target := repos.newTestPage(t, 3, site, "target", section, 0)
+		parent := repos.newTestPage(t, 10, otherSite, "archive", nil, 0)
+		repos.withChildren(section, link, target)
+		repos.pages.On("FindChildrenByParentID", parent.ID()).Return([]*entities.Page{}, nil)
+		repos.allowDuplicate()
+		targetSiteID, parentID := otherSite.ID().Value(), parent.ID().Value()
+
+		root, err := useCase.DuplicatePage(1, site.ID().Value(), 1, dto.DuplicatePageRequest{TargetSiteID: &targetSiteID, ParentID: &parentID})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "/archive/section", root.FullPath())
+		assert.Equal(t, parent.ID().Value(), root.ParentID().Value())
+		repos.pages.AssertCalled(t, "FindByPath", "/archive/section", otherSite.ID())
+		for _, saved := range repos.savedPages {
+			assert.Equal(t, otherSite.ID().Value(), saved.SiteID().Value(), saved.FullPath())
+		}
+		assert.Equal(t, repos.savedCopy(t, "target").ID().Value(), repos.savedCopy(t, "link").HardLinkPageID().Value())
+		assert.Equal(t, "/archive/section/target", repos.savedCopy(t, "target").FullPath())
+	})
+
+	t.Run("site of another tenant is not found", func(t *testing.T) {
+		useCase, repos := newTestPageUseCase()
+		site := repos.newTestSite(t, 1, 1)
+		foreign := repos.newTestSite(t, 2, 2)
+		repos.newTestPage(t, 1, site, "section", nil, 0)
+		targetSiteID := foreign.ID().Value()
+
+		root, err := useCase.DuplicatePage(1, site.ID().Value(), 1, dto.DuplicatePageRequest{TargetSiteID: &targetSiteID})
+
+		assert.Nil(t, root)
+		assert.ErrorIs(t, err, domainErrors.ErrSiteNotFound)
+	})
+
+	t.Run("parent must belong to the target site", func(t *testing.T) {
+		useCase, repos := newTestPageUseCase()
+		site := repos.newTestSite(t, 1, 1)
+		otherSite := repos.newTestSite(t, 2, 1)
+		repos.newTestPage(t, 1, site, "section", nil, 0)
+		parent := repos.newTestPage(t, 10, site, "archive", nil, 0)
+		targetSiteID, parentID := otherSite.ID().Value(), parent.ID().Value()
+
+		root, err := useCase.DuplicatePage(1, site.ID().Value(), 1, dto.DuplicatePageRequest{TargetSiteID: &targetSiteID, ParentID: &parentID})
+
+		assert.Nil(t, root)
+		assert.ErrorIs(t, err, domainErrors.ErrPageParentNotFound)
+	})
+}
+
+func TestPageUseCase_DuplicatePage_Versions(t *testing.T) {
+	setup := func(t *testing.T) (*PageUseCase, *pageTestRepos, *entities.Site) {
+		useCase, repos := newTestPageUseCase()
+		site := repos.newTestSite(t, 1, 1)
+		page := repos.newTestPage(t, 1, site, "about", nil, 0)
+		repos.withVersions(t, page,
+			newTestVersion(t, 14, page, 4, "en", "English draft", entities.PageVersionStatusDraft),
+			newTestVersion(t, 13, page, 3, "de", "German published", entities.PageVersionStatusPublished),
+			newTestVersion(t, 12, page, 2, "en", "English published", entities.PageVersionStatusPublished),
+			newTestVersion(t, 11, page, 1, "fr", "French archived", entities.PageVersionStatusArchived),
+		)
+		repos.allowDuplicate()
+		return useCase, repos, site
+	}
+
+	testCases := []struct {
+		name     string
+		versions string
+		expected map[entities.Locale]string
+	}{
+		{name: "latest version per locale by default", expected: map[entities.Locale]string{"en": "English draft", "de": "German published", "fr": "French archived"}},
+		{name: "latest version per locale", versions: "latest", expected: map[entities.Locale]string{"en": "English draft", "de": "German published", "fr": "French archived"}},
+		{name: "published version per locale", versions: "published", expected: map[entities.Locale]string{"en": "English published", "de": "German published"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			useCase, repos, site := setup(t)
+
+			root, err := useCase.DuplicatePage(1, site.ID().Value(), 1, dto.DuplicatePageRequest{Versions: tc.versions})
+
+			assert.NoError(t, err)
+			copied := make(map[entities.Locale]string, len(repos.savedVersions))
+			for _, version := range repos.savedVersions {
+				copied[version.Locale()] = version.Title()
+				assert.Equal(t, root.ID().Value(), version.PageID().Value())
+				assert.Equal(t, entities.PageVersionStatusDraft, version.Status())
+			}
+			assert.Equal(t, tc.expected, copied)
+			assert.Len(t, root.Versions(), len(tc.expected))
+
+			assert.Len(t, repos.savedBlocks, len(tc.expected))
+			for i, block := range repos.savedBlocks {
+				assert.Equal(t, repos.savedVersions[i].ID().Value(), block.PageVersionID().Value())
+				assert.Equal(t, "content of "+repos.savedVersions[i].Title(), block.Content())
+			}
+		})
+	}
+
+	t.Run("invalid version selection is rejected", func(t *testing.T) {
+		useCase, _, site := setup(t)
+
+		root, err := useCase.DuplicatePage(1, site.ID().Value(), 1, dto.DuplicatePageRequest{Versions: "all"})
+
+		assert.Nil(t, root)
+		assert.ErrorIs(t, err, domainErrors.ErrPageDuplicateVersionsInvalid)
+	})
+}
+
+func TestPageUseCase_DuplicatePage_BlockValidation(t *testing.T) {
+	setup := func(t *testing.T) (*PageUseCase, *pageTestRepos, *entities.Site) {
+		useCase, repos := newTestPageUseCase()
+		site := repos.newTestSite(t, 1, 1)
+		page := repos.newTestPage(t, 1, site, "about", nil, 0)
+		repos.withVersions(t, page, newTestVersion(t, 11, page, 1, "en", "About", entities.PageVersionStatusPublished))
+		repos.allowDuplicate()
+		return useCase, repos, site
+	}
+
+	t.Run("copied blocks are validated for the tenant and embedded by the copy", func(t *testing.T) {
+		useCase, repos, site := setup(t)
+
+		root, err := useCase.DuplicatePage(1, site.ID().Value(), 1, dto.DuplicatePageRequest{})
+
+		assert.NoError(t, err)
+		repos.validator.AssertCalled(t, "Validate", entities.NewTenantID(1), repos.savedBlocks[0])
+		repos.snippets.AssertCalled(t, "ValidateEmbed", root, repos.savedBlocks[0])
+	})
+
+	t.Run("invalid content fails the copy", func(t *testing.T) {
+		useCase, repos, site := setup(t)
+		repos.validator.ExpectedCalls = nil
+		contentErr := &domainErrors.BlockContentError{}
+		repos.validator.On("Validate", mock.Anything, mock.Anything).Return(contentErr)
+
+		root, err := useCase.DuplicatePage(1, site.ID().Value(), 1, dto.DuplicatePageRequest{})
+
+		assert.Nil(t, root)
+		assert.ErrorIs(t, err, contentErr)
+		repos.blocks.AssertNotCalled(t, "Save", mock.Anything)
+	})
+
+	t.Run("snippet nested too deep fails the copy", func(t *testing.T) {
+		useCase, repos, site := setup(t)
+		repos.snippets.ExpectedCalls = nil
+		repos.snippets.On("ValidateEmbed", mock.Anything, mock.Anything).Return(domainErrors.ErrPageSnippetCycle)
+
+		root, err := useCase.DuplicatePage(1, site.ID().Value(), 1, dto.DuplicatePageRequest{})
+
+		assert.Nil(t, root)
+		assert.ErrorIs(t, err, domainErrors.ErrPageSnippetCycle)
+		repos.blocks.AssertNotCalled(t, "Save", mock.Anything)
+	})
+}
@@ -0,0 +1,784 @@
+package use_cases
+
+import (
+	"github.com/h4rdc0m/aurora-api/application/dto"
+	"github.com/h4rdc0m/aurora-api/domain/common"
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"github.com/h4rdc0m/aurora-api/domain/errors"
+	"github.com/h4rdc0m/aurora-api/domain/repositories"
+	"github.com/h4rdc0m/aurora-api/domain/services"
+	"github.com/jmoiron/sqlx"
+	"time"
+)
+
+// PageVersionUseCase handles the versions of a page and their editorial workflow
+type PageVersionUseCase struct {
+	pageRepo         repositories.PageRepository
+	pageVersionRepo  repositories.PageVersionRepository
+	pageBlockRepo    repositories.PageBlockRepository
+	siteRepo         repositories.SiteRepository
+	snippetService   services.SnippetService
+	contentValidator services.ContentValidator
+	searchIndexer    services.SearchIndexer
+	editingLeases    services.EditingLeaseService
+	auditRepo        repositories.AuditEntryRepository
+	logger           common.Logger
+}
+
+// editingLeaseDuration is how long an editing lease lasts unless its holder renews it
+const editingLeaseDuration = 5 * time.Minute
+
+// NewPageVersionUseCase creates a new PageVersionUseCase
+func NewPageVersionUseCase(
+	pageRepo repositories.PageRepository,
+	pageVersionRepo repositories.PageVersionRepository,
+	pageBlockRepo repositories.PageBlockRepository,
+	siteRepo repositories.SiteRepository,
+	snippetService services.SnippetService,
+	contentValidator services.ContentValidator,
+	searchIndexer services.SearchIndexer,
+	editingLeases services.EditingLeaseService,
+	auditRepo repositories.AuditEntryRepository,
+	logger common.Logger,
+) *PageVersionUseCase {
+	return &PageVersionUseCase{
+		pageRepo:         pageRepo,
+		pageVersionRepo:  pageVersionRepo,
+		pageBlockRepo:    pageBlockRepo,
+		siteRepo:         siteRepo,
+		snippetService:   snippetService,
+		contentValidator: contentValidator,
+		searchIndexer:    searchIndexer,
+		editingLeases:    editingLeases,
+		auditRepo:        auditRepo,
+		logger:           logger,
+	}
+}
+
+// WithTrx returns a copy of the use case that runs all repository calls inside the given transaction
+func (u *PageVersionUseCase) WithTrx(trxHandle *sqlx.Tx) *PageVersionUseCase {
+	return &PageVersionUseCase{
+		pageRepo:         u.pageRepo.WithTrx(trxHandle),
+		pageVersionRepo:  u.pageVersionRepo.WithTrx(trxHandle),
+		pageBlockRepo:    u.pageBlockRepo.WithTrx(trxHandle),
+		siteRepo:         u.siteRepo.WithTrx(trxHandle),
+		snippetService:   u.snippetService.WithTrx(trxHandle),
+		contentValidator: u.contentValidator.WithTrx(trxHandle),
+		searchIndexer:    u.searchIndexer.WithTrx(trxHandle),
+		editingLeases:    u.editingLeases,
+		auditRepo:        u.auditRepo.WithTrx(trxHandle),
+		logger:           u.logger,
+	}
+}
+
+// GetVersions retrieves all versions of a page, newest first. A non-empty locale only returns the versions in that locale.
+func (u *PageVersionUseCase) GetVersions(tenantID, siteID, pageID uint64, locale string) ([]*entities.PageVersion, error) {
+	page, err := u.findPage(tenantID, siteID, pageID)
+	if err != nil {
+		return nil, err
+	}
+
+	var filter entities.Locale
+	if locale != "" {
+		if filter, err = entities.NewLocale(locale); err != nil {
+			return nil, err
+		}
+	}
+
+	versions, err := u.pageVersionRepo.FindByPageID(page.ID())
+	if err != nil {
+		u.logger.Error("Failed to get page versions", "pageID", pageID, "error", err)
+		return nil, err
+	}
+
+	if filter == "" {
+		return versions, nil
+	}
+	filtered := make([]*entities.PageVersion, 0, len(versions))
+	for _, version := range versions {
+		if version.Locale() == filter {
+			filtered = append(filtered, version)
+		}
+	}
+	return filtered, nil
+}
+
+// GetVersion retrieves a single version of a page
+func (u *PageVersionUseCase) GetVersion(tenantID, siteID, pageID, versionID uint64) (*entities.PageVersion, error) {
+	return u.findVersion(tenantID, siteID, pageID, versionID)
+}
+
+// RenderVersion returns the blocks of a version as they are delivered, with the published blocks of embedded snippets inlined
+func (u *PageVersionUseCase) RenderVersion(tenantID, siteID, pageID, versionID uint64) ([]*entities.RenderedBlock, error) {
+	page, err := u.findPage(tenantID, siteID, pageID)
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := u.findVersion(tenantID, siteID, pageID, versionID)
+	if err != nil {
+		return nil, err
+	}
+
+	blocks, err := u.pageBlockRepo.FindByPageVersionID(version.ID())
+	if err != nil {
+		u.logger.Error("Failed to get page blocks", "versionID", versionID, "error", err)
+		return nil, err
+	}
+
+	return u.snippetService.Render(page, version.Locale(), blocks)
+}
+
+// CompareVersions compares a version of a page with another version of the same page, including their blocks.
+// When fromVersionID is nil the version is compared with the version of the page published in the same locale.
+func (u *PageVersionUseCase) CompareVersions(tenantID, siteID, pageID, versionID uint64, fromVersionID *uint64) (*entities.PageVersionDiff, error) {
+	to, err := u.findVersion(tenantID, siteID, pageID, versionID)
+	if err != nil {
+		return nil, err
+	}
+
+	var from *entities.PageVersion
+	if fromVersionID != nil {
+		from, err = u.findVersion(tenantID, siteID, pageID, *fromVersionID)
+	} else {
+		from, err = u.pageVersionRepo.FindPublishedByPageID(to.PageID(), to.Locale())
+		if err == nil && from == nil {
+			err = errors.ErrPageVersionNotFound
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for _, version := range []*entities.PageVersion{from, to} {
+		if err := u.loadBlocks(version); err != nil {
+			return nil, err
+		}
+	}
+
+	return entities.NewPageVersionDiff(from, to), nil
+}
+
+// CreateVersion creates a new draft version numbered after the latest version of the page, in any locale.
+// The version is written in the requested locale, which must be enabled for the site, or in the default locale of the site.
+func (u *PageVersionUseCase) CreateVersion(tenantID, siteID, pageID uint64, req dto.CreatePageVersionRequest) (*entities.PageVersion, error) {
+	site, err := findTenantSite(u.siteRepo, u.logger, tenantID, siteID)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := findSitePage(u.pageRepo, u.logger, site, pageID)
+	if err != nil {
+		return nil, err
+	}
+
+	locale, err := siteLocale(site, req.Locale)
+	if err != nil {
+		return nil, err
+	}
+
+	number, err := u.nextVersionNumber(page.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := entities.NewPageVersion(page.ID(), number, locale, req.Title, req.Description)
+	if err != nil {
+		return nil, err
+	}
+	if req.SEO != nil {
+		if err := version.UpdateSEO(pageSEO(*req.SEO)); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := u.pageVersionRepo.Save(version); err != nil {
+		u.logger.Error("Failed to save page version", "pageID", pageID, "error", err)
+		return nil, err
+	}
+
+	return version, nil
+}
+
+// RestoreVersion copies a version and all its blocks into a new draft in the same locale, numbered after the latest
+// version of the page. The copied version itself is left untouched, so the history stays append-only.
+func (u *PageVersionUseCase) RestoreVersion(tenantID, siteID, pageID, versionID uint64) (*entities.PageVersion, error) {
+	page, err := u.findPage(tenantID, siteID, pageID)
+	if err != nil {
+		return nil, err
+	}
+	source, err := u.findPageVersion(page, versionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.loadBlocks(source); err != nil {
+		return nil, err
+	}
+
+	number, err := u.nextVersionNumber(source.PageID())
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := entities.NewPageVersion(source.PageID(), number, source.Locale(), source.Title(), source.Description())
+	if err != nil {
+		return nil, err
+	}
+	if err := version.UpdateSEO(source.SEO()); err != nil {
+		return nil, err
+	}
+
+	if err := u.pageVersionRepo.Save(version); err != nil {
+		u.logger.Error("Failed to save restored page version", "versionID", versionID, "error", err)
+		return nil, err
+	}
+
+	for _, sourceBlock := range source.Blocks() {
+		block, err := entities.NewPageBlock(version.ID(), sourceBlock.BlockKey(), sourceBlock.Index(), sourceBlock.ContentType(), sourceBlock.Content())
+		if err != nil {
+			return nil, err
+		}
+		// The schema of the content type and the embedded snippets may have changed since the version was written
+		if err := u.contentValidator.Validate(entities.NewTenantID(tenantID), block); err != nil {
+			return nil, err
+		}
+		if err := u.snippetService.ValidateEmbed(page, block); err != nil {
+			return nil, err
+		}
+		if err := u.pageBlockRepo.Save(block); err != nil {
+			u.logger.Error("Failed to save restored page block", "versionID", versionID, "blockKey", sourceBlock.BlockKey(), "error", err)
+			return nil, err
+		}
+		if err := version.AddBlock(block); err != nil {
+			return nil, err
+		}
+	}
+
+	return version, nil
+}
+
+// UpdateVersion updates the title, description and, when given, the SEO metadata of the given revision of a draft
+// version on behalf of the user. Fails with a PageVersionLockedError while another user holds the editing lease of
+// the version.
+func (u *PageVersionUseCase) UpdateVersion(tenantID, siteID, pageID, versionID, revision uint64, req dto.UpdatePageVersionRequest, user *entities.User) (*entities.PageVersion, error) {
+	version, err := u.findVersion(tenantID, siteID, pageID, versionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkRevision(version.Revision(), revision); err != nil {
+		return nil, err
+	}
+
+	if !version.IsDraft() {
+		return nil, errors.ErrPageVersionNotEditable
+	}
+
+	if err := u.checkLease(version, user); err != nil {
+		return nil, err
+	}
+
+	if err := version.UpdateTitle(req.Title); err != nil {
+		return nil, err
+	}
+	version.UpdateDescription(req.Description)
+	if req.SEO != nil {
+		if err := version.UpdateSEO(pageSEO(*req.SEO)); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := u.pageVersionRepo.Save(version); err != nil {
+		u.logger.Error("Failed to save updated page version", "versionID", versionID, "error", err)
+		return nil, err
+	}
+
+	return version, nil
+}
+
+// ReplaceBlocks replaces the blocks of the given revision of a draft version with the given ordered list. Blocks
+// are matched by their block key: existing blocks are updated, new keys are created and missing keys are deleted.
+// The index of every block is its position in the list. The content of every block is validated before anything
+// is written. Fails with a PageVersionLockedError while another user holds the editing lease of the version.
+func (u *PageVersionUseCase) ReplaceBlocks(tenantID, siteID, pageID, versionID, revision uint64, req dto.ReplacePageBlocksRequest, user *entities.User) (*entities.PageVersion, error) {
+	page, err := u.findPage(tenantID, siteID, pageID)
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := u.findVersion(tenantID, siteID, pageID, versionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkRevision(version.Revision(), revision); err != nil {
+		return nil, err
+	}
+
+	if !version.IsDraft() {
+		return nil, errors.ErrPageVersionNotEditable
+	}
+
+	if err := u.checkLease(version, user); err != nil {
+		return nil, err
+	}
+
+	blocks, err := u.buildBlocks(page, version, req.Blocks, entities.NewTenantID(tenantID))
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := u.pageBlockRepo.FindByPageVersionID(version.ID())
+	if err != nil {
+		u.logger.Error("Failed to get page blocks", "versionID", versionID, "error", err)
+		return nil, err
+	}
+
+	kept := make(map[string]bool, len(blocks))
+	for _, block := range blocks {
+		kept[block.BlockKey()] = true
+	}
+	for _, block := range existing {
+		if kept[block.BlockKey()] {
+			continue
+		}
+		if err := u.pageBlockRepo.Delete(block.ID()); err != nil {
+			u.logger.Error("Failed to delete page block", "versionID", versionID, "blockKey", block.BlockKey(), "error", err)
+			return nil, err
+		}
+	}
+
+	current := make(map[string]*entities.PageBlock, len(existing))
+	for _, block := range existing {
+		current[block.BlockKey()] = block
+	}
+	for _, block := range blocks {
+		if stored, exists := current[block.BlockKey()]; exists {
+			if err := applyBlock(stored, block); err != nil {
+				return nil, err
+			}
+			block = stored
+		}
+		if err := u.pageBlockRepo.Save(block); err != nil {
+			u.logger.Error("Failed to save page block", "versionID", versionID, "blockKey", block.BlockKey(), "error", err)
+			return nil, err
+		}
+		if err := version.AddBlock(block); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := u.pageVersionRepo.Save(version); err != nil {
+		u.logger.Error("Failed to save page version", "versionID", versionID, "error", err)
+		return nil, err
+	}
+
+	return version, nil
+}
+
+// GetLease retrieves the editing lease of a version. Returns ErrEditingLeaseNotFound when nobody is editing it.
+func (u *PageVersionUseCase) GetLease(tenantID, siteID, pageID, versionID uint64) (*entities.EditingLease, error) {
+	version, err := u.findVersion(tenantID, siteID, pageID, versionID)
+	if err != nil {
+		return nil, err
+	}
+
+	lease, err := u.editingLeases.Find(version.ID())
+	if err != nil {
+		return nil, err
+	}
+	if lease == nil {
+		return nil, errors.ErrEditingLeaseNotFound
+	}
+
+	return lease, nil
+}
+
+// AcquireLease gives the user the editing lease of a draft version, recording their name for other editors.
+// Acquiring a version the user already holds renews their lease; a version held by another user fails with a
+// PageVersionLockedError.
+func (u *PageVersionUseCase) AcquireLease(tenantID, siteID, pageID, versionID uint64, user *entities.User, name string) (*entities.EditingLease, error) {
+	version, err := u.findVersion(tenantID, siteID, pageID, versionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !version.IsDraft() {
+		return nil, errors.ErrPageVersionNotEditable
+	}
+
+	lease := entities.NewEditingLease(version.ID(), user.KeycloakID().String(), name, time.Now().Add(editingLeaseDuration))
+	if err := u.editingLeases.Acquire(lease); err != nil {
+		return nil, err
+	}
+
+	return lease, nil
+}
+
+// RenewLease extends the editing lease the user holds on a version
+func (u *PageVersionUseCase) RenewLease(tenantID, siteID, pageID, versionID uint64, user *entities.User) (*entities.EditingLease, error) {
+	version, err := u.findVersion(tenantID, siteID, pageID, versionID)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.editingLeases.Renew(version.ID(), user.KeycloakID().String(), time.Now().Add(editingLeaseDuration))
+}
+
+// ReleaseLease ends the editing lease the user holds on a version
+func (u *PageVersionUseCase) ReleaseLease(tenantID, siteID, pageID, versionID uint64, user *entities.User) error {
+	version, err := u.findVersion(tenantID, siteID, pageID, versionID)
+	if err != nil {
+		return err
+	}
+
+	return u.editingLeases.Release(version.ID(), user.KeycloakID().String())
+}
+
+// BreakLease ends the editing lease of a version on behalf of a tenant admin, whoever holds it, and records this
+// in the audit log of the tenant. The audit entry is written first, so a lease is never broken unaudited.
+func (u *PageVersionUseCase) BreakLease(tenantID, siteID, pageID, versionID uint64, user *entities.User) (*entities.EditingLease, error) {
+	version, err := u.findVersion(tenantID, siteID, pageID, versionID)
+	if err != nil {
+		return nil, err
+	}
+
+	lease, err := u.editingLeases.Find(version.ID())
+	if err != nil {
+		return nil, err
+	}
+	if lease == nil {
+		return nil, errors.ErrEditingLeaseNotFound
+	}
+
+	entry := entities.NewAuditEntry(entities.NewTenantID(tenantID), user.ID(), entities.AuditActionEditingLeaseBroken, entities.AuditResourcePageVersion, version.ID().Value(), map[string]string{
+		"holder_subject": lease.HolderSubject(),
+		"holder_name":    lease.HolderName(),
+		"expires_at":     lease.ExpiresAt().UTC().Format(time.RFC3339),
+	})
+	if err := u.auditRepo.Save(entry); err != nil {
+		u.logger.Error("Failed to save audit entry for broken editing lease", "versionID", versionID, "error", err)
+		return nil, err
+	}
+
+	if _, err := u.editingLeases.Break(version.ID()); err != nil {
+		return nil, err
+	}
+
+	return lease, nil
+}
+
+// SubmitVersion submits a draft version for review
+func (u *PageVersionUseCase) SubmitVersion(tenantID, siteID, pageID, versionID uint64, user *entities.User) (*entities.PageVersion, error) {
+	return u.transition(tenantID, siteID, pageID, versionID, func(version *entities.PageVersion) error {
+		return version.SubmitForReview(user.ID(), time.Now())
+	})
+}
+
+// ApproveVersion approves a version that is in review
+func (u *PageVersionUseCase) ApproveVersion(tenantID, siteID, pageID, versionID uint64, user *entities.User) (*entities.PageVersion, error) {
+	return u.transition(tenantID, siteID, pageID, versionID, func(version *entities.PageVersion) error {
+		return version.Approve(user.ID(), time.Now())
+	})
+}
+
+// RejectVersion sends a version in review, or an approved version, back to draft
+func (u *PageVersionUseCase) RejectVersion(tenantID, siteID, pageID, versionID uint64, user *entities.User) (*entities.PageVersion, error) {
+	return u.transition(tenantID, siteID, pageID, versionID, func(version *entities.PageVersion) error {
+		return version.Reject(user.ID(), time.Now())
+	})
+}
+
+// ArchiveVersion archives a version, which takes it offline when it is published
+func (u *PageVersionUseCase) ArchiveVersion(tenantID, siteID, pageID, versionID uint64, user *entities.User) (*entities.PageVersion, error) {
+	version, err := u.transition(tenantID, siteID, pageID, versionID, func(version *entities.PageVersion) error {
+		return version.Archive(user.ID(), time.Now())
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.reindex(version.PageID()); err != nil {
+		return nil, err
+	}
+
+	return version, nil
+}
+
+// PublishVersion publishes an approved version and archives the version of the page previously published in its locale.
+// Both changes must run in the same transaction, see WithTrx.
+func (u *PageVersionUseCase) PublishVersion(tenantID, siteID, pageID, versionID uint64, user *entities.User) (*entities.PageVersion, error) {
+	version, err := u.findVersion(tenantID, siteID, pageID, versionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.publish(version, user.ID(), time.Now()); err != nil {
+		return nil, err
+	}
+
+	return version, nil
+}
+
+// ScheduleVersion sets the publish and unpublish times of the given revision of a version on behalf of the given user
+func (u *PageVersionUseCase) ScheduleVersion(tenantID, siteID, pageID, versionID, revision uint64, req dto.SchedulePageVersionRequest, user *entities.User) (*entities.PageVersion, error) {
+	return u.transition(tenantID, siteID, pageID, versionID, func(version *entities.PageVersion) error {
+		if err := checkRevision(version.Revision(), revision); err != nil {
+			return err
+		}
+		return version.Schedule(req.PublishAt, req.UnpublishAt, user.ID())
+	})
+}
+
+// GetDueScheduledVersions retrieves the versions whose scheduled publish or unpublish time has been reached
+func (u *PageVersionUseCase) GetDueScheduledVersions(now time.Time) ([]*entities.PageVersion, error) {
+	versions, err := u.pageVersionRepo.FindDueScheduled(now)
+	if err != nil {
+		u.logger.Error("Failed to find scheduled page versions", "error", err)
+		return nil, err
+	}
+
+	return versions, nil
+}
+
+// ApplySchedule publishes or unpublishes a version when its scheduled time has been reached.
+// The status change is recorded on behalf of the user who scheduled the version.
+// Returns true if the version was changed.
+func (u *PageVersionUseCase) ApplySchedule(versionID uint64, now time.Time) (bool, error) {
+	version, err := u.pageVersionRepo.FindByID(entities.NewPageVersionID(versionID))
+	if err != nil {
+		u.logger.Error("Failed to find scheduled page version", "versionID", versionID, "error", err)
+		return false, err
+	}
+	// Another instance may have applied the schedule in the meantime
+	if version == nil || version.ScheduledBy() == nil {
+		return false, nil
+	}
+
+	scheduledBy := *version.ScheduledBy()
+
+	switch {
+	case version.IsPublishDue(now):
+		if err := u.publish(version, scheduledBy, now); err != nil {
+			return false, err
+		}
+	case version.IsUnpublishDue(now):
+		if err := version.Archive(scheduledBy, now); err != nil {
+			return false, err
+		}
+		if err := u.pageVersionRepo.Save(version); err != nil {
+			u.logger.Error("Failed to unpublish scheduled page version", "versionID", versionID, "error", err)
+			return false, err
+		}
+		if err := u.reindex(version.PageID()); err != nil {
+			return false, err
+		}
+	default:
+		return false, nil
+	}
+
+	return true, nil
+}
+
+// publish archives the version of the page currently published in the same locale, if any, and publishes the given version at the given time
+func (u *PageVersionUseCase) publish(version *entities.PageVersion, changedBy entities.UserID, at time.Time) error {
+	if !version.Status().CanTransitionTo(entities.PageVersionStatusPublished) {
+		return errors.ErrPageVersionTransitionNotAllowed
+	}
+
+	current, err := u.pageVersionRepo.FindPublishedByPageID(version.PageID(), version.Locale())
+	if err != nil {
+		u.logger.Error("Failed to find published page version", "pageID", version.PageID().Value(), "locale", version.Locale(), "error", err)
+		return err
+	}
+
+	// The previous version goes offline first, as only one version per page and locale may be published
+	if current != nil && current.ID().Value() != version.ID().Value() {
+		if err := current.Archive(changedBy, at); err != nil {
+			return err
+		}
+		if err := u.pageVersionRepo.Save(current); err != nil {
+			u.logger.Error("Failed to unpublish previous page version", "versionID", current.ID().Value(), "error", err)
+			return err
+		}
+	}
+
+	if err := version.Publish(changedBy, at); err != nil {
+		return err
+	}
+	if err := u.pageVersionRepo.Save(version); err != nil {
+		u.logger.Error("Failed to publish page version", "versionID", version.ID().Value(), "error", err)
+		return err
+	}
+
+	return u.reindex(version.PageID())
+}
+
+// reindex updates the search index after the published version of the page changed
+func (u *PageVersionUseCase) reindex(pageID entities.PageID) error {
+	page, err := u.pageRepo.FindByID(pageID)
+	if err != nil {
+		u.logger.Error("Failed to find page", "pageID", pageID.Value(), "error", err)
+		return err
+	}
+	if page == nil {
+		return u.searchIndexer.Remove(pageID)
+	}
+
+	if err := u.searchIndexer.Reindex(page); err != nil {
+		u.logger.Error("Failed to update search index", "pageID", pageID.Value(), "error", err)
+		return err
+	}
+	return nil
+}
+
+// transition loads a version, applies the workflow step and saves it
+func (u *PageVersionUseCase) transition(tenantID, siteID, pageID, versionID uint64, step func(*entities.PageVersion) error) (*entities.PageVersion, error) {
+	version, err := u.findVersion(tenantID, siteID, pageID, versionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := step(version); err != nil {
+		return nil, err
+	}
+
+	if err := u.pageVersionRepo.Save(version); err != nil {
+		u.logger.Error("Failed to save page version status", "versionID", versionID, "error", err)
+		return nil, err
+	}
+
+	return version, nil
+}
+
+// loadBlocks attaches the blocks of a version
+func (u *PageVersionUseCase) loadBlocks(version *entities.PageVersion) error {
+	blocks, err := u.pageBlockRepo.FindByPageVersionID(version.ID())
+	if err != nil {
+		u.logger.Error("Failed to get page blocks", "versionID", version.ID().Value(), "error", err)
+		return err
+	}
+	for _, block := range blocks {
+		if err := version.AddBlock(block); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// buildBlocks creates unsaved blocks for the requested list, indexed by position, and validates each of them
+func (u *PageVersionUseCase) buildBlocks(page *entities.Page, version *entities.PageVersion, requests []dto.PageBlockRequest, tenantID entities.TenantID) ([]*entities.PageBlock, error) {
+	blocks := make([]*entities.PageBlock, 0, len(requests))
+	seen := make(map[string]bool, len(requests))
+	for index, req := range requests {
+		if seen[req.BlockKey] {
+			return nil, errors.ErrPageBlockKeyDuplicate
+		}
+		seen[req.BlockKey] = true
+
+		block, err := entities.NewPageBlock(version.ID(), req.BlockKey, index, req.ContentType, req.Content)
+		if err != nil {
+			return nil, err
+		}
+		if err := u.contentValidator.Validate(tenantID, block); err != nil {
+			return nil, err
+		}
+		if err := u.snippetService.ValidateEmbed(page, block); err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, block)
+	}
+	return blocks, nil
+}
+
+// applyBlock copies the position, content type and content of the requested block onto the stored one
+func applyBlock(stored, requested *entities.PageBlock) error {
+	if stored.Index() != requested.Index() {
+		stored.UpdateIndex(requested.Index())
+	}
+	if stored.ContentType() != requested.ContentType() {
+		if err := stored.UpdateContentType(requested.ContentType()); err != nil {
+			return err
+		}
+	}
+	if stored.Content() != requested.Content() {
+		stored.UpdateContent(requested.Content())
+	}
+	return nil
+}
+
+// nextVersionNumber returns the number following the latest version of the page
+func (u *PageVersionUseCase) nextVersionNumber(pageID entities.PageID) (uint, error) {
+	latest, err := u.pageVersionRepo.FindLatestByPageID(pageID)
+	if err != nil {
+		u.logger.Error("Failed to find latest page version", "pageID", pageID.Value(), "error", err)
+		return 0, err
+	}
+	if latest == nil {
+		return 1, nil
+	}
+	return latest.Version() + 1, nil
+}
+
+// findPage retrieves a page and verifies it belongs to the site of the given tenant
+func (u *PageVersionUseCase) findPage(tenantID, siteID, pageID uint64) (*entities.Page, error) {
+	site, err := findTenantSite(u.siteRepo, u.logger, tenantID, siteID)
+	if err != nil {
+		return nil, err
+	}
+	return findSitePage(u.pageRepo, u.logger, site, pageID)
+}
+
+// findVersion retrieves a version and verifies it belongs to the page of the given site and tenant
+func (u *PageVersionUseCase) findVersion(tenantID, siteID, pageID, versionID uint64) (*entities.PageVersion, error) {
+	page, err := u.findPage(tenantID, siteID, pageID)
+	if err != nil {
+		return nil, err
+	}
+	return u.findPageVersion(page, versionID)
+}
+
+// findPageVersion retrieves a version and verifies it belongs to the page
+func (u *PageVersionUseCase) findPageVersion(page *entities.Page, versionID uint64) (*entities.PageVersion, error) {
+	version, err := u.pageVersionRepo.FindByID(entities.NewPageVersionID(versionID))
+	if err != nil {
+		u.logger.Error("Failed to find page version", "versionID", versionID, "error", err)
+		return nil, err
+	}
+	if version == nil || version.PageID().Value() != page.ID().Value() {
+		return nil, errors.ErrPageVersionNotFound
+	}
+
+	return version, nil
+}
+
+// checkLease verifies no other user than the given one holds the editing lease of the version. Versions without
+// a lease can be saved by anyone.
+func (u *PageVersionUseCase) checkLease(version *entities.PageVersion, user *entities.User) error {
+	lease, err := u.editingLeases.Find(version.ID())
+	if err != nil {
+		return err
+	}
+	if lease != nil && !lease.IsHeldBy(user.KeycloakID().String()) {
+		return lease.LockedError()
+	}
+	return nil
+}
+
+// pageSEO maps the SEO metadata of a request to the page version metadata it describes
+func pageSEO(req dto.PageSEORequest) entities.PageSEO {
+	seo := entities.PageSEO{
+		MetaTitle:     req.MetaTitle,
+		CanonicalURL:  req.CanonicalURL,
+		Robots:        req.Robots,
+		OGTitle:       req.OGTitle,
+		OGDescription: req.OGDescription,
+		OGImage:       req.OGImage,
+	}
+	if req.TwitterCard != nil {
+		card := entities.TwitterCard(*req.TwitterCard)
+		seo.TwitterCard = &card
+	}
+	return seo
+}
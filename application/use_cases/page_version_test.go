@@ -0,0 +1,512 @@
+package use_cases
+
+import (
+	stderrors "errors"
+	"github.com/h4rdc0m/aurora-api/application/dto"
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	domainErrors "github.com/h4rdc0m/aurora-api/domain/errors"
+	"github.com/h4rdc0m/aurora-api/domain/value_objects"
+	"github.com/h4rdc0m/aurora-api/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+const (
+	testEditorSubject = "4b1f6a8e-2c0d-4f5e-9a3b-7d8c6e5f4a21"
+	testOtherSubject  = "9e8d7c6b-5a4f-4e3d-8c2b-1a0f9e8d7c6b"
+)
+
+type pageVersionTestRepos struct {
+	sites     *mocks.MockSiteRepository
+	pages     *mocks.MockPageRepository
+	versions  *mocks.MockPageVersionRepository
+	blocks    *mocks.MockPageBlockRepository
+	snippets  *mocks.MockSnippetService
+	validator *mocks.MockContentValidator
+	leases    *mocks.MockEditingLeaseService
+	site      *entities.Site
+	page      *entities.Page
+}
+
+// newTestPageVersionUseCase creates a page version use case for page 1 of site 1 of tenant 1, accepting every
+// block as valid and without editing leases
+func newTestPageVersionUseCase(t *testing.T) (*PageVersionUseCase, *pageVersionTestRepos) {
+	repos := &pageVersionTestRepos{
+		sites:     &mocks.MockSiteRepository{},
+		pages:     &mocks.MockPageRepository{},
+		versions:  &mocks.MockPageVersionRepository{},
+		blocks:    &mocks.MockPageBlockRepository{},
+		snippets:  &mocks.MockSnippetService{},
+		validator: &mocks.MockContentValidator{},
+		leases:    &mocks.MockEditingLeaseService{},
+	}
+
+	domain, err := value_objects.NewDomainName("example.com")
+	assert.NoError(t, err)
+	repos.site, err = entities.NewSite("Site", nil, domain, entities.NewTemplateID(1), entities.NewTenantID(1))
+	assert.NoError(t, err)
+	assert.NoError(t, repos.site.SetID(entities.NewSiteID(1)))
+	repos.sites.On("FindByID", repos.site.ID()).Return(repos.site, nil)
+
+	key, err := value_objects.NewPageKey("about")
+	assert.NoError(t, err)
+	repos.page, err = entities.NewPage(key, nil, repos.site.ID(), entities.PageTypeContent)
+	assert.NoError(t, err)
+	repos.page.SetID(entities.NewPageID(1))
+	repos.pages.On("FindByID", repos.page.ID()).Return(repos.page, nil)
+
+	repos.validator.On("Validate", mock.Anything, mock.Anything).Return(nil)
+	repos.snippets.On("ValidateEmbed", mock.Anything, mock.Anything).Return(nil)
+	repos.leases.On("Find", mock.Anything).Return(nil, nil)
+
+	useCase := NewPageVersionUseCase(repos.pages, repos.versions, repos.blocks, repos.sites, repos.snippets, repos.validator, nil, repos.leases, nil, newTestLogger())
+	return useCase, repos
+}
+
+// newTestUser creates a user with the given Keycloak subject
+func newTestUser(t *testing.T, subject string) *entities.User {
+	keycloakID, err := value_objects.NewKeycloakID(subject)
+	assert.NoError(t, err)
+	role, err := value_objects.NewUserRole(value_objects.RoleTenantEditor)
+	assert.NoError(t, err)
+	user, err := entities.NewUser(keycloakID, role)
+	assert.NoError(t, err)
+	return user
+}
+
+// withVersion registers a version of the test page with the given revision and status, holding the blocks
+func (r *pageVersionTestRepos) withVersion(t *testing.T, id uint64, revision uint64, status entities.PageVersionStatus, blocks ...*entities.PageBlock) *entities.PageVersion {
+	version := newTestVersion(t, id, r.page, 1, entities.DefaultLocale, "About", status)
+	version.SetRevision(revision)
+	r.versions.On("FindByID", version.ID()).Return(version, nil)
+	r.blocks.On("FindByPageVersionID", version.ID()).Return(blocks, nil)
+	return version
+}
+
+// newStoredBlock creates a persisted block of the version
+func newStoredBlock(t *testing.T, id uint64, versionID uint64, key string, index int, content string) *entities.PageBlock {
+	block, err := entities.NewPageBlock(entities.NewPageVersionID(versionID), key, index, "text", content)
+	assert.NoError(t, err)
+	block.SetID(entities.NewPageBlockID(id))
+	return block
+}
+
+// allowWrites accepts every save and delete, recording the saved blocks in order
+func (r *pageVersionTestRepos) allowWrites() *[]*entities.PageBlock {
+	saved := &[]*entities.PageBlock{}
+	r.blocks.On("Save", mock.Anything).Run(func(args mock.Arguments) {
+		*saved = append(*saved, args.Get(0).(*entities.PageBlock))
+	}).Return(nil)
+	r.blocks.On("Delete", mock.Anything).Return(nil)
+	r.versions.On("Save", mock.Anything).Return(nil)
+	return saved
+}
+
+// assertNothingWritten checks that no block or version was saved or deleted
+func (r *pageVersionTestRepos) assertNothingWritten(t *testing.T) {
+	r.blocks.AssertNotCalled(t, "Save", mock.Anything)
+	r.blocks.AssertNotCalled(t, "Delete", mock.Anything)
+	r.versions.AssertNotCalled(t, "Save", mock.Anything)
+}
+
+func blockRequests(keys ...string) dto.ReplacePageBlocksRequest {
+	req := dto.ReplacePageBlocksRequest{}
+	for _, key := range keys {
+		req.Blocks = append(req.Blocks, dto.PageBlockRequest{BlockKey: key, ContentType: "text", Content: "new " + key})
+	}
+	return req
+}
+
+func TestPageVersionUseCase_ReplaceBlocks(t *testing.T) {
+	t.Run("duplicate keys are rejected before anything is written", func(t *testing.T) {
+		useCase, repos := newTestPageVersionUseCase(t)
+		repos.withVersion(t, 10, 3, entities.PageVersionStatusDraft)
+		repos.allowWrites()
+
+		version, err := useCase.ReplaceBlocks(1, 1, 1, 10, 3, blockRequests("intro", "body", "intro"), newTestUser(t, testEditorSubject))
+
+		assert.Nil(t, version)
+		assert.ErrorIs(t, err, domainErrors.ErrPageBlockKeyDuplicate)
+		repos.assertNothingWritten(t)
+	})
+
+	t.Run("blocks left out are deleted", func(t *testing.T) {
+		useCase, repos := newTestPageVersionUseCase(t)
+		intro := newStoredBlock(t, 1, 10, "intro", 0, "intro")
+		body := newStoredBlock(t, 2, 10, "body", 1, "body")
+		footer := newStoredBlock(t, 3, 10, "footer", 2, "footer")
+		repos.withVersion(t, 10, 3, entities.PageVersionStatusDraft, intro, body, footer)
+		repos.allowWrites()
+
+		_, err := useCase.ReplaceBlocks(1, 1, 1, 10, 3, blockRequests("footer", "intro"), newTestUser(t, testEditorSubject))
+
+		assert.NoError(t, err)
+		repos.blocks.AssertCalled(t, "Delete", body.ID())
+		repos.blocks.AssertNumberOfCalls(t, "Delete", 1)
+	})
+
+	t.Run("existing keys are updated and new keys are created", func(t *testing.T) {
+		useCase, repos := newTestPageVersionUseCase(t)
+		intro := newStoredBlock(t, 1, 10, "intro", 0, "old intro")
+		repos.withVersion(t, 10, 3, entities.PageVersionStatusDraft, intro)
+		saved := repos.allowWrites()
+
+		version, err := useCase.ReplaceBlocks(1, 1, 1, 10, 3, blockRequests("intro", "gallery"), newTestUser(t, testEditorSubject))
+
+		assert.NoError(t, err)
+		assert.Len(t, *saved, 2)
+		assert.Same(t, intro, (*saved)[0])
+		assert.Equal(t, "new intro", intro.Content())
+		assert.Equal(t, "gallery", (*saved)[1].BlockKey())
+		assert.True(t, (*saved)[1].ID().Value() == 0)
+		assert.Equal(t, version.ID().Value(), (*saved)[1].PageVersionID().Value())
+		assert.Len(t, version.Blocks(), 2)
+		repos.blocks.AssertNotCalled(t, "Delete", mock.Anything)
+	})
+
+	t.Run("indexes are compacted to the list positions", func(t *testing.T) {
+		useCase, repos := newTestPageVersionUseCase(t)
+		intro := newStoredBlock(t, 1, 10, "intro", 4, "intro")
+		body := newStoredBlock(t, 2, 10, "body", 9, "body")
+		repos.withVersion(t, 10, 3, entities.PageVersionStatusDraft, intro, body)
+		saved := repos.allowWrites()
+
+		_, err := useCase.ReplaceBlocks(1, 1, 1, 10, 3, blockRequests("body", "gallery", "intro"), newTestUser(t, testEditorSubject))
+
+		assert.NoError(t, err)
+		indexes := make(map[string]int, len(*saved))
+		for _, block := range *saved {
+			indexes[block.BlockKey()] = block.Index()
+		}
+		assert.Equal(t, map[string]int{"body": 0, "gallery": 1, "intro": 2}, indexes)
+	})
+
+	t.Run("invalid content is rejected before anything is written", func(t *testing.T) {
+		useCase, repos := newTestPageVersionUseCase(t)
+		repos.withVersion(t, 10, 3, entities.PageVersionStatusDraft, newStoredBlock(t, 1, 10, "intro", 0, "intro"))
+		repos.allowWrites()
+		repos.validator.ExpectedCalls = nil
+		contentErr := &domainErrors.BlockContentError{BlockKey: "body"}
+		repos.validator.On("Validate", mock.Anything, mock.Anything).Return(contentErr)
+
+		version, err := useCase.ReplaceBlocks(1, 1, 1, 10, 3, blockRequests("body"), newTestUser(t, testEditorSubject))
+
+		assert.Nil(t, version)
+		assert.ErrorIs(t, err, domainErrors.ErrBlockContentInvalid)
+		repos.assertNothingWritten(t)
+	})
+
+	t.Run("version that is not a draft is not editable", func(t *testing.T) {
+		useCase, repos := newTestPageVersionUseCase(t)
+		repos.withVersion(t, 10, 3, entities.PageVersionStatusPublished)
+		repos.allowWrites()
+
+		version, err := useCase.ReplaceBlocks(1, 1, 1, 10, 3, blockRequests("intro"), newTestUser(t, testEditorSubject))
+
+		assert.Nil(t, version)
+		assert.ErrorIs(t, err, domainErrors.ErrPageVersionNotEditable)
+		repos.assertNothingWritten(t)
+	})
+}
+
+func TestPageVersionUseCase_ReplaceBlocks_Concurrency(t *testing.T) {
+	t.Run("stale revision is rejected before anything is written", func(t *testing.T) {
+		useCase, repos := newTestPageVersionUseCase(t)
+		repos.withVersion(t, 10, 4, entities.PageVersionStatusDraft)
+		repos.allowWrites()
+
+		version, err := useCase.ReplaceBlocks(1, 1, 1, 10, 3, blockRequests("intro"), newTestUser(t, testEditorSubject))
+
+		assert.Nil(t, version)
+		assert.ErrorIs(t, err, domainErrors.ErrRevisionMismatch)
+		repos.assertNothingWritten(t)
+	})
+
+	t.Run("version is saved at the revision the client read", func(t *testing.T) {
+		useCase, repos := newTestPageVersionUseCase(t)
+		repos.withVersion(t, 10, 3, entities.PageVersionStatusDraft)
+		repos.blocks.On("Save", mock.Anything).Return(nil)
+		repos.versions.On("Save", mock.MatchedBy(func(version *entities.PageVersion) bool {
+			return version.Revision() == 3
+		})).Return(nil)
+
+		_, err := useCase.ReplaceBlocks(1, 1, 1, 10, 3, blockRequests("intro"), newTestUser(t, testEditorSubject))
+
+		assert.NoError(t, err)
+		repos.versions.AssertNumberOfCalls(t, "Save", 1)
+	})
+
+	t.Run("concurrent modification reported by the repository fails the replacement", func(t *testing.T) {
+		useCase, repos := newTestPageVersionUseCase(t)
+		repos.withVersion(t, 10, 3, entities.PageVersionStatusDraft)
+		repos.blocks.On("Save", mock.Anything).Return(nil)
+		repos.versions.On("Save", mock.Anything).Return(domainErrors.ErrConcurrentModification)
+
+		version, err := useCase.ReplaceBlocks(1, 1, 1, 10, 3, blockRequests("intro"), newTestUser(t, testEditorSubject))
+
+		assert.Nil(t, version)
+		assert.ErrorIs(t, err, domainErrors.ErrConcurrentModification)
+	})
+
+	t.Run("lease of another editor is rejected before anything is written", func(t *testing.T) {
+		useCase, repos := newTestPageVersionUseCase(t)
+		repos.withVersion(t, 10, 3, entities.PageVersionStatusDraft)
+		repos.allowWrites()
+		repos.leases.ExpectedCalls = nil
+		repos.leases.On("Find", entities.NewPageVersionID(10)).Return(entities.NewEditingLease(entities.NewPageVersionID(10), testOtherSubject, "Other Editor", time.Now().Add(time.Minute)), nil)
+
+		version, err := useCase.ReplaceBlocks(1, 1, 1, 10, 3, blockRequests("intro"), newTestUser(t, testEditorSubject))
+
+		assert.Nil(t, version)
+		var locked *domainErrors.PageVersionLockedError
+		assert.True(t, stderrors.As(err, &locked))
+		assert.Equal(t, "Other Editor", locked.HolderName)
+		repos.assertNothingWritten(t)
+	})
+
+	t.Run("lease of the editor is accepted", func(t *testing.T) {
+		useCase, repos := newTestPageVersionUseCase(t)
+		repos.withVersion(t, 10, 3, entities.PageVersionStatusDraft)
+		repos.allowWrites()
+		repos.leases.ExpectedCalls = nil
+		repos.leases.On("Find", entities.NewPageVersionID(10)).Return(entities.NewEditingLease(entities.NewPageVersionID(10), testEditorSubject, "Editor", time.Now().Add(time.Minute)), nil)
+
+		version, err := useCase.ReplaceBlocks(1, 1, 1, 10, 3, blockRequests("intro"), newTestUser(t, testEditorSubject))
+
+		assert.NoError(t, err)
+		assert.Len(t, version.Blocks(), 1)
+	})
+
+	t.Run("version of another page is not found", func(t *testing.T) {
+		useCase, repos := newTestPageVersionUseCase(t)
+		other, err := entities.NewPageVersion(entities.NewPageID(2), 1, entities.DefaultLocale, "Other", nil)
+		assert.NoError(t, err)
+		other.SetID(entities.NewPageVersionID(20))
+		repos.versions.On("FindByID", other.ID()).Return(other, nil)
+
+		version, err := useCase.ReplaceBlocks(1, 1, 1, 20, 0, blockRequests("intro"), newTestUser(t, testEditorSubject))
+
+		assert.Nil(t, version)
+		assert.ErrorIs(t, err, domainErrors.ErrPageVersionNotFound)
+		repos.assertNothingWritten(t)
+	})
+}
+
+func TestPageVersionUseCase_RestoreVersion(t *testing.T) {
+	t.Run("copies the version into a new draft", func(t *testing.T) {
+		useCase, repos := newTestPageVersionUseCase(t)
+		intro := newStoredBlock(t, 1, 10, "intro", 0, "intro")
+		body := newStoredBlock(t, 2, 10, "body", 1, "body")
+		source := repos.withVersion(t, 10, 3, entities.PageVersionStatusArchived, intro, body)
+		description := "About the company"
+		assert.NoError(t, source.UpdateTitle("About us"))
+		source.UpdateDescription(&description)
+		metaTitle := "About | Example"
+		assert.NoError(t, source.UpdateSEO(entities.PageSEO{MetaTitle: &metaTitle}))
+		latest := newTestVersion(t, 40, repos.page, 4, entities.DefaultLocale, "Latest", entities.PageVersionStatusPublished)
+		repos.versions.On("FindLatestByPageID", repos.page.ID()).Return(latest, nil)
+		var saved []*entities.PageBlock
+		repos.blocks.On("Save", mock.Anything).Run(func(args mock.Arguments) {
+			saved = append(saved, args.Get(0).(*entities.PageBlock))
+		}).Return(nil)
+		repos.versions.On("Save", mock.Anything).Run(func(args mock.Arguments) {
+			args.Get(0).(*entities.PageVersion).SetID(entities.NewPageVersionID(50))
+		}).Return(nil)
+
+		version, err := useCase.RestoreVersion(1, 1, 1, 10)
+
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(50), version.ID().Value())
+		assert.Equal(t, uint(5), version.Version())
+		assert.Equal(t, entities.PageVersionStatusDraft, version.Status())
+		assert.Equal(t, repos.page.ID(), version.PageID())
+		assert.Equal(t, source.Locale(), version.Locale())
+		assert.Equal(t, "About us", version.Title())
+		assert.Equal(t, &description, version.Description())
+		assert.Equal(t, &metaTitle, version.SEO().MetaTitle)
+
+		assert.Len(t, saved, 2)
+		for i, block := range saved {
+			assert.NotSame(t, source.Blocks()[i], block)
+			assert.True(t, block.ID().Value() == 0)
+			assert.Equal(t, uint64(50), block.PageVersionID().Value())
+			assert.Equal(t, source.Blocks()[i].BlockKey(), block.BlockKey())
+			assert.Equal(t, source.Blocks()[i].Index(), block.Index())
+			assert.Equal(t, source.Blocks()[i].Content(), block.Content())
+		}
+		assert.Len(t, version.Blocks(), 2)
+
+		assert.Equal(t, entities.PageVersionStatusArchived, source.Status())
+		assert.Equal(t, uint64(3), source.Revision())
+		assert.Equal(t, uint64(10), intro.PageVersionID().Value())
+		assert.Equal(t, uint64(10), body.PageVersionID().Value())
+		repos.versions.AssertNumberOfCalls(t, "Save", 1)
+		repos.versions.AssertNotCalled(t, "Save", source)
+	})
+
+	t.Run("first version of the page is numbered one", func(t *testing.T) {
+		useCase, repos := newTestPageVersionUseCase(t)
+		repos.withVersion(t, 10, 3, entities.PageVersionStatusPublished)
+		repos.versions.On("FindLatestByPageID", repos.page.ID()).Return(nil, nil)
+		repos.allowWrites()
+
+		version, err := useCase.RestoreVersion(1, 1, 1, 10)
+
+		assert.NoError(t, err)
+		assert.Equal(t, uint(1), version.Version())
+	})
+
+	t.Run("block no longer valid is rejected", func(t *testing.T) {
+		useCase, repos := newTestPageVersionUseCase(t)
+		repos.withVersion(t, 10, 3, entities.PageVersionStatusPublished, newStoredBlock(t, 1, 10, "intro", 0, "intro"))
+		repos.versions.On("FindLatestByPageID", repos.page.ID()).Return(nil, nil)
+		repos.allowWrites()
+		repos.validator.ExpectedCalls = nil
+		repos.validator.On("Validate", entities.NewTenantID(1), mock.Anything).Return(&domainErrors.BlockContentError{BlockKey: "intro"})
+
+		version, err := useCase.RestoreVersion(1, 1, 1, 10)
+
+		assert.Nil(t, version)
+		assert.ErrorIs(t, err, domainErrors.ErrBlockContentInvalid)
+		repos.blocks.AssertNotCalled(t, "Save", mock.Anything)
+	})
+
+	t.Run("snippet no longer embeddable is rejected", func(t *testing.T) {
+		useCase, repos := newTestPageVersionUseCase(t)
+		snippet := newStoredBlock(t, 1, 10, "footer", 0, "footer")
+		repos.withVersion(t, 10, 3, entities.PageVersionStatusPublished, snippet)
+		repos.versions.On("FindLatestByPageID", repos.page.ID()).Return(nil, nil)
+		repos.allowWrites()
+		repos.snippets.ExpectedCalls = nil
+		repos.snippets.On("ValidateEmbed", repos.page, mock.Anything).Return(domainErrors.ErrPageSnippetCycle)
+
+		version, err := useCase.RestoreVersion(1, 1, 1, 10)
+
+		assert.Nil(t, version)
+		assert.ErrorIs(t, err, domainErrors.ErrPageSnippetCycle)
+		repos.snippets.AssertCalled(t, "ValidateEmbed", repos.page, mock.MatchedBy(func(block *entities.PageBlock) bool {
+			return block.BlockKey() == "footer"
+		}))
+		repos.blocks.AssertNotCalled(t, "Save", mock.Anything)
+	})
+
+	testCases := []struct {
+		name     string
+		tenantID uint64
+		siteID   uint64
+		setup    func(t *testing.T, repos *pageVersionTestRepos)
+		expected error
+	}{
+		{
+			name:     "version of another page",
+			tenantID: 1,
+			siteID:   1,
+			setup: func(t *testing.T, repos *pageVersionTestRepos) {
+				other, err := entities.NewPageVersion(entities.NewPageID(2), 1, entities.DefaultLocale, "Other", nil)
+				assert.NoError(t, err)
+				other.SetID(entities.NewPageVersionID(10))
+				repos.versions.On("FindByID", other.ID()).Return(other, nil)
+			},
+			expected: domainErrors.ErrPageVersionNotFound,
+		},
+		{
+			name:     "site of another tenant",
+			tenantID: 2,
+			siteID:   1,
+			setup: func(t *testing.T, repos *pageVersionTestRepos) {
+				repos.withVersion(t, 10, 3, entities.PageVersionStatusPublished)
+			},
+			expected: domainErrors.ErrSiteNotFound,
+		},
+		{
+			name:     "page of another site",
+			tenantID: 1,
+			siteID:   2,
+			setup: func(t *testing.T, repos *pageVersionTestRepos) {
+				domain, err := value_objects.NewDomainName("other.example.com")
+				assert.NoError(t, err)
+				other, err := entities.NewSite("Other", nil, domain, entities.NewTemplateID(1), entities.NewTenantID(1))
+				assert.NoError(t, err)
+				assert.NoError(t, other.SetID(entities.NewSiteID(2)))
+				repos.sites.On("FindByID", other.ID()).Return(other, nil)
+				repos.withVersion(t, 10, 3, entities.PageVersionStatusPublished)
+			},
+			expected: domainErrors.ErrPageNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name+" is rejected", func(t *testing.T) {
+			useCase, repos := newTestPageVersionUseCase(t)
+			tc.setup(t, repos)
+			repos.allowWrites()
+
+			version, err := useCase.RestoreVersion(tc.tenantID, tc.siteID, 1, 10)
+
+			assert.Nil(t, version)
+			assert.ErrorIs(t, err, tc.expected)
+			repos.assertNothingWritten(t)
+		})
+	}
+}
+
+func TestPageVersionUseCase_ApplySchedule(t *testing.T) {
+	scheduledBy := entities.NewUserID(7)
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	due := now.Add(-time.Minute)
+
+	t.Run("due publish is recorded at the given time", func(t *testing.T) {
+		useCase, repos := newTestPageVersionUseCase(t)
+		indexer := &mocks.MockSearchIndexer{}
+		indexer.On("Reindex", repos.page).Return(nil)
+		useCase.searchIndexer = indexer
+		version := repos.withVersion(t, 10, 3, entities.PageVersionStatusApproved)
+		version.SetSchedule(&due, nil, &scheduledBy)
+		current := newTestVersion(t, 9, repos.page, 1, entities.DefaultLocale, "About", entities.PageVersionStatusPublished)
+		repos.versions.On("FindPublishedByPageID", repos.page.ID(), entities.DefaultLocale).Return(current, nil)
+		repos.allowWrites()
+
+		changed, err := useCase.ApplySchedule(10, now)
+
+		assert.NoError(t, err)
+		assert.True(t, changed)
+		assert.Equal(t, entities.PageVersionStatusPublished, version.Status())
+		assert.Equal(t, now, *version.StatusChangedAt())
+		assert.Equal(t, scheduledBy, *version.StatusChangedBy())
+		assert.Equal(t, entities.PageVersionStatusArchived, current.Status())
+		assert.Equal(t, now, *current.StatusChangedAt())
+	})
+
+	t.Run("due unpublish is recorded at the given time", func(t *testing.T) {
+		useCase, repos := newTestPageVersionUseCase(t)
+		indexer := &mocks.MockSearchIndexer{}
+		indexer.On("Reindex", repos.page).Return(nil)
+		useCase.searchIndexer = indexer
+		version := repos.withVersion(t, 10, 3, entities.PageVersionStatusPublished)
+		version.SetSchedule(nil, &due, &scheduledBy)
+		repos.allowWrites()
+
+		changed, err := useCase.ApplySchedule(10, now)
+
+		assert.NoError(t, err)
+		assert.True(t, changed)
+		assert.Equal(t, entities.PageVersionStatusArchived, version.Status())
+		assert.Equal(t, now, *version.StatusChangedAt())
+	})
+
+	t.Run("schedule not yet due is left alone", func(t *testing.T) {
+		useCase, repos := newTestPageVersionUseCase(t)
+		version := repos.withVersion(t, 10, 3, entities.PageVersionStatusApproved)
+		later := now.Add(time.Minute)
+		version.SetSchedule(&later, nil, &scheduledBy)
+
+		changed, err := useCase.ApplySchedule(10, now)
+
+		assert.NoError(t, err)
+		assert.False(t, changed)
+		assert.Nil(t, version.StatusChangedAt())
+		repos.assertNothingWritten(t)
+	})
+}
@@ -0,0 +1,132 @@
+package use_cases
+
+import (
+	stderrors "errors"
+	"github.com/h4rdc0m/aurora-api/application/dto"
+	"github.com/h4rdc0m/aurora-api/domain/common"
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"github.com/h4rdc0m/aurora-api/domain/errors"
+	"github.com/h4rdc0m/aurora-api/domain/repositories"
+	"github.com/h4rdc0m/aurora-api/domain/services"
+	"time"
+)
+
+// Lifetimes of preview tokens
+const (
+	defaultPreviewTokenLifetime = 7 * 24 * time.Hour
+	minPreviewTokenLifetime     = time.Minute
+	maxPreviewTokenLifetime     = 30 * 24 * time.Hour
+)
+
+// PreviewTokenUseCase manages the preview tokens editors share to show unpublished content of a site
+type PreviewTokenUseCase struct {
+	siteRepo        repositories.SiteRepository
+	pageRepo        repositories.PageRepository
+	pageVersionRepo repositories.PageVersionRepository
+	previewTokens   services.PreviewTokenService
+	logger          common.Logger
+}
+
+// NewPreviewTokenUseCase creates a new PreviewTokenUseCase
+func NewPreviewTokenUseCase(
+	siteRepo repositories.SiteRepository,
+	pageRepo repositories.PageRepository,
+	pageVersionRepo repositories.PageVersionRepository,
+	previewTokens services.PreviewTokenService,
+	logger common.Logger,
+) *PreviewTokenUseCase {
+	return &PreviewTokenUseCase{
+		siteRepo:        siteRepo,
+		pageRepo:        pageRepo,
+		pageVersionRepo: pageVersionRepo,
+		previewTokens:   previewTokens,
+		logger:          logger,
+	}
+}
+
+// GetPreviewTokens retrieves the preview tokens of a site that have not expired or been revoked
+func (u *PreviewTokenUseCase) GetPreviewTokens(tenantID, siteID uint64) ([]*entities.PreviewToken, error) {
+	site, err := findTenantSite(u.siteRepo, u.logger, tenantID, siteID)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.previewTokens.FindBySiteID(site.ID())
+}
+
+// CreatePreviewToken issues a preview token for a page version of the site, or for the whole site, on behalf of the
+// user and returns it together with its signed form. The signed form is only available now; it is not stored.
+func (u *PreviewTokenUseCase) CreatePreviewToken(tenantID, siteID uint64, req dto.CreatePreviewTokenRequest, user *entities.User) (*entities.PreviewToken, string, error) {
+	site, err := findTenantSite(u.siteRepo, u.logger, tenantID, siteID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	scope, err := entities.NewPreviewScope(req.Scope)
+	if err != nil {
+		return nil, "", err
+	}
+
+	lifetime := defaultPreviewTokenLifetime
+	if req.ExpiresIn != nil {
+		// Bound the seconds before converting them, so that huge values cannot overflow into a valid lifetime
+		if *req.ExpiresIn < int64(minPreviewTokenLifetime/time.Second) || *req.ExpiresIn > int64(maxPreviewTokenLifetime/time.Second) {
+			return nil, "", errors.ErrPreviewTokenLifetimeInvalid
+		}
+		lifetime = time.Duration(*req.ExpiresIn) * time.Second
+	}
+
+	var pageID *entities.PageID
+	var versionID *entities.PageVersionID
+	if req.VersionID != nil {
+		version, err := u.findSiteVersion(site, *req.VersionID)
+		if err != nil {
+			return nil, "", err
+		}
+		id, shared := version.PageID(), version.ID()
+		pageID, versionID = &id, &shared
+	}
+
+	token, err := entities.NewPreviewToken(site.ID(), scope, pageID, versionID, req.Label, user.ID(), time.Now().Add(lifetime))
+	if err != nil {
+		return nil, "", err
+	}
+
+	signed, err := u.previewTokens.Issue(token)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return token, signed, nil
+}
+
+// RevokePreviewToken withdraws a preview token of a site
+func (u *PreviewTokenUseCase) RevokePreviewToken(tenantID, siteID uint64, tokenID string) error {
+	site, err := findTenantSite(u.siteRepo, u.logger, tenantID, siteID)
+	if err != nil {
+		return err
+	}
+
+	return u.previewTokens.Revoke(site.ID(), tokenID)
+}
+
+// findSiteVersion retrieves a page version and verifies its page belongs to the site
+func (u *PreviewTokenUseCase) findSiteVersion(site *entities.Site, versionID uint64) (*entities.PageVersion, error) {
+	version, err := u.pageVersionRepo.FindByID(entities.NewPageVersionID(versionID))
+	if err != nil {
+		u.logger.Error("Failed to find page version", "versionID", versionID, "error", err)
+		return nil, err
+	}
+	if version == nil {
+		return nil, errors.ErrPageVersionNotFound
+	}
+
+	if _, err := findSitePage(u.pageRepo, u.logger, site, version.PageID().Value()); err != nil {
+		if stderrors.Is(err, errors.ErrPageNotFound) {
+			return nil, errors.ErrPageVersionNotFound
+		}
+		return nil, err
+	}
+
+	return version, nil
+}
@@ -0,0 +1,399 @@
+package use_cases
+
+import (
+	"encoding/csv"
+	stderrors "errors"
+	"github.com/h4rdc0m/aurora-api/application/dto"
+	"github.com/h4rdc0m/aurora-api/domain/common"
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"github.com/h4rdc0m/aurora-api/domain/errors"
+	"github.com/h4rdc0m/aurora-api/domain/repositories"
+	"github.com/jmoiron/sqlx"
+	"io"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// maxRedirectImportRows bounds the number of redirects in a single CSV import
+const maxRedirectImportRows = 10000
+
+// RedirectUseCase manages the redirects of the sites of a tenant
+type RedirectUseCase struct {
+	redirectRepo repositories.RedirectRepository
+	siteRepo     repositories.SiteRepository
+	pageRepo     repositories.PageRepository
+	logger       common.Logger
+}
+
+// NewRedirectUseCase creates a new RedirectUseCase
+func NewRedirectUseCase(
+	redirectRepo repositories.RedirectRepository,
+	siteRepo repositories.SiteRepository,
+	pageRepo repositories.PageRepository,
+	logger common.Logger,
+) *RedirectUseCase {
+	return &RedirectUseCase{
+		redirectRepo: redirectRepo,
+		siteRepo:     siteRepo,
+		pageRepo:     pageRepo,
+		logger:       logger,
+	}
+}
+
+// WithTrx returns a copy of the use case that runs all repository calls inside the given transaction
+func (u *RedirectUseCase) WithTrx(trxHandle *sqlx.Tx) *RedirectUseCase {
+	return &RedirectUseCase{
+		redirectRepo: u.redirectRepo.WithTrx(trxHandle),
+		siteRepo:     u.siteRepo.WithTrx(trxHandle),
+		pageRepo:     u.pageRepo.WithTrx(trxHandle),
+		logger:       u.logger,
+	}
+}
+
+// GetRedirects retrieves all redirects of a site ordered by source path
+func (u *RedirectUseCase) GetRedirects(tenantID, siteID uint64) ([]*entities.Redirect, error) {
+	site, err := findTenantSite(u.siteRepo, u.logger, tenantID, siteID)
+	if err != nil {
+		return nil, err
+	}
+
+	redirects, err := u.redirectRepo.FindBySiteID(site.ID())
+	if err != nil {
+		u.logger.Error("Failed to get redirects", "siteID", siteID, "error", err)
+		return nil, err
+	}
+
+	return redirects, nil
+}
+
+// GetRedirect retrieves a single redirect of a site
+func (u *RedirectUseCase) GetRedirect(tenantID, siteID, redirectID uint64) (*entities.Redirect, error) {
+	site, err := findTenantSite(u.siteRepo, u.logger, tenantID, siteID)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.findRedirect(site, redirectID)
+}
+
+// CreateRedirect creates a manual redirect for a path of the site
+func (u *RedirectUseCase) CreateRedirect(tenantID, siteID uint64, req dto.RedirectRequest) (*entities.Redirect, error) {
+	site, err := findTenantSite(u.siteRepo, u.logger, tenantID, siteID)
+	if err != nil {
+		return nil, err
+	}
+
+	sourcePath, targetPageID, targetURL, statusCode, err := u.parseRequest(site, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := u.ensureSourceAvailable(site, sourcePath, nil); err != nil {
+		return nil, err
+	}
+
+	redirect, err := entities.NewRedirect(site.ID(), sourcePath, targetPageID, targetURL, statusCode)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.redirectRepo.Save(redirect); err != nil {
+		u.logger.Error("Failed to save redirect", "siteID", siteID, "error", err)
+		return nil, err
+	}
+
+	return redirect, nil
+}
+
+// UpdateRedirect replaces the source, target and status code of a redirect
+func (u *RedirectUseCase) UpdateRedirect(tenantID, siteID, redirectID uint64, req dto.RedirectRequest) (*entities.Redirect, error) {
+	site, err := findTenantSite(u.siteRepo, u.logger, tenantID, siteID)
+	if err != nil {
+		return nil, err
+	}
+
+	redirect, err := u.findRedirect(site, redirectID)
+	if err != nil {
+		return nil, err
+	}
+
+	sourcePath, targetPageID, targetURL, statusCode, err := u.parseRequest(site, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := u.ensureSourceAvailable(site, sourcePath, redirect); err != nil {
+		return nil, err
+	}
+
+	if err := redirect.Update(sourcePath, targetPageID, targetURL, statusCode); err != nil {
+		return nil, err
+	}
+
+	if err := u.redirectRepo.Save(redirect); err != nil {
+		u.logger.Error("Failed to save redirect", "redirectID", redirectID, "error", err)
+		return nil, err
+	}
+
+	return redirect, nil
+}
+
+// DeleteRedirect deletes a redirect
+func (u *RedirectUseCase) DeleteRedirect(tenantID, siteID, redirectID uint64) error {
+	site, err := findTenantSite(u.siteRepo, u.logger, tenantID, siteID)
+	if err != nil {
+		return err
+	}
+
+	redirect, err := u.findRedirect(site, redirectID)
+	if err != nil {
+		return err
+	}
+
+	if err := u.redirectRepo.Delete(redirect.ID()); err != nil {
+		u.logger.Error("Failed to delete redirect", "redirectID", redirectID, "error", err)
+		return err
+	}
+
+	return nil
+}
+
+// ImportRedirects creates or replaces the redirects listed in CSV rows of source path, target and status code.
+// An optional header row is skipped. The target is a URL, a path of the site, which targets the page at that path
+// when there is one, or empty for gone redirects; the status code may be left empty. Redirects whose source path
+// already exists are replaced. The first invalid row aborts the import with a RedirectImportError, so the import
+// must run in a transaction to be all or nothing, see WithTrx.
+func (u *RedirectUseCase) ImportRedirects(tenantID, siteID uint64, input io.Reader) (*entities.RedirectImport, error) {
+	site, err := findTenantSite(u.siteRepo, u.logger, tenantID, siteID)
+	if err != nil {
+		return nil, err
+	}
+
+	reader := csv.NewReader(input)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	result := &entities.RedirectImport{}
+	imported := make(map[string]bool)
+	for rows := 0; ; {
+		record, err := reader.Read()
+		if stderrors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			var parseErr *csv.ParseError
+			line := 0
+			if stderrors.As(err, &parseErr) {
+				line = parseErr.Line
+			}
+			return nil, &errors.RedirectImportError{Line: line, Err: errors.ErrRedirectImportInvalid}
+		}
+		line, _ := reader.FieldPos(0)
+		if line == 1 {
+			// Spreadsheet exports may start with a byte order mark
+			record[0] = strings.TrimPrefix(record[0], "\ufeff")
+		}
+
+		if isBlankRecord(record) || (line == 1 && isRedirectImportHeader(record)) {
+			continue
+		}
+		if rows++; rows > maxRedirectImportRows {
+			return nil, errors.ErrRedirectImportTooLarge
+		}
+
+		created, err := u.importRedirect(site, record, imported)
+		if err != nil {
+			return nil, &errors.RedirectImportError{Line: line, Err: err}
+		}
+		if created {
+			result.Created++
+		} else {
+			result.Updated++
+		}
+	}
+
+	return result, nil
+}
+
+// importRedirect saves the redirect of a CSV row and reports whether it was created rather than replaced.
+// Source paths imported earlier in the same file cannot be repeated.
+func (u *RedirectUseCase) importRedirect(site *entities.Site, record []string, imported map[string]bool) (bool, error) {
+	req := dto.RedirectRequest{SourcePath: record[0]}
+	if len(record) > 1 {
+		if target := strings.TrimSpace(record[1]); target != "" {
+			req.TargetURL = &target
+		}
+	}
+	if len(record) > 2 && strings.TrimSpace(record[2]) != "" {
+		statusCode, err := strconv.Atoi(strings.TrimSpace(record[2]))
+		if err != nil {
+			return false, errors.ErrRedirectStatusInvalid
+		}
+		req.StatusCode = statusCode
+	}
+
+	sourcePath, targetPageID, targetURL, statusCode, err := u.parseRequest(site, req)
+	if err != nil {
+		return false, err
+	}
+	if imported[sourcePath] {
+		return false, errors.ErrRedirectSourceAlreadyExists
+	}
+	imported[sourcePath] = true
+
+	// Site paths of existing pages target the page itself, so the redirect follows later moves
+	if targetURL != nil && strings.HasPrefix(*targetURL, "/") {
+		targetPath, err := normalizePagePath(*targetURL)
+		if err != nil {
+			return false, errors.ErrRedirectTargetURLInvalid
+		}
+		page, err := u.pageRepo.FindByPath(targetPath, site.ID())
+		if err != nil {
+			u.logger.Error("Failed to find redirect target page", "siteID", site.ID().Value(), "path", targetPath, "error", err)
+			return false, err
+		}
+		if page != nil && page.Type() != entities.PageTypeSnippet {
+			pageID := page.ID()
+			targetPageID, targetURL = &pageID, nil
+		}
+	}
+
+	redirect, err := u.redirectRepo.FindBySourcePath(site.ID(), sourcePath)
+	if err != nil {
+		u.logger.Error("Failed to find redirect by source path", "siteID", site.ID().Value(), "error", err)
+		return false, err
+	}
+
+	created := redirect == nil
+	if created {
+		redirect, err = entities.NewRedirect(site.ID(), sourcePath, targetPageID, targetURL, statusCode)
+	} else {
+		err = redirect.Update(sourcePath, targetPageID, targetURL, statusCode)
+	}
+	if err != nil {
+		return false, err
+	}
+
+	if err := u.redirectRepo.Save(redirect); err != nil {
+		u.logger.Error("Failed to save imported redirect", "siteID", site.ID().Value(), "error", err)
+		return false, err
+	}
+
+	return created, nil
+}
+
+// parseRequest normalizes the source path, checks the targets and applies the default status code
+func (u *RedirectUseCase) parseRequest(site *entities.Site, req dto.RedirectRequest) (string, *entities.PageID, *string, int, error) {
+	sourcePath, err := normalizeRedirectSource(req.SourcePath)
+	if err != nil {
+		return "", nil, nil, 0, err
+	}
+
+	var targetPageID *entities.PageID
+	if req.TargetPageID != nil {
+		page, err := u.findTargetPage(site, *req.TargetPageID)
+		if err != nil {
+			return "", nil, nil, 0, err
+		}
+		id := page.ID()
+		targetPageID = &id
+	}
+
+	var targetURL *string
+	if req.TargetURL != nil {
+		target := strings.TrimSpace(*req.TargetURL)
+		if !isValidLinkURL(target) {
+			return "", nil, nil, 0, errors.ErrRedirectTargetURLInvalid
+		}
+		targetURL = &target
+	}
+
+	statusCode := req.StatusCode
+	if statusCode == 0 {
+		statusCode = entities.RedirectStatusMovedPermanently
+		if targetPageID == nil && targetURL == nil {
+			statusCode = entities.RedirectStatusGone
+		}
+	}
+
+	return sourcePath, targetPageID, targetURL, statusCode, nil
+}
+
+// findTargetPage retrieves a page of any site of the tenant owning the site
+func (u *RedirectUseCase) findTargetPage(site *entities.Site, pageID uint64) (*entities.Page, error) {
+	page, err := u.pageRepo.FindByID(entities.NewPageID(pageID))
+	if err != nil {
+		u.logger.Error("Failed to find redirect target page", "pageID", pageID, "error", err)
+		return nil, err
+	}
+	if page == nil {
+		return nil, errors.ErrRedirectTargetPageNotFound
+	}
+	if page.SiteID().Value() == site.ID().Value() {
+		return page, nil
+	}
+
+	if _, err := findTenantSite(u.siteRepo, u.logger, site.TenantID().Value(), page.SiteID().Value()); err != nil {
+		if stderrors.Is(err, errors.ErrSiteNotFound) {
+			return nil, errors.ErrRedirectTargetPageNotFound
+		}
+		return nil, err
+	}
+	return page, nil
+}
+
+// ensureSourceAvailable rejects a source path that another redirect of the site already uses
+func (u *RedirectUseCase) ensureSourceAvailable(site *entities.Site, sourcePath string, redirect *entities.Redirect) error {
+	existing, err := u.redirectRepo.FindBySourcePath(site.ID(), sourcePath)
+	if err != nil {
+		u.logger.Error("Failed to find redirect by source path", "siteID", site.ID().Value(), "error", err)
+		return err
+	}
+	if existing != nil && (redirect == nil || existing.ID().Value() != redirect.ID().Value()) {
+		return errors.ErrRedirectSourceAlreadyExists
+	}
+	return nil
+}
+
+// findRedirect retrieves a redirect and verifies it belongs to the given site
+func (u *RedirectUseCase) findRedirect(site *entities.Site, redirectID uint64) (*entities.Redirect, error) {
+	redirect, err := u.redirectRepo.FindByID(entities.NewRedirectID(redirectID))
+	if err != nil {
+		u.logger.Error("Failed to find redirect", "redirectID", redirectID, "error", err)
+		return nil, err
+	}
+	if redirect == nil || redirect.SiteID().Value() != site.ID().Value() {
+		return nil, errors.ErrRedirectNotFound
+	}
+	return redirect, nil
+}
+
+// normalizeRedirectSource turns a source path, or the URL of a former site, into the form matched against
+// delivered paths. The query string is dropped; the root path cannot be redirected.
+func normalizeRedirectSource(source string) (string, error) {
+	source = strings.TrimSpace(source)
+	if parsed, err := url.Parse(source); err == nil && (parsed.Host != "" || parsed.RawQuery != "" || parsed.Fragment != "") {
+		source = parsed.Path
+	}
+
+	source, err := normalizePagePath(source)
+	if err != nil || source == "/" || len(source) > entities.MaxRedirectSourceLength {
+		return "", errors.ErrRedirectSourceInvalid
+	}
+	return source, nil
+}
+
+// isRedirectImportHeader reports whether the row is a header naming the columns rather than a redirect
+func isRedirectImportHeader(record []string) bool {
+	first := strings.ToLower(strings.TrimSpace(record[0]))
+	return first == "source" || first == "source_path"
+}
+
+// isBlankRecord reports whether all fields of the row are empty
+func isBlankRecord(record []string) bool {
+	for _, field := range record {
+		if strings.TrimSpace(field) != "" {
+			return false
+		}
+	}
+	return true
+}
@@ -1,30 +1,55 @@
 package use_cases
 
 import (
-	"errors"
 	"github.com/h4rdc0m/aurora-api/domain/common"
 	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"github.com/h4rdc0m/aurora-api/domain/errors"
 	"github.com/h4rdc0m/aurora-api/domain/repositories"
+	"github.com/h4rdc0m/aurora-api/domain/services"
 	"github.com/h4rdc0m/aurora-api/domain/value_objects"
+	"github.com/jmoiron/sqlx"
+	"strings"
+	"time"
 )
 
 // SiteUseCase handles site business logic
 type SiteUseCase struct {
-	siteRepo   repositories.SiteRepository
-	tenantRepo repositories.TenantRepository
-	logger     common.Logger
+	siteRepo        repositories.SiteRepository
+	tenantRepo      repositories.TenantRepository
+	pageRepo        repositories.PageRepository
+	pageVersionRepo repositories.PageVersionRepository
+	searchIndexer   services.SearchIndexer
+	logger          common.Logger
 }
 
 // NewSiteUseCase creates a new SiteUseCase
 func NewSiteUseCase(
 	siteRepo repositories.SiteRepository,
 	tenantRepo repositories.TenantRepository,
+	pageRepo repositories.PageRepository,
+	pageVersionRepo repositories.PageVersionRepository,
+	searchIndexer services.SearchIndexer,
 	logger common.Logger,
 ) *SiteUseCase {
 	return &SiteUseCase{
-		siteRepo:   siteRepo,
-		tenantRepo: tenantRepo,
-		logger:     logger,
+		siteRepo:        siteRepo,
+		tenantRepo:      tenantRepo,
+		pageRepo:        pageRepo,
+		pageVersionRepo: pageVersionRepo,
+		searchIndexer:   searchIndexer,
+		logger:          logger,
+	}
+}
+
+// WithTrx returns a copy of the use case that runs all repository calls inside the given transaction
+func (u *SiteUseCase) WithTrx(trxHandle *sqlx.Tx) *SiteUseCase {
+	return &SiteUseCase{
+		siteRepo:        u.siteRepo.WithTrx(trxHandle),
+		tenantRepo:      u.tenantRepo,
+		pageRepo:        u.pageRepo.WithTrx(trxHandle),
+		pageVersionRepo: u.pageVersionRepo.WithTrx(trxHandle),
+		searchIndexer:   u.searchIndexer.WithTrx(trxHandle),
+		logger:          u.logger,
 	}
 }
 
@@ -33,10 +58,11 @@ func (u *SiteUseCase) CreateSite(name string, description *string, domainStr str
 	// Validate tenant exists
 	tenant, err := u.tenantRepo.FindByID(entities.NewTenantID(tenantID))
 	if err != nil {
+		u.logger.Error("Failed to find tenant for site", "tenantID", tenantID, "error", err)
 		return nil, err
 	}
 	if tenant == nil {
-		return nil, errors.New("tenant not found")
+		return nil, errors.ErrTenantNotFound
 	}
 
 	// Create domain value object
@@ -48,10 +74,11 @@ func (u *SiteUseCase) CreateSite(name string, description *string, domainStr str
 	// Check if domain already exists
 	exists, err := u.siteRepo.ExistsByDomain(domain)
 	if err != nil {
+		u.logger.Error("Failed to check site domain", "domain", domainStr, "error", err)
 		return nil, err
 	}
 	if exists {
-		return nil, errors.New("site with this domain already exists")
+		return nil, errors.ErrSiteDomainAlreadyExists
 	}
 
 	// Create new site entity
@@ -68,15 +95,16 @@ func (u *SiteUseCase) CreateSite(name string, description *string, domainStr str
 
 	// Save site
 	if err := u.siteRepo.Save(site); err != nil {
+		u.logger.Error("Failed to save site", "domain", domainStr, "error", err)
 		return nil, err
 	}
 
 	return site, nil
 }
 
-// GetSite retrieves a site by ID
-func (u *SiteUseCase) GetSite(id uint64) (*entities.Site, error) {
-	return u.siteRepo.FindByID(entities.NewSiteID(id))
+// GetSite retrieves a site of a tenant by ID
+func (u *SiteUseCase) GetSite(tenantID, id uint64) (*entities.Site, error) {
+	return u.findSite(tenantID, id)
 }
 
 // GetSiteByDomain retrieves a site by domain
@@ -99,15 +127,16 @@ func (u *SiteUseCase) GetEnabledSitesByTenant(tenantID uint64) ([]*entities.Site
 	return u.siteRepo.FindEnabledByTenantID(entities.NewTenantID(tenantID))
 }
 
-// UpdateSite updates a site
-func (u *SiteUseCase) UpdateSite(id uint64, name string, description *string, domainStr string, templateID uint64) (*entities.Site, error) {
+// UpdateSite updates the given revision of a site of a tenant
+func (u *SiteUseCase) UpdateSite(tenantID, id, revision uint64, name string, description *string, domainStr string, templateID uint64) (*entities.Site, error) {
 	// Get existing site
-	site, err := u.siteRepo.FindByID(entities.NewSiteID(id))
+	site, err := u.findSite(tenantID, id)
 	if err != nil {
 		return nil, err
 	}
-	if site == nil {
-		return nil, errors.New("site not found")
+
+	if err := checkRevision(site.Revision(), revision); err != nil {
+		return nil, err
 	}
 
 	// Update name if provided
@@ -136,7 +165,7 @@ func (u *SiteUseCase) UpdateSite(id uint64, name string, description *string, do
 			return nil, err
 		}
 		if existingSite != nil && existingSite.ID().Value() != site.ID().Value() {
-			return nil, errors.New("site with this domain already exists")
+			return nil, errors.ErrSiteDomainAlreadyExists
 		}
 
 		if err := site.UpdateDomain(domain); err != nil {
@@ -151,52 +180,203 @@ func (u *SiteUseCase) UpdateSite(id uint64, name string, description *string, do
 
 	// Save updated site
 	if err := u.siteRepo.Save(site); err != nil {
+		u.logger.Error("Failed to save updated site", "id", id, "error", err)
 		return nil, err
 	}
 
 	return site, nil
 }
 
-// DeleteSite deletes a site
-func (u *SiteUseCase) DeleteSite(id uint64) error {
-	siteID := entities.NewSiteID(id)
-
+// DeleteSite moves the given revision of a site of a tenant to the trash, together with all its pages and their
+// versions. The pages leave the search index until the site is restored.
+func (u *SiteUseCase) DeleteSite(tenantID, id, revision uint64) error {
 	// Check if site exists
-	site, err := u.siteRepo.FindByID(siteID)
+	site, err := u.findSite(tenantID, id)
 	if err != nil {
 		return err
 	}
-	if site == nil {
-		return errors.New("site not found")
+
+	if err := checkRevision(site.Revision(), revision); err != nil {
+		return err
 	}
 
-	return u.siteRepo.Delete(siteID)
+	pages, err := u.pageRepo.FindBySiteID(site.ID())
+	if err != nil {
+		u.logger.Error("Failed to find pages of site", "id", id, "error", err)
+		return err
+	}
+
+	deletedAt := time.Now()
+	for _, page := range pages {
+		if err := trashPage(u.pageRepo, u.pageVersionRepo, u.searchIndexer, u.logger, page, deletedAt); err != nil {
+			return err
+		}
+	}
+
+	site.MoveToTrash(deletedAt)
+	if err := u.siteRepo.Save(site); err != nil {
+		u.logger.Error("Failed to move site to trash", "id", id, "error", err)
+		return err
+	}
+
+	return nil
 }
 
-// EnableSite enables a site
-func (u *SiteUseCase) EnableSite(id uint64) error {
-	site, err := u.siteRepo.FindByID(entities.NewSiteID(id))
+// UpdateRobotsTxt sets the robots.txt rules of the given revision of a site of a tenant. Blank rules restore the
+// default rules.
+func (u *SiteUseCase) UpdateRobotsTxt(tenantID, id, revision uint64, robotsTxt *string) (*entities.Site, error) {
+	site, err := u.findSite(tenantID, id)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	if err := checkRevision(site.Revision(), revision); err != nil {
+		return nil, err
+	}
+
+	if robotsTxt != nil && strings.TrimSpace(*robotsTxt) == "" {
+		robotsTxt = nil
 	}
-	if site == nil {
-		return errors.New("site not found")
+	site.UpdateRobotsTxt(robotsTxt)
+	if err := u.siteRepo.Save(site); err != nil {
+		u.logger.Error("Failed to update site robots.txt", "id", id, "error", err)
+		return nil, err
+	}
+
+	return site, nil
+}
+
+// UpdateTitleTemplate sets the template the page titles of the given revision of a site of a tenant are rendered
+// with. A blank template
+// renders plain page titles.
+func (u *SiteUseCase) UpdateTitleTemplate(tenantID, id, revision uint64, titleTemplate *string) (*entities.Site, error) {
+	site, err := u.findSite(tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkRevision(site.Revision(), revision); err != nil {
+		return nil, err
+	}
+
+	if err := site.UpdateTitleTemplate(titleTemplate); err != nil {
+		return nil, err
+	}
+	if err := u.siteRepo.Save(site); err != nil {
+		u.logger.Error("Failed to update site title template", "id", id, "error", err)
+		return nil, err
+	}
+
+	return site, nil
+}
+
+// UpdateLocales sets the enabled locales, the default locale and the fallback chain of the given revision of a
+// site of a tenant
+func (u *SiteUseCase) UpdateLocales(tenantID, id, revision uint64, defaultLocale string, locales []string, fallbackLocales []string) (*entities.Site, error) {
+	site, err := u.findSite(tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkRevision(site.Revision(), revision); err != nil {
+		return nil, err
+	}
+
+	parsedDefault, err := entities.NewLocale(defaultLocale)
+	if err != nil {
+		return nil, err
+	}
+	parsedLocales, err := parseLocales(locales)
+	if err != nil {
+		return nil, err
+	}
+	parsedFallbacks, err := parseLocales(fallbackLocales)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := site.UpdateLocales(parsedDefault, parsedLocales, parsedFallbacks); err != nil {
+		return nil, err
+	}
+	if err := u.siteRepo.Save(site); err != nil {
+		u.logger.Error("Failed to update site locales", "id", id, "error", err)
+		return nil, err
+	}
+
+	return site, nil
+}
+
+// EnableSite enables a site of a tenant
+func (u *SiteUseCase) EnableSite(tenantID, id uint64) (*entities.Site, error) {
+	site, err := u.findSite(tenantID, id)
+	if err != nil {
+		return nil, err
 	}
 
 	site.Enable()
-	return u.siteRepo.Save(site)
+	if err := u.siteRepo.Save(site); err != nil {
+		u.logger.Error("Failed to enable site", "id", id, "error", err)
+		return nil, err
+	}
+
+	return site, nil
 }
 
-// DisableSite disables a site
-func (u *SiteUseCase) DisableSite(id uint64) error {
+// DisableSite disables a site of a tenant
+func (u *SiteUseCase) DisableSite(tenantID, id uint64) (*entities.Site, error) {
+	site, err := u.findSite(tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	site.Disable()
+	if err := u.siteRepo.Save(site); err != nil {
+		u.logger.Error("Failed to disable site", "id", id, "error", err)
+		return nil, err
+	}
+
+	return site, nil
+}
+
+// findSite retrieves a site and verifies it belongs to the given tenant
+func (u *SiteUseCase) findSite(tenantID, id uint64) (*entities.Site, error) {
 	site, err := u.siteRepo.FindByID(entities.NewSiteID(id))
 	if err != nil {
-		return err
+		u.logger.Error("Failed to find site", "id", id, "error", err)
+		return nil, err
 	}
-	if site == nil {
-		return errors.New("site not found")
+	if site == nil || site.TenantID().Value() != tenantID {
+		return nil, errors.ErrSiteNotFound
 	}
+	return site, nil
+}
 
-	site.Disable()
-	return u.siteRepo.Save(site)
+// parseLocales parses a list of language tags into their canonical locales
+func parseLocales(values []string) ([]entities.Locale, error) {
+	locales := make([]entities.Locale, 0, len(values))
+	for _, value := range values {
+		locale, err := entities.NewLocale(value)
+		if err != nil {
+			return nil, err
+		}
+		locales = append(locales, locale)
+	}
+	return locales, nil
+}
+
+// siteLocale parses the requested locale and verifies it is enabled for the site.
+// A missing locale selects the default locale of the site.
+func siteLocale(site *entities.Site, requested *string) (entities.Locale, error) {
+	if requested == nil || *requested == "" {
+		return site.DefaultLocale(), nil
+	}
+
+	locale, err := entities.NewLocale(*requested)
+	if err != nil {
+		return "", err
+	}
+	if !site.HasLocale(locale) {
+		return "", errors.ErrLocaleNotEnabled
+	}
+	return locale, nil
 }
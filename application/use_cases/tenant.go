@@ -50,6 +50,15 @@ func (u *TenantUseCase) GetAllTenants() ([]*entities.Tenant, error) {
 	return tenants, nil
 }
 
+// GetTenantsForUser retrieves the tenants the given user can manage
+func (u *TenantUseCase) GetTenantsForUser(user *entities.User) ([]*entities.Tenant, error) {
+	tenants, err := u.GetAllTenants()
+	if err != nil {
+		return nil, err
+	}
+	return filterManageableTenants(user, tenants), nil
+}
+
 // CreateTenant creates a new tenant
 func (u *TenantUseCase) CreateTenant(name string, description *string) (*entities.Tenant, error) {
 	if name == "" {
@@ -82,8 +91,17 @@ func (u *TenantUseCase) GetActiveTenants() ([]*entities.Tenant, error) {
 	return tenants, nil
 }
 
-// UpdateTenant updates an existing tenant
-func (u *TenantUseCase) UpdateTenant(id uint64, name string, description *string) (*entities.Tenant, error) {
+// GetActiveTenantsForUser retrieves the active tenants the given user can manage
+func (u *TenantUseCase) GetActiveTenantsForUser(user *entities.User) ([]*entities.Tenant, error) {
+	tenants, err := u.GetActiveTenants()
+	if err != nil {
+		return nil, err
+	}
+	return filterManageableTenants(user, tenants), nil
+}
+
+// UpdateTenant updates the given revision of an existing tenant
+func (u *TenantUseCase) UpdateTenant(id, revision uint64, name string, description *string) (*entities.Tenant, error) {
 	if name == "" {
 		return nil, errors.ErrTenantNameEmpty
 	}
@@ -98,6 +116,10 @@ func (u *TenantUseCase) UpdateTenant(id uint64, name string, description *string
 		return nil, errors.ErrTenantNotFound
 	}
 
+	if err := checkRevision(tenant.Revision(), revision); err != nil {
+		return nil, err
+	}
+
 	err = tenant.UpdateName(name)
 	if err != nil {
 		u.logger.Error("Failed to update tenant name", "id", id, "error", err)
@@ -121,8 +143,8 @@ func (u *TenantUseCase) UpdateTenant(id uint64, name string, description *string
 	return tenant, nil
 }
 
-// DeleteTenant deletes a tenant by its ID
-func (u *TenantUseCase) DeleteTenant(id uint64) error {
+// DeleteTenant deletes the given revision of a tenant by its ID
+func (u *TenantUseCase) DeleteTenant(id, revision uint64) error {
 	tenant, err := u.tenantRepo.FindByID(entities.NewTenantID(id))
 	if err != nil {
 		u.logger.Error("Failed to find tenant for deletion", "id", id, "error", err)
@@ -133,6 +155,10 @@ func (u *TenantUseCase) DeleteTenant(id uint64) error {
 		return errors.ErrTenantNotFound
 	}
 
+	if err := checkRevision(tenant.Revision(), revision); err != nil {
+		return err
+	}
+
 	err = u.tenantRepo.Delete(tenant.ID())
 	if err != nil {
 		u.logger.Error("Failed to delete tenant", "id", id, "error", err)
@@ -141,3 +167,14 @@ func (u *TenantUseCase) DeleteTenant(id uint64) error {
 
 	return nil
 }
+
+// filterManageableTenants keeps only the tenants the user can manage
+func filterManageableTenants(user *entities.User, tenants []*entities.Tenant) []*entities.Tenant {
+	result := make([]*entities.Tenant, 0, len(tenants))
+	for _, tenant := range tenants {
+		if user.CanManageTenant(tenant.ID()) {
+			result = append(result, tenant)
+		}
+	}
+	return result
+}
@@ -0,0 +1,94 @@
+package use_cases
+
+import (
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"github.com/h4rdc0m/aurora-api/domain/value_objects"
+	"github.com/h4rdc0m/aurora-api/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+// newTestTenants creates persisted tenants with the given IDs
+func newTestTenants(t *testing.T, ids ...uint64) []*entities.Tenant {
+	tenants := make([]*entities.Tenant, 0, len(ids))
+	for _, id := range ids {
+		tenant, err := entities.NewTenant("Tenant", nil)
+		assert.NoError(t, err)
+		tenant.SetID(entities.NewTenantID(id))
+		tenants = append(tenants, tenant)
+	}
+	return tenants
+}
+
+// newRoleUser creates a user with the given role who belongs to the given tenants
+func newRoleUser(t *testing.T, roleName string, tenantIDs ...uint64) *entities.User {
+	role, err := value_objects.NewUserRole(roleName)
+	assert.NoError(t, err)
+	user := newTestUser(t, testEditorSubject)
+	assert.NoError(t, user.UpdateRole(role))
+	for _, id := range tenantIDs {
+		assert.NoError(t, user.AddToTenant(entities.NewTenantID(id)))
+	}
+	return user
+}
+
+// tenantIDs returns the IDs of the tenants in order
+func tenantIDs(tenants []*entities.Tenant) []uint64 {
+	ids := make([]uint64, 0, len(tenants))
+	for _, tenant := range tenants {
+		ids = append(ids, tenant.ID().Value())
+	}
+	return ids
+}
+
+func TestFilterManageableTenants(t *testing.T) {
+	tests := []struct {
+		name     string
+		role     string
+		memberOf []uint64
+		expected []uint64
+	}{
+		{name: "super admin sees every tenant", role: value_objects.RoleSuperAdmin, expected: []uint64{1, 2, 3}},
+		{name: "admin sees every tenant", role: value_objects.RoleAdmin, expected: []uint64{1, 2, 3}},
+		{name: "tenant admin sees their own tenants", role: value_objects.RoleTenantAdmin, memberOf: []uint64{1, 3}, expected: []uint64{1, 3}},
+		{name: "tenant admin without tenants sees none", role: value_objects.RoleTenantAdmin, expected: []uint64{}},
+		{name: "tenant admin of an unknown tenant sees none", role: value_objects.RoleTenantAdmin, memberOf: []uint64{9}, expected: []uint64{}},
+		{name: "tenant editor sees none", role: value_objects.RoleTenantEditor, memberOf: []uint64{1}, expected: []uint64{}},
+		{name: "user sees none", role: value_objects.RoleUser, memberOf: []uint64{1}, expected: []uint64{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user := newRoleUser(t, tt.role, tt.memberOf...)
+
+			tenants := filterManageableTenants(user, newTestTenants(t, 1, 2, 3))
+
+			assert.Equal(t, tt.expected, tenantIDs(tenants))
+		})
+	}
+}
+
+func TestTenantUseCase_TenantsForUser(t *testing.T) {
+	t.Run("all tenants are filtered", func(t *testing.T) {
+		tenantRepo := &mocks.MockTenantRepository{}
+		tenantRepo.On("FindAll").Return(newTestTenants(t, 1, 2), nil)
+		useCase := NewTenantUseCase(tenantRepo, &mocks.MockSiteRepository{}, newTestLogger())
+
+		tenants, err := useCase.GetTenantsForUser(newRoleUser(t, value_objects.RoleTenantAdmin, 2))
+
+		assert.NoError(t, err)
+		assert.Equal(t, []uint64{2}, tenantIDs(tenants))
+	})
+
+	t.Run("active tenants are filtered", func(t *testing.T) {
+		tenantRepo := &mocks.MockTenantRepository{}
+		tenantRepo.On("FindActiveOnly").Return(newTestTenants(t, 1, 2), nil)
+		useCase := NewTenantUseCase(tenantRepo, &mocks.MockSiteRepository{}, newTestLogger())
+
+		tenants, err := useCase.GetActiveTenantsForUser(newRoleUser(t, value_objects.RoleSuperAdmin))
+
+		assert.NoError(t, err)
+		assert.Equal(t, []uint64{1, 2}, tenantIDs(tenants))
+		tenantRepo.AssertNotCalled(t, "FindAll")
+	})
+}
@@ -0,0 +1,399 @@
+package use_cases
+
+import (
+	"github.com/h4rdc0m/aurora-api/domain/common"
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"github.com/h4rdc0m/aurora-api/domain/errors"
+	"github.com/h4rdc0m/aurora-api/domain/repositories"
+	"github.com/h4rdc0m/aurora-api/domain/services"
+	"github.com/jmoiron/sqlx"
+	"sort"
+	"strings"
+	"time"
+)
+
+// TrashUseCase lists, restores and purges the sites, pages and templates that were deleted. Deleting moves a site or page
+// to the trash by stamping it, its descendants and their versions with the same time, which is how restoring
+// finds everything that went into the trash together.
+type TrashUseCase struct {
+	siteRepo        repositories.SiteRepository
+	pageRepo        repositories.PageRepository
+	pageVersionRepo repositories.PageVersionRepository
+	pageBlockRepo   repositories.PageBlockRepository
+	templateRepo    repositories.TemplateRepository
+	searchIndexer   services.SearchIndexer
+	logger          common.Logger
+}
+
+// NewTrashUseCase creates a new TrashUseCase
+func NewTrashUseCase(
+	siteRepo repositories.SiteRepository,
+	pageRepo repositories.PageRepository,
+	pageVersionRepo repositories.PageVersionRepository,
+	pageBlockRepo repositories.PageBlockRepository,
+	templateRepo repositories.TemplateRepository,
+	searchIndexer services.SearchIndexer,
+	logger common.Logger,
+) *TrashUseCase {
+	return &TrashUseCase{
+		siteRepo:        siteRepo,
+		pageRepo:        pageRepo,
+		pageVersionRepo: pageVersionRepo,
+		pageBlockRepo:   pageBlockRepo,
+		templateRepo:    templateRepo,
+		searchIndexer:   searchIndexer,
+		logger:          logger,
+	}
+}
+
+// WithTrx returns a copy of the use case that runs all repository calls inside the given transaction
+func (u *TrashUseCase) WithTrx(trxHandle *sqlx.Tx) *TrashUseCase {
+	return &TrashUseCase{
+		siteRepo:        u.siteRepo.WithTrx(trxHandle),
+		pageRepo:        u.pageRepo.WithTrx(trxHandle),
+		pageVersionRepo: u.pageVersionRepo.WithTrx(trxHandle),
+		pageBlockRepo:   u.pageBlockRepo.WithTrx(trxHandle),
+		templateRepo:    u.templateRepo.WithTrx(trxHandle),
+		searchIndexer:   u.searchIndexer.WithTrx(trxHandle),
+		logger:          u.logger,
+	}
+}
+
+// GetTrash retrieves the trashed sites of a tenant and the trashed pages of its other sites, most recently trashed
+// first. Only the pages that were deleted are listed; their descendants are restored along with them.
+func (u *TrashUseCase) GetTrash(tenantID uint64) ([]*entities.Site, []*entities.Page, error) {
+	sites, err := u.siteRepo.FindTrashedByTenantID(entities.NewTenantID(tenantID))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	liveSites, err := u.siteRepo.FindByTenantID(entities.NewTenantID(tenantID))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var pages []*entities.Page
+	for _, site := range liveSites {
+		trashed, err := u.pageRepo.FindTrashedBySiteID(site.ID())
+		if err != nil {
+			return nil, nil, err
+		}
+		pages = append(pages, trashedRoots(trashed)...)
+	}
+
+	sort.SliceStable(pages, func(i, j int) bool {
+		return pages[i].DeletedAt().After(*pages[j].DeletedAt())
+	})
+
+	return sites, pages, nil
+}
+
+// RestoreSite takes a trashed site of a tenant out of the trash together with the pages and versions deleted
+// along with it. Returns ErrSiteDomainAlreadyExists if another site took over the domain in the meantime.
+func (u *TrashUseCase) RestoreSite(tenantID, siteID uint64) (*entities.Site, error) {
+	site, err := u.siteRepo.FindTrashedByID(entities.NewSiteID(siteID))
+	if err != nil {
+		u.logger.Error("Failed to find trashed site", "siteID", siteID, "error", err)
+		return nil, err
+	}
+	if site == nil || site.TenantID().Value() != tenantID {
+		return nil, errors.ErrSiteNotFound
+	}
+
+	existing, err := u.siteRepo.FindByDomain(site.Domain())
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, errors.ErrSiteDomainAlreadyExists
+	}
+
+	trashed, err := u.pageRepo.FindTrashedBySiteID(site.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	var pages []*entities.Page
+	for _, page := range trashed {
+		if sameTime(page.DeletedAt(), site.DeletedAt()) {
+			pages = append(pages, page)
+		}
+	}
+
+	site.Restore()
+	if err := u.siteRepo.Save(site); err != nil {
+		u.logger.Error("Failed to restore site", "siteID", siteID, "error", err)
+		return nil, err
+	}
+
+	if err := u.restorePages(pages); err != nil {
+		return nil, err
+	}
+
+	return site, nil
+}
+
+// RestorePage takes a trashed page of a site of a tenant out of the trash together with the descendants and
+// versions deleted along with it. The page is placed after its current siblings. Returns ErrPageParentTrashed
+// while its parent is trashed, and ErrPagePathAlreadyExists if another page took over one of the paths.
+func (u *TrashUseCase) RestorePage(tenantID, siteID, pageID uint64) (*entities.Page, error) {
+	site, err := findTenantSite(u.siteRepo, u.logger, tenantID, siteID)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := u.pageRepo.FindTrashedByID(entities.NewPageID(pageID))
+	if err != nil {
+		u.logger.Error("Failed to find trashed page", "pageID", pageID, "error", err)
+		return nil, err
+	}
+	if page == nil || page.SiteID().Value() != site.ID().Value() {
+		return nil, errors.ErrPageNotFound
+	}
+
+	if err := u.ensureParentLive(site, page); err != nil {
+		return nil, err
+	}
+
+	trashed, err := u.pageRepo.FindTrashedBySiteID(site.ID())
+	if err != nil {
+		return nil, err
+	}
+	pages := trashedSubtree(page, trashed)
+
+	for _, restored := range pages {
+		if restored.Path() == nil {
+			continue
+		}
+		exists, err := u.pageRepo.ExistsByPath(*restored.Path(), site.ID())
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			return nil, errors.ErrPagePathAlreadyExists
+		}
+	}
+
+	var siblings []*entities.Page
+	if page.ParentID() == nil {
+		siblings, err = u.pageRepo.FindRootPagesBySiteID(site.ID())
+	} else {
+		siblings, err = u.pageRepo.FindChildrenByParentID(*page.ParentID())
+	}
+	if err != nil {
+		return nil, err
+	}
+	page.UpdateIndex(len(siblings))
+
+	if err := u.restorePages(pages); err != nil {
+		return nil, err
+	}
+
+	return page, nil
+}
+
+// GetTemplateTrash retrieves the trashed templates, most recently trashed first. Templates are shared by all
+// tenants, so their trash is global.
+func (u *TrashUseCase) GetTemplateTrash() ([]*entities.Template, error) {
+	return u.templateRepo.FindTrashed()
+}
+
+// RestoreTemplate takes a template out of the trash. Returns ErrTemplateNameAlreadyExists or
+// ErrTemplateFilePathAlreadyExists if another template took over its name or file path in the meantime.
+func (u *TrashUseCase) RestoreTemplate(templateID uint64) (*entities.Template, error) {
+	template, err := u.templateRepo.FindTrashedByID(entities.NewTemplateID(templateID))
+	if err != nil {
+		u.logger.Error("Failed to find trashed template", "templateID", templateID, "error", err)
+		return nil, err
+	}
+	if template == nil {
+		return nil, errors.ErrTemplateNotFound
+	}
+
+	nameTaken, err := u.templateRepo.ExistsByName(template.Name())
+	if err != nil {
+		return nil, err
+	}
+	if nameTaken {
+		return nil, errors.ErrTemplateNameAlreadyExists
+	}
+
+	filePathTaken, err := u.templateRepo.ExistsByFilePath(template.FilePath())
+	if err != nil {
+		return nil, err
+	}
+	if filePathTaken {
+		return nil, errors.ErrTemplateFilePathAlreadyExists
+	}
+
+	template.Restore()
+	if err := u.templateRepo.Save(template); err != nil {
+		u.logger.Error("Failed to restore template", "templateID", templateID, "error", err)
+		return nil, err
+	}
+
+	return template, nil
+}
+
+// GetExpiredTrash retrieves the sites, pages and templates trashed before the given time. The pages are ordered
+// deepest first, so every page can be purged before its parent.
+func (u *TrashUseCase) GetExpiredTrash(before time.Time) ([]*entities.Site, []*entities.Page, []*entities.Template, error) {
+	sites, err := u.siteRepo.FindTrashedBefore(before)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	pages, err := u.pageRepo.FindTrashedBefore(before)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	sort.SliceStable(pages, func(i, j int) bool {
+		return pageDepth(pages[i]) > pageDepth(pages[j])
+	})
+
+	templates, err := u.templateRepo.FindTrashedBefore(before)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return sites, pages, templates, nil
+}
+
+// PurgePage permanently deletes a trashed page together with its versions and their blocks
+func (u *TrashUseCase) PurgePage(page *entities.Page) error {
+	versions, err := u.pageVersionRepo.FindTrashedByPageID(page.ID())
+	if err != nil {
+		return err
+	}
+	for _, version := range versions {
+		if err := u.pageBlockRepo.DeleteByPageVersionID(version.ID()); err != nil {
+			return err
+		}
+		if err := u.pageVersionRepo.Delete(version.ID()); err != nil {
+			return err
+		}
+	}
+
+	return u.pageRepo.Delete(page.ID())
+}
+
+// PurgeSite permanently deletes a trashed site. Its pages are purged first, as they were trashed along with it.
+func (u *TrashUseCase) PurgeSite(site *entities.Site) error {
+	return u.siteRepo.Delete(site.ID())
+}
+
+// PurgeTemplate permanently deletes a trashed template
+func (u *TrashUseCase) PurgeTemplate(template *entities.Template) error {
+	return u.templateRepo.Delete(template.ID())
+}
+
+// ensureParentLive checks that the parent of a trashed page is not (or no longer) trashed
+func (u *TrashUseCase) ensureParentLive(site *entities.Site, page *entities.Page) error {
+	if page.ParentID() == nil {
+		return nil
+	}
+
+	parent, err := u.pageRepo.FindByID(*page.ParentID())
+	if err != nil {
+		return err
+	}
+	if parent != nil && parent.SiteID().Value() == site.ID().Value() {
+		return nil
+	}
+
+	trashedParent, err := u.pageRepo.FindTrashedByID(*page.ParentID())
+	if err != nil {
+		return err
+	}
+	if trashedParent != nil {
+		return errors.ErrPageParentTrashed
+	}
+	return errors.ErrPageParentNotFound
+}
+
+// restorePages takes the pages and the versions trashed along with them out of the trash, and indexes the pages
+// again once all of them are back
+func (u *TrashUseCase) restorePages(pages []*entities.Page) error {
+	for _, page := range pages {
+		versions, err := u.pageVersionRepo.FindTrashedByPageID(page.ID())
+		if err != nil {
+			return err
+		}
+		for _, version := range versions {
+			if !sameTime(version.DeletedAt(), page.DeletedAt()) {
+				continue
+			}
+			version.Restore()
+			if err := u.pageVersionRepo.Save(version); err != nil {
+				u.logger.Error("Failed to restore page version", "versionID", version.ID().Value(), "error", err)
+				return err
+			}
+		}
+
+		page.Restore()
+		if err := u.pageRepo.Save(page); err != nil {
+			u.logger.Error("Failed to restore page", "pageID", page.ID().Value(), "error", err)
+			return err
+		}
+	}
+
+	for _, page := range pages {
+		if err := u.searchIndexer.Reindex(page); err != nil {
+			u.logger.Error("Failed to index restored page", "pageID", page.ID().Value(), "error", err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// trashedRoots returns the trashed pages that were deleted themselves, leaving out the descendants trashed along
+// with their parent
+func trashedRoots(trashed []*entities.Page) []*entities.Page {
+	byID := make(map[uint64]*entities.Page, len(trashed))
+	for _, page := range trashed {
+		byID[page.ID().Value()] = page
+	}
+
+	var roots []*entities.Page
+	for _, page := range trashed {
+		if page.ParentID() != nil {
+			parent, found := byID[page.ParentID().Value()]
+			if found && sameTime(parent.DeletedAt(), page.DeletedAt()) {
+				continue
+			}
+		}
+		roots = append(roots, page)
+	}
+	return roots
+}
+
+// trashedSubtree returns the trashed page followed by the descendants trashed along with it
+func trashedSubtree(page *entities.Page, trashed []*entities.Page) []*entities.Page {
+	subtree := []*entities.Page{page}
+	for i := 0; i < len(subtree); i++ {
+		for _, candidate := range trashed {
+			parentID := candidate.ParentID()
+			if parentID != nil && parentID.Value() == subtree[i].ID().Value() && sameTime(candidate.DeletedAt(), page.DeletedAt()) {
+				subtree = append(subtree, candidate)
+			}
+		}
+	}
+	return subtree
+}
+
+// pageDepth returns the number of ancestors of a page, derived from its path
+func pageDepth(page *entities.Page) int {
+	if page.Path() == nil {
+		return 0
+	}
+	return strings.Count(strings.Trim(*page.Path(), "/"), "/")
+}
+
+// sameTime reports whether two optional times are both unset or equal
+func sameTime(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
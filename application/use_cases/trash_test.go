@@ -0,0 +1,103 @@
+package use_cases
+
+import (
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	domainErrors "github.com/h4rdc0m/aurora-api/domain/errors"
+	"github.com/h4rdc0m/aurora-api/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+// newTestTrashUseCase creates a trash use case on a mocked template repository
+func newTestTrashUseCase() (*TrashUseCase, *mocks.MockTemplateRepository) {
+	templates := &mocks.MockTemplateRepository{}
+	useCase := NewTrashUseCase(
+		&mocks.MockSiteRepository{},
+		&mocks.MockPageRepository{},
+		&mocks.MockPageVersionRepository{},
+		&mocks.MockPageBlockRepository{},
+		templates,
+		&mocks.MockSearchIndexer{},
+		newTestLogger(),
+	)
+	return useCase, templates
+}
+
+// newTrashedTemplate creates template 7 trashed at the given time
+func newTrashedTemplate(t *testing.T, deletedAt time.Time) *entities.Template {
+	template, err := entities.NewTemplate("Classic", "templates/classic", nil)
+	assert.NoError(t, err)
+	template.SetID(entities.NewTemplateID(7))
+	template.SetRevision(2)
+	template.MoveToTrash(deletedAt)
+	return template
+}
+
+func TestTrashUseCase_GetTemplateTrash(t *testing.T) {
+	useCase, templates := newTestTrashUseCase()
+	trashed := []*entities.Template{newTrashedTemplate(t, time.Now())}
+	templates.On("FindTrashed").Return(trashed, nil)
+
+	result, err := useCase.GetTemplateTrash()
+
+	assert.NoError(t, err)
+	assert.Equal(t, trashed, result)
+}
+
+func TestTrashUseCase_RestoreTemplate(t *testing.T) {
+	id := entities.NewTemplateID(7)
+
+	t.Run("takes the template out of the trash", func(t *testing.T) {
+		useCase, templates := newTestTrashUseCase()
+		template := newTrashedTemplate(t, time.Now())
+		templates.On("FindTrashedByID", id).Return(template, nil)
+		templates.On("ExistsByName", "Classic").Return(false, nil)
+		templates.On("ExistsByFilePath", "templates/classic").Return(false, nil)
+		templates.On("Save", template).Return(nil)
+
+		restored, err := useCase.RestoreTemplate(7)
+
+		assert.NoError(t, err)
+		assert.Same(t, template, restored)
+		assert.False(t, restored.IsDeleted())
+		templates.AssertExpectations(t)
+	})
+
+	t.Run("template not in the trash", func(t *testing.T) {
+		useCase, templates := newTestTrashUseCase()
+		templates.On("FindTrashedByID", id).Return(nil, nil)
+
+		restored, err := useCase.RestoreTemplate(7)
+
+		assert.Nil(t, restored)
+		assert.ErrorIs(t, err, domainErrors.ErrTemplateNotFound)
+	})
+
+	tests := []struct {
+		name          string
+		nameTaken     bool
+		filePathTaken bool
+		err           error
+	}{
+		{"name taken over", true, false, domainErrors.ErrTemplateNameAlreadyExists},
+		{"file path taken over", false, true, domainErrors.ErrTemplateFilePathAlreadyExists},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			useCase, templates := newTestTrashUseCase()
+			template := newTrashedTemplate(t, time.Now())
+			templates.On("FindTrashedByID", id).Return(template, nil)
+			templates.On("ExistsByName", "Classic").Return(tt.nameTaken, nil)
+			templates.On("ExistsByFilePath", "templates/classic").Return(tt.filePathTaken, nil)
+
+			restored, err := useCase.RestoreTemplate(7)
+
+			assert.Nil(t, restored)
+			assert.ErrorIs(t, err, tt.err)
+			assert.True(t, template.IsDeleted())
+			templates.AssertNotCalled(t, "Save", mock.Anything)
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package use_cases
+
+import (
+	"github.com/h4rdc0m/aurora-api/domain/common"
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"github.com/h4rdc0m/aurora-api/domain/errors"
+	"github.com/h4rdc0m/aurora-api/domain/repositories"
+	"github.com/h4rdc0m/aurora-api/domain/value_objects"
+)
+
+// UserUseCase handles the local users linked to Keycloak accounts
+type UserUseCase struct {
+	userRepo repositories.UserRepository
+	logger   common.Logger
+}
+
+// NewUserUseCase creates a new UserUseCase
+func NewUserUseCase(userRepo repositories.UserRepository, logger common.Logger) *UserUseCase {
+	return &UserUseCase{
+		userRepo: userRepo,
+		logger:   logger,
+	}
+}
+
+// GetUserByKeycloakID retrieves the local user, including its tenants, for a Keycloak subject
+func (u *UserUseCase) GetUserByKeycloakID(keycloakID string) (*entities.User, error) {
+	id, err := value_objects.NewKeycloakID(keycloakID)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := u.userRepo.FindByKeycloakID(*id)
+	if err != nil {
+		u.logger.Error("Failed to get user by Keycloak ID", "keycloakID", keycloakID, "error", err)
+		return nil, err
+	}
+	if user == nil {
+		return nil, errors.ErrUserNotFound
+	}
+
+	return user, nil
+}
@@ -3,4 +3,7 @@ package constants
 const (
 	// DBTransaction is the database transaction handle set at the router context
 	DBTransaction = "db_trx"
+
+	// CurrentUser is the local user of the authenticated request set at the router context
+	CurrentUser = "current_user"
 )
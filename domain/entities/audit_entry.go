@@ -0,0 +1,111 @@
+package entities
+
+import "time"
+
+// AuditEntryID represents a unique identifier for an audit entry entity.
+type AuditEntryID struct {
+	value uint64
+}
+
+// NewAuditEntryID creates a new AuditEntryID instance with the specified value.
+func NewAuditEntryID(id uint64) AuditEntryID {
+	return AuditEntryID{value: id}
+}
+
+// Value retrieves the underlying value of the AuditEntryID.
+func (a AuditEntryID) Value() uint64 {
+	return a.value
+}
+
+// IsEmpty checks if the AuditEntryID is empty, which is defined as having a value of 0.
+func (a AuditEntryID) IsEmpty() bool {
+	return a.value == 0
+}
+
+// AuditAction is a privileged action recorded in the audit log of a tenant
+type AuditAction string
+
+const (
+	AuditActionEditingLeaseBroken AuditAction = "editing_lease.broken" // A tenant admin broke the editing lease of another user.
+)
+
+// Resources audit entries refer to
+const (
+	AuditResourcePageVersion = "page_version"
+)
+
+// AuditEntry records who performed a privileged action on which resource of a tenant. Details hold the
+// action specific context, e.g. the user whose editing lease was broken. Entries are never changed.
+type AuditEntry struct {
+	id         AuditEntryID
+	tenantID   TenantID
+	actorID    UserID
+	action     AuditAction
+	resource   string
+	resourceID uint64
+	details    map[string]string
+	createdAt  time.Time
+}
+
+// NewAuditEntry creates an audit entry for an action of the actor on a resource of the tenant
+func NewAuditEntry(tenantID TenantID, actorID UserID, action AuditAction, resource string, resourceID uint64, details map[string]string) *AuditEntry {
+	return &AuditEntry{
+		tenantID:   tenantID,
+		actorID:    actorID,
+		action:     action,
+		resource:   resource,
+		resourceID: resourceID,
+		details:    details,
+		createdAt:  time.Now(),
+	}
+}
+
+// ID returns the unique identifier of the audit entry
+func (a *AuditEntry) ID() AuditEntryID {
+	return a.id
+}
+
+// TenantID returns the tenant the action was performed in
+func (a *AuditEntry) TenantID() TenantID {
+	return a.tenantID
+}
+
+// ActorID returns the user who performed the action
+func (a *AuditEntry) ActorID() UserID {
+	return a.actorID
+}
+
+// Action returns the recorded action
+func (a *AuditEntry) Action() AuditAction {
+	return a.action
+}
+
+// Resource returns the kind of resource the action was performed on, e.g. "page_version"
+func (a *AuditEntry) Resource() string {
+	return a.resource
+}
+
+// ResourceID returns the ID of the resource the action was performed on
+func (a *AuditEntry) ResourceID() uint64 {
+	return a.resourceID
+}
+
+// Details returns the action specific context of the entry
+func (a *AuditEntry) Details() map[string]string {
+	return a.details
+}
+
+// CreatedAt returns when the action was performed
+func (a *AuditEntry) CreatedAt() time.Time {
+	return a.createdAt
+}
+
+// SetID sets the audit entry ID (used by repository when loading from database)
+func (a *AuditEntry) SetID(id AuditEntryID) {
+	a.id = id
+}
+
+// SetCreatedAt sets when the action was performed (used by repository when loading from database)
+func (a *AuditEntry) SetCreatedAt(createdAt time.Time) {
+	a.createdAt = createdAt
+}
@@ -0,0 +1,155 @@
+package entities
+
+import "time"
+
+// MaxLinkURLLength is the maximum length of a link URL kept in a link report
+const MaxLinkURLLength = 2048
+
+// BrokenLinkID represents a unique identifier for a broken link entity.
+type BrokenLinkID struct {
+	value uint64
+}
+
+// NewBrokenLinkID creates a new BrokenLinkID instance with the specified value.
+func NewBrokenLinkID(id uint64) BrokenLinkID {
+	return BrokenLinkID{value: id}
+}
+
+// Value retrieves the underlying value of the BrokenLinkID.
+func (b BrokenLinkID) Value() uint64 {
+	return b.value
+}
+
+// IsEmpty checks if the BrokenLinkID is empty, which is defined as having a value of 0.
+func (b BrokenLinkID) IsEmpty() bool {
+	return b.value == 0
+}
+
+// LinkCheck is the outcome of checking a single link. StatusCode is nil when no HTTP response was received.
+type LinkCheck struct {
+	URL        string
+	Broken     bool
+	StatusCode *int
+	Reason     string
+}
+
+// BrokenLink is an entry of the link report of a site: a link on a page that did not resolve when last checked.
+// BlockKey identifies the block holding the link and is empty for the URL of a link page.
+type BrokenLink struct {
+	id            BrokenLinkID
+	siteID        SiteID
+	pageID        PageID
+	blockKey      string
+	url           string
+	statusCode    *int
+	reason        string
+	firstSeenAt   time.Time
+	lastCheckedAt time.Time
+	createdAt     time.Time
+	updatedAt     time.Time
+}
+
+// NewBrokenLink creates a report entry for a link first found broken at checkedAt.
+func NewBrokenLink(siteID SiteID, pageID PageID, blockKey string, check LinkCheck, checkedAt time.Time) *BrokenLink {
+	now := time.Now()
+
+	return &BrokenLink{
+		siteID:        siteID,
+		pageID:        pageID,
+		blockKey:      blockKey,
+		url:           check.URL,
+		statusCode:    check.StatusCode,
+		reason:        check.Reason,
+		firstSeenAt:   checkedAt,
+		lastCheckedAt: checkedAt,
+		createdAt:     now,
+		updatedAt:     now,
+	}
+}
+
+// ID returns the unique identifier of the broken link.
+func (b *BrokenLink) ID() BrokenLinkID {
+	return b.id
+}
+
+// SiteID returns the site of the page holding the link.
+func (b *BrokenLink) SiteID() SiteID {
+	return b.siteID
+}
+
+// PageID returns the page holding the link.
+func (b *BrokenLink) PageID() PageID {
+	return b.pageID
+}
+
+// BlockKey returns the key of the block holding the link, or an empty string for the URL of a link page.
+func (b *BrokenLink) BlockKey() string {
+	return b.blockKey
+}
+
+// URL returns the link target as written on the page.
+func (b *BrokenLink) URL() string {
+	return b.url
+}
+
+// StatusCode returns the HTTP status code of the last check, or nil when no response was received.
+func (b *BrokenLink) StatusCode() *int {
+	return b.statusCode
+}
+
+// Reason returns why the link is considered broken.
+func (b *BrokenLink) Reason() string {
+	return b.reason
+}
+
+// FirstSeenAt returns when the link was first found broken.
+func (b *BrokenLink) FirstSeenAt() time.Time {
+	return b.firstSeenAt
+}
+
+// LastCheckedAt returns when the link was last checked.
+func (b *BrokenLink) LastCheckedAt() time.Time {
+	return b.lastCheckedAt
+}
+
+// CreatedAt returns the creation timestamp of the broken link.
+func (b *BrokenLink) CreatedAt() time.Time {
+	return b.createdAt
+}
+
+// UpdatedAt returns the timestamp of the last update of the broken link.
+func (b *BrokenLink) UpdatedAt() time.Time {
+	return b.updatedAt
+}
+
+// Recheck records the outcome of checking the link again while it is still broken. The first seen time is kept.
+func (b *BrokenLink) Recheck(check LinkCheck, checkedAt time.Time) {
+	b.statusCode = check.StatusCode
+	b.reason = check.Reason
+	b.lastCheckedAt = checkedAt
+	b.updatedAt = time.Now()
+}
+
+// SetID sets the broken link ID (used by repository when loading from database)
+func (b *BrokenLink) SetID(id BrokenLinkID) {
+	b.id = id
+}
+
+// SetCheckTimes sets when the link was first seen broken and last checked (used by repository when loading from database)
+func (b *BrokenLink) SetCheckTimes(firstSeenAt, lastCheckedAt time.Time) {
+	b.firstSeenAt = firstSeenAt
+	b.lastCheckedAt = lastCheckedAt
+}
+
+// SetTimestamps sets the timestamps (used by repository when loading from database)
+func (b *BrokenLink) SetTimestamps(createdAt, updatedAt time.Time) {
+	b.createdAt = createdAt
+	b.updatedAt = updatedAt
+}
+
+// LinkFinding is a broken link found while scanning the pages of a site.
+type LinkFinding struct {
+	PageID   PageID
+	BlockKey string
+	Check    LinkCheck
+}
@@ -0,0 +1,149 @@
+package entities
+
+import (
+	"github.com/h4rdc0m/aurora-api/domain/errors"
+	"regexp"
+	"time"
+)
+
+// maxContentTypeNameLength is the maximum length of a content type name
+const maxContentTypeNameLength = 100
+
+// contentTypeNamePattern matches names such as "hero", "rich-text" or "application/vnd.gallery+json"
+var contentTypeNamePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._+/-]*$`)
+
+// ContentTypeID represents a unique identifier for a content type entity.
+type ContentTypeID struct {
+	value uint64
+}
+
+// NewContentTypeID creates a new ContentTypeID instance with the specified value.
+func NewContentTypeID(id uint64) ContentTypeID {
+	return ContentTypeID{value: id}
+}
+
+// Value retrieves the underlying value of the ContentTypeID.
+func (c ContentTypeID) Value() uint64 {
+	return c.value
+}
+
+// IsEmpty checks if the ContentTypeID is empty, which is defined as having a value of 0.
+func (c ContentTypeID) IsEmpty() bool {
+	return c.value == 0
+}
+
+// ContentType is a registered block content type whose JSON Schema the content of matching page blocks must satisfy.
+// Global content types have no tenant; a tenant may register a content type of the same name to replace the global one.
+type ContentType struct {
+	id          ContentTypeID
+	tenantID    *TenantID
+	name        string
+	description *string
+	schema      string
+	createdAt   time.Time
+	updatedAt   time.Time
+}
+
+// NewContentType creates a new ContentType for the tenant, or a global one when tenantID is nil.
+func NewContentType(tenantID *TenantID, name string, description *string, schema string) (*ContentType, error) {
+	if err := validateContentTypeName(name); err != nil {
+		return nil, err
+	}
+	if schema == "" {
+		return nil, errors.ErrContentTypeSchemaEmpty
+	}
+
+	now := time.Now()
+
+	return &ContentType{
+		tenantID:    tenantID,
+		name:        name,
+		description: description,
+		schema:      schema,
+		createdAt:   now,
+		updatedAt:   now,
+	}, nil
+}
+
+// ID returns the unique identifier of the content type.
+func (c *ContentType) ID() ContentTypeID {
+	return c.id
+}
+
+// TenantID returns the tenant owning the content type, or nil for a global content type.
+func (c *ContentType) TenantID() *TenantID {
+	return c.tenantID
+}
+
+// IsGlobal reports whether the content type is available to all tenants.
+func (c *ContentType) IsGlobal() bool {
+	return c.tenantID == nil
+}
+
+// Name returns the name matched against the content type of page blocks.
+func (c *ContentType) Name() string {
+	return c.name
+}
+
+// Description returns the description of the content type.
+func (c *ContentType) Description() *string {
+	return c.description
+}
+
+// Schema returns the JSON Schema of the content type.
+func (c *ContentType) Schema() string {
+	return c.schema
+}
+
+// CreatedAt returns the creation timestamp of the content type.
+func (c *ContentType) CreatedAt() time.Time {
+	return c.createdAt
+}
+
+// UpdatedAt returns the timestamp of the last update of the content type.
+func (c *ContentType) UpdatedAt() time.Time {
+	return c.updatedAt
+}
+
+// UpdateDescription updates the description of the content type.
+func (c *ContentType) UpdateDescription(description *string) {
+	c.description = description
+	c.updatedAt = time.Now()
+}
+
+// UpdateSchema replaces the JSON Schema of the content type. Returns an error if the schema is empty.
+func (c *ContentType) UpdateSchema(schema string) error {
+	if schema == "" {
+		return errors.ErrContentTypeSchemaEmpty
+	}
+
+	c.schema = schema
+	c.updatedAt = time.Now()
+	return nil
+}
+
+// SetID sets the content type ID (used by repository when loading from database)
+func (c *ContentType) SetID(id ContentTypeID) {
+	c.id = id
+}
+
+// SetTimestamps sets the timestamps (used by repository when loading from database)
+func (c *ContentType) SetTimestamps(createdAt, updatedAt time.Time) {
+	c.createdAt = createdAt
+	c.updatedAt = updatedAt
+}
+
+// validateContentTypeName checks the name of a content type; built-in block content types cannot be registered
+func validateContentTypeName(name string) error {
+	switch {
+	case name == "":
+		return errors.ErrContentTypeNameEmpty
+	case len(name) > maxContentTypeNameLength:
+		return errors.ErrContentTypeNameTooLong
+	case !contentTypeNamePattern.MatchString(name):
+		return errors.ErrContentTypeNameInvalid
+	case name == PageBlockContentTypeSnippet:
+		return errors.ErrContentTypeNameReserved
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package entities
+
+// DeliveredPage is the published state of a page as served by the public delivery API.
+// Resolved holds the page reached through the hard links of the requested page. For link pages
+// Version is nil and the client is expected to follow the link URL instead.
+// When no page exists at the requested path but a redirect does, only Site, Locale and Redirect are set.
+// Locale is the locale negotiated for the request; Version may be published in a fallback locale of it.
+// Head holds the computed head metadata and is set whenever Version is. Preview reports whether the request carried
+// a preview token, in which case Version may be unpublished.
+type DeliveredPage struct {
+	Site     *Site
+	Locale   Locale
+	Preview  bool
+	Page     *Page
+	Resolved *ResolvedPage
+	Version  *PageVersion
+	Head     *PageHead
+	Blocks   []*RenderedBlock
+	Redirect *DeliveredRedirect
+}
+
+// DeliveredRedirect is a redirect found for a requested path. Location is the path within the site, or an
+// absolute URL for targets outside of it, and is empty for gone redirects.
+type DeliveredRedirect struct {
+	StatusCode int
+	Location   string
+}
+
+// ContentLocale returns the locale of the delivered content: the locale of the version, or the negotiated locale
+// when there is no version.
+func (d *DeliveredPage) ContentLocale() Locale {
+	if d.Version != nil {
+		return d.Version.Locale()
+	}
+	return d.Locale
+}
+
+// IsLink reports whether the delivered page points to an URL instead of content.
+func (d *DeliveredPage) IsLink() bool {
+	return d.Resolved.IsLink()
+}
+
+// IsRedirect reports whether a redirect was delivered instead of a page.
+func (d *DeliveredPage) IsRedirect() bool {
+	return d.Redirect != nil
+}
@@ -0,0 +1,72 @@
+package entities
+
+import (
+	"github.com/h4rdc0m/aurora-api/domain/errors"
+	"time"
+)
+
+// EditingLease grants one editor the right to save a page version until it expires. Editors renew their lease
+// while they keep editing; other users cannot save the version in the meantime. The holder is identified by the
+// subject of their Keycloak token, the name is kept to tell other editors who is editing.
+type EditingLease struct {
+	versionID     PageVersionID
+	holderSubject string
+	holderName    string
+	acquiredAt    time.Time
+	expiresAt     time.Time
+}
+
+// NewEditingLease creates a lease on the version for the editor with the given Keycloak subject and name
+func NewEditingLease(versionID PageVersionID, holderSubject, holderName string, expiresAt time.Time) *EditingLease {
+	return &EditingLease{
+		versionID:     versionID,
+		holderSubject: holderSubject,
+		holderName:    holderName,
+		acquiredAt:    time.Now(),
+		expiresAt:     expiresAt,
+	}
+}
+
+// VersionID returns the leased page version
+func (l *EditingLease) VersionID() PageVersionID {
+	return l.versionID
+}
+
+// HolderSubject returns the Keycloak subject of the editor holding the lease
+func (l *EditingLease) HolderSubject() string {
+	return l.holderSubject
+}
+
+// HolderName returns the display name of the editor holding the lease
+func (l *EditingLease) HolderName() string {
+	return l.holderName
+}
+
+// AcquiredAt returns when the editor acquired the lease
+func (l *EditingLease) AcquiredAt() time.Time {
+	return l.acquiredAt
+}
+
+// ExpiresAt returns when the lease ends unless it is renewed
+func (l *EditingLease) ExpiresAt() time.Time {
+	return l.expiresAt
+}
+
+// IsHeldBy reports whether the editor with the given Keycloak subject holds the lease
+func (l *EditingLease) IsHeldBy(subject string) bool {
+	return l.holderSubject == subject
+}
+
+// LockedError returns the error reported to other users trying to edit the leased version
+func (l *EditingLease) LockedError() error {
+	return &errors.PageVersionLockedError{
+		HolderSubject: l.holderSubject,
+		HolderName:    l.holderName,
+		ExpiresAt:     l.expiresAt,
+	}
+}
+
+// SetAcquiredAt sets when the lease was acquired (used by the editing lease service when loading the lease)
+func (l *EditingLease) SetAcquiredAt(acquiredAt time.Time) {
+	l.acquiredAt = acquiredAt
+}
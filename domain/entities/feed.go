@@ -0,0 +1,63 @@
+package entities
+
+import (
+	"sort"
+	"time"
+)
+
+// FeedMaxItems is the maximum number of entries listed in a feed.
+const FeedMaxItems = 50
+
+// FeedItem is a published child page listed in the feed of its parent.
+type FeedItem struct {
+	Page    *Page
+	Version *PageVersion
+}
+
+// PublishedAt returns when the version of the item went live, falling back to its creation time.
+func (i FeedItem) PublishedAt() time.Time {
+	if i.Version.StatusChangedAt() != nil {
+		return *i.Version.StatusChangedAt()
+	}
+	return i.Version.CreatedAt()
+}
+
+// Feed lists the published children of a feed root page, newest first.
+// Version is the published version of the feed root, which provides the title and description of the feed.
+// Locale is the locale the feed was requested in; the versions may be in fallback locales of it.
+type Feed struct {
+	Site    *Site
+	Page    *Page
+	Locale  Locale
+	Version *PageVersion
+	Items   []FeedItem
+}
+
+// NewFeed creates a feed of the given items, keeping the FeedMaxItems most recently published ones.
+func NewFeed(site *Site, page *Page, locale Locale, version *PageVersion, items []FeedItem) *Feed {
+	sorted := append([]FeedItem(nil), items...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].PublishedAt().After(sorted[j].PublishedAt())
+	})
+	if len(sorted) > FeedMaxItems {
+		sorted = sorted[:FeedMaxItems]
+	}
+
+	return &Feed{Site: site, Page: page, Locale: locale, Version: version, Items: sorted}
+}
+
+// URL returns the public URL of a page of the feed in the locale of the feed.
+func (f *Feed) URL(page *Page) string {
+	return f.Site.BaseURL() + f.Site.LocalizedPath(f.Locale, page.FullPath())
+}
+
+// UpdatedAt returns the most recent update of the feed root or any of its items.
+func (f *Feed) UpdatedAt() time.Time {
+	updatedAt := f.Version.UpdatedAt()
+	for _, item := range f.Items {
+		if item.Version.UpdatedAt().After(updatedAt) {
+			updatedAt = item.Version.UpdatedAt()
+		}
+	}
+	return updatedAt
+}
@@ -0,0 +1,51 @@
+package entities
+
+import (
+	"github.com/h4rdc0m/aurora-api/domain/errors"
+	"regexp"
+	"strings"
+)
+
+// Locale is a language tag such as "en" or "nl-BE" selecting the language of page versions.
+// Locales are kept in their canonical form: a lower case language, a title case script and an upper case region.
+type Locale string
+
+// DefaultLocale is the locale of sites that have not configured their locales, and of their page versions
+const DefaultLocale Locale = "en"
+
+// MaxLocaleLength is the maximum length of a locale
+const MaxLocaleLength = 35
+
+// MaxSiteLocales is the maximum number of locales a site can enable
+const MaxSiteLocales = 16
+
+// localePattern matches a language with an optional script and region, e.g. "en", "zh-Hant" or "pt_BR"
+var localePattern = regexp.MustCompile(`^([a-zA-Z]{2,3})(?:[-_]([a-zA-Z]{4}))?(?:[-_]([a-zA-Z]{2}|[0-9]{3}))?$`)
+
+// NewLocale parses the given language tag into its canonical Locale. Returns an error if it is not a valid tag.
+func NewLocale(value string) (Locale, error) {
+	match := localePattern.FindStringSubmatch(strings.TrimSpace(value))
+	if match == nil {
+		return "", errors.ErrLocaleInvalid
+	}
+
+	locale := strings.ToLower(match[1])
+	if match[2] != "" {
+		locale += "-" + strings.ToUpper(match[2][:1]) + strings.ToLower(match[2][1:])
+	}
+	if match[3] != "" {
+		locale += "-" + strings.ToUpper(match[3])
+	}
+	return Locale(locale), nil
+}
+
+// Language returns the language subtag of the locale, e.g. "nl" for "nl-BE".
+func (l Locale) Language() Locale {
+	language, _, _ := strings.Cut(string(l), "-")
+	return Locale(language)
+}
+
+// String returns the locale as a language tag.
+func (l Locale) String() string {
+	return string(l)
+}
@@ -30,6 +30,7 @@ func (p PageID) IsEmpty() bool {
 	return p.value == 0
 }
 
+// PageType represents the kind of page and determines how its content is resolved.
 type PageType string
 
 const (
@@ -39,6 +40,16 @@ const (
 	PageTypeSnippet  PageType = "snippet"  // Represents a snippet page type.
 )
 
+// NewPageType parses the given string into a PageType. Returns an error if the type is unknown.
+func NewPageType(value string) (PageType, error) {
+	switch PageType(value) {
+	case PageTypeContent, PageTypeLink, PageTypeHardLink, PageTypeSnippet:
+		return PageType(value), nil
+	default:
+		return "", errors.ErrPageTypeInvalid
+	}
+}
+
 // Page represents a page entity within a site
 type Page struct {
 	id             PageID
@@ -50,8 +61,11 @@ type Page struct {
 	pageType       PageType
 	linkURL        *string
 	hardLinkPageID *PageID
+	feedRoot       bool
 	createdAt      time.Time
 	updatedAt      time.Time
+	revision       uint64
+	deletedAt      *time.Time
 	children       []*Page
 	versions       []*PageVersion
 }
@@ -91,11 +105,13 @@ func (p *Page) Path() *string {
 	return p.path
 }
 
+// FullPath returns the materialized path of the page, e.g. "/about/team".
+// Falls back to a root level path when the path has not been built yet.
 func (p *Page) FullPath() string {
 	if p.path != nil {
-		return *p.path + "/" + p.key.Value()
+		return *p.path
 	}
-	return p.key.Value()
+	return "/" + p.key.Value()
 }
 
 // Index returns the page index
@@ -128,6 +144,11 @@ func (p *Page) HardLinkPageID() *PageID {
 	return p.hardLinkPageID
 }
 
+// IsFeedRoot reports whether the published children of the page are delivered as a feed
+func (p *Page) IsFeedRoot() bool {
+	return p.feedRoot
+}
+
 // CreatedAt returns the creation timestamp of the Page.
 func (p *Page) CreatedAt() time.Time {
 	return p.createdAt
@@ -138,6 +159,37 @@ func (p *Page) UpdatedAt() time.Time {
 	return p.updatedAt
 }
 
+// Revision returns the number of times the page was saved, used to detect concurrent modifications
+func (p *Page) Revision() uint64 {
+	return p.revision
+}
+
+// DeletedAt returns when the page was moved to the trash, nil while it is not trashed
+func (p *Page) DeletedAt() *time.Time {
+	return p.deletedAt
+}
+
+// IsDeleted reports whether the page is in the trash
+func (p *Page) IsDeleted() bool {
+	return p.deletedAt != nil
+}
+
+// MoveToTrash marks the page as deleted at the given time. Descendants and versions trashed along with the page
+// share the time, so restoring the page restores exactly them.
+func (p *Page) MoveToTrash(at time.Time) {
+	p.deletedAt = &at
+}
+
+// Restore takes the page back out of the trash
+func (p *Page) Restore() {
+	p.deletedAt = nil
+}
+
+// Children returns the child pages that have been attached to the page
+func (p *Page) Children() []*Page {
+	return p.children
+}
+
 // Versions returns page versions
 func (p *Page) Versions() []*PageVersion {
 	return p.versions
@@ -158,6 +210,16 @@ func (p *Page) UpdatePath(path *string) {
 	p.path = path
 }
 
+// BuildPath rebuilds the materialized path of the page from its parent. A nil parent makes the page a root page.
+func (p *Page) BuildPath(parent *Page) {
+	path := "/" + p.key.Value()
+	if parent != nil {
+		path = parent.FullPath() + path
+	}
+	p.path = &path
+	p.updatedAt = time.Now()
+}
+
 // UpdateIndex updates the page index
 func (p *Page) UpdateIndex(index int) {
 	p.index = index
@@ -170,10 +232,35 @@ func (p *Page) SetParent(parentID *PageID) {
 	p.updatedAt = time.Now()
 }
 
-// UpdateType updates the page type
+// MoveToSite moves the page to another site
+func (p *Page) MoveToSite(siteID SiteID) {
+	p.siteID = siteID
+	p.updatedAt = time.Now()
+}
+
+// UpdateType updates the page type and clears link targets that do not apply to the new type
 func (p *Page) UpdateType(pageType PageType) {
 	p.pageType = pageType
+	if pageType != PageTypeLink {
+		p.linkURL = nil
+	}
+	if pageType != PageTypeHardLink {
+		p.hardLinkPageID = nil
+	}
+	if pageType != PageTypeContent {
+		p.feedRoot = false
+	}
+	p.updatedAt = time.Now()
+}
+
+// SetFeedRoot marks the page as a feed root or clears the mark. Only content pages can be feed roots.
+func (p *Page) SetFeedRoot(feedRoot bool) error {
+	if feedRoot && p.pageType != PageTypeContent {
+		return errors.ErrPageFeedRootTypeInvalid
+	}
+	p.feedRoot = feedRoot
 	p.updatedAt = time.Now()
+	return nil
 }
 
 // SetLinkURL sets the link URL (for link type pages)
@@ -196,6 +283,36 @@ func (p *Page) SetHardLinkPageID(pageID *PageID) error {
 	return nil
 }
 
+// ValidateTarget checks that the link or hard link target of the page fits its type
+func (p *Page) ValidateTarget() error {
+	switch p.pageType {
+	case PageTypeLink:
+		if p.linkURL == nil || *p.linkURL == "" {
+			return errors.ErrPageLinkURLRequired
+		}
+		if p.hardLinkPageID != nil {
+			return errors.ErrPageTargetNotAllowed
+		}
+	case PageTypeHardLink:
+		if p.hardLinkPageID == nil || p.hardLinkPageID.IsEmpty() {
+			return errors.ErrPageHardLinkTargetRequired
+		}
+		if !p.id.IsEmpty() && p.hardLinkPageID.Value() == p.id.Value() {
+			return errors.ErrPageHardLinkSelfReference
+		}
+		if p.linkURL != nil {
+			return errors.ErrPageTargetNotAllowed
+		}
+	case PageTypeContent, PageTypeSnippet:
+		if p.linkURL != nil || p.hardLinkPageID != nil {
+			return errors.ErrPageTargetNotAllowed
+		}
+	default:
+		return errors.ErrPageTypeInvalid
+	}
+	return nil
+}
+
 // AddChild adds a child page
 func (p *Page) AddChild(child *Page) error {
 	if child == nil {
@@ -229,3 +346,13 @@ func (p *Page) SetTimestamps(createdAt, updatedAt time.Time) {
 	p.createdAt = createdAt
 	p.updatedAt = updatedAt
 }
+
+// SetRevision sets the revision (used by repository when loading from or saving to database)
+func (p *Page) SetRevision(revision uint64) {
+	p.revision = revision
+}
+
+// SetDeletedAt sets when the page was trashed (used by repository when loading from database)
+func (p *Page) SetDeletedAt(deletedAt *time.Time) {
+	p.deletedAt = deletedAt
+}
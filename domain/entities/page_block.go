@@ -1,10 +1,20 @@
 package entities
 
 import (
+	"encoding/json"
 	"github.com/h4rdc0m/aurora-api/domain/errors"
 	"time"
 )
 
+// PageBlockContentTypeSnippet is the content type of blocks that embed a snippet page.
+// The content of such a block is a JSON object of the form {"page_id": <id>}.
+const PageBlockContentTypeSnippet = "snippet"
+
+// snippetBlockContent is the content of a snippet block
+type snippetBlockContent struct {
+	PageID uint64 `json:"page_id"`
+}
+
 type PageBlockID struct {
 	value uint64
 }
@@ -26,6 +36,7 @@ type PageBlock struct {
 	content       string
 	createdAt     time.Time
 	updatedAt     time.Time
+	revision      uint64
 }
 
 func NewPageBlock(pageVersionID PageVersionID, blockKey string, index int, contentType string, content string) (*PageBlock, error) {
@@ -81,6 +92,32 @@ func (pb *PageBlock) UpdatedAt() time.Time {
 	return pb.updatedAt
 }
 
+// Revision returns the number of times the block was saved, used to detect concurrent modifications
+func (pb *PageBlock) Revision() uint64 {
+	return pb.revision
+}
+
+// IsSnippet reports whether the block embeds a snippet page
+func (pb *PageBlock) IsSnippet() bool {
+	return pb.contentType == PageBlockContentTypeSnippet
+}
+
+// SnippetPageID returns the ID of the snippet page embedded by the block, or nil when the block is no snippet block.
+// Returns an error if the content of a snippet block does not reference a page.
+func (pb *PageBlock) SnippetPageID() (*PageID, error) {
+	if !pb.IsSnippet() {
+		return nil, nil
+	}
+
+	var content snippetBlockContent
+	if err := json.Unmarshal([]byte(pb.content), &content); err != nil || content.PageID == 0 {
+		return nil, errors.ErrPageBlockSnippetInvalid
+	}
+
+	pageID := NewPageID(content.PageID)
+	return &pageID, nil
+}
+
 // UpdateBlockKey updates the block key
 func (pb *PageBlock) UpdateBlockKey(blockKey string) error {
 	if blockKey == "" {
@@ -125,3 +162,8 @@ func (pb *PageBlock) SetTimestamps(createdAt, updatedAt time.Time) {
 	pb.createdAt = createdAt
 	pb.updatedAt = updatedAt
 }
+
+// SetRevision sets the revision (used by repository when loading from or saving to database)
+func (pb *PageBlock) SetRevision(revision uint64) {
+	pb.revision = revision
+}
@@ -0,0 +1,121 @@
+package entities
+
+import "strings"
+
+// openGraphType is the Open Graph object type of delivered pages
+const openGraphType = "website"
+
+// hrefLangDefault marks the alternate link served to visitors whose language matches no locale
+const hrefLangDefault = "x-default"
+
+// PageHead is the head metadata of a delivered page, computed from the SEO metadata of its version and the
+// settings of its site so that frontends can render it as is. All URLs are absolute.
+type PageHead struct {
+	Title        string
+	Description  *string
+	CanonicalURL string
+	Robots       *string
+	OpenGraph    OpenGraphMetadata
+	Twitter      TwitterMetadata
+	Alternates   []AlternateLink
+}
+
+// OpenGraphMetadata is the Open Graph data of a delivered page. Locale is written with an underscore, e.g. "nl_BE".
+type OpenGraphMetadata struct {
+	Type        string
+	Title       string
+	Description *string
+	Image       *string
+	URL         string
+	SiteName    string
+	Locale      string
+}
+
+// TwitterMetadata is the Twitter card data of a delivered page
+type TwitterMetadata struct {
+	Card        TwitterCard
+	Title       string
+	Description *string
+	Image       *string
+}
+
+// AlternateLink points to the page in another locale. HrefLang is a locale, or "x-default" for the default locale.
+type AlternateLink struct {
+	HrefLang string
+	URL      string
+}
+
+// NewPageHead computes the head metadata of a delivered page with a version. The title is rendered with the title
+// template of the site serving the request, filling {page.title} with the meta title of the version or else its
+// title. Canonical and alternate URLs point to the resolved page on its own site, contentSite, which differs from
+// the serving site for hard links into another site. Translations lists the locales the resolved page is
+// published in.
+func NewPageHead(delivered *DeliveredPage, contentSite *Site, parentTitle *string, translations []Locale) *PageHead {
+	version := delivered.Version
+	seo := version.SEO()
+	contentPath := delivered.Resolved.Page.FullPath()
+
+	pageTitle := version.Title()
+	if seo.MetaTitle != nil {
+		pageTitle = *seo.MetaTitle
+	}
+
+	head := &PageHead{
+		Title:        delivered.Site.RenderTitle(pageTitle, version.Description(), parentTitle),
+		Description:  version.Description(),
+		CanonicalURL: contentSite.BaseURL() + contentSite.LocalizedPath(version.Locale(), contentPath),
+		Robots:       seo.Robots,
+	}
+	if seo.CanonicalURL != nil {
+		head.CanonicalURL = contentSite.AbsoluteURL(*seo.CanonicalURL)
+	}
+
+	head.OpenGraph = OpenGraphMetadata{
+		Type:        openGraphType,
+		Title:       pageTitle,
+		Description: version.Description(),
+		URL:         head.CanonicalURL,
+		SiteName:    delivered.Site.Name(),
+		Locale:      strings.ReplaceAll(string(version.Locale()), "-", "_"),
+	}
+	if seo.OGTitle != nil {
+		head.OpenGraph.Title = *seo.OGTitle
+	}
+	if seo.OGDescription != nil {
+		head.OpenGraph.Description = seo.OGDescription
+	}
+	if seo.OGImage != nil {
+		image := contentSite.AbsoluteURL(*seo.OGImage)
+		head.OpenGraph.Image = &image
+	}
+
+	head.Twitter = TwitterMetadata{
+		Card:        TwitterCardSummary,
+		Title:       head.OpenGraph.Title,
+		Description: head.OpenGraph.Description,
+		Image:       head.OpenGraph.Image,
+	}
+	switch {
+	case seo.TwitterCard != nil:
+		head.Twitter.Card = *seo.TwitterCard
+	case head.Twitter.Image != nil:
+		head.Twitter.Card = TwitterCardSummaryLargeImage
+	}
+
+	published := make(map[Locale]bool, len(translations))
+	for _, locale := range translations {
+		published[locale] = true
+	}
+	for _, locale := range contentSite.Locales() {
+		if published[locale] {
+			url := contentSite.BaseURL() + contentSite.LocalizedPath(locale, contentPath)
+			head.Alternates = append(head.Alternates, AlternateLink{HrefLang: string(locale), URL: url})
+		}
+	}
+	if published[contentSite.DefaultLocale()] {
+		url := contentSite.BaseURL() + contentSite.LocalizedPath(contentSite.DefaultLocale(), contentPath)
+		head.Alternates = append(head.Alternates, AlternateLink{HrefLang: hrefLangDefault, URL: url})
+	}
+
+	return head
+}
@@ -0,0 +1,201 @@
+package entities
+
+import (
+	"github.com/h4rdc0m/aurora-api/domain/errors"
+	"net/url"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Limits of the SEO metadata of a page version
+const (
+	MaxSEOTitleLength       = 255
+	MaxSEODescriptionLength = 500
+	MaxSEOURLLength         = 2048
+	MaxSEORobotsLength      = 255
+)
+
+// TwitterCard is the kind of card shown when a page is shared on Twitter
+type TwitterCard string
+
+const (
+	TwitterCardSummary           TwitterCard = "summary"
+	TwitterCardSummaryLargeImage TwitterCard = "summary_large_image"
+)
+
+// NewTwitterCard parses the given string into a TwitterCard. Returns an error if the card type is unknown.
+func NewTwitterCard(value string) (TwitterCard, error) {
+	switch card := TwitterCard(value); card {
+	case TwitterCardSummary, TwitterCardSummaryLargeImage:
+		return card, nil
+	}
+	return "", errors.ErrPageSEOTwitterCardInvalid
+}
+
+// robotsDirectives are the robots directives without a value
+var robotsDirectives = map[string]bool{
+	"all":             true,
+	"index":           true,
+	"noindex":         true,
+	"follow":          true,
+	"nofollow":        true,
+	"none":            true,
+	"noarchive":       true,
+	"nosnippet":       true,
+	"noimageindex":    true,
+	"notranslate":     true,
+	"indexifembedded": true,
+}
+
+// robotsValueDirectives validate the value of the robots directives written as "name:value"
+var robotsValueDirectives = map[string]func(value string) bool{
+	"max-snippet":       isRobotsLimit,
+	"max-video-preview": isRobotsLimit,
+	"max-image-preview": func(value string) bool {
+		return value == "none" || value == "standard" || value == "large"
+	},
+	"unavailable_after": func(value string) bool {
+		return value != ""
+	},
+}
+
+// PageSEO holds the search engine and social sharing metadata of a page version. All fields are optional; the
+// delivered head metadata derives missing values from the version and its site, see NewPageHead.
+// URLs are absolute http(s) URLs or paths relative to the site root.
+type PageSEO struct {
+	MetaTitle     *string
+	CanonicalURL  *string
+	Robots        *string
+	OGTitle       *string
+	OGDescription *string
+	OGImage       *string
+	TwitterCard   *TwitterCard
+}
+
+// Normalize validates the metadata and returns it with blank fields cleared and the robots directives in their
+// canonical form, e.g. "noindex, max-snippet:50".
+func (s PageSEO) Normalize() (PageSEO, error) {
+	s.MetaTitle = trimmedOrNil(s.MetaTitle)
+	s.CanonicalURL = trimmedOrNil(s.CanonicalURL)
+	s.Robots = trimmedOrNil(s.Robots)
+	s.OGTitle = trimmedOrNil(s.OGTitle)
+	s.OGDescription = trimmedOrNil(s.OGDescription)
+	s.OGImage = trimmedOrNil(s.OGImage)
+
+	if exceeds(s.MetaTitle, MaxSEOTitleLength) || exceeds(s.OGTitle, MaxSEOTitleLength) {
+		return PageSEO{}, errors.ErrPageSEOTitleTooLong
+	}
+	if exceeds(s.OGDescription, MaxSEODescriptionLength) {
+		return PageSEO{}, errors.ErrPageSEODescriptionTooLong
+	}
+	if s.CanonicalURL != nil && !isSEOURL(*s.CanonicalURL) {
+		return PageSEO{}, errors.ErrPageSEOCanonicalURLInvalid
+	}
+	if s.OGImage != nil && !isSEOURL(*s.OGImage) {
+		return PageSEO{}, errors.ErrPageSEOImageInvalid
+	}
+	if s.TwitterCard != nil {
+		if _, err := NewTwitterCard(string(*s.TwitterCard)); err != nil {
+			return PageSEO{}, err
+		}
+	}
+
+	if s.Robots != nil {
+		robots, err := normalizeRobots(*s.Robots)
+		if err != nil {
+			return PageSEO{}, err
+		}
+		s.Robots = &robots
+	}
+
+	return s, nil
+}
+
+// fields returns the metadata as named values, in a fixed order
+func (s PageSEO) fields() []namedValue {
+	var twitterCard *string
+	if s.TwitterCard != nil {
+		card := string(*s.TwitterCard)
+		twitterCard = &card
+	}
+	return []namedValue{
+		{name: "meta_title", value: s.MetaTitle},
+		{name: "canonical_url", value: s.CanonicalURL},
+		{name: "robots", value: s.Robots},
+		{name: "og_title", value: s.OGTitle},
+		{name: "og_description", value: s.OGDescription},
+		{name: "og_image", value: s.OGImage},
+		{name: "twitter_card", value: twitterCard},
+	}
+}
+
+// namedValue is an optional field value together with its name
+type namedValue struct {
+	name  string
+	value *string
+}
+
+// normalizeRobots validates comma separated robots directives and joins them lowercased
+func normalizeRobots(robots string) (string, error) {
+	if len(robots) > MaxSEORobotsLength {
+		return "", errors.ErrPageSEORobotsInvalid
+	}
+
+	var directives []string
+	for _, directive := range strings.Split(robots, ",") {
+		directive = strings.ToLower(strings.TrimSpace(directive))
+		name, value, hasValue := strings.Cut(directive, ":")
+		if hasValue {
+			name, value = strings.TrimSpace(name), strings.TrimSpace(value)
+			valid, known := robotsValueDirectives[name]
+			if !known || !valid(value) {
+				return "", errors.ErrPageSEORobotsInvalid
+			}
+			directive = name + ":" + value
+		} else if !robotsDirectives[name] {
+			return "", errors.ErrPageSEORobotsInvalid
+		}
+		directives = append(directives, directive)
+	}
+
+	return strings.Join(directives, ", "), nil
+}
+
+// isRobotsLimit reports whether the value is a length limit of a robots directive, where -1 means no limit
+func isRobotsLimit(value string) bool {
+	limit, err := strconv.Atoi(value)
+	return err == nil && limit >= -1
+}
+
+// isSEOURL reports whether the link is an absolute http(s) URL or a path relative to the site root
+func isSEOURL(link string) bool {
+	if len(link) > MaxSEOURLLength {
+		return false
+	}
+	if strings.HasPrefix(link, "/") && !strings.HasPrefix(link, "//") {
+		return true
+	}
+	parsed, err := url.Parse(link)
+	if err != nil {
+		return false
+	}
+	return (parsed.Scheme == "http" || parsed.Scheme == "https") && parsed.Host != ""
+}
+
+// trimmedOrNil returns the trimmed value, or nil when it is blank
+func trimmedOrNil(value *string) *string {
+	if value == nil {
+		return nil
+	}
+	trimmed := strings.TrimSpace(*value)
+	if trimmed == "" {
+		return nil
+	}
+	return &trimmed
+}
+
+// exceeds reports whether the optional value is longer than the given number of characters
+func exceeds(value *string, limit int) bool {
+	return value != nil && utf8.RuneCountInString(*value) > limit
+}
@@ -17,19 +17,67 @@ func (p PageVersionID) Value() uint64 {
 	return p.value
 }
 
+// PageVersionStatus represents the editorial workflow state of a page version
+type PageVersionStatus string
+
+const (
+	PageVersionStatusDraft     PageVersionStatus = "draft"     // Being edited, not visible to reviewers.
+	PageVersionStatusInReview  PageVersionStatus = "in_review" // Submitted and waiting for review.
+	PageVersionStatusApproved  PageVersionStatus = "approved"  // Passed review and ready to go live.
+	PageVersionStatusPublished PageVersionStatus = "published" // Live; at most one version per page and locale.
+	PageVersionStatusArchived  PageVersionStatus = "archived"  // Retired, either unpublished or discarded.
+)
+
+// pageVersionTransitions lists the statuses each status may move to
+var pageVersionTransitions = map[PageVersionStatus][]PageVersionStatus{
+	PageVersionStatusDraft:     {PageVersionStatusInReview, PageVersionStatusArchived},
+	PageVersionStatusInReview:  {PageVersionStatusApproved, PageVersionStatusDraft, PageVersionStatusArchived},
+	PageVersionStatusApproved:  {PageVersionStatusPublished, PageVersionStatusDraft, PageVersionStatusArchived},
+	PageVersionStatusPublished: {PageVersionStatusArchived},
+	PageVersionStatusArchived:  {},
+}
+
+// NewPageVersionStatus parses the given string into a PageVersionStatus. Returns an error if the status is unknown.
+func NewPageVersionStatus(value string) (PageVersionStatus, error) {
+	status := PageVersionStatus(value)
+	if _, ok := pageVersionTransitions[status]; !ok {
+		return "", errors.ErrPageVersionStatusInvalid
+	}
+	return status, nil
+}
+
+// CanTransitionTo reports whether a version in this status may move to the target status
+func (s PageVersionStatus) CanTransitionTo(target PageVersionStatus) bool {
+	for _, allowed := range pageVersionTransitions[s] {
+		if allowed == target {
+			return true
+		}
+	}
+	return false
+}
+
 type PageVersion struct {
-	id          PageVersionID
-	pageID      PageID
-	version     uint
-	title       string
-	description *string
-	isPublished bool
-	createdAt   time.Time
-	updatedAt   time.Time
-	blocks      []*PageBlock
-}
-
-func NewPageVersion(pageID PageID, version uint, title string, description *string) (*PageVersion, error) {
+	id              PageVersionID
+	pageID          PageID
+	locale          Locale
+	version         uint
+	title           string
+	description     *string
+	seo             PageSEO
+	status          PageVersionStatus
+	statusChangedBy *UserID
+	statusChangedAt *time.Time
+	publishAt       *time.Time
+	unpublishAt     *time.Time
+	scheduledBy     *UserID
+	createdAt       time.Time
+	updatedAt       time.Time
+	revision        uint64
+	deletedAt       *time.Time
+	blocks          []*PageBlock
+}
+
+func NewPageVersion(pageID PageID, version uint, locale Locale, title string, description *string) (*PageVersion, error) {
 	if title == "" {
 		return nil, errors.ErrPageVersionTitleEmpty
 	}
@@ -38,14 +86,19 @@ func NewPageVersion(pageID PageID, version uint, title string, description *stri
 		return nil, errors.ErrPageVersionInvalidVersion
 	}
 
+	if locale == "" {
+		return nil, errors.ErrLocaleInvalid
+	}
+
 	now := time.Now()
 
 	return &PageVersion{
 		pageID:      pageID,
+		locale:      locale,
 		version:     version,
 		title:       title,
 		description: description,
-		isPublished: false,
+		status:      PageVersionStatusDraft,
 		createdAt:   now,
 		updatedAt:   now,
 		blocks:      make([]*PageBlock, 0),
@@ -62,6 +115,11 @@ func (p *PageVersion) PageID() PageID {
 	return p.pageID
 }
 
+// Locale returns the locale the page version is written in
+func (p *PageVersion) Locale() Locale {
+	return p.locale
+}
+
 // Version returns the version number
 func (p *PageVersion) Version() uint {
 	return p.version
@@ -77,9 +135,59 @@ func (p *PageVersion) Description() *string {
 	return p.description
 }
 
+// SEO returns the search engine and social sharing metadata of the page version
+func (p *PageVersion) SEO() PageSEO {
+	return p.seo
+}
+
+// Status returns the workflow status of the page version
+func (p *PageVersion) Status() PageVersionStatus {
+	return p.status
+}
+
+// StatusChangedBy returns the user who last changed the status, nil while it is still the initial draft
+func (p *PageVersion) StatusChangedBy() *UserID {
+	return p.statusChangedBy
+}
+
+// StatusChangedAt returns when the status was last changed, nil while it is still the initial draft
+func (p *PageVersion) StatusChangedAt() *time.Time {
+	return p.statusChangedAt
+}
+
 // IsPublished returns whether the page version is published
 func (p *PageVersion) IsPublished() bool {
-	return p.isPublished
+	return p.status == PageVersionStatusPublished
+}
+
+// IsDraft returns whether the page version is still a draft
+func (p *PageVersion) IsDraft() bool {
+	return p.status == PageVersionStatusDraft
+}
+
+// PublishAt returns when the version is scheduled to be published
+func (p *PageVersion) PublishAt() *time.Time {
+	return p.publishAt
+}
+
+// UnpublishAt returns when the version is scheduled to be taken offline
+func (p *PageVersion) UnpublishAt() *time.Time {
+	return p.unpublishAt
+}
+
+// ScheduledBy returns the user who scheduled the version, on whose behalf the scheduler changes its status
+func (p *PageVersion) ScheduledBy() *UserID {
+	return p.scheduledBy
+}
+
+// IsPublishDue reports whether an approved version has reached its scheduled publish time
+func (p *PageVersion) IsPublishDue(now time.Time) bool {
+	return p.status == PageVersionStatusApproved && p.publishAt != nil && !p.publishAt.After(now)
+}
+
+// IsUnpublishDue reports whether a published version has reached its scheduled unpublish time
+func (p *PageVersion) IsUnpublishDue(now time.Time) bool {
+	return p.status == PageVersionStatusPublished && p.unpublishAt != nil && !p.unpublishAt.After(now)
 }
 
 // CreatedAt returns the creation time
@@ -92,6 +200,31 @@ func (p *PageVersion) UpdatedAt() time.Time {
 	return p.updatedAt
 }
 
+// Revision returns the number of times the version was saved, used to detect concurrent modifications
+func (p *PageVersion) Revision() uint64 {
+	return p.revision
+}
+
+// DeletedAt returns when the version was moved to the trash, nil while it is not trashed
+func (p *PageVersion) DeletedAt() *time.Time {
+	return p.deletedAt
+}
+
+// IsDeleted reports whether the version is in the trash
+func (p *PageVersion) IsDeleted() bool {
+	return p.deletedAt != nil
+}
+
+// MoveToTrash marks the version as deleted at the given time, which is the time its page was trashed
+func (p *PageVersion) MoveToTrash(at time.Time) {
+	p.deletedAt = &at
+}
+
+// Restore takes the version back out of the trash
+func (p *PageVersion) Restore() {
+	p.deletedAt = nil
+}
+
 // Blocks returns the page blocks
 func (p *PageVersion) Blocks() []*PageBlock {
 	return p.blocks
@@ -115,16 +248,76 @@ func (p *PageVersion) UpdateDescription(description *string) {
 	p.updatedAt = time.Now()
 }
 
-// Publish publishes the page version
-func (p *PageVersion) Publish() {
-	p.isPublished = true
+// UpdateSEO validates and replaces the search engine and social sharing metadata of the page version
+func (p *PageVersion) UpdateSEO(seo PageSEO) error {
+	seo, err := seo.Normalize()
+	if err != nil {
+		return err
+	}
+
+	p.seo = seo
 	p.updatedAt = time.Now()
+
+	return nil
 }
 
-// Unpublish unpublishes the page version
-func (p *PageVersion) Unpublish() {
-	p.isPublished = false
+// TransitionTo moves the page version to the target status and records who changed it and at what time.
+// Returns an error if the workflow does not allow the transition.
+func (p *PageVersion) TransitionTo(status PageVersionStatus, changedBy UserID, at time.Time) error {
+	if !p.status.CanTransitionTo(status) {
+		return errors.ErrPageVersionTransitionNotAllowed
+	}
+
+	p.status = status
+	p.statusChangedBy = &changedBy
+	p.statusChangedAt = &at
+	p.updatedAt = at
+
+	return nil
+}
+
+// SubmitForReview moves a draft into review
+func (p *PageVersion) SubmitForReview(changedBy UserID, at time.Time) error {
+	return p.TransitionTo(PageVersionStatusInReview, changedBy, at)
+}
+
+// Approve approves a version that is in review
+func (p *PageVersion) Approve(changedBy UserID, at time.Time) error {
+	return p.TransitionTo(PageVersionStatusApproved, changedBy, at)
+}
+
+// Reject sends a version in review or an approved version back to draft
+func (p *PageVersion) Reject(changedBy UserID, at time.Time) error {
+	return p.TransitionTo(PageVersionStatusDraft, changedBy, at)
+}
+
+// Publish publishes an approved version
+func (p *PageVersion) Publish(changedBy UserID, at time.Time) error {
+	return p.TransitionTo(PageVersionStatusPublished, changedBy, at)
+}
+
+// Archive retires the version, which unpublishes it when it is live
+func (p *PageVersion) Archive(changedBy UserID, at time.Time) error {
+	return p.TransitionTo(PageVersionStatusArchived, changedBy, at)
+}
+
+// Schedule sets when the version goes live and when it is taken offline again; nil clears a time.
+// Returns an error if the version is archived or the unpublish time is not after the publish time.
+func (p *PageVersion) Schedule(publishAt, unpublishAt *time.Time, scheduledBy UserID) error {
+	if p.status == PageVersionStatusArchived {
+		return errors.ErrPageVersionNotSchedulable
+	}
+
+	if publishAt != nil && unpublishAt != nil && !unpublishAt.After(*publishAt) {
+		return errors.ErrPageVersionScheduleInvalid
+	}
+
+	p.publishAt = publishAt
+	p.unpublishAt = unpublishAt
+	p.scheduledBy = &scheduledBy
 	p.updatedAt = time.Now()
+
+	return nil
 }
 
 // AddBlock adds a page block
@@ -156,8 +349,37 @@ func (p *PageVersion) SetID(id PageVersionID) {
 	p.id = id
 }
 
+// SetStatus sets the workflow status without validating the transition (used by repository when loading from database)
+func (p *PageVersion) SetStatus(status PageVersionStatus, changedBy *UserID, changedAt *time.Time) {
+	p.status = status
+	p.statusChangedBy = changedBy
+	p.statusChangedAt = changedAt
+}
+
+// SetSchedule sets the publish schedule without validation (used by repository when loading from database)
+func (p *PageVersion) SetSchedule(publishAt, unpublishAt *time.Time, scheduledBy *UserID) {
+	p.publishAt = publishAt
+	p.unpublishAt = unpublishAt
+	p.scheduledBy = scheduledBy
+}
+
+// SetSEO sets the search engine and social sharing metadata without validation (used by repository when loading from database)
+func (p *PageVersion) SetSEO(seo PageSEO) {
+	p.seo = seo
+}
+
 // SetTimestamps sets the timestamps (used by repository when loading from database)
 func (p *PageVersion) SetTimestamps(createdAt, updatedAt time.Time) {
 	p.createdAt = createdAt
 	p.updatedAt = updatedAt
 }
+
+// SetRevision sets the revision (used by repository when loading from or saving to database)
+func (p *PageVersion) SetRevision(revision uint64) {
+	p.revision = revision
+}
+
+// SetDeletedAt sets when the version was trashed (used by repository when loading from database)
+func (p *PageVersion) SetDeletedAt(deletedAt *time.Time) {
+	p.deletedAt = deletedAt
+}
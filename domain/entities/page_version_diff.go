@@ -0,0 +1,207 @@
+package entities
+
+import (
+	"github.com/h4rdc0m/aurora-api/utils/textdiff"
+	"sort"
+	"strings"
+)
+
+// PageBlockChange describes what happened to a block between two page versions.
+type PageBlockChange string
+
+const (
+	PageBlockAdded     PageBlockChange = "added"
+	PageBlockRemoved   PageBlockChange = "removed"
+	PageBlockMoved     PageBlockChange = "moved"
+	PageBlockChanged   PageBlockChange = "changed"
+	PageBlockUnchanged PageBlockChange = "unchanged"
+)
+
+// textContentTypes are the block content types compared line by line, besides text/* and JSON or XML media types.
+var textContentTypes = map[string]bool{
+	"text":     true,
+	"richtext": true,
+	"html":     true,
+	"markdown": true,
+	"json":     true,
+	"xml":      true,
+}
+
+// StringChange holds the old and new value of a field that differs between two page versions.
+type StringChange struct {
+	From *string
+	To   *string
+}
+
+// FieldChange holds the old and new value of a named field that differs between two page versions.
+type FieldChange struct {
+	Field string
+	StringChange
+}
+
+// PageBlockDiff describes how a single block, matched by its block key, differs between two page versions.
+// FromIndex and ToIndex are nil when the block does not exist on that side.
+// ContentDiff holds a line diff of the content for text-like content types whose content changed.
+type PageBlockDiff struct {
+	BlockKey        string
+	Changes         []PageBlockChange
+	FromIndex       *int
+	ToIndex         *int
+	FromContentType string
+	ToContentType   string
+	ContentDiff     []textdiff.Line
+}
+
+// PageVersionDiff describes the differences between two versions of the same page.
+// Title and Description are nil when unchanged and SEO lists only the changed SEO metadata fields. Blocks are ordered by their position in the newer version,
+// followed by the removed blocks in their old order.
+type PageVersionDiff struct {
+	FromVersionID PageVersionID
+	ToVersionID   PageVersionID
+	Title         *StringChange
+	Description   *StringChange
+	SEO           []FieldChange
+	Blocks        []PageBlockDiff
+}
+
+// NewPageVersionDiff compares two page versions including their attached blocks.
+func NewPageVersionDiff(from, to *PageVersion) *PageVersionDiff {
+	diff := &PageVersionDiff{
+		FromVersionID: from.ID(),
+		ToVersionID:   to.ID(),
+	}
+
+	if from.Title() != to.Title() {
+		fromTitle, toTitle := from.Title(), to.Title()
+		diff.Title = &StringChange{From: &fromTitle, To: &toTitle}
+	}
+	if !equalStringPtr(from.Description(), to.Description()) {
+		diff.Description = &StringChange{From: from.Description(), To: to.Description()}
+	}
+	toSEO := to.SEO().fields()
+	for i, field := range from.SEO().fields() {
+		if !equalStringPtr(field.value, toSEO[i].value) {
+			diff.SEO = append(diff.SEO, FieldChange{Field: field.name, StringChange: StringChange{From: field.value, To: toSEO[i].value}})
+		}
+	}
+
+	fromBlocks := blocksByKey(from.Blocks())
+	for _, block := range sortedBlocks(to.Blocks()) {
+		diff.Blocks = append(diff.Blocks, diffBlock(fromBlocks[block.BlockKey()], block))
+	}
+	toBlocks := blocksByKey(to.Blocks())
+	for _, block := range sortedBlocks(from.Blocks()) {
+		if _, exists := toBlocks[block.BlockKey()]; !exists {
+			diff.Blocks = append(diff.Blocks, diffBlock(block, nil))
+		}
+	}
+
+	return diff
+}
+
+// HasChanges reports whether the two versions differ at all.
+func (d *PageVersionDiff) HasChanges() bool {
+	if d.Title != nil || d.Description != nil || len(d.SEO) > 0 {
+		return true
+	}
+	for _, block := range d.Blocks {
+		if block.Changes[0] != PageBlockUnchanged {
+			return true
+		}
+	}
+	return false
+}
+
+// IsTextContentType reports whether blocks of the given content type hold text that can be diffed line by line.
+func IsTextContentType(contentType string) bool {
+	contentType = strings.ToLower(strings.TrimSpace(contentType))
+	if mediaType, _, found := strings.Cut(contentType, ";"); found {
+		contentType = strings.TrimSpace(mediaType)
+	}
+
+	return textContentTypes[contentType] ||
+		strings.HasPrefix(contentType, "text/") ||
+		contentType == "application/json" ||
+		contentType == "application/xml" ||
+		strings.HasSuffix(contentType, "+json") ||
+		strings.HasSuffix(contentType, "+xml")
+}
+
+// diffBlock compares the old and new state of a block; either side may be nil.
+func diffBlock(from, to *PageBlock) PageBlockDiff {
+	switch {
+	case from == nil:
+		index := to.Index()
+		return PageBlockDiff{
+			BlockKey:      to.BlockKey(),
+			Changes:       []PageBlockChange{PageBlockAdded},
+			ToIndex:       &index,
+			ToContentType: to.ContentType(),
+			ContentDiff:   contentDiff("", to),
+		}
+	case to == nil:
+		index := from.Index()
+		return PageBlockDiff{
+			BlockKey:        from.BlockKey(),
+			Changes:         []PageBlockChange{PageBlockRemoved},
+			FromIndex:       &index,
+			FromContentType: from.ContentType(),
+		}
+	}
+
+	fromIndex, toIndex := from.Index(), to.Index()
+	diff := PageBlockDiff{
+		BlockKey:        to.BlockKey(),
+		FromIndex:       &fromIndex,
+		ToIndex:         &toIndex,
+		FromContentType: from.ContentType(),
+		ToContentType:   to.ContentType(),
+	}
+
+	if fromIndex != toIndex {
+		diff.Changes = append(diff.Changes, PageBlockMoved)
+	}
+	if from.ContentType() != to.ContentType() || from.Content() != to.Content() {
+		diff.Changes = append(diff.Changes, PageBlockChanged)
+		diff.ContentDiff = contentDiff(from.Content(), to)
+	}
+	if len(diff.Changes) == 0 {
+		diff.Changes = []PageBlockChange{PageBlockUnchanged}
+	}
+
+	return diff
+}
+
+// contentDiff returns a line diff of the block content when the new block holds text
+func contentDiff(fromContent string, to *PageBlock) []textdiff.Line {
+	if !IsTextContentType(to.ContentType()) {
+		return nil
+	}
+	return textdiff.Lines(fromContent, to.Content())
+}
+
+// blocksByKey indexes blocks by their block key
+func blocksByKey(blocks []*PageBlock) map[string]*PageBlock {
+	byKey := make(map[string]*PageBlock, len(blocks))
+	for _, block := range blocks {
+		byKey[block.BlockKey()] = block
+	}
+	return byKey
+}
+
+// sortedBlocks returns the blocks ordered by index without changing the given slice
+func sortedBlocks(blocks []*PageBlock) []*PageBlock {
+	sorted := append([]*PageBlock(nil), blocks...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Index() < sorted[j].Index()
+	})
+	return sorted
+}
+
+// equalStringPtr reports whether two optional strings hold the same value
+func equalStringPtr(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
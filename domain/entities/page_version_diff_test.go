@@ -0,0 +1,231 @@
+package entities
+
+import (
+	"github.com/h4rdc0m/aurora-api/utils/textdiff"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+// testBlock describes a block attached to a version under test
+type testBlock struct {
+	key         string
+	index       int
+	contentType string
+	content     string
+}
+
+// newDiffVersion creates a persisted version of page 1 with the given title and blocks
+func newDiffVersion(t *testing.T, id uint64, title string, blocks ...testBlock) *PageVersion {
+	version, err := NewPageVersion(NewPageID(1), uint(id), DefaultLocale, title, nil)
+	assert.NoError(t, err)
+	version.SetID(NewPageVersionID(id))
+	for _, spec := range blocks {
+		block, err := NewPageBlock(version.ID(), spec.key, spec.index, spec.contentType, spec.content)
+		assert.NoError(t, err)
+		assert.NoError(t, version.AddBlock(block))
+	}
+	return version
+}
+
+// stringPtr returns a pointer to the value
+func stringPtr(value string) *string {
+	return &value
+}
+
+func TestNewPageVersionDiff_Blocks(t *testing.T) {
+	type expectedBlock struct {
+		key     string
+		changes []PageBlockChange
+	}
+
+	tests := []struct {
+		name string
+		from []testBlock
+		to   []testBlock
+		want []expectedBlock
+	}{
+		{
+			name: "unchanged",
+			from: []testBlock{{"intro", 0, "text", "Hello"}},
+			to:   []testBlock{{"intro", 0, "text", "Hello"}},
+			want: []expectedBlock{{"intro", []PageBlockChange{PageBlockUnchanged}}},
+		},
+		{
+			name: "added",
+			from: []testBlock{{"intro", 0, "text", "Hello"}},
+			to:   []testBlock{{"intro", 0, "text", "Hello"}, {"gallery", 1, "image", "cat.png"}},
+			want: []expectedBlock{
+				{"intro", []PageBlockChange{PageBlockUnchanged}},
+				{"gallery", []PageBlockChange{PageBlockAdded}},
+			},
+		},
+		{
+			name: "removed blocks follow in their old order",
+			from: []testBlock{{"footer", 2, "text", "Bye"}, {"intro", 0, "text", "Hello"}, {"body", 1, "text", "Text"}},
+			to:   []testBlock{{"intro", 0, "text", "Hello"}},
+			want: []expectedBlock{
+				{"intro", []PageBlockChange{PageBlockUnchanged}},
+				{"body", []PageBlockChange{PageBlockRemoved}},
+				{"footer", []PageBlockChange{PageBlockRemoved}},
+			},
+		},
+		{
+			name: "moved",
+			from: []testBlock{{"intro", 0, "text", "Hello"}, {"body", 1, "text", "Text"}},
+			to:   []testBlock{{"body", 0, "text", "Text"}, {"intro", 1, "text", "Hello"}},
+			want: []expectedBlock{
+				{"body", []PageBlockChange{PageBlockMoved}},
+				{"intro", []PageBlockChange{PageBlockMoved}},
+			},
+		},
+		{
+			name: "changed content",
+			from: []testBlock{{"intro", 0, "text", "Hello"}},
+			to:   []testBlock{{"intro", 0, "text", "Hello world"}},
+			want: []expectedBlock{{"intro", []PageBlockChange{PageBlockChanged}}},
+		},
+		{
+			name: "changed content type",
+			from: []testBlock{{"intro", 0, "text", "Hello"}},
+			to:   []testBlock{{"intro", 0, "markdown", "Hello"}},
+			want: []expectedBlock{{"intro", []PageBlockChange{PageBlockChanged}}},
+		},
+		{
+			name: "moved and changed",
+			from: []testBlock{{"intro", 0, "text", "Hello"}, {"body", 1, "text", "Text"}},
+			to:   []testBlock{{"body", 0, "text", "Text"}, {"intro", 1, "text", "Hi"}},
+			want: []expectedBlock{
+				{"body", []PageBlockChange{PageBlockMoved}},
+				{"intro", []PageBlockChange{PageBlockMoved, PageBlockChanged}},
+			},
+		},
+		{
+			name: "matched by key rather than position",
+			from: []testBlock{{"a", 0, "text", "Same"}, {"b", 1, "text", "Same"}},
+			to:   []testBlock{{"b", 0, "text", "Same"}, {"c", 1, "text", "Same"}},
+			want: []expectedBlock{
+				{"b", []PageBlockChange{PageBlockMoved}},
+				{"c", []PageBlockChange{PageBlockAdded}},
+				{"a", []PageBlockChange{PageBlockRemoved}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diff := NewPageVersionDiff(newDiffVersion(t, 1, "Title", tt.from...), newDiffVersion(t, 2, "Title", tt.to...))
+
+			got := make([]expectedBlock, 0, len(diff.Blocks))
+			for _, block := range diff.Blocks {
+				got = append(got, expectedBlock{block.BlockKey, block.Changes})
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNewPageVersionDiff_BlockDetails(t *testing.T) {
+	t.Run("added block has only a new side", func(t *testing.T) {
+		diff := NewPageVersionDiff(newDiffVersion(t, 1, "Title"), newDiffVersion(t, 2, "Title", testBlock{"intro", 3, "text", "a\nb"}))
+
+		block := diff.Blocks[0]
+		assert.Nil(t, block.FromIndex)
+		assert.Equal(t, 3, *block.ToIndex)
+		assert.Empty(t, block.FromContentType)
+		assert.Equal(t, "text", block.ToContentType)
+		assert.Equal(t, []textdiff.Line{{Operation: textdiff.OperationInsert, Text: "a"}, {Operation: textdiff.OperationInsert, Text: "b"}}, block.ContentDiff)
+	})
+
+	t.Run("removed block has only an old side", func(t *testing.T) {
+		diff := NewPageVersionDiff(newDiffVersion(t, 1, "Title", testBlock{"intro", 3, "text", "a"}), newDiffVersion(t, 2, "Title"))
+
+		block := diff.Blocks[0]
+		assert.Equal(t, 3, *block.FromIndex)
+		assert.Nil(t, block.ToIndex)
+		assert.Equal(t, "text", block.FromContentType)
+		assert.Nil(t, block.ContentDiff)
+	})
+
+	t.Run("changed text block has a line diff", func(t *testing.T) {
+		diff := NewPageVersionDiff(
+			newDiffVersion(t, 1, "Title", testBlock{"intro", 0, "text/html", "a\nb"}),
+			newDiffVersion(t, 2, "Title", testBlock{"intro", 0, "text/html", "a\nc"}),
+		)
+
+		assert.Equal(t, []textdiff.Line{
+			{Operation: textdiff.OperationEqual, Text: "a"},
+			{Operation: textdiff.OperationDelete, Text: "b"},
+			{Operation: textdiff.OperationInsert, Text: "c"},
+		}, diff.Blocks[0].ContentDiff)
+	})
+
+	t.Run("changed binary block has no line diff", func(t *testing.T) {
+		diff := NewPageVersionDiff(
+			newDiffVersion(t, 1, "Title", testBlock{"hero", 0, "image", "cat.png"}),
+			newDiffVersion(t, 2, "Title", testBlock{"hero", 0, "image", "dog.png"}),
+		)
+
+		assert.Equal(t, []PageBlockChange{PageBlockChanged}, diff.Blocks[0].Changes)
+		assert.Nil(t, diff.Blocks[0].ContentDiff)
+	})
+}
+
+func TestNewPageVersionDiff_Fields(t *testing.T) {
+	t.Run("identical versions have no changes", func(t *testing.T) {
+		from := newDiffVersion(t, 1, "About", testBlock{"intro", 0, "text", "Hello"})
+		to := newDiffVersion(t, 2, "About", testBlock{"intro", 0, "text", "Hello"})
+
+		diff := NewPageVersionDiff(from, to)
+
+		assert.Equal(t, uint64(1), diff.FromVersionID.Value())
+		assert.Equal(t, uint64(2), diff.ToVersionID.Value())
+		assert.Nil(t, diff.Title)
+		assert.Nil(t, diff.Description)
+		assert.Empty(t, diff.SEO)
+		assert.False(t, diff.HasChanges())
+	})
+
+	t.Run("title change", func(t *testing.T) {
+		diff := NewPageVersionDiff(newDiffVersion(t, 1, "About"), newDiffVersion(t, 2, "About us"))
+
+		assert.Equal(t, &StringChange{From: stringPtr("About"), To: stringPtr("About us")}, diff.Title)
+		assert.True(t, diff.HasChanges())
+	})
+
+	t.Run("description added", func(t *testing.T) {
+		to := newDiffVersion(t, 2, "About")
+		to.UpdateDescription(stringPtr("Who we are"))
+
+		diff := NewPageVersionDiff(newDiffVersion(t, 1, "About"), to)
+
+		assert.Equal(t, &StringChange{From: nil, To: stringPtr("Who we are")}, diff.Description)
+		assert.True(t, diff.HasChanges())
+	})
+
+	t.Run("only changed SEO fields are listed", func(t *testing.T) {
+		card := TwitterCardSummary
+		from := newDiffVersion(t, 1, "About")
+		from.SetSEO(PageSEO{MetaTitle: stringPtr("About"), Robots: stringPtr("noindex"), OGImage: stringPtr("a.png")})
+		to := newDiffVersion(t, 2, "About")
+		to.SetSEO(PageSEO{MetaTitle: stringPtr("About us"), OGImage: stringPtr("a.png"), TwitterCard: &card})
+
+		diff := NewPageVersionDiff(from, to)
+
+		assert.Equal(t, []FieldChange{
+			{Field: "meta_title", StringChange: StringChange{From: stringPtr("About"), To: stringPtr("About us")}},
+			{Field: "robots", StringChange: StringChange{From: stringPtr("noindex"), To: nil}},
+			{Field: "twitter_card", StringChange: StringChange{From: nil, To: stringPtr(string(card))}},
+		}, diff.SEO)
+		assert.Nil(t, diff.Title)
+		assert.True(t, diff.HasChanges())
+	})
+
+	t.Run("moved block is a change", func(t *testing.T) {
+		diff := NewPageVersionDiff(
+			newDiffVersion(t, 1, "About", testBlock{"intro", 0, "text", "Hello"}),
+			newDiffVersion(t, 2, "About", testBlock{"intro", 1, "text", "Hello"}),
+		)
+
+		assert.True(t, diff.HasChanges())
+	})
+}
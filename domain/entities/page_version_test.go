@@ -0,0 +1,170 @@
+package entities
+
+import (
+	"github.com/h4rdc0m/aurora-api/domain/errors"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+var allPageVersionStatuses = []PageVersionStatus{
+	PageVersionStatusDraft,
+	PageVersionStatusInReview,
+	PageVersionStatusApproved,
+	PageVersionStatusPublished,
+	PageVersionStatusArchived,
+}
+
+// newStatusVersion creates a version of page 1 in the given status
+func newStatusVersion(t *testing.T, status PageVersionStatus) *PageVersion {
+	version, err := NewPageVersion(NewPageID(1), 1, DefaultLocale, "About", nil)
+	assert.NoError(t, err)
+	version.SetStatus(status, nil, nil)
+	return version
+}
+
+func TestPageVersion_TransitionTo(t *testing.T) {
+	allowed := map[PageVersionStatus][]PageVersionStatus{
+		PageVersionStatusDraft:     {PageVersionStatusInReview, PageVersionStatusArchived},
+		PageVersionStatusInReview:  {PageVersionStatusApproved, PageVersionStatusDraft, PageVersionStatusArchived},
+		PageVersionStatusApproved:  {PageVersionStatusPublished, PageVersionStatusDraft, PageVersionStatusArchived},
+		PageVersionStatusPublished: {PageVersionStatusArchived},
+		PageVersionStatusArchived:  {},
+	}
+
+	changedBy := NewUserID(7)
+	changedAt := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	for _, from := range allPageVersionStatuses {
+		for _, to := range allPageVersionStatuses {
+			expected := false
+			for _, target := range allowed[from] {
+				expected = expected || target == to
+			}
+
+			t.Run(string(from)+" to "+string(to), func(t *testing.T) {
+				version := newStatusVersion(t, from)
+
+				err := version.TransitionTo(to, changedBy, changedAt)
+
+				assert.Equal(t, expected, from.CanTransitionTo(to))
+				if expected {
+					assert.NoError(t, err)
+					assert.Equal(t, to, version.Status())
+					assert.Equal(t, changedBy, *version.StatusChangedBy())
+					assert.Equal(t, changedAt, *version.StatusChangedAt())
+					assert.Equal(t, changedAt, version.UpdatedAt())
+				} else {
+					assert.ErrorIs(t, err, errors.ErrPageVersionTransitionNotAllowed)
+					assert.Equal(t, from, version.Status())
+					assert.Nil(t, version.StatusChangedBy())
+					assert.Nil(t, version.StatusChangedAt())
+				}
+			})
+		}
+	}
+}
+
+func TestPageVersion_WorkflowActions(t *testing.T) {
+	changedBy := NewUserID(7)
+	changedAt := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		from     PageVersionStatus
+		action   func(version *PageVersion) error
+		expected PageVersionStatus
+		err      error
+	}{
+		{"submit draft", PageVersionStatusDraft, func(v *PageVersion) error { return v.SubmitForReview(changedBy, changedAt) }, PageVersionStatusInReview, nil},
+		{"approve in review", PageVersionStatusInReview, func(v *PageVersion) error { return v.Approve(changedBy, changedAt) }, PageVersionStatusApproved, nil},
+		{"reject in review", PageVersionStatusInReview, func(v *PageVersion) error { return v.Reject(changedBy, changedAt) }, PageVersionStatusDraft, nil},
+		{"reject approved", PageVersionStatusApproved, func(v *PageVersion) error { return v.Reject(changedBy, changedAt) }, PageVersionStatusDraft, nil},
+		{"publish approved", PageVersionStatusApproved, func(v *PageVersion) error { return v.Publish(changedBy, changedAt) }, PageVersionStatusPublished, nil},
+		{"archive published", PageVersionStatusPublished, func(v *PageVersion) error { return v.Archive(changedBy, changedAt) }, PageVersionStatusArchived, nil},
+		{"publish in review", PageVersionStatusInReview, func(v *PageVersion) error { return v.Publish(changedBy, changedAt) }, PageVersionStatusInReview, errors.ErrPageVersionTransitionNotAllowed},
+		{"publish draft", PageVersionStatusDraft, func(v *PageVersion) error { return v.Publish(changedBy, changedAt) }, PageVersionStatusDraft, errors.ErrPageVersionTransitionNotAllowed},
+		{"approve draft", PageVersionStatusDraft, func(v *PageVersion) error { return v.Approve(changedBy, changedAt) }, PageVersionStatusDraft, errors.ErrPageVersionTransitionNotAllowed},
+		{"reject published", PageVersionStatusPublished, func(v *PageVersion) error { return v.Reject(changedBy, changedAt) }, PageVersionStatusPublished, errors.ErrPageVersionTransitionNotAllowed},
+		{"publish archived", PageVersionStatusArchived, func(v *PageVersion) error { return v.Publish(changedBy, changedAt) }, PageVersionStatusArchived, errors.ErrPageVersionTransitionNotAllowed},
+		{"archive archived", PageVersionStatusArchived, func(v *PageVersion) error { return v.Archive(changedBy, changedAt) }, PageVersionStatusArchived, errors.ErrPageVersionTransitionNotAllowed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			version := newStatusVersion(t, tt.from)
+
+			err := tt.action(version)
+
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, changedAt, *version.StatusChangedAt())
+			}
+			assert.Equal(t, tt.expected, version.Status())
+		})
+	}
+}
+
+func TestPageVersion_ArchivedIsTerminal(t *testing.T) {
+	for _, target := range allPageVersionStatuses {
+		assert.False(t, PageVersionStatusArchived.CanTransitionTo(target), "archived must not move to %s", target)
+	}
+}
+
+func TestNewPageVersionStatus(t *testing.T) {
+	for _, status := range allPageVersionStatuses {
+		parsed, err := NewPageVersionStatus(string(status))
+		assert.NoError(t, err)
+		assert.Equal(t, status, parsed)
+	}
+
+	_, err := NewPageVersionStatus("deleted")
+	assert.ErrorIs(t, err, errors.ErrPageVersionStatusInvalid)
+}
+
+func TestPageVersion_Schedule(t *testing.T) {
+	scheduledBy := NewUserID(7)
+	publishAt := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	later := publishAt.Add(time.Hour)
+	earlier := publishAt.Add(-time.Hour)
+
+	tests := []struct {
+		name        string
+		status      PageVersionStatus
+		publishAt   *time.Time
+		unpublishAt *time.Time
+		err         error
+	}{
+		{name: "publish and unpublish", status: PageVersionStatusApproved, publishAt: &publishAt, unpublishAt: &later},
+		{name: "publish only", status: PageVersionStatusDraft, publishAt: &publishAt},
+		{name: "unpublish only", status: PageVersionStatusPublished, unpublishAt: &earlier},
+		{name: "clear both", status: PageVersionStatusApproved},
+		{name: "unpublish at the publish time", status: PageVersionStatusApproved, publishAt: &publishAt, unpublishAt: &publishAt, err: errors.ErrPageVersionScheduleInvalid},
+		{name: "unpublish before publish", status: PageVersionStatusApproved, publishAt: &publishAt, unpublishAt: &earlier, err: errors.ErrPageVersionScheduleInvalid},
+		{name: "archived version", status: PageVersionStatusArchived, publishAt: &publishAt, unpublishAt: &later, err: errors.ErrPageVersionNotSchedulable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			version := newStatusVersion(t, tt.status)
+			previous := publishAt.Add(-24 * time.Hour)
+			version.SetSchedule(&previous, nil, nil)
+
+			err := version.Schedule(tt.publishAt, tt.unpublishAt, scheduledBy)
+
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+				assert.Equal(t, &previous, version.PublishAt())
+				assert.Nil(t, version.UnpublishAt())
+				assert.Nil(t, version.ScheduledBy())
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.publishAt, version.PublishAt())
+			assert.Equal(t, tt.unpublishAt, version.UnpublishAt())
+			assert.Equal(t, scheduledBy, *version.ScheduledBy())
+			assert.Equal(t, tt.status, version.Status())
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package entities
+
+import (
+	"github.com/h4rdc0m/aurora-api/domain/errors"
+	"time"
+)
+
+// PreviewScope is the content a preview token grants access to
+type PreviewScope string
+
+const (
+	PreviewScopeVersion PreviewScope = "version" // A single page version, shown instead of the published version in its locale.
+	PreviewScopeSite    PreviewScope = "site"    // The latest version of every page of the site, published or not.
+)
+
+// NewPreviewScope parses the given string into a PreviewScope. Returns an error if the scope is unknown.
+func NewPreviewScope(value string) (PreviewScope, error) {
+	switch scope := PreviewScope(value); scope {
+	case PreviewScopeVersion, PreviewScopeSite:
+		return scope, nil
+	}
+	return "", errors.ErrPreviewScopeInvalid
+}
+
+// PreviewToken grants anonymous visitors of a site access to unpublished content until it expires or is revoked.
+// Version scoped tokens reference the page version they share; site scoped tokens reference no version.
+type PreviewToken struct {
+	id        string
+	siteID    SiteID
+	scope     PreviewScope
+	pageID    *PageID
+	versionID *PageVersionID
+	label     *string
+	createdBy UserID
+	createdAt time.Time
+	expiresAt time.Time
+}
+
+// NewPreviewToken creates a preview token for the site. Returns an error if a version scoped token lacks the page
+// or version it shares, or a site scoped token references one.
+func NewPreviewToken(siteID SiteID, scope PreviewScope, pageID *PageID, versionID *PageVersionID, label *string, createdBy UserID, expiresAt time.Time) (*PreviewToken, error) {
+	switch scope {
+	case PreviewScopeVersion:
+		if pageID == nil || versionID == nil {
+			return nil, errors.ErrPreviewTokenVersionRequired
+		}
+	case PreviewScopeSite:
+		if pageID != nil || versionID != nil {
+			return nil, errors.ErrPreviewTokenVersionNotAllowed
+		}
+	default:
+		return nil, errors.ErrPreviewScopeInvalid
+	}
+
+	return &PreviewToken{
+		siteID:    siteID,
+		scope:     scope,
+		pageID:    pageID,
+		versionID: versionID,
+		label:     label,
+		createdBy: createdBy,
+		createdAt: time.Now(),
+		expiresAt: expiresAt,
+	}, nil
+}
+
+// ID returns the random identifier of the preview token, empty until the token is issued
+func (t *PreviewToken) ID() string {
+	return t.id
+}
+
+// SiteID returns the site the preview token belongs to
+func (t *PreviewToken) SiteID() SiteID {
+	return t.siteID
+}
+
+// Scope returns the content the preview token grants access to
+func (t *PreviewToken) Scope() PreviewScope {
+	return t.scope
+}
+
+// PageID returns the page of the shared version, nil for site scoped tokens
+func (t *PreviewToken) PageID() *PageID {
+	return t.pageID
+}
+
+// VersionID returns the shared version, nil for site scoped tokens
+func (t *PreviewToken) VersionID() *PageVersionID {
+	return t.versionID
+}
+
+// Label returns the note the editor added to recognize the token, e.g. who it was shared with
+func (t *PreviewToken) Label() *string {
+	return t.label
+}
+
+// CreatedBy returns the user who created the preview token
+func (t *PreviewToken) CreatedBy() UserID {
+	return t.createdBy
+}
+
+// CreatedAt returns the creation time
+func (t *PreviewToken) CreatedAt() time.Time {
+	return t.createdAt
+}
+
+// ExpiresAt returns when the preview token stops granting access
+func (t *PreviewToken) ExpiresAt() time.Time {
+	return t.expiresAt
+}
+
+// IsExpired reports whether the preview token has expired at the given time
+func (t *PreviewToken) IsExpired(now time.Time) bool {
+	return !t.expiresAt.After(now)
+}
+
+// Previews reports whether the token grants access to unpublished content of the page
+func (t *PreviewToken) Previews(page *Page) bool {
+	if page.SiteID().Value() != t.siteID.Value() {
+		return false
+	}
+	return t.scope == PreviewScopeSite || t.pageID.Value() == page.ID().Value()
+}
+
+// SetID sets the preview token ID (used by the preview token service when issuing or loading the token)
+func (t *PreviewToken) SetID(id string) {
+	t.id = id
+}
+
+// SetCreatedAt sets the creation time (used by the preview token service when loading the token)
+func (t *PreviewToken) SetCreatedAt(createdAt time.Time) {
+	t.createdAt = createdAt
+}
@@ -0,0 +1,215 @@
+package entities
+
+import (
+	"github.com/h4rdc0m/aurora-api/domain/errors"
+	"strings"
+	"time"
+)
+
+// MaxRedirectSourceLength is the maximum length of the source path of a redirect
+const MaxRedirectSourceLength = 768
+
+// Status codes of redirects
+const (
+	RedirectStatusMovedPermanently = 301
+	RedirectStatusFound            = 302
+	RedirectStatusGone             = 410
+)
+
+// RedirectID represents a unique identifier for a redirect entity.
+type RedirectID struct {
+	value uint64
+}
+
+// NewRedirectID creates a new RedirectID instance with the specified value.
+func NewRedirectID(id uint64) RedirectID {
+	return RedirectID{value: id}
+}
+
+// Value retrieves the underlying value of the RedirectID.
+func (r RedirectID) Value() uint64 {
+	return r.value
+}
+
+// IsEmpty checks if the RedirectID is empty, which is defined as having a value of 0.
+func (r RedirectID) IsEmpty() bool {
+	return r.value == 0
+}
+
+// Redirect sends requests for a path of a site that no longer holds a page to a page or URL, or reports it as gone.
+// Targeting a page rather than its path keeps the redirect valid when the page moves again.
+// Automatic redirects are created when a page is moved or renamed.
+type Redirect struct {
+	id           RedirectID
+	siteID       SiteID
+	sourcePath   string
+	targetPageID *PageID
+	targetURL    *string
+	statusCode   int
+	automatic    bool
+	hits         uint64
+	createdAt    time.Time
+	updatedAt    time.Time
+}
+
+// NewRedirect creates a new redirect from the source path of the site. Redirects with status 301 or 302 require
+// exactly one of a target page and a target URL; gone redirects (410) have no target.
+func NewRedirect(siteID SiteID, sourcePath string, targetPageID *PageID, targetURL *string, statusCode int) (*Redirect, error) {
+	if err := validateRedirect(sourcePath, targetPageID, targetURL, statusCode); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	return &Redirect{
+		siteID:       siteID,
+		sourcePath:   sourcePath,
+		targetPageID: targetPageID,
+		targetURL:    targetURL,
+		statusCode:   statusCode,
+		createdAt:    now,
+		updatedAt:    now,
+	}, nil
+}
+
+// NewPageRedirect creates the automatic, permanent redirect from a former path of a page to the page.
+func NewPageRedirect(siteID SiteID, sourcePath string, pageID PageID) (*Redirect, error) {
+	redirect, err := NewRedirect(siteID, sourcePath, &pageID, nil, RedirectStatusMovedPermanently)
+	if err != nil {
+		return nil, err
+	}
+	redirect.automatic = true
+	return redirect, nil
+}
+
+// ID returns the unique identifier of the redirect.
+func (r *Redirect) ID() RedirectID {
+	return r.id
+}
+
+// SiteID returns the site whose path is redirected.
+func (r *Redirect) SiteID() SiteID {
+	return r.siteID
+}
+
+// SourcePath returns the redirected path.
+func (r *Redirect) SourcePath() string {
+	return r.sourcePath
+}
+
+// TargetPageID returns the page the redirect points to, or nil.
+func (r *Redirect) TargetPageID() *PageID {
+	return r.targetPageID
+}
+
+// TargetURL returns the URL the redirect points to, or nil.
+func (r *Redirect) TargetURL() *string {
+	return r.targetURL
+}
+
+// StatusCode returns the HTTP status code of the redirect.
+func (r *Redirect) StatusCode() int {
+	return r.statusCode
+}
+
+// IsGone reports whether the source path is reported as permanently removed instead of redirected.
+func (r *Redirect) IsGone() bool {
+	return r.statusCode == RedirectStatusGone
+}
+
+// IsAutomatic reports whether the redirect was created when a page moved.
+func (r *Redirect) IsAutomatic() bool {
+	return r.automatic
+}
+
+// Hits returns the number of requests served by the redirect.
+func (r *Redirect) Hits() uint64 {
+	return r.hits
+}
+
+// CreatedAt returns the creation timestamp of the redirect.
+func (r *Redirect) CreatedAt() time.Time {
+	return r.createdAt
+}
+
+// UpdatedAt returns the timestamp of the last update of the redirect.
+func (r *Redirect) UpdatedAt() time.Time {
+	return r.updatedAt
+}
+
+// Update replaces the source, target and status code of the redirect. A redirect edited this way is no longer
+// automatic.
+func (r *Redirect) Update(sourcePath string, targetPageID *PageID, targetURL *string, statusCode int) error {
+	if err := validateRedirect(sourcePath, targetPageID, targetURL, statusCode); err != nil {
+		return err
+	}
+
+	r.sourcePath = sourcePath
+	r.targetPageID = targetPageID
+	r.targetURL = targetURL
+	r.statusCode = statusCode
+	r.automatic = false
+	r.updatedAt = time.Now()
+	return nil
+}
+
+// RetargetPage turns the redirect into the automatic, permanent redirect to the page.
+func (r *Redirect) RetargetPage(pageID PageID) {
+	r.targetPageID = &pageID
+	r.targetURL = nil
+	r.statusCode = RedirectStatusMovedPermanently
+	r.automatic = true
+	r.updatedAt = time.Now()
+}
+
+// SetID sets the redirect ID (used by repository when loading from database)
+func (r *Redirect) SetID(id RedirectID) {
+	r.id = id
+}
+
+// SetAutomatic sets whether the redirect was created automatically (used by repository when loading from database)
+func (r *Redirect) SetAutomatic(automatic bool) {
+	r.automatic = automatic
+}
+
+// SetHits sets the hit counter (used by repository when loading from database)
+func (r *Redirect) SetHits(hits uint64) {
+	r.hits = hits
+}
+
+// SetTimestamps sets the timestamps (used by repository when loading from database)
+func (r *Redirect) SetTimestamps(createdAt, updatedAt time.Time) {
+	r.createdAt = createdAt
+	r.updatedAt = updatedAt
+}
+
+// validateRedirect checks the source path, the status code and the targets allowed for it
+func validateRedirect(sourcePath string, targetPageID *PageID, targetURL *string, statusCode int) error {
+	if !strings.HasPrefix(sourcePath, "/") || len(sourcePath) > MaxRedirectSourceLength {
+		return errors.ErrRedirectSourceInvalid
+	}
+
+	switch statusCode {
+	case RedirectStatusMovedPermanently, RedirectStatusFound:
+		if (targetPageID == nil) == (targetURL == nil) {
+			return errors.ErrRedirectTargetRequired
+		}
+		if targetURL != nil && *targetURL == sourcePath {
+			return errors.ErrRedirectLoop
+		}
+	case RedirectStatusGone:
+		if targetPageID != nil || targetURL != nil {
+			return errors.ErrRedirectTargetNotAllowed
+		}
+	default:
+		return errors.ErrRedirectStatusInvalid
+	}
+
+	return nil
+}
+
+// RedirectImport counts the redirects created and updated by an import.
+type RedirectImport struct {
+	Created int
+	Updated int
+}
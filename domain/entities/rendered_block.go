@@ -0,0 +1,22 @@
+package entities
+
+// RenderedBlock is a content block prepared for delivery. For snippet blocks Snippet holds the inlined
+// published blocks of the embedded snippet page; it is nil when the snippet could not be inlined.
+type RenderedBlock struct {
+	Block   *PageBlock
+	Snippet *RenderedSnippet
+}
+
+// RenderedSnippet is the published content of a snippet page inlined into an embedding block.
+type RenderedSnippet struct {
+	PageID    PageID
+	VersionID PageVersionID
+	Blocks    []*RenderedBlock
+}
+
+// SnippetUsage describes a block of a page version that embeds a snippet page.
+type SnippetUsage struct {
+	Page     *Page
+	Version  *PageVersion
+	BlockKey string
+}
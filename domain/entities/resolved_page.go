@@ -0,0 +1,22 @@
+package entities
+
+// ResolvedPage is the outcome of following the hard links of a page.
+// Page is the final page of the chain, which is a content, snippet or link page.
+// Chain lists the IDs of all visited pages in order, starting with the requested page and ending with Page.
+type ResolvedPage struct {
+	Page  *Page
+	Chain []PageID
+}
+
+// IsLink reports whether the chain ends at a link page, whose target is an URL instead of content.
+func (r *ResolvedPage) IsLink() bool {
+	return r.Page.Type() == PageTypeLink
+}
+
+// LinkURL returns the URL of the final link page, or nil when the chain ends at content.
+func (r *ResolvedPage) LinkURL() *string {
+	if !r.IsLink() {
+		return nil
+	}
+	return r.Page.LinkURL()
+}
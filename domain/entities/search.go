@@ -0,0 +1,155 @@
+package entities
+
+import (
+	"encoding/json"
+	"github.com/h4rdc0m/aurora-api/utils/fulltext"
+	"strings"
+	"time"
+)
+
+// SearchDocument is the searchable text of the version of a page published in a locale.
+type SearchDocument struct {
+	PageID      PageID
+	SiteID      SiteID
+	Locale      Locale
+	VersionID   PageVersionID
+	Path        string
+	Title       string
+	Description string
+	Body        string
+	UpdatedAt   time.Time
+}
+
+// NewSearchDocument builds the search document of a page from its published version and the blocks of that version.
+// The body holds the plain text of all text blocks in block order; markup is stripped and JSON content contributes
+// its string values. Snippet blocks are left out, their text belongs to the snippet page.
+func NewSearchDocument(page *Page, version *PageVersion, blocks []*PageBlock) *SearchDocument {
+	parts := make([]string, 0, len(blocks))
+	for _, block := range sortedBlocks(blocks) {
+		if text := blockText(block); text != "" {
+			parts = append(parts, text)
+		}
+	}
+
+	document := &SearchDocument{
+		PageID:    page.ID(),
+		SiteID:    page.SiteID(),
+		Locale:    version.Locale(),
+		VersionID: version.ID(),
+		Path:      page.FullPath(),
+		Title:     version.Title(),
+		Body:      strings.Join(parts, "\n"),
+		UpdatedAt: version.UpdatedAt(),
+	}
+	if version.Description() != nil {
+		document.Description = *version.Description()
+	}
+
+	return document
+}
+
+// SearchQuery is a full-text search within the published pages of a site. Page is 1-based.
+// Locales is the locale chain of the search: every page is searched in the first of these locales it is
+// published in, and pages published in none of them are left out.
+type SearchQuery struct {
+	SiteID  SiteID
+	Locales []Locale
+	Text    string
+	Page    int
+	PerPage int
+}
+
+// Terms returns the distinct words searched for.
+func (q SearchQuery) Terms() []string {
+	return fulltext.Terms(q.Text)
+}
+
+// Offset returns the number of hits skipped before the requested page.
+func (q SearchQuery) Offset() int {
+	return (q.Page - 1) * q.PerPage
+}
+
+// LocaleRank returns the position of the locale in the locale chain of the query, or false when the chain does
+// not contain it.
+func (q SearchQuery) LocaleRank(locale Locale) (int, bool) {
+	for rank, candidate := range q.Locales {
+		if candidate == locale {
+			return rank, true
+		}
+	}
+	return 0, false
+}
+
+// SearchHit is a page matching a search query. Title and Excerpt are HTML with the matched words wrapped in <mark>.
+type SearchHit struct {
+	PageID  PageID
+	Locale  Locale
+	Path    string
+	Title   string
+	Excerpt string
+	Score   float64
+}
+
+// SearchResult is a page of hits for the query together with the total number of matching pages.
+type SearchResult struct {
+	Query SearchQuery
+	Hits  []SearchHit
+	Total int
+}
+
+// SearchExcerptLength is the approximate length of the highlighted excerpt of a search hit.
+const SearchExcerptLength = 200
+
+// NewSearchHit highlights the terms in the title and an excerpt of the document. The excerpt is taken from the
+// description when it matches and from the body otherwise.
+func NewSearchHit(document *SearchDocument, terms []string, score float64) SearchHit {
+	excerptSource := document.Body
+	if document.Description != "" && (document.Body == "" || containsTerm(document.Description, terms)) {
+		excerptSource = document.Description
+	}
+
+	return SearchHit{
+		PageID:  document.PageID,
+		Locale:  document.Locale,
+		Path:    document.Path,
+		Title:   fulltext.Highlight(document.Title, terms),
+		Excerpt: fulltext.Fragment(excerptSource, terms, SearchExcerptLength),
+		Score:   score,
+	}
+}
+
+// containsTerm reports whether a word of the text starts with one of the terms
+func containsTerm(text string, terms []string) bool {
+	words := fulltext.Words(text)
+	for _, term := range terms {
+		if fulltext.CountMatches(words, term) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// blockText returns the searchable plain text of a block, or an empty string for blocks without text
+func blockText(block *PageBlock) string {
+	contentType := strings.ToLower(strings.TrimSpace(block.ContentType()))
+	if mediaType, _, found := strings.Cut(contentType, ";"); found {
+		contentType = strings.TrimSpace(mediaType)
+	}
+
+	switch {
+	case block.IsSnippet():
+		return ""
+	case contentType == "html" || contentType == "richtext" || contentType == "xml" ||
+		contentType == "text/html" || contentType == "application/xml" || strings.HasSuffix(contentType, "+xml"):
+		return fulltext.StripTags(block.Content())
+	case contentType == "json" || contentType == "application/json" || strings.HasSuffix(contentType, "+json"):
+		return fulltext.JSONText(block.Content())
+	case IsTextContentType(contentType):
+		return strings.TrimSpace(block.Content())
+	case json.Valid([]byte(block.Content())):
+		// JSON blocks and blocks of registered content types, whose content is JSON
+		return fulltext.JSONText(block.Content())
+	default:
+		return ""
+	}
+}
@@ -3,9 +3,33 @@ package entities
 import (
 	"github.com/h4rdc0m/aurora-api/domain/errors"
 	"github.com/h4rdc0m/aurora-api/domain/value_objects"
+	"github.com/h4rdc0m/aurora-api/utils/titletemplate"
+	"strings"
 	"time"
+	"unicode/utf8"
 )
 
+// MaxTitleTemplateLength is the maximum length of the title template of a site
+const MaxTitleTemplateLength = 255
+
+// Placeholders available in the title template of a site
+const (
+	TitlePlaceholderPageTitle       = "page.title"
+	TitlePlaceholderPageDescription = "page.description"
+	TitlePlaceholderParentTitle     = "parent.title"
+	TitlePlaceholderSiteName        = "site.name"
+	TitlePlaceholderSiteDescription = "site.description"
+)
+
+// titlePlaceholders lists the placeholders a title template may use
+var titlePlaceholders = map[string]bool{
+	TitlePlaceholderPageTitle:       true,
+	TitlePlaceholderPageDescription: true,
+	TitlePlaceholderParentTitle:     true,
+	TitlePlaceholderSiteName:        true,
+	TitlePlaceholderSiteDescription: true,
+}
+
 // SiteID represents a unique identifier for a site entity.
 // It encapsulates an unsigned integer value as its underlying data.
 type SiteID struct {
@@ -29,17 +53,23 @@ func (s SiteID) IsEmpty() bool {
 
 // Site represents a web platform or application containing various pages managed by a tenant.
 type Site struct {
-	id            SiteID
-	name          string
-	description   *string
-	domain        *value_objects.DomainName
-	titleTemplate *string
-	enabled       bool
-	templateID    TemplateID
-	tenantID      TenantID
-	createdAt     time.Time
-	updatedAt     time.Time
-	pages         []*Page
+	id              SiteID
+	name            string
+	description     *string
+	domain          *value_objects.DomainName
+	titleTemplate   *string
+	robotsTxt       *string
+	defaultLocale   Locale
+	locales         []Locale
+	fallbackLocales []Locale
+	enabled         bool
+	templateID      TemplateID
+	tenantID        TenantID
+	createdAt       time.Time
+	updatedAt       time.Time
+	revision        uint64
+	deletedAt       *time.Time
+	pages           []*Page
 }
 
 // NewSite creates a new Site entity
@@ -55,15 +85,18 @@ func NewSite(name string, description *string, domain *value_objects.DomainName,
 	now := time.Now()
 
 	return &Site{
-		name:        name,
-		description: description,
-		domain:      domain,
-		enabled:     true,
-		templateID:  templateID,
-		tenantID:    tenantID,
-		createdAt:   now,
-		updatedAt:   now,
-		pages:       make([]*Page, 0),
+		name:            name,
+		description:     description,
+		domain:          domain,
+		defaultLocale:   DefaultLocale,
+		locales:         []Locale{DefaultLocale},
+		fallbackLocales: make([]Locale, 0),
+		enabled:         true,
+		templateID:      templateID,
+		tenantID:        tenantID,
+		createdAt:       now,
+		updatedAt:       now,
+		pages:           make([]*Page, 0),
 	}, nil
 }
 
@@ -92,6 +125,102 @@ func (s *Site) TitleTemplate() *string {
 	return s.titleTemplate
 }
 
+// RobotsTxt returns the configured robots.txt rules, or nil when the default rules apply.
+func (s *Site) RobotsTxt() *string {
+	return s.robotsTxt
+}
+
+// DefaultLocale returns the locale delivered when a request does not ask for one.
+func (s *Site) DefaultLocale() Locale {
+	return s.defaultLocale
+}
+
+// Locales returns the locales enabled for the site, in their configured order.
+func (s *Site) Locales() []Locale {
+	return s.locales
+}
+
+// FallbackLocales returns the locales tried, in order, when a page has no published version in the requested locale.
+// The default locale is always tried last, even when it is not listed.
+func (s *Site) FallbackLocales() []Locale {
+	return s.fallbackLocales
+}
+
+// HasLocale reports whether the given locale is enabled for the site.
+func (s *Site) HasLocale(locale Locale) bool {
+	for _, enabled := range s.locales {
+		if enabled == locale {
+			return true
+		}
+	}
+	return false
+}
+
+// MatchLocale returns the enabled locale serving a requested locale: the locale itself, or else an enabled
+// locale of the same language, preferring the bare language. Returns false when no enabled locale matches.
+func (s *Site) MatchLocale(requested Locale) (Locale, bool) {
+	if s.HasLocale(requested) {
+		return requested, true
+	}
+	if s.HasLocale(requested.Language()) {
+		return requested.Language(), true
+	}
+	for _, enabled := range s.locales {
+		if enabled.Language() == requested.Language() {
+			return enabled, true
+		}
+	}
+	return "", false
+}
+
+// LocaleChain returns the locales to look for content in when the given locale is requested:
+// the locale itself when enabled, followed by the fallback locales and the default locale.
+func (s *Site) LocaleChain(locale Locale) []Locale {
+	chain := make([]Locale, 0, len(s.fallbackLocales)+2)
+	seen := make(map[Locale]bool, cap(chain))
+	add := func(l Locale) {
+		if l != "" && !seen[l] {
+			seen[l] = true
+			chain = append(chain, l)
+		}
+	}
+
+	if s.HasLocale(locale) {
+		add(locale)
+	}
+	for _, fallback := range s.fallbackLocales {
+		add(fallback)
+	}
+	add(s.defaultLocale)
+
+	return chain
+}
+
+// LocalizedPath returns the public path of a page path in the given locale: the path itself in the default
+// locale, and the path prefixed with the locale otherwise, e.g. "/nl-BE/about".
+func (s *Site) LocalizedPath(locale Locale, pagePath string) string {
+	if locale == "" || locale == s.defaultLocale {
+		return pagePath
+	}
+	if pagePath == "/" {
+		return "/" + string(locale)
+	}
+	return "/" + string(locale) + pagePath
+}
+
+// BaseURL returns the public URL of the site root, without a trailing slash.
+func (s *Site) BaseURL() string {
+	return "https://" + s.domain.Value()
+}
+
+// AbsoluteURL resolves a path relative to the site root against the base URL of the site; other links are returned as is.
+func (s *Site) AbsoluteURL(link string) string {
+	if strings.HasPrefix(link, "/") && !strings.HasPrefix(link, "//") {
+		return s.BaseURL() + link
+	}
+	return link
+}
+
 // IsEnabled determines whether the site is currently active and returns true if active, otherwise false.
 func (s *Site) IsEnabled() bool {
 	return s.enabled
@@ -117,6 +246,32 @@ func (s *Site) UpdatedAt() time.Time {
 	return s.updatedAt
 }
 
+// Revision returns the number of times the site was saved, used to detect concurrent modifications
+func (s *Site) Revision() uint64 {
+	return s.revision
+}
+
+// DeletedAt returns when the site was moved to the trash, nil while it is not trashed
+func (s *Site) DeletedAt() *time.Time {
+	return s.deletedAt
+}
+
+// IsDeleted reports whether the site is in the trash
+func (s *Site) IsDeleted() bool {
+	return s.deletedAt != nil
+}
+
+// MoveToTrash marks the site as deleted at the given time. Its pages are trashed at the same time, which is how
+// restoring the site finds them again.
+func (s *Site) MoveToTrash(at time.Time) {
+	s.deletedAt = &at
+}
+
+// Restore takes the site back out of the trash
+func (s *Site) Restore() {
+	s.deletedAt = nil
+}
+
 // Pages returns a slice of pointers to the Page objects associated with the Site.
 func (s *Site) Pages() []*Page {
 	return s.pages
@@ -129,6 +284,7 @@ func (s *Site) UpdateName(name string) error {
 	}
 
 	s.name = name
+	s.updatedAt = time.Now()
 
 	return nil
 }
@@ -136,6 +292,7 @@ func (s *Site) UpdateName(name string) error {
 // UpdateDescription updates the description of the Site with the provided value and returns an error if the operation fails.
 func (s *Site) UpdateDescription(description *string) error {
 	s.description = description
+	s.updatedAt = time.Now()
 
 	return nil
 }
@@ -147,29 +304,117 @@ func (s *Site) UpdateDomain(domain *value_objects.DomainName) error {
 	}
 
 	s.domain = domain
+	s.updatedAt = time.Now()
 
 	return nil
 }
 
-// UpdateTitleTemplate updates the title template
-func (s *Site) UpdateTitleTemplate(titleTemplate *string) {
+// UpdateTitleTemplate updates the title template. A blank template renders plain page titles again.
+// Returns an error if the template is too long or uses a placeholder that is not available.
+func (s *Site) UpdateTitleTemplate(titleTemplate *string) error {
+	if titleTemplate != nil && strings.TrimSpace(*titleTemplate) == "" {
+		titleTemplate = nil
+	}
+
+	if titleTemplate != nil {
+		if utf8.RuneCountInString(*titleTemplate) > MaxTitleTemplateLength {
+			return errors.ErrSiteTitleTemplateTooLong
+		}
+		for _, placeholder := range titletemplate.Placeholders(*titleTemplate) {
+			if !titlePlaceholders[placeholder] {
+				return errors.ErrSiteTitleTemplatePlaceholderUnknown
+			}
+		}
+	}
+
 	s.titleTemplate = titleTemplate
 	s.updatedAt = time.Now()
+
+	return nil
+}
+
+// RenderTitle returns the title of a page as rendered by the title template of the site, where pageTitle fills
+// {page.title}. Without template, or when the template renders blank, the page title is returned as is.
+func (s *Site) RenderTitle(pageTitle string, pageDescription *string, parentTitle *string) string {
+	if s.titleTemplate == nil {
+		return pageTitle
+	}
+
+	values := map[string]string{
+		TitlePlaceholderPageTitle:       pageTitle,
+		TitlePlaceholderPageDescription: valueOrEmpty(pageDescription),
+		TitlePlaceholderParentTitle:     valueOrEmpty(parentTitle),
+		TitlePlaceholderSiteName:        s.name,
+		TitlePlaceholderSiteDescription: valueOrEmpty(s.description),
+	}
+	if title := titletemplate.Render(*s.titleTemplate, values); title != "" {
+		return title
+	}
+	return pageTitle
+}
+
+// UpdateRobotsTxt updates the robots.txt rules. A nil value restores the default rules.
+func (s *Site) UpdateRobotsTxt(robotsTxt *string) {
+	s.robotsTxt = robotsTxt
+	s.updatedAt = time.Now()
+}
+
+// UpdateLocales replaces the enabled locales, the default locale and the fallback chain of the site.
+// The default and fallback locales must be enabled, and no list may repeat a locale.
+func (s *Site) UpdateLocales(defaultLocale Locale, locales []Locale, fallbackLocales []Locale) error {
+	if len(locales) == 0 {
+		return errors.ErrSiteLocalesEmpty
+	}
+	if len(locales) > MaxSiteLocales {
+		return errors.ErrSiteLocalesTooMany
+	}
+
+	enabled := make(map[Locale]bool, len(locales))
+	for _, locale := range locales {
+		if enabled[locale] {
+			return errors.ErrSiteLocaleDuplicate
+		}
+		enabled[locale] = true
+	}
+	if !enabled[defaultLocale] {
+		return errors.ErrSiteDefaultLocaleNotEnabled
+	}
+
+	seen := make(map[Locale]bool, len(fallbackLocales))
+	for _, fallback := range fallbackLocales {
+		if !enabled[fallback] {
+			return errors.ErrSiteFallbackLocaleNotEnabled
+		}
+		if seen[fallback] {
+			return errors.ErrSiteLocaleDuplicate
+		}
+		seen[fallback] = true
+	}
+
+	s.defaultLocale = defaultLocale
+	s.locales = append([]Locale(nil), locales...)
+	s.fallbackLocales = append([]Locale(nil), fallbackLocales...)
+	s.updatedAt = time.Now()
+
+	return nil
 }
 
 // Enable sets the `isActive` field of the Site to `true`, marking the site as active.
 func (s *Site) Enable() {
 	s.enabled = true
+	s.updatedAt = time.Now()
 }
 
 // Disable sets the site's isActive property to false, marking it as inactive.
 func (s *Site) Disable() {
 	s.enabled = false
+	s.updatedAt = time.Now()
 }
 
 // UpdateTemplate updates the template ID
 func (s *Site) UpdateTemplate(templateID TemplateID) {
 	s.templateID = templateID
+	s.updatedAt = time.Now()
 }
 
 // AddPage adds a new page to the Site. Returns an error if the page is nil or if the page's slug already exists.
@@ -205,8 +450,38 @@ func (s *Site) SetID(id SiteID) error {
 	return nil
 }
 
+// SetLocales sets the locale configuration of the Site, used by repository when loading from database.
+func (s *Site) SetLocales(defaultLocale Locale, locales []Locale, fallbackLocales []Locale) {
+	s.defaultLocale = defaultLocale
+	s.locales = locales
+	s.fallbackLocales = fallbackLocales
+}
+
+// SetTitleTemplate sets the title template without validation (used by repository when loading from database)
+func (s *Site) SetTitleTemplate(titleTemplate *string) {
+	s.titleTemplate = titleTemplate
+}
+
 // SetTimestamps sets the creation and last updated timestamps for the Site instance.
 func (s *Site) SetTimestamps(createdAt, updatedAt time.Time) {
 	s.createdAt = createdAt
 	s.updatedAt = updatedAt
 }
+
+// SetRevision sets the revision (used by repository when loading from or saving to database)
+func (s *Site) SetRevision(revision uint64) {
+	s.revision = revision
+}
+
+// SetDeletedAt sets when the site was trashed (used by repository when loading from database)
+func (s *Site) SetDeletedAt(deletedAt *time.Time) {
+	s.deletedAt = deletedAt
+}
+
+// valueOrEmpty returns the value of an optional string, or an empty string when it is nil
+func valueOrEmpty(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
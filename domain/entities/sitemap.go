@@ -0,0 +1,47 @@
+package entities
+
+import "time"
+
+// SitemapMaxURLs is the maximum number of URLs a single sitemap file may list according to the sitemap protocol.
+// Larger sites are served as a sitemap index that references numbered parts.
+const SitemapMaxURLs = 50000
+
+// SitemapURL is a published content page listed in the sitemap of a site.
+type SitemapURL struct {
+	Path         string
+	LastModified time.Time
+}
+
+// SitemapPage is a content page published in a locale, as stored; its path is not localized yet.
+type SitemapPage struct {
+	Path         string
+	Locale       Locale
+	LastModified time.Time
+}
+
+// SitemapPartSummary counts the URLs of a 1-based part of a sitemap and holds their most recent modification.
+type SitemapPartSummary struct {
+	Number       int
+	URLCount     int
+	LastModified time.Time
+}
+
+// Sitemap lists the published content pages of a site in the order of their paths. Parts summarizes every
+// sitemap file; URLs are only loaded when the sitemap fits a single file.
+type Sitemap struct {
+	Site  *Site
+	Parts []SitemapPartSummary
+	URLs  []SitemapURL
+}
+
+// SitemapPart is a single numbered file of a sitemap index.
+type SitemapPart struct {
+	Site   *Site
+	Number int
+	URLs   []SitemapURL
+}
+
+// IsIndex reports whether the sitemap has too many URLs for a single file and is served as an index of parts.
+func (s *Sitemap) IsIndex() bool {
+	return len(s.Parts) > 1
+}
@@ -27,6 +27,8 @@ type Template struct {
 	enabled     bool
 	createdAt   time.Time
 	updatedAt   time.Time
+	revision    uint64
+	deletedAt   *time.Time
 	settings    []*TemplateSetting
 }
 
@@ -87,6 +89,31 @@ func (t *Template) UpdatedAt() time.Time {
 	return t.updatedAt
 }
 
+// Revision returns the number of times the template was saved, used to detect concurrent modifications
+func (t *Template) Revision() uint64 {
+	return t.revision
+}
+
+// DeletedAt returns when the template was moved to the trash, nil while it is not trashed
+func (t *Template) DeletedAt() *time.Time {
+	return t.deletedAt
+}
+
+// IsDeleted reports whether the template is in the trash
+func (t *Template) IsDeleted() bool {
+	return t.deletedAt != nil
+}
+
+// MoveToTrash marks the template as deleted at the given time
+func (t *Template) MoveToTrash(at time.Time) {
+	t.deletedAt = &at
+}
+
+// Restore takes the template back out of the trash
+func (t *Template) Restore() {
+	t.deletedAt = nil
+}
+
 // Settings returns the template settings
 func (t *Template) Settings() []*TemplateSetting {
 	return t.settings
@@ -165,3 +192,13 @@ func (t *Template) SetTimestamps(createdAt, updatedAt time.Time) {
 	t.createdAt = createdAt
 	t.updatedAt = updatedAt
 }
+
+// SetRevision sets the revision (used by repository when loading from or saving to database)
+func (t *Template) SetRevision(revision uint64) {
+	t.revision = revision
+}
+
+// SetDeletedAt sets when the template was trashed (used by repository when loading from database)
+func (t *Template) SetDeletedAt(deletedAt *time.Time) {
+	t.deletedAt = deletedAt
+}
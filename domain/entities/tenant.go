@@ -28,6 +28,7 @@ type Tenant struct {
 	isBillingEnabled bool
 	createdAt        time.Time
 	updatedAt        time.Time
+	revision         uint64
 	sites            []*Site
 	usersIDs         []UserID
 }
@@ -81,6 +82,11 @@ func (t *Tenant) UpdatedAt() time.Time {
 	return t.updatedAt
 }
 
+// Revision returns the number of times the tenant was saved, used to detect concurrent modifications
+func (t *Tenant) Revision() uint64 {
+	return t.revision
+}
+
 func (t *Tenant) Sites() []*Site {
 	return t.sites
 }
@@ -179,3 +185,8 @@ func (t *Tenant) SetTimestamps(createdAt, updatedAt time.Time) {
 	t.createdAt = createdAt
 	t.updatedAt = updatedAt
 }
+
+// SetRevision sets the revision (used by repository when loading from or saving to database)
+func (t *Tenant) SetRevision(revision uint64) {
+	t.revision = revision
+}
@@ -139,13 +139,24 @@ func (u *User) HasAccessToTenant(tenantID TenantID) bool {
 	return false
 }
 
+// IsGlobalAdmin checks if the user administers the whole platform and thereby every tenant
+func (u *User) IsGlobalAdmin() bool {
+	return u.role.IsSuperAdmin() || u.role.IsAdmin()
+}
+
 // CanManageTenant checks if the user can manage a specific tenant
 func (u *User) CanManageTenant(tenantID TenantID) bool {
+	if u.IsGlobalAdmin() {
+		return true
+	}
 	return u.role.CanManageTenant() && u.HasAccessToTenant(tenantID)
 }
 
 // CanEditContent checks if the user can edit content in a specific tenant
 func (u *User) CanEditContent(tenantID TenantID) bool {
+	if u.IsGlobalAdmin() {
+		return true
+	}
 	return u.role.CanEditContent() && u.HasAccessToTenant(tenantID)
 }
 
@@ -0,0 +1,44 @@
+package errors
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var ErrContentTypeNotFound = errors.New("content type not found")
+var ErrContentTypeNameEmpty = errors.New("content type name cannot be empty")
+var ErrContentTypeNameTooLong = errors.New("content type name cannot be longer than 100 characters")
+var ErrContentTypeNameInvalid = errors.New("content type name can only contain alphanumeric characters, dots, slashes, plus signs, underscores, and hyphens")
+var ErrContentTypeNameReserved = errors.New("content type name is reserved for a built-in block type")
+var ErrContentTypeAlreadyExists = errors.New("content type with this name already exists")
+var ErrContentTypeSchemaEmpty = errors.New("content type schema cannot be empty")
+var ErrContentTypeSchemaInvalid = errors.New("content type schema is not a valid JSON Schema")
+var ErrBlockContentInvalid = errors.New("block content does not match its content type")
+
+// ContentViolation is a single reason why block content does not match its content type.
+// Path is a JSON Pointer to the offending value within the content, "/" for the content itself.
+type ContentViolation struct {
+	Path    string
+	Message string
+}
+
+// BlockContentError reports every violation found when validating block content against its content type.
+// It matches ErrBlockContentInvalid with errors.Is.
+type BlockContentError struct {
+	BlockKey    string
+	ContentType string
+	Violations  []ContentViolation
+}
+
+func (e *BlockContentError) Error() string {
+	messages := make([]string, 0, len(e.Violations))
+	for _, violation := range e.Violations {
+		messages = append(messages, fmt.Sprintf("%s: %s", violation.Path, violation.Message))
+	}
+	return fmt.Sprintf("%s: %s", ErrBlockContentInvalid.Error(), strings.Join(messages, "; "))
+}
+
+func (e *BlockContentError) Unwrap() error {
+	return ErrBlockContentInvalid
+}
@@ -0,0 +1,26 @@
+package errors
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var ErrPageVersionLocked = errors.New("page version is being edited by another user")
+var ErrEditingLeaseNotFound = errors.New("page version has no editing lease")
+
+// PageVersionLockedError reports who holds the editing lease of a page version another user tried to edit.
+// It matches ErrPageVersionLocked with errors.Is.
+type PageVersionLockedError struct {
+	HolderSubject string
+	HolderName    string
+	ExpiresAt     time.Time
+}
+
+func (e *PageVersionLockedError) Error() string {
+	return fmt.Sprintf("%s: %s", ErrPageVersionLocked.Error(), e.HolderName)
+}
+
+func (e *PageVersionLockedError) Unwrap() error {
+	return ErrPageVersionLocked
+}
@@ -0,0 +1,11 @@
+package errors
+
+import "errors"
+
+var ErrLocaleInvalid = errors.New("locale must be a language tag such as en or nl-BE")
+var ErrLocaleNotEnabled = errors.New("locale is not enabled for the site")
+var ErrSiteLocalesEmpty = errors.New("site must enable at least one locale")
+var ErrSiteLocalesTooMany = errors.New("site enables too many locales")
+var ErrSiteLocaleDuplicate = errors.New("site locales must not repeat")
+var ErrSiteDefaultLocaleNotEnabled = errors.New("default locale must be one of the enabled locales")
+var ErrSiteFallbackLocaleNotEnabled = errors.New("fallback locales must be enabled locales")
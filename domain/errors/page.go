@@ -14,3 +14,42 @@ var ErrInvalidContentType = errors.New("content type is invalid")
 var ErrInvalidBlockKey = errors.New("block key is invalid")
 var ErrInvalidPageType = errors.New("invalid page type")
 var ErrInvalidPageVersionModel = errors.New("invalid page version model")
+var ErrPageNotFound = errors.New("page not found")
+var ErrPagePathAlreadyExists = errors.New("page with this path already exists in site")
+var ErrPageParentNotFound = errors.New("parent page not found in site")
+var ErrPageParentTrashed = errors.New("parent page is in the trash and must be restored first")
+var ErrPageLinkURLRequired = errors.New("link pages require a link URL")
+var ErrPageLinkURLInvalid = errors.New("link URL must be an absolute http(s) URL or a site relative path")
+var ErrPageHardLinkTargetRequired = errors.New("hard link pages require a target page")
+var ErrPageHardLinkTargetNotFound = errors.New("hard link target page not found")
+var ErrPageHardLinkSelfReference = errors.New("hard link page cannot target itself")
+var ErrPageTargetNotAllowed = errors.New("link targets are not allowed for this page type")
+var ErrPageMoveCycle = errors.New("page cannot be moved below itself or one of its descendants")
+var ErrPagePositionInvalid = errors.New("page position cannot be negative")
+var ErrPageHardLinkCycle = errors.New("page hard link chain contains a cycle")
+var ErrPageHardLinkDangling = errors.New("page hard link points to a missing page")
+var ErrPageHardLinkCrossTenant = errors.New("page hard link target belongs to another tenant")
+var ErrPageDuplicateVersionsInvalid = errors.New("duplicated page versions must be latest or published")
+var ErrPageDuplicateExternalHardLink = errors.New("hard links of pages copied into another site must point into the copied subtree")
+var ErrPageBlockKeyDuplicate = errors.New("block keys must be unique within a page version")
+var ErrPageBlockSnippetInvalid = errors.New("snippet block content must reference a page as {\"page_id\": <id>}")
+var ErrPageSnippetNotFound = errors.New("embedded snippet page not found")
+var ErrPageSnippetTypeInvalid = errors.New("embedded page is not a snippet page")
+var ErrPageSnippetCrossTenant = errors.New("embedded snippet page belongs to another tenant")
+var ErrPageSnippetCycle = errors.New("snippet embeds contain a cycle")
+var ErrPageSnippetInUse = errors.New("snippet page is embedded by other pages")
+var ErrPageVersionNotFound = errors.New("page version not found")
+var ErrPageVersionStatusInvalid = errors.New("page version status is invalid")
+var ErrPageVersionTransitionNotAllowed = errors.New("page version status transition is not allowed")
+var ErrPageVersionNotEditable = errors.New("only draft page versions can be edited")
+var ErrPageVersionNotSchedulable = errors.New("archived page versions cannot be scheduled")
+var ErrPageVersionScheduleInvalid = errors.New("page version unpublish time must be after its publish time")
+var ErrPagePathInvalid = errors.New("page path is invalid")
+var ErrPageFeedRootTypeInvalid = errors.New("only content pages can be feed roots")
+var ErrFeedNotFound = errors.New("feed not found")
+var ErrPageSEOTitleTooLong = errors.New("meta and social sharing titles cannot be longer than 255 characters")
+var ErrPageSEODescriptionTooLong = errors.New("social sharing description cannot be longer than 500 characters")
+var ErrPageSEOCanonicalURLInvalid = errors.New("canonical URL must be an absolute http(s) URL or a site relative path")
+var ErrPageSEOImageInvalid = errors.New("social sharing image must be an absolute http(s) URL or a site relative path")
+var ErrPageSEORobotsInvalid = errors.New("robots directives are invalid")
+var ErrPageSEOTwitterCardInvalid = errors.New("twitter card must be summary or summary_large_image")
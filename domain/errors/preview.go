@@ -0,0 +1,11 @@
+package errors
+
+import "errors"
+
+var ErrPreviewScopeInvalid = errors.New("preview scope must be version or site")
+var ErrPreviewTokenVersionRequired = errors.New("version preview tokens require a page version")
+var ErrPreviewTokenVersionNotAllowed = errors.New("site preview tokens cannot reference a page version")
+var ErrPreviewTokenLifetimeInvalid = errors.New("preview token lifetime must be between one minute and 30 days")
+var ErrPreviewTokenNotFound = errors.New("preview token not found")
+var ErrPreviewTokenInvalid = errors.New("preview token is invalid, expired or revoked")
+var ErrPreviewTokenSecretMissing = errors.New("preview token secret is not configured")
@@ -0,0 +1,33 @@
+package errors
+
+import (
+	"errors"
+	"fmt"
+)
+
+var ErrRedirectNotFound = errors.New("redirect not found")
+var ErrRedirectSourceInvalid = errors.New("redirect source must be a site relative path of at most 768 characters")
+var ErrRedirectSourceAlreadyExists = errors.New("redirect with this source path already exists in site")
+var ErrRedirectStatusInvalid = errors.New("redirect status code must be 301, 302 or 410")
+var ErrRedirectTargetRequired = errors.New("redirects require either a target page or a target URL")
+var ErrRedirectTargetNotAllowed = errors.New("gone redirects cannot have a target")
+var ErrRedirectTargetURLInvalid = errors.New("redirect target URL must be an absolute http(s) URL or a site relative path")
+var ErrRedirectTargetPageNotFound = errors.New("redirect target page not found")
+var ErrRedirectLoop = errors.New("redirect target cannot be its own source path")
+var ErrRedirectImportInvalid = errors.New("redirect import is not valid CSV")
+var ErrRedirectImportTooLarge = errors.New("redirect import has too many rows")
+
+// RedirectImportError reports the CSV line of an imported redirect that was rejected.
+// It matches the reason of the rejection with errors.Is.
+type RedirectImportError struct {
+	Line int
+	Err  error
+}
+
+func (e *RedirectImportError) Error() string {
+	return fmt.Sprintf("line %d: %s", e.Line, e.Err.Error())
+}
+
+func (e *RedirectImportError) Unwrap() error {
+	return e.Err
+}
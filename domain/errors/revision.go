@@ -0,0 +1,6 @@
+package errors
+
+import "errors"
+
+var ErrRevisionMismatch = errors.New("resource was modified since it was read, reload it and try again")
+var ErrConcurrentModification = errors.New("resource was modified concurrently, reload it and try again")
@@ -0,0 +1,5 @@
+package errors
+
+import "errors"
+
+var ErrSearchQueryTooLong = errors.New("search query is too long")
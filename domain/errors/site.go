@@ -8,3 +8,8 @@ var ErrSitePageEmpty = errors.New("site page cannot be empty")
 var ErrSitePageWithSlugAlreadyExists = errors.New("site page with slug already exists")
 var ErrSitePageNotFound = errors.New("site page not found")
 var ErrSiteEmpty = errors.New("site cannot be empty")
+var ErrSiteNotFound = errors.New("site not found")
+var ErrSiteDomainAlreadyExists = errors.New("site with this domain already exists")
+var ErrSitemapNotFound = errors.New("sitemap not found")
+var ErrSiteTitleTemplateTooLong = errors.New("site title template cannot be longer than 255 characters")
+var ErrSiteTitleTemplatePlaceholderUnknown = errors.New("site title template contains an unknown placeholder")
@@ -0,0 +1,7 @@
+package errors
+
+import "errors"
+
+var ErrTemplateNotFound = errors.New("template not found")
+var ErrTemplateNameAlreadyExists = errors.New("template with this name already exists")
+var ErrTemplateFilePathAlreadyExists = errors.New("template with this file path already exists")
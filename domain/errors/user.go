@@ -5,3 +5,4 @@ import "errors"
 var ErrUserAlreadyOnTenant = errors.New("user is already on the tenant")
 var ErrUserRoleEmpty = errors.New("user role cannot be empty")
 var ErrUserRoleInvalid = errors.New("user role is invalid")
+var ErrUserNotFound = errors.New("user not found")
@@ -0,0 +1,15 @@
+package repositories
+
+import (
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"github.com/jmoiron/sqlx"
+)
+
+// AuditEntryRepository defines the interface for audit log data operations. Entries are only ever added.
+type AuditEntryRepository interface {
+	Save(entry *entities.AuditEntry) error
+	// FindByTenantID returns the audit log of the tenant, latest first, limited to the given number of entries
+	FindByTenantID(tenantID entities.TenantID, limit uint64) ([]*entities.AuditEntry, error)
+	// WithTrx returns a repository that runs its queries inside the given transaction
+	WithTrx(trxHandle *sqlx.Tx) AuditEntryRepository
+}
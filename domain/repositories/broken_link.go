@@ -0,0 +1,18 @@
+package repositories
+
+import (
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"github.com/jmoiron/sqlx"
+	"time"
+)
+
+// BrokenLinkRepository defines the interface for broken link data operations
+type BrokenLinkRepository interface {
+	Save(link *entities.BrokenLink) error
+	FindBySiteID(siteID entities.SiteID) ([]*entities.BrokenLink, error)
+	// DeleteCheckedBefore removes the links of the site that were not checked since the given time,
+	// i.e. links that were fixed or removed from their page
+	DeleteCheckedBefore(siteID entities.SiteID, before time.Time) error
+	// WithTrx returns a repository that runs its queries inside the given transaction
+	WithTrx(trxHandle *sqlx.Tx) BrokenLinkRepository
+}
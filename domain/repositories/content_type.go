@@ -0,0 +1,19 @@
+package repositories
+
+import (
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"github.com/jmoiron/sqlx"
+)
+
+// ContentTypeRepository defines the interface for content type data operations
+type ContentTypeRepository interface {
+	Save(contentType *entities.ContentType) error
+	FindByID(id entities.ContentTypeID) (*entities.ContentType, error)
+	// FindByName returns the content type of the tenant with the given name, or the global one when tenantID is nil
+	FindByName(tenantID *entities.TenantID, name string) (*entities.ContentType, error)
+	// FindByTenantID returns the content types of the tenant, or the global ones when tenantID is nil
+	FindByTenantID(tenantID *entities.TenantID) ([]*entities.ContentType, error)
+	Delete(id entities.ContentTypeID) error
+	// WithTrx returns a repository that runs its queries inside the given transaction
+	WithTrx(trxHandle *sqlx.Tx) ContentTypeRepository
+}
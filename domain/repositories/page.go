@@ -2,6 +2,8 @@ package repositories
 
 import (
 	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"github.com/jmoiron/sqlx"
+	"time"
 )
 
 // PageRepository defines the interface for page data operations
@@ -12,6 +14,15 @@ type PageRepository interface {
 	FindBySiteID(siteID entities.SiteID) ([]*entities.Page, error)
 	FindRootPagesBySiteID(siteID entities.SiteID) ([]*entities.Page, error)
 	FindChildrenByParentID(parentID entities.PageID) ([]*entities.Page, error)
+	// FindTrashedByID returns a trashed page, nil when the page does not exist or is not trashed
+	FindTrashedByID(id entities.PageID) (*entities.Page, error)
+	// FindTrashedBySiteID returns the trashed pages of a site, most recently trashed first
+	FindTrashedBySiteID(siteID entities.SiteID) ([]*entities.Page, error)
+	// FindTrashedBefore returns the pages of all sites trashed before the given time
+	FindTrashedBefore(before time.Time) ([]*entities.Page, error)
+	// Delete permanently deletes a page; pages are trashed by saving them
 	Delete(id entities.PageID) error
 	ExistsByPath(path string, siteID entities.SiteID) (bool, error)
+	// WithTrx returns a repository that runs its queries inside the given transaction
+	WithTrx(trxHandle *sqlx.Tx) PageRepository
 }
@@ -2,6 +2,7 @@ package repositories
 
 import (
 	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"github.com/jmoiron/sqlx"
 )
 
 // PageBlockRepository defines the interface for page block data operations
@@ -10,6 +11,10 @@ type PageBlockRepository interface {
 	FindByID(id entities.PageBlockID) (*entities.PageBlock, error)
 	FindByPageVersionID(pageVersionID entities.PageVersionID) ([]*entities.PageBlock, error)
 	FindByBlockKey(blockKey string, pageVersionID entities.PageVersionID) (*entities.PageBlock, error)
+	// FindBySnippetPageID returns the blocks of all page versions that embed the given snippet page
+	FindBySnippetPageID(snippetPageID entities.PageID) ([]*entities.PageBlock, error)
 	Delete(id entities.PageBlockID) error
 	DeleteByPageVersionID(pageVersionID entities.PageVersionID) error
+	// WithTrx returns a repository that runs its queries inside the given transaction
+	WithTrx(trxHandle *sqlx.Tx) PageBlockRepository
 }
@@ -2,6 +2,8 @@ package repositories
 
 import (
 	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"github.com/jmoiron/sqlx"
+	"time"
 )
 
 // PageVersionRepository defines the interface for page version data operations
@@ -9,7 +11,23 @@ type PageVersionRepository interface {
 	Save(version *entities.PageVersion) error
 	FindByID(id entities.PageVersionID) (*entities.PageVersion, error)
 	FindByPageID(pageID entities.PageID) ([]*entities.PageVersion, error)
-	FindPublishedByPageID(pageID entities.PageID) (*entities.PageVersion, error)
+	// FindPublishedByPageID returns the published version of a page in the given locale, nil when there is none
+	FindPublishedByPageID(pageID entities.PageID, locale entities.Locale) (*entities.PageVersion, error)
 	FindLatestByPageID(pageID entities.PageID) (*entities.PageVersion, error)
+	// FindPublishedBySiteID returns the published versions of all pages of a site, in every locale
+	FindPublishedBySiteID(siteID entities.SiteID) ([]*entities.PageVersion, error)
+	// FindSitemapPages returns the published content pages of a site in the given locales, ordered by path and
+	// locale, skipping the first offset pages and returning at most limit pages
+	FindSitemapPages(siteID entities.SiteID, locales []entities.Locale, offset, limit uint64) ([]entities.SitemapPage, error)
+	// FindSitemapParts summarizes the sitemap of a site in the given locales as parts of partSize pages in the
+	// order of FindSitemapPages; a sitemap without pages has no parts
+	FindSitemapParts(siteID entities.SiteID, locales []entities.Locale, partSize uint64) ([]entities.SitemapPartSummary, error)
+	// FindDueScheduled returns the versions whose scheduled publish or unpublish time has been reached
+	FindDueScheduled(now time.Time) ([]*entities.PageVersion, error)
+	// FindTrashedByPageID returns the trashed versions of a page
+	FindTrashedByPageID(pageID entities.PageID) ([]*entities.PageVersion, error)
+	// Delete permanently deletes a page version; versions are trashed by saving them
 	Delete(id entities.PageVersionID) error
+	// WithTrx returns a repository that runs its queries inside the given transaction
+	WithTrx(trxHandle *sqlx.Tx) PageVersionRepository
 }
@@ -0,0 +1,20 @@
+package repositories
+
+import (
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"github.com/jmoiron/sqlx"
+)
+
+// RedirectRepository defines the interface for redirect data operations
+type RedirectRepository interface {
+	Save(redirect *entities.Redirect) error
+	FindByID(id entities.RedirectID) (*entities.Redirect, error)
+	// FindBySourcePath returns the redirect of the site for the path, or nil
+	FindBySourcePath(siteID entities.SiteID, sourcePath string) (*entities.Redirect, error)
+	FindBySiteID(siteID entities.SiteID) ([]*entities.Redirect, error)
+	// RecordHit increments the hit counter of the redirect
+	RecordHit(id entities.RedirectID) error
+	Delete(id entities.RedirectID) error
+	// WithTrx returns a repository that runs its queries inside the given transaction
+	WithTrx(trxHandle *sqlx.Tx) RedirectRepository
+}
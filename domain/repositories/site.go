@@ -3,6 +3,8 @@ package repositories
 import (
 	"github.com/h4rdc0m/aurora-api/domain/entities"
 	"github.com/h4rdc0m/aurora-api/domain/value_objects"
+	"github.com/jmoiron/sqlx"
+	"time"
 )
 
 // SiteRepository defines the interface for site data operations
@@ -13,6 +15,15 @@ type SiteRepository interface {
 	FindByTenantID(tenantID entities.TenantID) ([]*entities.Site, error)
 	FindAll() ([]*entities.Site, error)
 	FindEnabledByTenantID(tenantID entities.TenantID) ([]*entities.Site, error)
+	// FindTrashedByID returns a trashed site, nil when the site does not exist or is not trashed
+	FindTrashedByID(id entities.SiteID) (*entities.Site, error)
+	// FindTrashedByTenantID returns the trashed sites of a tenant, most recently trashed first
+	FindTrashedByTenantID(tenantID entities.TenantID) ([]*entities.Site, error)
+	// FindTrashedBefore returns the sites trashed before the given time
+	FindTrashedBefore(before time.Time) ([]*entities.Site, error)
+	// Delete permanently deletes a site; sites are trashed by saving them
 	Delete(id entities.SiteID) error
 	ExistsByDomain(domain *value_objects.DomainName) (bool, error)
+	// WithTrx returns a repository that runs its queries inside the given transaction
+	WithTrx(trxHandle *sqlx.Tx) SiteRepository
 }
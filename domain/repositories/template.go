@@ -2,6 +2,8 @@ package repositories
 
 import (
 	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"github.com/jmoiron/sqlx"
+	"time"
 )
 
 // TemplateRepository defines the interface for template data operations
@@ -11,7 +13,16 @@ type TemplateRepository interface {
 	FindByName(name string) (*entities.Template, error)
 	FindAll() ([]*entities.Template, error)
 	FindEnabledOnly() ([]*entities.Template, error)
+	// FindTrashed returns the trashed templates, most recently trashed first
+	FindTrashed() ([]*entities.Template, error)
+	// FindTrashedByID returns a trashed template, nil when there is no such template in the trash
+	FindTrashedByID(id entities.TemplateID) (*entities.Template, error)
+	// FindTrashedBefore returns the templates trashed before the given time
+	FindTrashedBefore(before time.Time) ([]*entities.Template, error)
+	// Delete permanently deletes a template; templates are trashed by saving them
 	Delete(id entities.TemplateID) error
 	ExistsByName(name string) (bool, error)
 	ExistsByFilePath(filePath string) (bool, error)
+	// WithTrx returns a repository that runs its queries inside the given transaction
+	WithTrx(trxHandle *sqlx.Tx) TemplateRepository
 }
@@ -0,0 +1,20 @@
+package services
+
+import (
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"github.com/jmoiron/sqlx"
+)
+
+// ContentValidator checks block content against the JSON Schemas of the content type registry.
+type ContentValidator interface {
+	// ValidateSchema checks that the schema is a valid JSON Schema.
+	ValidateSchema(schema string) error
+
+	// Validate checks the content of the block against the content type of the tenant with the same name,
+	// falling back to the global content type. Blocks of unregistered content types are accepted as they are.
+	// Returns a *errors.BlockContentError listing the field paths when the content does not match.
+	Validate(tenantID entities.TenantID, block *entities.PageBlock) error
+
+	// WithTrx returns a validator that reads inside the given transaction
+	WithTrx(trxHandle *sqlx.Tx) ContentValidator
+}
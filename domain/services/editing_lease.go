@@ -0,0 +1,30 @@
+package services
+
+import (
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"time"
+)
+
+// EditingLeaseService keeps the editing leases of page versions, shared between all running API instances.
+// A lease ends when it expires, when its holder releases it or when it is broken.
+type EditingLeaseService interface {
+	// Acquire stores the lease unless another editor holds the version, which returns a PageVersionLockedError.
+	// A holder acquiring the version again replaces their lease.
+	Acquire(lease *entities.EditingLease) error
+
+	// Renew extends the lease the editor with the given Keycloak subject holds on the version until expiresAt.
+	// Returns ErrEditingLeaseNotFound if the version has no lease, or a PageVersionLockedError if another
+	// editor holds it.
+	Renew(versionID entities.PageVersionID, subject string, expiresAt time.Time) (*entities.EditingLease, error)
+
+	// Release ends the lease the editor with the given Keycloak subject holds on the version. Releasing a version
+	// without a lease does nothing; a lease of another editor returns a PageVersionLockedError.
+	Release(versionID entities.PageVersionID, subject string) error
+
+	// Find returns the lease on the version, or nil when nobody holds it.
+	Find(versionID entities.PageVersionID) (*entities.EditingLease, error)
+
+	// Break ends the lease on the version regardless of its holder and returns the lease it ended, or nil when
+	// nobody held it.
+	Break(versionID entities.PageVersionID) (*entities.EditingLease, error)
+}
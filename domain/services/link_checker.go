@@ -0,0 +1,10 @@
+package services
+
+import "github.com/h4rdc0m/aurora-api/domain/entities"
+
+// LinkChecker checks whether external links still resolve.
+type LinkChecker interface {
+	// Check requests the absolute http(s) URL and reports whether it is broken. Failing to reach the server is
+	// reported as a broken link rather than an error.
+	Check(url string) entities.LinkCheck
+}
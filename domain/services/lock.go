@@ -0,0 +1,13 @@
+package services
+
+import "time"
+
+// LockService defines a lock shared between all running API instances, used to make sure background jobs
+// run on a single instance at a time.
+type LockService interface {
+	// TryLock acquires the named lock for at most the given duration. Returns false if another holder owns it.
+	TryLock(name string, ttl time.Duration) (bool, error)
+
+	// Unlock releases the named lock if it is still held by this instance.
+	Unlock(name string) error
+}
@@ -0,0 +1,20 @@
+package services
+
+import (
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"github.com/jmoiron/sqlx"
+)
+
+// PageResolver turns a page into the page whose content it shows, following hard-link chains.
+type PageResolver interface {
+	// Resolve follows the hard links starting at the page and returns the final content or link page.
+	// Returns an error if the chain contains a cycle or ends at a missing page.
+	Resolve(page *entities.Page) (*entities.ResolvedPage, error)
+
+	// ValidateHardLinkTarget checks that the page may hard link to the target: the target must exist,
+	// belong to the same tenant and not lead back to the page.
+	ValidateHardLinkTarget(page *entities.Page, targetID entities.PageID) error
+
+	// WithTrx returns a resolver that reads inside the given transaction
+	WithTrx(trxHandle *sqlx.Tx) PageResolver
+}
@@ -0,0 +1,20 @@
+package services
+
+import "github.com/h4rdc0m/aurora-api/domain/entities"
+
+// PreviewTokenService issues and verifies signed preview tokens, and keeps track of the issued tokens until they
+// expire so that they can be listed and revoked.
+type PreviewTokenService interface {
+	// Issue assigns an ID to the token, stores it until it expires and returns its signed form.
+	Issue(token *entities.PreviewToken) (string, error)
+
+	// Verify returns the token of a signed form. Returns ErrPreviewTokenInvalid if the signature does not match,
+	// or the token expired or was revoked.
+	Verify(signed string) (*entities.PreviewToken, error)
+
+	// FindBySiteID returns the tokens of the site that have not expired or been revoked, latest expiring first.
+	FindBySiteID(siteID entities.SiteID) ([]*entities.PreviewToken, error)
+
+	// Revoke withdraws a token of the site. Returns ErrPreviewTokenNotFound if the site has no such token.
+	Revoke(siteID entities.SiteID, id string) error
+}
@@ -0,0 +1,37 @@
+package services
+
+import (
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"github.com/jmoiron/sqlx"
+)
+
+// SearchIndex stores the search documents of published pages and answers full-text queries scoped by site and
+// locale chain.
+type SearchIndex interface {
+	// Index adds the document to the index, replacing an earlier document of the same page and locale.
+	Index(document *entities.SearchDocument) error
+
+	// Remove removes the documents of the page in every locale from the index; removing a page that is not indexed
+	// is not an error.
+	Remove(pageID entities.PageID) error
+
+	// Search returns the requested page of hits matching all terms of the query, best matches first. Every page
+	// is matched against its document in the first locale of the query's locale chain it is indexed in.
+	Search(query entities.SearchQuery) (*entities.SearchResult, error)
+
+	// WithTrx returns an index that writes inside the given transaction
+	WithTrx(trxHandle *sqlx.Tx) SearchIndex
+}
+
+// SearchIndexer keeps the search index in line with the published versions of pages.
+type SearchIndexer interface {
+	// Reindex indexes the published versions of a content page in every locale, or removes the page from the
+	// index when it has no published version or is not a content page.
+	Reindex(page *entities.Page) error
+
+	// Remove removes the page from the index.
+	Remove(pageID entities.PageID) error
+
+	// WithTrx returns an indexer that reads and writes inside the given transaction
+	WithTrx(trxHandle *sqlx.Tx) SearchIndexer
+}
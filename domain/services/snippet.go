@@ -0,0 +1,21 @@
+package services
+
+import (
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"github.com/jmoiron/sqlx"
+)
+
+// SnippetService validates and inlines snippet pages embedded by the blocks of other pages.
+type SnippetService interface {
+	// ValidateEmbed checks that the page may embed the snippet referenced by the block: the target must be
+	// a snippet page of the same tenant whose own embeds do not lead back to the page.
+	ValidateEmbed(page *entities.Page, block *entities.PageBlock) error
+
+	// Render prepares the blocks of a page for delivery, inlining the published blocks of embedded snippets
+	// in the given locale or the first locale of its fallback chain that has a published version.
+	// Snippets that are missing, unpublished, nested too deep or part of a cycle are left empty.
+	Render(page *entities.Page, locale entities.Locale, blocks []*entities.PageBlock) ([]*entities.RenderedBlock, error)
+
+	// WithTrx returns a service that reads inside the given transaction
+	WithTrx(trxHandle *sqlx.Tx) SnippetService
+}
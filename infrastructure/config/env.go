@@ -27,6 +27,12 @@ type Env struct {
 	KeycloakClientID           string `mapstructure:"AURORA_KEYCLOAK_CLIENT_ID"`
 	KeycloakClientSecret       string `mapstructure:"AURORA_KEYCLOAK_CLIENT_SECRET"`
 	KeycloakDefaultRedirectURI string `mapstructure:"AURORA_KEYCLOAK_DEFAULT_REDIRECT_URI"`
+	SchedulerInterval          string `mapstructure:"AURORA_SCHEDULER_INTERVAL"`
+	LinkCheckInterval          string `mapstructure:"AURORA_LINK_CHECK_INTERVAL"`
+	LinkCheckHostDelay         string `mapstructure:"AURORA_LINK_CHECK_HOST_DELAY"`
+	PreviewTokenSecret         string `mapstructure:"AURORA_PREVIEW_TOKEN_SECRET"`
+	TrashPurgeInterval         string `mapstructure:"AURORA_TRASH_PURGE_INTERVAL"`
+	TrashRetention             string `mapstructure:"AURORA_TRASH_RETENTION"`
 }
 
 // NewEnv initializes and returns an Env struct by reading and unmarshaling the configuration from a .env file.
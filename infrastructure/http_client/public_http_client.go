@@ -0,0 +1,76 @@
+package http_client
+
+import (
+	"errors"
+	"fmt"
+	"github.com/h4rdc0m/aurora-api/domain/common"
+	"net"
+	"net/http"
+	"net/netip"
+	"syscall"
+	"time"
+)
+
+// ErrNonPublicAddress is returned when a public client is asked to connect to an internal address
+var ErrNonPublicAddress = errors.New("connection to non-public address refused")
+
+// nonPublicPrefixes are the ranges not covered by the netip predicates that are not reachable on the internet
+var nonPublicPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+	netip.MustParsePrefix("64:ff9b::/96"),
+}
+
+// NewPublicHttpClient creates a StandardHttpClient with the specified timeout in seconds that only connects to
+// public addresses. The address is checked on every connection after the host name is resolved, so redirects and
+// host names resolving to loopback, private or link-local addresses are refused as well.
+// Proxies from the environment are not used, as the check would apply to the proxy instead of the target.
+func NewPublicHttpClient(timeOut int) common.HTTPClient {
+	dialer := &net.Dialer{
+		Timeout: time.Duration(timeOut) * time.Second,
+		Control: publicAddressOnly,
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &StandardHttpClient{
+		client: &http.Client{
+			Timeout:   time.Duration(timeOut) * time.Second,
+			Transport: transport,
+		},
+	}
+}
+
+// publicAddressOnly refuses to connect to a resolved address that is not public
+func publicAddressOnly(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip, err := netip.ParseAddr(host)
+	if err != nil {
+		return err
+	}
+	if !IsPublicAddress(ip) {
+		return fmt.Errorf("%w: %s", ErrNonPublicAddress, ip)
+	}
+	return nil
+}
+
+// IsPublicAddress reports whether the address is a unicast address reachable on the internet, rejecting loopback,
+// private, link-local, multicast and other reserved addresses.
+func IsPublicAddress(ip netip.Addr) bool {
+	ip = ip.Unmap()
+	if !ip.IsGlobalUnicast() || ip.IsPrivate() {
+		return false
+	}
+	for _, prefix := range nonPublicPrefixes {
+		if prefix.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,106 @@
+package mappers
+
+import (
+	"encoding/json"
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"github.com/h4rdc0m/aurora-api/infrastructure/persistence/models"
+)
+
+// AuditEntryMapper handles conversion between domain entities and GORM models
+type AuditEntryMapper struct{}
+
+// NewAuditEntryMapper creates a new AuditEntryMapper
+func NewAuditEntryMapper() *AuditEntryMapper {
+	return &AuditEntryMapper{}
+}
+
+// ToModel converts a domain AuditEntry to a GORM models.AuditEntry
+func (m *AuditEntryMapper) ToModel(entry *entities.AuditEntry) (*models.AuditEntry, error) {
+	if entry == nil {
+		return nil, nil
+	}
+
+	details := entry.Details()
+	if details == nil {
+		details = map[string]string{}
+	}
+	encoded, err := json.Marshal(details)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.AuditEntry{
+		Base: models.Base{
+			ID:        entry.ID().Value(),
+			CreatedAt: entry.CreatedAt(),
+			UpdatedAt: entry.CreatedAt(),
+		},
+		TenantID:   entry.TenantID().Value(),
+		ActorID:    entry.ActorID().Value(),
+		Action:     string(entry.Action()),
+		Resource:   entry.Resource(),
+		ResourceID: entry.ResourceID(),
+		Details:    string(encoded),
+	}, nil
+}
+
+// ToDomain converts a GORM models.AuditEntry to a domain AuditEntry
+func (m *AuditEntryMapper) ToDomain(model *models.AuditEntry) (*entities.AuditEntry, error) {
+	if model == nil {
+		return nil, nil
+	}
+
+	var details map[string]string
+	if err := json.Unmarshal([]byte(model.Details), &details); err != nil {
+		return nil, err
+	}
+
+	entry := entities.NewAuditEntry(
+		entities.NewTenantID(model.TenantID),
+		entities.NewUserID(model.ActorID),
+		entities.AuditAction(model.Action),
+		model.Resource,
+		model.ResourceID,
+		details,
+	)
+	entry.SetID(entities.NewAuditEntryID(model.ID))
+	entry.SetCreatedAt(model.CreatedAt)
+
+	return entry, nil
+}
+
+// ToModels converts a slice of domain AuditEntries to GORM models
+func (m *AuditEntryMapper) ToModels(entries []*entities.AuditEntry) ([]*models.AuditEntry, error) {
+	if entries == nil {
+		return nil, nil
+	}
+
+	result := make([]*models.AuditEntry, len(entries))
+	for i, entry := range entries {
+		model, err := m.ToModel(entry)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = model
+	}
+
+	return result, nil
+}
+
+// ToDomains converts a slice of GORM models to domain AuditEntries
+func (m *AuditEntryMapper) ToDomains(modelList []*models.AuditEntry) ([]*entities.AuditEntry, error) {
+	if modelList == nil {
+		return nil, nil
+	}
+
+	result := make([]*entities.AuditEntry, len(modelList))
+	for i, model := range modelList {
+		entry, err := m.ToDomain(model)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = entry
+	}
+
+	return result, nil
+}
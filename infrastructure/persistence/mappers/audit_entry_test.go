@@ -0,0 +1,88 @@
+package mappers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"github.com/h4rdc0m/aurora-api/infrastructure/persistence/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuditEntryMapper_ToModel(t *testing.T) {
+	mapper := NewAuditEntryMapper()
+
+	t.Run("nil input", func(t *testing.T) {
+		result, err := mapper.ToModel(nil)
+		assert.NoError(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("broken editing lease", func(t *testing.T) {
+		entry := entities.NewAuditEntry(entities.NewTenantID(2), entities.NewUserID(5), entities.AuditActionEditingLeaseBroken, entities.AuditResourcePageVersion, 9, map[string]string{"holder_name": "Jane Doe"})
+		entry.SetID(entities.NewAuditEntryID(7))
+
+		result, err := mapper.ToModel(entry)
+
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(7), result.ID)
+		assert.Equal(t, uint64(2), result.TenantID)
+		assert.Equal(t, uint64(5), result.ActorID)
+		assert.Equal(t, "editing_lease.broken", result.Action)
+		assert.Equal(t, "page_version", result.Resource)
+		assert.Equal(t, uint64(9), result.ResourceID)
+		assert.JSONEq(t, `{"holder_name":"Jane Doe"}`, result.Details)
+		assert.Equal(t, entry.CreatedAt(), result.CreatedAt)
+	})
+
+	t.Run("no details", func(t *testing.T) {
+		entry := entities.NewAuditEntry(entities.NewTenantID(2), entities.NewUserID(5), entities.AuditActionEditingLeaseBroken, entities.AuditResourcePageVersion, 9, nil)
+
+		result, err := mapper.ToModel(entry)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "{}", result.Details)
+	})
+}
+
+func TestAuditEntryMapper_ToDomain(t *testing.T) {
+	mapper := NewAuditEntryMapper()
+	createdAt := time.Date(2025, 7, 27, 9, 0, 0, 0, time.UTC)
+
+	t.Run("valid model", func(t *testing.T) {
+		model := &models.AuditEntry{
+			Base:       models.Base{ID: 7, CreatedAt: createdAt, UpdatedAt: createdAt},
+			TenantID:   2,
+			ActorID:    5,
+			Action:     "editing_lease.broken",
+			Resource:   "page_version",
+			ResourceID: 9,
+			Details:    `{"holder_name":"Jane Doe"}`,
+		}
+
+		result, err := mapper.ToDomain(model)
+
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(7), result.ID().Value())
+		assert.Equal(t, uint64(2), result.TenantID().Value())
+		assert.Equal(t, uint64(5), result.ActorID().Value())
+		assert.Equal(t, entities.AuditActionEditingLeaseBroken, result.Action())
+		assert.Equal(t, entities.AuditResourcePageVersion, result.Resource())
+		assert.Equal(t, uint64(9), result.ResourceID())
+		assert.Equal(t, map[string]string{"holder_name": "Jane Doe"}, result.Details())
+		assert.Equal(t, createdAt, result.CreatedAt())
+	})
+
+	t.Run("invalid details", func(t *testing.T) {
+		result, err := mapper.ToDomain(&models.AuditEntry{Details: "not json"})
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("nil input", func(t *testing.T) {
+		result, err := mapper.ToDomain(nil)
+		assert.NoError(t, err)
+		assert.Nil(t, result)
+	})
+}
@@ -0,0 +1,94 @@
+package mappers
+
+import (
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"github.com/h4rdc0m/aurora-api/infrastructure/persistence/models"
+)
+
+// BrokenLinkMapper handles conversion between domain entities and GORM models
+type BrokenLinkMapper struct{}
+
+// NewBrokenLinkMapper creates a new BrokenLinkMapper
+func NewBrokenLinkMapper() *BrokenLinkMapper {
+	return &BrokenLinkMapper{}
+}
+
+// ToModel converts a domain BrokenLink to a GORM models.BrokenLink
+func (m *BrokenLinkMapper) ToModel(link *entities.BrokenLink) (*models.BrokenLink, error) {
+	if link == nil {
+		return nil, nil
+	}
+
+	return &models.BrokenLink{
+		Base: models.Base{
+			ID:        link.ID().Value(),
+			CreatedAt: link.CreatedAt(),
+			UpdatedAt: link.UpdatedAt(),
+		},
+		SiteID:        link.SiteID().Value(),
+		PageID:        link.PageID().Value(),
+		BlockKey:      link.BlockKey(),
+		URL:           link.URL(),
+		StatusCode:    link.StatusCode(),
+		Reason:        link.Reason(),
+		FirstSeenAt:   link.FirstSeenAt(),
+		LastCheckedAt: link.LastCheckedAt(),
+	}, nil
+}
+
+// ToDomain converts a GORM models.BrokenLink to a domain BrokenLink
+func (m *BrokenLinkMapper) ToDomain(model *models.BrokenLink) (*entities.BrokenLink, error) {
+	if model == nil {
+		return nil, nil
+	}
+
+	check := entities.LinkCheck{
+		URL:        model.URL,
+		Broken:     true,
+		StatusCode: model.StatusCode,
+		Reason:     model.Reason,
+	}
+	link := entities.NewBrokenLink(entities.NewSiteID(model.SiteID), entities.NewPageID(model.PageID), model.BlockKey, check, model.FirstSeenAt)
+
+	link.SetID(entities.NewBrokenLinkID(model.ID))
+	link.SetCheckTimes(model.FirstSeenAt, model.LastCheckedAt)
+	link.SetTimestamps(model.CreatedAt, model.UpdatedAt)
+
+	return link, nil
+}
+
+// ToModels converts a slice of domain BrokenLinks to GORM models
+func (m *BrokenLinkMapper) ToModels(links []*entities.BrokenLink) ([]*models.BrokenLink, error) {
+	if links == nil {
+		return nil, nil
+	}
+
+	result := make([]*models.BrokenLink, len(links))
+	for i, link := range links {
+		model, err := m.ToModel(link)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = model
+	}
+
+	return result, nil
+}
+
+// ToDomains converts a slice of GORM models to domain BrokenLinks
+func (m *BrokenLinkMapper) ToDomains(modelList []*models.BrokenLink) ([]*entities.BrokenLink, error) {
+	if modelList == nil {
+		return nil, nil
+	}
+
+	result := make([]*entities.BrokenLink, len(modelList))
+	for i, model := range modelList {
+		link, err := m.ToDomain(model)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = link
+	}
+
+	return result, nil
+}
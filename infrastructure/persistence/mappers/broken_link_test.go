@@ -0,0 +1,93 @@
+package mappers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"github.com/h4rdc0m/aurora-api/infrastructure/persistence/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBrokenLinkMapper_ToModel(t *testing.T) {
+	mapper := NewBrokenLinkMapper()
+
+	t.Run("nil input", func(t *testing.T) {
+		result, err := mapper.ToModel(nil)
+		assert.NoError(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("rechecked link", func(t *testing.T) {
+		firstSeen := time.Date(2025, 7, 1, 3, 0, 0, 0, time.UTC)
+		lastChecked := firstSeen.Add(24 * time.Hour)
+		status := 404
+		link := entities.NewBrokenLink(entities.NewSiteID(2), entities.NewPageID(5), "intro", entities.LinkCheck{URL: "https://example.org/gone", Broken: true, Reason: "timeout"}, firstSeen)
+		link.SetID(entities.NewBrokenLinkID(7))
+		link.Recheck(entities.LinkCheck{URL: "https://example.org/gone", Broken: true, StatusCode: &status, Reason: "Not Found"}, lastChecked)
+
+		result, err := mapper.ToModel(link)
+
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(7), result.ID)
+		assert.Equal(t, uint64(2), result.SiteID)
+		assert.Equal(t, uint64(5), result.PageID)
+		assert.Equal(t, "intro", result.BlockKey)
+		assert.Equal(t, "https://example.org/gone", result.URL)
+		assert.Equal(t, &status, result.StatusCode)
+		assert.Equal(t, "Not Found", result.Reason)
+		assert.Equal(t, firstSeen, result.FirstSeenAt)
+		assert.Equal(t, lastChecked, result.LastCheckedAt)
+	})
+}
+
+func TestBrokenLinkMapper_ToDomain(t *testing.T) {
+	mapper := NewBrokenLinkMapper()
+	now := time.Now()
+	firstSeen := now.Add(-72 * time.Hour)
+	status := 500
+
+	tests := []struct {
+		name  string
+		input *models.BrokenLink
+	}{
+		{
+			name:  "http error in block",
+			input: &models.BrokenLink{Base: models.Base{ID: 7, CreatedAt: now, UpdatedAt: now}, SiteID: 2, PageID: 5, BlockKey: "intro", URL: "https://example.org", StatusCode: &status, Reason: "Internal Server Error", FirstSeenAt: firstSeen, LastCheckedAt: now},
+		},
+		{
+			name:  "missing internal page of link page",
+			input: &models.BrokenLink{Base: models.Base{ID: 8, CreatedAt: now, UpdatedAt: now}, SiteID: 2, PageID: 6, URL: "/missing", Reason: "page not found", FirstSeenAt: now, LastCheckedAt: now},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := mapper.ToDomain(tt.input)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.input.ID, result.ID().Value())
+			assert.Equal(t, tt.input.SiteID, result.SiteID().Value())
+			assert.Equal(t, tt.input.PageID, result.PageID().Value())
+			assert.Equal(t, tt.input.BlockKey, result.BlockKey())
+			assert.Equal(t, tt.input.URL, result.URL())
+			assert.Equal(t, tt.input.StatusCode, result.StatusCode())
+			assert.Equal(t, tt.input.Reason, result.Reason())
+			assert.Equal(t, tt.input.FirstSeenAt, result.FirstSeenAt())
+			assert.Equal(t, tt.input.LastCheckedAt, result.LastCheckedAt())
+			assert.Equal(t, now, result.CreatedAt())
+		})
+	}
+}
+
+func TestBrokenLinkMapper_ToDomains(t *testing.T) {
+	mapper := NewBrokenLinkMapper()
+
+	result, err := mapper.ToDomains([]*models.BrokenLink{{SiteID: 1, PageID: 1, URL: "/a"}, {SiteID: 1, PageID: 2, URL: "/b"}})
+	assert.NoError(t, err)
+	assert.Len(t, result, 2)
+
+	result, err = mapper.ToDomains(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, result)
+}
@@ -0,0 +1,97 @@
+package mappers
+
+import (
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"github.com/h4rdc0m/aurora-api/infrastructure/persistence/models"
+)
+
+// ContentTypeMapper handles conversion between domain entities and GORM models
+type ContentTypeMapper struct{}
+
+// NewContentTypeMapper creates a new ContentTypeMapper
+func NewContentTypeMapper() *ContentTypeMapper {
+	return &ContentTypeMapper{}
+}
+
+// ToModel converts a domain ContentType to a GORM models.ContentType
+func (m *ContentTypeMapper) ToModel(contentType *entities.ContentType) (*models.ContentType, error) {
+	if contentType == nil {
+		return nil, nil
+	}
+
+	model := &models.ContentType{
+		Base: models.Base{
+			ID:        contentType.ID().Value(),
+			CreatedAt: contentType.CreatedAt(),
+			UpdatedAt: contentType.UpdatedAt(),
+		},
+		Name:        contentType.Name(),
+		Description: contentType.Description(),
+		Schema:      contentType.Schema(),
+	}
+	if contentType.TenantID() != nil {
+		tenantID := contentType.TenantID().Value()
+		model.TenantID = &tenantID
+	}
+
+	return model, nil
+}
+
+// ToDomain converts a GORM models.ContentType to a domain ContentType
+func (m *ContentTypeMapper) ToDomain(model *models.ContentType) (*entities.ContentType, error) {
+	if model == nil {
+		return nil, nil
+	}
+
+	var tenantID *entities.TenantID
+	if model.TenantID != nil {
+		id := entities.NewTenantID(*model.TenantID)
+		tenantID = &id
+	}
+
+	contentType, err := entities.NewContentType(tenantID, model.Name, model.Description, model.Schema)
+	if err != nil {
+		return nil, err
+	}
+
+	contentType.SetID(entities.NewContentTypeID(model.ID))
+	contentType.SetTimestamps(model.CreatedAt, model.UpdatedAt)
+
+	return contentType, nil
+}
+
+// ToModels converts a slice of domain ContentTypes to GORM models
+func (m *ContentTypeMapper) ToModels(contentTypes []*entities.ContentType) ([]*models.ContentType, error) {
+	if contentTypes == nil {
+		return nil, nil
+	}
+
+	result := make([]*models.ContentType, len(contentTypes))
+	for i, contentType := range contentTypes {
+		model, err := m.ToModel(contentType)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = model
+	}
+
+	return result, nil
+}
+
+// ToDomains converts a slice of GORM models to domain ContentTypes
+func (m *ContentTypeMapper) ToDomains(modelList []*models.ContentType) ([]*entities.ContentType, error) {
+	if modelList == nil {
+		return nil, nil
+	}
+
+	result := make([]*entities.ContentType, len(modelList))
+	for i, model := range modelList {
+		contentType, err := m.ToDomain(model)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = contentType
+	}
+
+	return result, nil
+}
@@ -0,0 +1,112 @@
+package mappers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	domainErrors "github.com/h4rdc0m/aurora-api/domain/errors"
+	"github.com/h4rdc0m/aurora-api/infrastructure/persistence/models"
+	"github.com/stretchr/testify/assert"
+)
+
+const testContentTypeSchema = `{"type": "object"}`
+
+func TestContentTypeMapper_ToModel(t *testing.T) {
+	mapper := NewContentTypeMapper()
+
+	t.Run("nil input", func(t *testing.T) {
+		result, err := mapper.ToModel(nil)
+		assert.NoError(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("tenant content type", func(t *testing.T) {
+		tenantID := entities.NewTenantID(3)
+		description := "Hero banner"
+		contentType, err := entities.NewContentType(&tenantID, "hero", &description, testContentTypeSchema)
+		assert.NoError(t, err)
+		contentType.SetID(entities.NewContentTypeID(7))
+
+		result, err := mapper.ToModel(contentType)
+
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(7), result.ID)
+		assert.Equal(t, uint64(3), *result.TenantID)
+		assert.Equal(t, "hero", result.Name)
+		assert.Equal(t, &description, result.Description)
+		assert.Equal(t, testContentTypeSchema, result.Schema)
+	})
+
+	t.Run("global content type", func(t *testing.T) {
+		contentType, err := entities.NewContentType(nil, "hero", nil, testContentTypeSchema)
+		assert.NoError(t, err)
+
+		result, err := mapper.ToModel(contentType)
+
+		assert.NoError(t, err)
+		assert.Nil(t, result.TenantID)
+	})
+}
+
+func TestContentTypeMapper_ToDomain(t *testing.T) {
+	mapper := NewContentTypeMapper()
+	now := time.Now()
+	tenantID := uint64(3)
+
+	tests := []struct {
+		name       string
+		input      *models.ContentType
+		wantTenant *uint64
+		wantErr    error
+	}{
+		{
+			name:       "tenant content type",
+			input:      &models.ContentType{Base: models.Base{ID: 7, CreatedAt: now, UpdatedAt: now}, TenantID: &tenantID, Name: "hero", Schema: testContentTypeSchema, ScopeTenantID: tenantID},
+			wantTenant: &tenantID,
+		},
+		{
+			name:  "global content type",
+			input: &models.ContentType{Base: models.Base{ID: 8, CreatedAt: now, UpdatedAt: now}, Name: "hero", Schema: testContentTypeSchema},
+		},
+		{
+			name:    "invalid name",
+			input:   &models.ContentType{Name: "", Schema: testContentTypeSchema},
+			wantErr: domainErrors.ErrContentTypeNameEmpty,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := mapper.ToDomain(tt.input)
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+				assert.Nil(t, result)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.input.ID, result.ID().Value())
+			assert.Equal(t, tt.input.Name, result.Name())
+			assert.Equal(t, tt.input.Schema, result.Schema())
+			assert.Equal(t, now, result.CreatedAt())
+			if tt.wantTenant == nil {
+				assert.True(t, result.IsGlobal())
+			} else {
+				assert.Equal(t, *tt.wantTenant, result.TenantID().Value())
+			}
+		})
+	}
+}
+
+func TestContentTypeMapper_ToDomains(t *testing.T) {
+	mapper := NewContentTypeMapper()
+
+	result, err := mapper.ToDomains([]*models.ContentType{{Name: "hero", Schema: testContentTypeSchema}, {Name: "quote", Schema: testContentTypeSchema}})
+	assert.NoError(t, err)
+	assert.Len(t, result, 2)
+
+	result, err = mapper.ToDomains(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, result)
+}
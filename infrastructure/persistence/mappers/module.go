@@ -10,4 +10,8 @@ var Module = fx.Options(
 	fx.Provide(NewPageMapper),
 	fx.Provide(NewPageVersionMapper),
 	fx.Provide(NewPageBlockMapper),
+	fx.Provide(NewContentTypeMapper),
+	fx.Provide(NewRedirectMapper),
+	fx.Provide(NewBrokenLinkMapper),
+	fx.Provide(NewAuditEntryMapper),
 )
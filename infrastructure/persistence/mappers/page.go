@@ -35,13 +35,16 @@ func (m *PageMapper) ToModel(page *entities.Page) (*models.Page, error) {
 			ID:        page.ID().Value(),
 			CreatedAt: page.CreatedAt(),
 			UpdatedAt: page.UpdatedAt(),
+			DeletedAt: page.DeletedAt(),
 		},
-		Key:     page.Key().Value(),
-		Path:    page.Path(),
-		Index:   page.Index(),
-		SiteID:  page.SiteID().Value(),
-		Type:    ptype,
-		LinkURL: page.LinkURL(),
+		Revision:   page.Revision(),
+		Key:        page.Key().Value(),
+		Path:       page.Path(),
+		Index:      page.Index(),
+		SiteID:     page.SiteID().Value(),
+		Type:       ptype,
+		LinkURL:    page.LinkURL(),
+		IsFeedRoot: page.IsFeedRoot(),
 	}
 
 	if page.ParentID() != nil {
@@ -83,7 +86,6 @@ func (m *PageMapper) ToDomain(model *models.Page) (*entities.Page, error) {
 	}
 
 	page.SetID(entities.NewPageID(model.ID))
-	page.SetTimestamps(model.CreatedAt, model.UpdatedAt)
 	page.UpdateIndex(model.Index)
 
 	if model.ParentID != nil {
@@ -106,6 +108,17 @@ func (m *PageMapper) ToDomain(model *models.Page) (*entities.Page, error) {
 		}
 	}
 
+	if model.IsFeedRoot {
+		if err := page.SetFeedRoot(true); err != nil {
+			return nil, err
+		}
+	}
+
+	// Timestamps are applied last, as the setters above touch updatedAt
+	page.SetTimestamps(model.CreatedAt, model.UpdatedAt)
+	page.SetRevision(model.Revision)
+	page.SetDeletedAt(model.DeletedAt)
+
 	return page, nil
 }
 
@@ -19,18 +19,29 @@ func (m *PageBlockMapper) ToModel(block *entities.PageBlock) (*models.PageBlock,
 		return nil, nil
 	}
 
-	return &models.PageBlock{
+	snippetPageID, err := block.SnippetPageID()
+	if err != nil {
+		return nil, err
+	}
+
+	model := &models.PageBlock{
 		Base: models.Base{
 			ID:        block.ID().Value(),
 			CreatedAt: block.CreatedAt(),
 			UpdatedAt: block.UpdatedAt(),
 		},
+		Revision:      block.Revision(),
 		PageVersionID: block.PageVersionID().Value(),
 		BlockKey:      block.BlockKey(),
 		Index:         block.Index(),
 		ContentType:   block.ContentType(),
 		Content:       block.Content(),
-	}, nil
+	}
+	if snippetPageID != nil {
+		model.SnippetPageID = snippetPageID.ValuePtr()
+	}
+
+	return model, nil
 }
 
 // ToDomain converts a GORM models.PageBlock to a domain PageBlock
@@ -52,6 +63,7 @@ func (m *PageBlockMapper) ToDomain(model *models.PageBlock) (*entities.PageBlock
 
 	block.SetID(entities.NewPageBlockID(model.ID))
 	block.SetTimestamps(model.CreatedAt, model.UpdatedAt)
+	block.SetRevision(model.Revision)
 
 	return block, nil
 }
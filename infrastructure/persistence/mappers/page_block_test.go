@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/h4rdc0m/aurora-api/domain/entities"
+	domainErrors "github.com/h4rdc0m/aurora-api/domain/errors"
 	"github.com/h4rdc0m/aurora-api/infrastructure/persistence/models"
 	"github.com/stretchr/testify/assert"
 )
@@ -52,6 +53,43 @@ func TestPageBlockMapper_ToModel(t *testing.T) {
 			},
 			wantErr: nil,
 		},
+		{
+			name: "snippet block",
+			input: func() *entities.PageBlock {
+				block, _ := entities.NewPageBlock(
+					entities.NewPageVersionID(1),
+					"snippet_key",
+					2,
+					entities.PageBlockContentTypeSnippet,
+					`{"page_id": 7}`,
+				)
+				return block
+			}(),
+			expected: &models.PageBlock{
+				PageVersionID: 1,
+				BlockKey:      "snippet_key",
+				Index:         2,
+				ContentType:   entities.PageBlockContentTypeSnippet,
+				Content:       `{"page_id": 7}`,
+				SnippetPageID: entities.NewPageID(7).ValuePtr(),
+			},
+			wantErr: nil,
+		},
+		{
+			name: "invalid snippet block",
+			input: func() *entities.PageBlock {
+				block, _ := entities.NewPageBlock(
+					entities.NewPageVersionID(1),
+					"snippet_key",
+					2,
+					entities.PageBlockContentTypeSnippet,
+					"not json",
+				)
+				return block
+			}(),
+			expected: nil,
+			wantErr:  domainErrors.ErrPageBlockSnippetInvalid,
+		},
 	}
 
 	for _, tt := range tests {
@@ -68,6 +106,7 @@ func TestPageBlockMapper_ToModel(t *testing.T) {
 				assert.Equal(t, tt.expected.Index, result.Index)
 				assert.Equal(t, tt.expected.ContentType, result.ContentType)
 				assert.Equal(t, tt.expected.Content, result.Content)
+				assert.Equal(t, tt.expected.SnippetPageID, result.SnippetPageID)
 			}
 		})
 	}
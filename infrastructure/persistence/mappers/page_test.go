@@ -32,8 +32,8 @@ func TestPageMapper_ToModel(t *testing.T) {
 				key, _ := value_objects.NewPageKey("content-key")
 				page, _ := entities.NewPage(key, value_objects.NewNullableString("/content-path").Value(), entities.NewSiteID(1), entities.PageTypeContent)
 				page.SetID(entities.NewPageID(1))
-				page.SetTimestamps(now, now)
 				page.UpdateIndex(1)
+				page.SetTimestamps(now, now)
 				return page
 			}(),
 			want: &models.Page{
@@ -58,9 +58,9 @@ func TestPageMapper_ToModel(t *testing.T) {
 				key, _ := value_objects.NewPageKey("link-key")
 				page, _ := entities.NewPage(key, value_objects.NewNullableString("/link-path").Value(), entities.NewSiteID(1), entities.PageTypeLink)
 				page.SetID(entities.NewPageID(2))
-				page.SetTimestamps(now, now)
 				page.UpdateIndex(2)
 				_ = page.SetLinkURL(value_objects.NewNullableString("https://example.com").Value())
+				page.SetTimestamps(now, now)
 				return page
 			}(),
 			want: &models.Page{
@@ -85,10 +85,10 @@ func TestPageMapper_ToModel(t *testing.T) {
 				key, _ := value_objects.NewPageKey("child-key")
 				page, _ := entities.NewPage(key, value_objects.NewNullableString("/child-path").Value(), entities.NewSiteID(1), entities.PageTypeContent)
 				page.SetID(entities.NewPageID(3))
-				page.SetTimestamps(now, now)
 				page.UpdateIndex(3)
 				parentID := entities.NewPageID(1)
 				page.SetParent(&parentID)
+				page.SetTimestamps(now, now)
 				return page
 			}(),
 			want: &models.Page{
@@ -248,6 +248,44 @@ func TestPageMapper_ToDomain(t *testing.T) {
 				assert.Equal(t, uint64(1), result.ParentID().Value())
 			},
 		},
+		{
+			name: "feed root page",
+			input: &models.Page{
+				Base: models.Base{
+					ID:        4,
+					CreatedAt: now,
+					UpdatedAt: now,
+				},
+				Key:        "news",
+				Path:       value_objects.NewNullableString("/news").Value(),
+				SiteID:     1,
+				Type:       models.PageTypeContent,
+				IsFeedRoot: true,
+			},
+			expectErr: false,
+			validate: func(t *testing.T, result *entities.Page) {
+				assert.NotNil(t, result)
+				assert.True(t, result.IsFeedRoot())
+				assert.Equal(t, now, result.UpdatedAt())
+			},
+		},
+		{
+			name: "feed root link page should fail",
+			input: &models.Page{
+				Base: models.Base{
+					ID: 5,
+				},
+				Key:        "external",
+				SiteID:     1,
+				Type:       models.PageTypeLink,
+				LinkURL:    value_objects.NewNullableString("https://example.com").Value(),
+				IsFeedRoot: true,
+			},
+			expectErr: true,
+			validate: func(t *testing.T, result *entities.Page) {
+				assert.Nil(t, result)
+			},
+		},
 		{
 			name: "invalid key",
 			input: &models.Page{
@@ -24,17 +24,51 @@ func (m *PageVersionMapper) ToModel(version *entities.PageVersion) (*models.Page
 		return nil, nil
 	}
 
+	var statusChangedBy *uint64
+	if version.StatusChangedBy() != nil {
+		changedBy := version.StatusChangedBy().Value()
+		statusChangedBy = &changedBy
+	}
+
+	var scheduledBy *uint64
+	if version.ScheduledBy() != nil {
+		userID := version.ScheduledBy().Value()
+		scheduledBy = &userID
+	}
+
+	seo := version.SEO()
+	var twitterCard *string
+	if seo.TwitterCard != nil {
+		card := string(*seo.TwitterCard)
+		twitterCard = &card
+	}
+
 	model := &models.PageVersion{
 		Base: models.Base{
 			ID:        version.ID().Value(),
 			CreatedAt: version.CreatedAt(),
 			UpdatedAt: version.UpdatedAt(),
+			DeletedAt: version.DeletedAt(),
 		},
-		PageID:      version.PageID().Value(),
-		Version:     version.Version(),
-		Title:       version.Title(),
-		Description: version.Description(),
-		IsPublished: version.IsPublished(),
+		Revision:        version.Revision(),
+		PageID:          version.PageID().Value(),
+		Locale:          string(version.Locale()),
+		Version:         version.Version(),
+		Title:           version.Title(),
+		Description:     version.Description(),
+		MetaTitle:       seo.MetaTitle,
+		CanonicalURL:    seo.CanonicalURL,
+		Robots:          seo.Robots,
+		OgTitle:         seo.OGTitle,
+		OgDescription:   seo.OGDescription,
+		OgImage:         seo.OGImage,
+		TwitterCard:     twitterCard,
+		Status:          string(version.Status()),
+		StatusChangedBy: statusChangedBy,
+		StatusChangedAt: version.StatusChangedAt(),
+		PublishAt:       version.PublishAt(),
+		UnpublishAt:     version.UnpublishAt(),
+		ScheduledBy:     scheduledBy,
 	}
 
 	return model, nil
@@ -49,6 +83,7 @@ func (m *PageVersionMapper) ToDomain(model *models.PageVersion) (*entities.PageV
 	version, err := entities.NewPageVersion(
 		entities.NewPageID(model.PageID),
 		model.Version,
+		entities.Locale(model.Locale),
 		model.Title,
 		model.Description,
 	)
@@ -57,11 +92,43 @@ func (m *PageVersionMapper) ToDomain(model *models.PageVersion) (*entities.PageV
 	}
 
 	version.SetID(entities.NewPageVersionID(model.ID))
-	version.SetTimestamps(model.CreatedAt, model.UpdatedAt)
 
-	if model.IsPublished {
-		version.Publish()
+	status, err := entities.NewPageVersionStatus(model.Status)
+	if err != nil {
+		return nil, err
+	}
+	var statusChangedBy *entities.UserID
+	if model.StatusChangedBy != nil {
+		changedBy := entities.NewUserID(*model.StatusChangedBy)
+		statusChangedBy = &changedBy
+	}
+	version.SetStatus(status, statusChangedBy, model.StatusChangedAt)
+
+	var scheduledBy *entities.UserID
+	if model.ScheduledBy != nil {
+		userID := entities.NewUserID(*model.ScheduledBy)
+		scheduledBy = &userID
 	}
+	version.SetSchedule(model.PublishAt, model.UnpublishAt, scheduledBy)
+
+	var twitterCard *entities.TwitterCard
+	if model.TwitterCard != nil {
+		card := entities.TwitterCard(*model.TwitterCard)
+		twitterCard = &card
+	}
+	version.SetSEO(entities.PageSEO{
+		MetaTitle:     model.MetaTitle,
+		CanonicalURL:  model.CanonicalURL,
+		Robots:        model.Robots,
+		OGTitle:       model.OgTitle,
+		OGDescription: model.OgDescription,
+		OGImage:       model.OgImage,
+		TwitterCard:   twitterCard,
+	})
+
+	version.SetTimestamps(model.CreatedAt, model.UpdatedAt)
+	version.SetRevision(model.Revision)
+	version.SetDeletedAt(model.DeletedAt)
 
 	return version, nil
 }
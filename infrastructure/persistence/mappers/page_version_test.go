@@ -30,7 +30,7 @@ func TestPageVersionMapper_ToModel(t *testing.T) {
 			input: func() *entities.PageVersion {
 				id := entities.NewPageVersionID(123)
 				pageID := entities.NewPageID(456)
-				v, err := entities.NewPageVersion(pageID, 1, "Title", value_objects.NewNullableString("Description").Value())
+				v, err := entities.NewPageVersion(pageID, 1, entities.DefaultLocale, "Title", value_objects.NewNullableString("Description").Value())
 				if err != nil {
 					t.Fatalf("failed to create PageVersion: %v", err)
 				}
@@ -38,8 +38,10 @@ func TestPageVersionMapper_ToModel(t *testing.T) {
 					t.Fatal("PageVersion is nil")
 				}
 				v.SetID(id)
+				changedBy := entities.NewUserID(7)
+				v.SetStatus(entities.PageVersionStatusPublished, &changedBy, &now)
+				v.SetSchedule(&now, nil, &changedBy)
 				v.SetTimestamps(now, now)
-				v.Publish()
 				return v
 			}(),
 			expected: &models.PageVersion{
@@ -48,11 +50,16 @@ func TestPageVersionMapper_ToModel(t *testing.T) {
 					CreatedAt: now,
 					UpdatedAt: now,
 				},
-				PageID:      456,
-				Version:     1,
-				Title:       "Title",
-				Description: value_objects.NewNullableString("Description").Value(),
-				IsPublished: true,
+				PageID:          456,
+				Locale:          "en",
+				Version:         1,
+				Title:           "Title",
+				Description:     value_objects.NewNullableString("Description").Value(),
+				Status:          "published",
+				StatusChangedBy: func() *uint64 { id := uint64(7); return &id }(),
+				StatusChangedAt: &now,
+				PublishAt:       &now,
+				ScheduledBy:     func() *uint64 { id := uint64(7); return &id }(),
 			},
 			expectError: false,
 		},
@@ -99,13 +106,39 @@ func TestPageVersionMapper_ToDomain(t *testing.T) {
 					UpdatedAt: time.Unix(0, 0),
 				},
 				PageID:      456,
+				Locale:      "en",
 				Version:     1,
 				Title:       "Title",
 				Description: value_objects.NewNullableString("Description").Value(),
-				IsPublished: true,
+				Status:      "published",
+			},
+			expectError: false,
+		},
+		{
+			name: "scheduled input",
+			input: &models.PageVersion{
+				PageID:      456,
+				Locale:      "nl-BE",
+				Version:     2,
+				Title:       "Title",
+				Status:      "approved",
+				PublishAt:   func() *time.Time { at := time.Unix(3600, 0); return &at }(),
+				UnpublishAt: func() *time.Time { at := time.Unix(7200, 0); return &at }(),
+				ScheduledBy: func() *uint64 { id := uint64(7); return &id }(),
 			},
 			expectError: false,
 		},
+		{
+			name: "invalid status",
+			input: &models.PageVersion{
+				PageID:  456,
+				Locale:  "en",
+				Version: 1,
+				Title:   "Title",
+				Status:  "unknown",
+			},
+			expectError: true,
+		},
 		{
 			name: "invalid input",
 			input: &models.PageVersion{
@@ -151,7 +184,7 @@ func TestPageVersionMapper_ToModels(t *testing.T) {
 				func() *entities.PageVersion {
 					id := entities.NewPageVersionID(123)
 					pageID := entities.NewPageID(456)
-					v, _ := entities.NewPageVersion(pageID, 1, "Title", value_objects.NewNullableString("Description").Value())
+					v, _ := entities.NewPageVersion(pageID, 1, entities.DefaultLocale, "Title", value_objects.NewNullableString("Description").Value())
 					v.SetID(id)
 					v.SetTimestamps(now, now)
 					return v
@@ -207,9 +240,11 @@ func TestPageVersionMapper_ToDomains(t *testing.T) {
 						UpdatedAt: time.Unix(0, 0),
 					},
 					PageID:      456,
+					Locale:      "en",
 					Version:     1,
 					Title:       "Title",
 					Description: value_objects.NewNullableString("Description").Value(),
+					Status:      "draft",
 				},
 			},
 			expectError: false,
@@ -238,3 +273,39 @@ func TestPageVersionMapper_ToDomains(t *testing.T) {
 		})
 	}
 }
+
+func TestPageVersionMapper_SEORoundTrip(t *testing.T) {
+	mapper := NewPageVersionMapper()
+
+	model := &models.PageVersion{
+		PageID:        456,
+		Locale:        "en",
+		Version:       1,
+		Title:         "Title",
+		Status:        "draft",
+		MetaTitle:     value_objects.NewNullableString("Meta title").Value(),
+		CanonicalURL:  value_objects.NewNullableString("/about").Value(),
+		Robots:        value_objects.NewNullableString("noindex, nofollow").Value(),
+		OgTitle:       value_objects.NewNullableString("Shared title").Value(),
+		OgDescription: value_objects.NewNullableString("Shared description").Value(),
+		OgImage:       value_objects.NewNullableString("https://cdn.example.com/about.png").Value(),
+		TwitterCard:   value_objects.NewNullableString("summary_large_image").Value(),
+	}
+
+	version, err := mapper.ToDomain(model)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if version.SEO().TwitterCard == nil || *version.SEO().TwitterCard != entities.TwitterCardSummaryLargeImage {
+		t.Errorf("expected twitter card %q, got %v", entities.TwitterCardSummaryLargeImage, version.SEO().TwitterCard)
+	}
+
+	result, err := mapper.ToModel(version)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	result.CreatedAt, result.UpdatedAt = model.CreatedAt, model.UpdatedAt
+	if !reflect.DeepEqual(result, model) {
+		t.Errorf("expected %+v, got %+v", model, result)
+	}
+}
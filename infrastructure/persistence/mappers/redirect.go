@@ -0,0 +1,102 @@
+package mappers
+
+import (
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"github.com/h4rdc0m/aurora-api/infrastructure/persistence/models"
+)
+
+// RedirectMapper handles conversion between domain entities and GORM models
+type RedirectMapper struct{}
+
+// NewRedirectMapper creates a new RedirectMapper
+func NewRedirectMapper() *RedirectMapper {
+	return &RedirectMapper{}
+}
+
+// ToModel converts a domain Redirect to a GORM models.Redirect
+func (m *RedirectMapper) ToModel(redirect *entities.Redirect) (*models.Redirect, error) {
+	if redirect == nil {
+		return nil, nil
+	}
+
+	model := &models.Redirect{
+		Base: models.Base{
+			ID:        redirect.ID().Value(),
+			CreatedAt: redirect.CreatedAt(),
+			UpdatedAt: redirect.UpdatedAt(),
+		},
+		SiteID:      redirect.SiteID().Value(),
+		SourcePath:  redirect.SourcePath(),
+		TargetURL:   redirect.TargetURL(),
+		StatusCode:  redirect.StatusCode(),
+		IsAutomatic: redirect.IsAutomatic(),
+		Hits:        redirect.Hits(),
+	}
+	if redirect.TargetPageID() != nil {
+		targetPageID := redirect.TargetPageID().Value()
+		model.TargetPageID = &targetPageID
+	}
+
+	return model, nil
+}
+
+// ToDomain converts a GORM models.Redirect to a domain Redirect
+func (m *RedirectMapper) ToDomain(model *models.Redirect) (*entities.Redirect, error) {
+	if model == nil {
+		return nil, nil
+	}
+
+	var targetPageID *entities.PageID
+	if model.TargetPageID != nil {
+		id := entities.NewPageID(*model.TargetPageID)
+		targetPageID = &id
+	}
+
+	redirect, err := entities.NewRedirect(entities.NewSiteID(model.SiteID), model.SourcePath, targetPageID, model.TargetURL, model.StatusCode)
+	if err != nil {
+		return nil, err
+	}
+
+	redirect.SetID(entities.NewRedirectID(model.ID))
+	redirect.SetAutomatic(model.IsAutomatic)
+	redirect.SetHits(model.Hits)
+	redirect.SetTimestamps(model.CreatedAt, model.UpdatedAt)
+
+	return redirect, nil
+}
+
+// ToModels converts a slice of domain Redirects to GORM models
+func (m *RedirectMapper) ToModels(redirects []*entities.Redirect) ([]*models.Redirect, error) {
+	if redirects == nil {
+		return nil, nil
+	}
+
+	result := make([]*models.Redirect, len(redirects))
+	for i, redirect := range redirects {
+		model, err := m.ToModel(redirect)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = model
+	}
+
+	return result, nil
+}
+
+// ToDomains converts a slice of GORM models to domain Redirects
+func (m *RedirectMapper) ToDomains(modelList []*models.Redirect) ([]*entities.Redirect, error) {
+	if modelList == nil {
+		return nil, nil
+	}
+
+	result := make([]*entities.Redirect, len(modelList))
+	for i, model := range modelList {
+		redirect, err := m.ToDomain(model)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = redirect
+	}
+
+	return result, nil
+}
@@ -0,0 +1,123 @@
+package mappers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	domainErrors "github.com/h4rdc0m/aurora-api/domain/errors"
+	"github.com/h4rdc0m/aurora-api/infrastructure/persistence/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedirectMapper_ToModel(t *testing.T) {
+	mapper := NewRedirectMapper()
+
+	t.Run("nil input", func(t *testing.T) {
+		result, err := mapper.ToModel(nil)
+		assert.NoError(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("automatic page redirect", func(t *testing.T) {
+		redirect, err := entities.NewPageRedirect(entities.NewSiteID(2), "/old", entities.NewPageID(5))
+		assert.NoError(t, err)
+		redirect.SetID(entities.NewRedirectID(7))
+		redirect.SetHits(12)
+
+		result, err := mapper.ToModel(redirect)
+
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(7), result.ID)
+		assert.Equal(t, uint64(2), result.SiteID)
+		assert.Equal(t, "/old", result.SourcePath)
+		assert.Equal(t, uint64(5), *result.TargetPageID)
+		assert.Nil(t, result.TargetURL)
+		assert.Equal(t, 301, result.StatusCode)
+		assert.True(t, result.IsAutomatic)
+		assert.Equal(t, uint64(12), result.Hits)
+	})
+
+	t.Run("url redirect", func(t *testing.T) {
+		target := "https://example.org/new"
+		redirect, err := entities.NewRedirect(entities.NewSiteID(2), "/old", nil, &target, 302)
+		assert.NoError(t, err)
+
+		result, err := mapper.ToModel(redirect)
+
+		assert.NoError(t, err)
+		assert.Nil(t, result.TargetPageID)
+		assert.Equal(t, &target, result.TargetURL)
+		assert.Equal(t, 302, result.StatusCode)
+		assert.False(t, result.IsAutomatic)
+	})
+}
+
+func TestRedirectMapper_ToDomain(t *testing.T) {
+	mapper := NewRedirectMapper()
+	now := time.Now()
+	pageID := uint64(5)
+	target := "/new"
+
+	tests := []struct {
+		name    string
+		input   *models.Redirect
+		wantErr error
+	}{
+		{
+			name:  "page redirect",
+			input: &models.Redirect{Base: models.Base{ID: 7, CreatedAt: now, UpdatedAt: now}, SiteID: 2, SourcePath: "/old", TargetPageID: &pageID, StatusCode: 301, IsAutomatic: true, Hits: 3},
+		},
+		{
+			name:  "url redirect",
+			input: &models.Redirect{Base: models.Base{ID: 8, CreatedAt: now, UpdatedAt: now}, SiteID: 2, SourcePath: "/old", TargetURL: &target, StatusCode: 302},
+		},
+		{
+			name:  "gone",
+			input: &models.Redirect{Base: models.Base{ID: 9, CreatedAt: now, UpdatedAt: now}, SiteID: 2, SourcePath: "/old", StatusCode: 410},
+		},
+		{
+			name:    "invalid status",
+			input:   &models.Redirect{SiteID: 2, SourcePath: "/old", TargetURL: &target, StatusCode: 307},
+			wantErr: domainErrors.ErrRedirectStatusInvalid,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := mapper.ToDomain(tt.input)
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+				assert.Nil(t, result)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.input.ID, result.ID().Value())
+			assert.Equal(t, tt.input.SiteID, result.SiteID().Value())
+			assert.Equal(t, tt.input.SourcePath, result.SourcePath())
+			assert.Equal(t, tt.input.TargetURL, result.TargetURL())
+			assert.Equal(t, tt.input.StatusCode, result.StatusCode())
+			assert.Equal(t, tt.input.IsAutomatic, result.IsAutomatic())
+			assert.Equal(t, tt.input.Hits, result.Hits())
+			assert.Equal(t, now, result.CreatedAt())
+			if tt.input.TargetPageID == nil {
+				assert.Nil(t, result.TargetPageID())
+			} else {
+				assert.Equal(t, *tt.input.TargetPageID, result.TargetPageID().Value())
+			}
+		})
+	}
+}
+
+func TestRedirectMapper_ToDomains(t *testing.T) {
+	mapper := NewRedirectMapper()
+
+	result, err := mapper.ToDomains([]*models.Redirect{{SiteID: 1, SourcePath: "/a", StatusCode: 410}, {SiteID: 1, SourcePath: "/b", StatusCode: 410}})
+	assert.NoError(t, err)
+	assert.Len(t, result, 2)
+
+	result, err = mapper.ToDomains(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, result)
+}
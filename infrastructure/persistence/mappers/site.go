@@ -5,6 +5,7 @@ import (
 	"github.com/h4rdc0m/aurora-api/domain/errors"
 	"github.com/h4rdc0m/aurora-api/domain/value_objects"
 	"github.com/h4rdc0m/aurora-api/infrastructure/persistence/models"
+	"strings"
 )
 
 // SiteMapper handles conversion between domain entities and GORM models
@@ -26,14 +27,20 @@ func (m *SiteMapper) ToModel(site *entities.Site) (*models.Site, error) {
 			ID:        site.ID().Value(),
 			CreatedAt: site.CreatedAt(),
 			UpdatedAt: site.UpdatedAt(),
+			DeletedAt: site.DeletedAt(),
 		},
-		Name:          site.Name(),
-		Description:   site.Description(),
-		Domain:        site.Domain().Value(),
-		TitleTemplate: site.TitleTemplate(),
-		Enabled:       site.IsEnabled(),
-		TemplateID:    site.TemplateID().Value(),
-		TenantID:      site.TenantID().Value(),
+		Revision:        site.Revision(),
+		Name:            site.Name(),
+		Description:     site.Description(),
+		Domain:          site.Domain().Value(),
+		TitleTemplate:   site.TitleTemplate(),
+		RobotsTxt:       site.RobotsTxt(),
+		DefaultLocale:   string(site.DefaultLocale()),
+		Locales:         joinLocales(site.Locales()),
+		FallbackLocales: joinLocales(site.FallbackLocales()),
+		Enabled:         site.IsEnabled(),
+		TemplateID:      site.TemplateID().Value(),
+		TenantID:        site.TenantID().Value(),
 	}, nil
 }
 
@@ -63,16 +70,28 @@ func (m *SiteMapper) ToDomain(model *models.Site) (*entities.Site, error) {
 	if err != nil {
 		return nil, err
 	}
-	site.SetTimestamps(model.CreatedAt, model.UpdatedAt)
 
 	if model.TitleTemplate != nil {
-		site.UpdateTitleTemplate(model.TitleTemplate)
+		site.SetTitleTemplate(model.TitleTemplate)
+	}
+
+	if model.RobotsTxt != nil {
+		site.UpdateRobotsTxt(model.RobotsTxt)
+	}
+
+	if model.DefaultLocale != "" {
+		site.SetLocales(entities.Locale(model.DefaultLocale), splitLocales(model.Locales), splitLocales(model.FallbackLocales))
 	}
 
 	if !model.Enabled {
 		site.Disable()
 	}
 
+	// Timestamps are applied last, as the setters above touch updatedAt
+	site.SetTimestamps(model.CreatedAt, model.UpdatedAt)
+	site.SetRevision(model.Revision)
+	site.SetDeletedAt(model.DeletedAt)
+
 	return site, nil
 }
 
@@ -114,3 +133,25 @@ func (m *SiteMapper) ToDomains(modelList []*models.Site) ([]*entities.Site, erro
 
 	return result, nil
 }
+
+// joinLocales stores a list of locales as a comma separated string
+func joinLocales(locales []entities.Locale) string {
+	values := make([]string, len(locales))
+	for i, locale := range locales {
+		values[i] = string(locale)
+	}
+	return strings.Join(values, ",")
+}
+
+// splitLocales reads a comma separated list of locales, an empty string being an empty list
+func splitLocales(value string) []entities.Locale {
+	if value == "" {
+		return []entities.Locale{}
+	}
+	values := strings.Split(value, ",")
+	locales := make([]entities.Locale, len(values))
+	for i, locale := range values {
+		locales[i] = entities.Locale(locale)
+	}
+	return locales
+}
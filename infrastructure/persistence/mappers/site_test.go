@@ -51,6 +51,8 @@ func TestSiteMapper_ToModel(t *testing.T) {
 				Description:   value_objects.NewNullableString("Description").Value(),
 				Domain:        "example.com",
 				TitleTemplate: nil,
+				DefaultLocale: "en",
+				Locales:       "en",
 				Enabled:       true,
 				TemplateID:    1,
 				TenantID:      1,
@@ -120,6 +122,58 @@ func TestSiteMapper_ToDomain(t *testing.T) {
 			}(),
 			expectErr: false,
 		},
+		{
+			name: "with robots rules",
+			input: &models.Site{
+				Base: models.Base{
+					ID:        1,
+					CreatedAt: now,
+					UpdatedAt: now,
+				},
+				Name:       "Test",
+				Domain:     "example.com",
+				RobotsTxt:  value_objects.NewNullableString("User-agent: *\nDisallow: /private").Value(),
+				Enabled:    true,
+				TemplateID: 1,
+				TenantID:   1,
+			},
+			want: func() *entities.Site {
+				domain, _ := value_objects.NewDomainName("example.com")
+				site, _ := entities.NewSite("Test", nil, domain, entities.NewTemplateID(1), entities.NewTenantID(1))
+				site.UpdateRobotsTxt(value_objects.NewNullableString("User-agent: *\nDisallow: /private").Value())
+				site.SetTimestamps(now, now)
+				_ = site.SetID(entities.NewSiteID(1))
+				return site
+			}(),
+			expectErr: false,
+		},
+		{
+			name: "with locales",
+			input: &models.Site{
+				Base: models.Base{
+					ID:        1,
+					CreatedAt: now,
+					UpdatedAt: now,
+				},
+				Name:            "Test",
+				Domain:          "example.com",
+				DefaultLocale:   "nl",
+				Locales:         "nl,nl-BE,fr",
+				FallbackLocales: "nl",
+				Enabled:         true,
+				TemplateID:      1,
+				TenantID:        1,
+			},
+			want: func() *entities.Site {
+				domain, _ := value_objects.NewDomainName("example.com")
+				site, _ := entities.NewSite("Test", nil, domain, entities.NewTemplateID(1), entities.NewTenantID(1))
+				site.SetLocales("nl", []entities.Locale{"nl", "nl-BE", "fr"}, []entities.Locale{"nl"})
+				site.SetTimestamps(now, now)
+				_ = site.SetID(entities.NewSiteID(1))
+				return site
+			}(),
+			expectErr: false,
+		},
 		{
 			name: "invalid domain",
 			input: &models.Site{
@@ -188,6 +242,8 @@ func TestSiteMapper_ToModels(t *testing.T) {
 					Description:   value_objects.NewNullableString("Description").Value(),
 					Domain:        "example.com",
 					TitleTemplate: nil,
+					DefaultLocale: "en",
+					Locales:       "en",
 					Enabled:       true,
 					TemplateID:    1,
 					TenantID:      1,
@@ -248,6 +304,8 @@ func TestSiteMapper_ToDomains(t *testing.T) {
 					Description:   value_objects.NewNullableString("Description").Value(),
 					Domain:        "example.com",
 					TitleTemplate: nil,
+					DefaultLocale: "en",
+					Locales:       "en",
 					Enabled:       true,
 					TemplateID:    1,
 					TenantID:      1,
@@ -23,7 +23,9 @@ func (m *TemplateMapper) ToModel(template *entities.Template) (*models.Template,
 			ID:        template.ID().Value(),
 			CreatedAt: template.CreatedAt(),
 			UpdatedAt: template.UpdatedAt(),
+			DeletedAt: template.DeletedAt(),
 		},
+		Revision:    template.Revision(),
 		Name:        template.Name(),
 		Description: template.Description(),
 		FilePath:    template.FilePath(),
@@ -50,6 +52,8 @@ func (m *TemplateMapper) ToDomain(model *models.Template) (*entities.Template, e
 
 	template.SetID(entities.NewTemplateID(model.ID))
 	template.SetTimestamps(model.CreatedAt, model.UpdatedAt)
+	template.SetRevision(model.Revision)
+	template.SetDeletedAt(model.DeletedAt)
 	if model.Enabled {
 		template.Enable()
 	} else {
@@ -27,6 +27,7 @@ func (m *TenantMapper) ToModel(tenant *entities.Tenant) (*models.Tenant, error)
 			CreatedAt: tenant.CreatedAt(),
 			UpdatedAt: tenant.UpdatedAt(),
 		},
+		Revision:         tenant.Revision(),
 		Name:             tenant.Name(),
 		Description:      tenant.Description(),
 		IsActive:         tenant.IsActive(),
@@ -49,6 +50,7 @@ func (m *TenantMapper) ToDomain(model *models.Tenant) (*entities.Tenant, error)
 
 	tenant.SetID(entities.NewTenantID(model.ID))
 	tenant.SetTimestamps(model.CreatedAt, model.UpdatedAt)
+	tenant.SetRevision(model.Revision)
 
 	if !model.IsActive {
 		tenant.Deactivate()
@@ -32,6 +32,7 @@ func TestTenantMapper_ToModel(t *testing.T) {
 				tenant, _ := entities.NewTenant("TestTenant", desc)
 				tenant.SetID(entities.NewTenantID(1))
 				tenant.SetTimestamps(now, now)
+				tenant.SetRevision(3)
 				tenant.EnableBilling()
 				tenant.Activate()
 				return tenant
@@ -42,6 +43,7 @@ func TestTenantMapper_ToModel(t *testing.T) {
 					CreatedAt: now,
 					UpdatedAt: now,
 				},
+				Revision:         3,
 				Name:             "TestTenant",
 				Description:      desc,
 				IsActive:         true,
@@ -90,6 +92,7 @@ func TestTenantMapper_ToDomain(t *testing.T) {
 					CreatedAt: now,
 					UpdatedAt: now,
 				},
+				Revision:         3,
 				Name:             "TestTenant",
 				Description:      desc,
 				IsActive:         true,
@@ -99,6 +102,7 @@ func TestTenantMapper_ToDomain(t *testing.T) {
 				tenant, _ := entities.NewTenant("TestTenant", desc)
 				tenant.SetID(entities.NewTenantID(1))
 				tenant.SetTimestamps(now, now)
+				tenant.SetRevision(3)
 				tenant.EnableBilling()
 				tenant.Activate()
 				return tenant
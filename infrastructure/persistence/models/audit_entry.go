@@ -0,0 +1,12 @@
+package models
+
+type AuditEntry struct {
+	Base
+	TenantID   uint64
+	ActorID    uint64
+	Action     string
+	Resource   string
+	ResourceID uint64
+	// Details is the JSON object of the action specific context
+	Details string
+}
@@ -0,0 +1,15 @@
+package models
+
+import "time"
+
+type BrokenLink struct {
+	Base
+	SiteID        uint64
+	PageID        uint64
+	BlockKey      string
+	URL           string
+	StatusCode    *int
+	Reason        string
+	FirstSeenAt   time.Time
+	LastCheckedAt time.Time
+}
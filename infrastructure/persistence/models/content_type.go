@@ -0,0 +1,11 @@
+package models
+
+type ContentType struct {
+	Base
+	TenantID    *uint64
+	Name        string
+	Description *string
+	Schema      string
+	// ScopeTenantID is a generated column making names unique per tenant and among global content types; it is never written
+	ScopeTenantID uint64
+}
@@ -1,5 +1,7 @@
 package models
 
+import "time"
+
 type PageType string
 
 const (
@@ -11,6 +13,10 @@ const (
 
 type Page struct {
 	Base
+	Revision uint64
+	// NotDeleted is a generated column, true while the row is not trashed, that keeps the unique indexes to rows
+	// outside the trash; it is never written
+	NotDeleted     *bool
 	Key            string
 	Path           *string
 	Index          int
@@ -19,22 +25,58 @@ type Page struct {
 	SiteID         uint64
 	LinkURL        *string
 	HardLinkPageID *uint64
+	IsFeedRoot     bool
 }
 
 type PageVersion struct {
 	Base
-	PageID      uint64
-	Version     uint
-	Title       string
-	Description *string
-	IsPublished bool
+	Revision        uint64
+	PageID          uint64
+	Locale          string
+	Version         uint
+	Title           string
+	Description     *string
+	MetaTitle       *string
+	CanonicalURL    *string
+	Robots          *string
+	OgTitle         *string
+	OgDescription   *string
+	OgImage         *string
+	TwitterCard     *string
+	Status          string
+	StatusChangedBy *uint64
+	StatusChangedAt *time.Time
+	PublishAt       *time.Time
+	UnpublishAt     *time.Time
+	ScheduledBy     *uint64
+	// PublishedPageID is a generated column enforcing, together with the locale, a single published version per page and locale; it is never written
+	PublishedPageID *uint64
+}
+
+// SitemapPage is a published version of a content page together with the page columns listed in a sitemap
+type SitemapPage struct {
+	Key              string
+	Path             *string
+	Locale           string
+	PageUpdatedAt    time.Time
+	VersionUpdatedAt time.Time
+}
+
+// SitemapPartSummary is the number of pages and their most recent modification in a part of a sitemap
+type SitemapPartSummary struct {
+	Number       int
+	URLCount     int
+	LastModified time.Time
 }
 
 type PageBlock struct {
 	Base
+	Revision      uint64
 	PageVersionID uint64
 	BlockKey      string
 	Index         int
 	ContentType   string
 	Content       string
+	// SnippetPageID is the snippet page embedded by a snippet block, kept in its own column to find its usages
+	SnippetPageID *uint64
 }
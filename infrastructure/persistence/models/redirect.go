@@ -0,0 +1,12 @@
+package models
+
+type Redirect struct {
+	Base
+	SiteID       uint64
+	SourcePath   string
+	TargetPageID *uint64
+	TargetURL    *string
+	StatusCode   int
+	IsAutomatic  bool
+	Hits         uint64
+}
@@ -0,0 +1,22 @@
+package models
+
+import "time"
+
+// SearchDocument is a row of the full-text search index, keyed by page and locale
+type SearchDocument struct {
+	PageID        uint64
+	SiteID        uint64
+	Locale        string
+	PageVersionID uint64
+	Path          string
+	Title         string
+	Description   string
+	Body          string
+	UpdatedAt     *time.Time
+}
+
+// SearchHit is a search document matched by a full-text query together with its relevance
+type SearchHit struct {
+	SearchDocument
+	Score float64
+}
@@ -2,10 +2,19 @@ package models
 
 type Site struct {
 	Base
-	Name             string
-	Description      *string
-	Domain           string
-	TitleTemplate    *string
+	Revision uint64
+	// NotDeleted is a generated column, true while the row is not trashed, that keeps the unique indexes to rows
+	// outside the trash; it is never written
+	NotDeleted    *bool
+	Name          string
+	Description   *string
+	Domain        string
+	TitleTemplate *string
+	RobotsTxt     *string
+	DefaultLocale string
+	// Locales and FallbackLocales hold comma separated lists of locales
+	Locales          string
+	FallbackLocales  string
 	Enabled          bool
 	TemplateID       uint64
 	Template         Template
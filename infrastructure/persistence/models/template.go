@@ -2,6 +2,10 @@ package models
 
 type Template struct {
 	Base
+	Revision uint64
+	// NotDeleted is a generated column, true while the row is not trashed, that keeps the unique indexes to rows
+	// outside the trash; it is never written
+	NotDeleted  *bool
 	Name        string
 	Description *string
 	FilePath    string
@@ -2,6 +2,7 @@ package models
 
 type Tenant struct {
 	Base
+	Revision         uint64
 	Name             string
 	Description      *string
 	IsActive         bool
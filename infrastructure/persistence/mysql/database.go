@@ -5,6 +5,9 @@ import (
 	"fmt"
 	"github.com/h4rdc0m/aurora-api/domain/common"
 	"github.com/jmoiron/sqlx"
+	"github.com/jmoiron/sqlx/reflectx"
+	"strings"
+	"unicode"
 )
 
 // Database is a wrapper around gorm.Database to provide a consistent interface for database operations
@@ -22,6 +25,8 @@ func NewDatabase(config Config, logger common.Logger) (common.Database, error) {
 	if err != nil {
 		return nil, err // Handle error appropriately in production code
 	}
+	// Models carry no db tags, so their field names are mapped onto the snake_case columns
+	db.Mapper = reflectx.NewMapperFunc("db", toSnakeCase)
 
 	return &Database{
 		db:     db,
@@ -103,3 +108,19 @@ func (d *Database) Stats() sql.DBStats {
 	}
 	return stats
 }
+
+// toSnakeCase converts a Go field name like HardLinkPageID into its column name hard_link_page_id
+func toSnakeCase(name string) string {
+	runes := []rune(name)
+	var b strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			if i > 0 && (unicode.IsLower(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1]) && unicode.IsUpper(runes[i-1]))) {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
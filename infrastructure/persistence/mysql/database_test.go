@@ -373,3 +373,18 @@ func TestDatabase_Stats(t *testing.T) {
 	stats := database.Stats()
 	assert.NotNil(t, stats)
 }
+
+func TestToSnakeCase(t *testing.T) {
+	testCases := map[string]string{
+		"ID":             "id",
+		"CreatedAt":      "created_at",
+		"KeycloakID":     "keycloak_id",
+		"LinkURL":        "link_url",
+		"HardLinkPageID": "hard_link_page_id",
+		"PageVersionID":  "page_version_id",
+	}
+
+	for input, expected := range testCases {
+		assert.Equal(t, expected, toSnakeCase(input), input)
+	}
+}
@@ -0,0 +1,81 @@
+package mysql
+
+import (
+	"database/sql"
+	"errors"
+	"github.com/h4rdc0m/aurora-api/domain/common"
+	"github.com/jmoiron/sqlx"
+)
+
+// ErrNestedTransaction is returned when a transaction is started inside a running transaction
+var ErrNestedTransaction = errors.New("nested transactions are not supported")
+
+// Transaction is a wrapper around sqlx.Tx that lets repositories run their queries inside a running transaction
+type Transaction struct {
+	tx     *sqlx.Tx
+	logger common.Logger
+}
+
+var _ common.Database = (*Transaction)(nil)
+
+// NewTransaction creates a new instance of Transaction for the provided transaction handle
+func NewTransaction(tx *sqlx.Tx, logger common.Logger) common.Database {
+	return &Transaction{
+		tx:     tx,
+		logger: logger,
+	}
+}
+
+func (t *Transaction) Get(dest interface{}, query string, args ...interface{}) error {
+	err := t.tx.Get(dest, query, args...)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		t.logger.Error("Failed to execute Get query in transaction", "query", query, "args", args, "error", err)
+	}
+	return err
+}
+
+func (t *Transaction) Select(dest interface{}, query string, args ...interface{}) error {
+	err := t.tx.Select(dest, query, args...)
+	if err != nil {
+		t.logger.Error("Failed to execute Select query in transaction", "query", query, "args", args, "error", err)
+	}
+	return err
+}
+
+func (t *Transaction) NamedExec(query string, arg interface{}) (sql.Result, error) {
+	result, err := t.tx.NamedExec(query, arg)
+	if err != nil {
+		t.logger.Error("Failed to execute NamedExec query in transaction", "query", query, "arg", arg, "error", err)
+		return nil, err
+	}
+	return result, nil
+}
+
+func (t *Transaction) Exec(query string, args ...interface{}) (sql.Result, error) {
+	result, err := t.tx.Exec(query, args...)
+	if err != nil {
+		t.logger.Error("Failed to execute Exec query in transaction", "query", query, "args", args, "error", err)
+		return nil, err
+	}
+	return result, nil
+}
+
+// Begin is not supported, as the transaction is owned by the caller that started it
+func (t *Transaction) Begin() (*sqlx.Tx, error) {
+	return nil, ErrNestedTransaction
+}
+
+// Ping checks the connection by running a trivial query inside the transaction
+func (t *Transaction) Ping() error {
+	_, err := t.tx.Exec("SELECT 1")
+	return err
+}
+
+func (t *Transaction) Dialect() string {
+	return t.tx.DriverName()
+}
+
+// Stats returns empty statistics, as they are only tracked on the connection pool
+func (t *Transaction) Stats() sql.DBStats {
+	return sql.DBStats{}
+}
@@ -0,0 +1,78 @@
+package mysql
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestTransaction(t *testing.T) (*Transaction, sqlmock.Sqlmock) {
+	db, mockDb, err := sqlmock.New()
+	assert.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	mockDb.ExpectBegin()
+	tx, err := sqlx.NewDb(db, "mysql").Beginx()
+	assert.NoError(t, err)
+
+	return NewTransaction(tx, &mockLogger{}).(*Transaction), mockDb
+}
+
+func TestTransaction_Get(t *testing.T) {
+	trx, mockDb := newTestTransaction(t)
+
+	mockDb.ExpectQuery("SELECT \\* FROM users WHERE id = \\?").WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mockDb.ExpectCommit()
+
+	dest := struct {
+		ID int `db:"id"`
+	}{}
+	assert.NoError(t, trx.Get(&dest, "SELECT * FROM users WHERE id = ?", 1))
+	assert.Equal(t, 1, dest.ID)
+	assert.NoError(t, trx.tx.Commit())
+	assert.NoError(t, mockDb.ExpectationsWereMet())
+}
+
+func TestTransaction_Exec(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		trx, mockDb := newTestTransaction(t)
+
+		mockDb.ExpectExec("UPDATE pages SET path = \\? WHERE id = \\?").WithArgs("/a", 1).WillReturnResult(sqlmock.NewResult(0, 1))
+		mockDb.ExpectRollback()
+
+		result, err := trx.Exec("UPDATE pages SET path = ? WHERE id = ?", "/a", 1)
+		assert.NoError(t, err)
+		affected, _ := result.RowsAffected()
+		assert.Equal(t, int64(1), affected)
+		assert.NoError(t, trx.tx.Rollback())
+		assert.NoError(t, mockDb.ExpectationsWereMet())
+	})
+
+	t.Run("error", func(t *testing.T) {
+		trx, mockDb := newTestTransaction(t)
+
+		mockDb.ExpectExec("DELETE FROM pages").WillReturnError(errors.New("exec error"))
+
+		result, err := trx.Exec("DELETE FROM pages")
+		assert.Error(t, err)
+		assert.Nil(t, result)
+		assert.NoError(t, mockDb.ExpectationsWereMet())
+	})
+}
+
+func TestTransaction_Begin(t *testing.T) {
+	trx, _ := newTestTransaction(t)
+
+	tx, err := trx.Begin()
+	assert.Nil(t, tx)
+	assert.ErrorIs(t, err, ErrNestedTransaction)
+}
+
+func TestTransaction_Dialect(t *testing.T) {
+	trx, _ := newTestTransaction(t)
+
+	assert.Equal(t, "mysql", trx.Dialect())
+}
@@ -0,0 +1,87 @@
+package repositories
+
+import (
+	"github.com/Masterminds/squirrel"
+	"github.com/h4rdc0m/aurora-api/domain/common"
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"github.com/h4rdc0m/aurora-api/domain/repositories"
+	"github.com/h4rdc0m/aurora-api/infrastructure/persistence/mappers"
+	"github.com/h4rdc0m/aurora-api/infrastructure/persistence/models"
+	"github.com/h4rdc0m/aurora-api/infrastructure/persistence/mysql"
+	"github.com/jmoiron/sqlx"
+)
+
+// AuditEntryRepositoryImpl implements AuditEntryRepository using sqlx and squirrel
+type AuditEntryRepositoryImpl struct {
+	db     common.Database
+	logger common.Logger
+	mapper common.Mapper[*entities.AuditEntry, *models.AuditEntry]
+}
+
+// NewAuditEntryRepository creates a new AuditEntryRepository implementation
+func NewAuditEntryRepository(db common.Database, logger common.Logger) repositories.AuditEntryRepository {
+	return &AuditEntryRepositoryImpl{
+		db:     db,
+		logger: logger,
+		mapper: mappers.NewAuditEntryMapper(),
+	}
+}
+
+// WithTrx returns a copy of the repository that runs its queries inside the given transaction
+func (r *AuditEntryRepositoryImpl) WithTrx(trxHandle *sqlx.Tx) repositories.AuditEntryRepository {
+	return &AuditEntryRepositoryImpl{
+		db:     mysql.NewTransaction(trxHandle, r.logger),
+		logger: r.logger,
+		mapper: r.mapper,
+	}
+}
+
+// Save adds an audit entry to the audit log
+func (r *AuditEntryRepositoryImpl) Save(entry *entities.AuditEntry) error {
+	model, err := r.mapper.ToModel(entry)
+	if err != nil {
+		r.logger.Error("Failed to convert audit entry to model", "error", err)
+		return err
+	}
+
+	query, args, err := squirrel.Insert("audit_entries").
+		Columns("tenant_id", "actor_id", "action", "resource", "resource_id", "details", "created_at", "updated_at").
+		Values(model.TenantID, model.ActorID, model.Action, model.Resource, model.ResourceID, model.Details, model.CreatedAt, model.UpdatedAt).
+		PlaceholderFormat(squirrel.Question).
+		ToSql()
+	if err != nil {
+		r.logger.Error("Failed to build insert query for audit entry", "error", err)
+		return err
+	}
+	result, err := r.db.Exec(query, args...)
+	if err != nil {
+		r.logger.Error("Failed to create audit entry", "action", model.Action, "error", err)
+		return err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		r.logger.Error("Failed to get last insert ID for audit entry", "error", err)
+		return err
+	}
+	entry.SetID(entities.NewAuditEntryID(uint64(id)))
+	return nil
+}
+
+// FindByTenantID retrieves the latest audit entries of a tenant
+func (r *AuditEntryRepositoryImpl) FindByTenantID(tenantID entities.TenantID, limit uint64) ([]*entities.AuditEntry, error) {
+	var modelList []*models.AuditEntry
+	query, args, err := squirrel.Select("*").From("audit_entries").
+		Where(squirrel.Eq{"tenant_id": tenantID.Value()}).
+		OrderBy("created_at DESC", "id DESC").
+		Limit(limit).
+		ToSql()
+	if err != nil {
+		r.logger.Error("Failed to build select query for FindByTenantID", "error", err)
+		return nil, err
+	}
+	if err := r.db.Select(&modelList, query, args...); err != nil {
+		r.logger.Error("Failed to find audit entries by tenant ID", "tenantID", tenantID.Value(), "error", err)
+		return nil, err
+	}
+	return r.mapper.ToDomains(modelList)
+}
@@ -0,0 +1,92 @@
+package repositories
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"github.com/h4rdc0m/aurora-api/infrastructure/persistence/models"
+	"github.com/h4rdc0m/aurora-api/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newTestAuditEntryRepository() (*AuditEntryRepositoryImpl, *mocks.Database, *mocks.Logger, *mocks.MockAuditEntryMapper) {
+	mockDB := new(mocks.Database)
+	mockLogger := new(mocks.Logger)
+	mapperMock := &mocks.MockAuditEntryMapper{}
+	return &AuditEntryRepositoryImpl{db: mockDB, logger: mockLogger, mapper: mapperMock}, mockDB, mockLogger, mapperMock
+}
+
+func TestAuditEntryRepository_Save(t *testing.T) {
+	t.Run("insert success", func(t *testing.T) {
+		repo, mockDB, mockLogger, mapperMock := newTestAuditEntryRepository()
+		entry := &entities.AuditEntry{}
+		mapperMock.On("ToModel", entry).Return(&models.AuditEntry{TenantID: 1, ActorID: 2, Action: "editing_lease.broken", Resource: "page_version", ResourceID: 3, Details: "{}"}, nil)
+		mockResult := new(mocks.SqlResult)
+		mockResult.On("LastInsertId").Return(int64(42), nil)
+		mockDB.On("Exec", mock.Anything, uint64(1), uint64(2), "editing_lease.broken", "page_version", uint64(3), "{}", mock.Anything, mock.Anything).Return(mockResult, nil)
+
+		err := repo.Save(entry)
+
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(42), entry.ID().Value())
+		mockDB.AssertExpectations(t)
+		mockLogger.AssertExpectations(t)
+		mapperMock.AssertExpectations(t)
+	})
+
+	t.Run("db error", func(t *testing.T) {
+		repo, mockDB, mockLogger, mapperMock := newTestAuditEntryRepository()
+		entry := &entities.AuditEntry{}
+		dbErr := errors.New("db error")
+		mapperMock.On("ToModel", entry).Return(&models.AuditEntry{Action: "editing_lease.broken", Details: "{}"}, nil)
+		mockDB.On("Exec", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, dbErr)
+		mockLogger.On("Error", "Failed to create audit entry", "action", "editing_lease.broken", "error", dbErr).Return()
+
+		assert.Equal(t, dbErr, repo.Save(entry))
+		assert.True(t, entry.ID().IsEmpty())
+		mockLogger.AssertExpectations(t)
+	})
+
+	t.Run("mapper error", func(t *testing.T) {
+		repo, mockDB, mockLogger, mapperMock := newTestAuditEntryRepository()
+		entry := &entities.AuditEntry{}
+		mapperErr := errors.New("mapper error")
+		mapperMock.On("ToModel", entry).Return(nil, mapperErr)
+		mockLogger.On("Error", "Failed to convert audit entry to model", "error", mapperErr).Return()
+
+		assert.Equal(t, mapperErr, repo.Save(entry))
+		mockDB.AssertNotCalled(t, "Exec")
+		mockLogger.AssertExpectations(t)
+	})
+}
+
+func TestAuditEntryRepository_FindByTenantID(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		repo, mockDB, _, mapperMock := newTestAuditEntryRepository()
+		modelList := []*models.AuditEntry{{TenantID: 3, Details: "{}"}}
+		mockDB.On("Select", mock.AnythingOfType("*[]*models.AuditEntry"), "SELECT * FROM audit_entries WHERE tenant_id = ? ORDER BY created_at DESC, id DESC LIMIT 50", uint64(3)).Run(func(args mock.Arguments) {
+			*args.Get(0).(*[]*models.AuditEntry) = modelList
+		}).Return(nil)
+		mapperMock.On("ToDomains", modelList).Return([]*entities.AuditEntry{{}}, nil)
+
+		result, err := repo.FindByTenantID(entities.NewTenantID(3), 50)
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+	})
+
+	t.Run("db error", func(t *testing.T) {
+		repo, mockDB, mockLogger, _ := newTestAuditEntryRepository()
+		dbErr := errors.New("db error")
+		mockDB.On("Select", mock.AnythingOfType("*[]*models.AuditEntry"), mock.Anything, uint64(3)).Return(dbErr)
+		mockLogger.On("Error", "Failed to find audit entries by tenant ID", "tenantID", uint64(3), "error", dbErr).Return()
+
+		result, err := repo.FindByTenantID(entities.NewTenantID(3), 50)
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+		mockLogger.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,123 @@
+package repositories
+
+import (
+	"github.com/Masterminds/squirrel"
+	"github.com/h4rdc0m/aurora-api/domain/common"
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"github.com/h4rdc0m/aurora-api/domain/repositories"
+	"github.com/h4rdc0m/aurora-api/infrastructure/persistence/mappers"
+	"github.com/h4rdc0m/aurora-api/infrastructure/persistence/models"
+	"github.com/h4rdc0m/aurora-api/infrastructure/persistence/mysql"
+	"github.com/jmoiron/sqlx"
+	"time"
+)
+
+// BrokenLinkRepositoryImpl implements BrokenLinkRepository using sqlx and squirrel
+type BrokenLinkRepositoryImpl struct {
+	db     common.Database
+	logger common.Logger
+	mapper common.Mapper[*entities.BrokenLink, *models.BrokenLink]
+}
+
+// NewBrokenLinkRepository creates a new BrokenLinkRepository implementation
+func NewBrokenLinkRepository(db common.Database, logger common.Logger) repositories.BrokenLinkRepository {
+	return &BrokenLinkRepositoryImpl{
+		db:     db,
+		logger: logger,
+		mapper: mappers.NewBrokenLinkMapper(),
+	}
+}
+
+// WithTrx returns a copy of the repository that runs its queries inside the given transaction
+func (r *BrokenLinkRepositoryImpl) WithTrx(trxHandle *sqlx.Tx) repositories.BrokenLinkRepository {
+	return &BrokenLinkRepositoryImpl{
+		db:     mysql.NewTransaction(trxHandle, r.logger),
+		logger: r.logger,
+		mapper: r.mapper,
+	}
+}
+
+// Save saves a broken link (create or update). The first seen time never changes once stored.
+func (r *BrokenLinkRepositoryImpl) Save(link *entities.BrokenLink) error {
+	model, err := r.mapper.ToModel(link)
+	if err != nil {
+		r.logger.Error("Failed to convert broken link to model", "error", err)
+		return err
+	}
+
+	if model.ID == 0 {
+		query, args, err := squirrel.Insert("broken_links").
+			Columns("site_id", "page_id", "block_key", "url", "status_code", "reason", "first_seen_at", "last_checked_at", "created_at", "updated_at").
+			Values(model.SiteID, model.PageID, model.BlockKey, model.URL, model.StatusCode, model.Reason, model.FirstSeenAt, model.LastCheckedAt, model.CreatedAt, model.UpdatedAt).
+			PlaceholderFormat(squirrel.Question).
+			ToSql()
+		if err != nil {
+			r.logger.Error("Failed to build insert query for broken link", "error", err)
+			return err
+		}
+		result, err := r.db.Exec(query, args...)
+		if err != nil {
+			r.logger.Error("Failed to create broken link", "error", err)
+			return err
+		}
+		id, err := result.LastInsertId()
+		if err != nil {
+			r.logger.Error("Failed to get last insert ID for broken link", "error", err)
+			return err
+		}
+		link.SetID(entities.NewBrokenLinkID(uint64(id)))
+	} else {
+		query, args, err := squirrel.Update("broken_links").
+			Set("status_code", model.StatusCode).
+			Set("reason", model.Reason).
+			Set("last_checked_at", model.LastCheckedAt).
+			Set("updated_at", model.UpdatedAt).
+			Where(squirrel.Eq{"id": model.ID}).
+			PlaceholderFormat(squirrel.Question).
+			ToSql()
+		if err != nil {
+			r.logger.Error("Failed to build update query for broken link", "error", err)
+			return err
+		}
+		if _, err := r.db.Exec(query, args...); err != nil {
+			r.logger.Error("Failed to update broken link", "id", model.ID, "error", err)
+			return err
+		}
+	}
+	return nil
+}
+
+// FindBySiteID retrieves the broken links of a site, the longest broken first
+func (r *BrokenLinkRepositoryImpl) FindBySiteID(siteID entities.SiteID) ([]*entities.BrokenLink, error) {
+	var modelList []*models.BrokenLink
+	query, args, err := squirrel.Select("*").From("broken_links").
+		Where(squirrel.Eq{"site_id": siteID.Value()}).
+		OrderBy("first_seen_at ASC", "id ASC").
+		ToSql()
+	if err != nil {
+		r.logger.Error("Failed to build select query for FindBySiteID", "error", err)
+		return nil, err
+	}
+	if err := r.db.Select(&modelList, query, args...); err != nil {
+		r.logger.Error("Failed to find broken links by site ID", "siteID", siteID.Value(), "error", err)
+		return nil, err
+	}
+	return r.mapper.ToDomains(modelList)
+}
+
+// DeleteCheckedBefore deletes the broken links of a site that were last checked before the given time
+func (r *BrokenLinkRepositoryImpl) DeleteCheckedBefore(siteID entities.SiteID, before time.Time) error {
+	query, args, err := squirrel.Delete("broken_links").
+		Where(squirrel.Eq{"site_id": siteID.Value()}).
+		Where(squirrel.Lt{"last_checked_at": before}).
+		ToSql()
+	if err != nil {
+		r.logger.Error("Failed to build delete query for broken links", "siteID", siteID.Value(), "error", err)
+		return err
+	}
+	if _, err := r.db.Exec(query, args...); err != nil {
+		r.logger.Error("Failed to delete stale broken links", "siteID", siteID.Value(), "error", err)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package repositories
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"github.com/h4rdc0m/aurora-api/infrastructure/persistence/models"
+	"github.com/h4rdc0m/aurora-api/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newTestBrokenLinkRepository() (*BrokenLinkRepositoryImpl, *mocks.Database, *mocks.Logger, *mocks.MockBrokenLinkMapper) {
+	mockDB := new(mocks.Database)
+	mockLogger := new(mocks.Logger)
+	mapperMock := &mocks.MockBrokenLinkMapper{}
+	return &BrokenLinkRepositoryImpl{db: mockDB, logger: mockLogger, mapper: mapperMock}, mockDB, mockLogger, mapperMock
+}
+
+func TestBrokenLinkRepository_Save(t *testing.T) {
+	t.Run("insert success", func(t *testing.T) {
+		repo, mockDB, mockLogger, mapperMock := newTestBrokenLinkRepository()
+		link := &entities.BrokenLink{}
+		mapperMock.On("ToModel", link).Return(&models.BrokenLink{SiteID: 1, PageID: 2, URL: "/missing"}, nil)
+		mockResult := new(mocks.SqlResult)
+		mockResult.On("LastInsertId").Return(int64(42), nil)
+		mockDB.On("Exec", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(mockResult, nil)
+
+		err := repo.Save(link)
+
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(42), link.ID().Value())
+		mockDB.AssertExpectations(t)
+		mockLogger.AssertExpectations(t)
+		mapperMock.AssertExpectations(t)
+	})
+
+	t.Run("update keeps the first seen time", func(t *testing.T) {
+		repo, mockDB, mockLogger, mapperMock := newTestBrokenLinkRepository()
+		link := &entities.BrokenLink{}
+		link.SetID(entities.NewBrokenLinkID(99))
+		mapperMock.On("ToModel", link).Return(&models.BrokenLink{Base: models.Base{ID: 99}, SiteID: 1, PageID: 2, URL: "/missing"}, nil)
+		mockDB.On("Exec", mock.MatchedBy(func(query string) bool {
+			return !strings.Contains(query, "first_seen_at")
+		}), mock.Anything, mock.Anything, mock.Anything, mock.Anything, uint64(99)).Return(new(mocks.SqlResult), nil)
+
+		err := repo.Save(link)
+
+		assert.NoError(t, err)
+		mockDB.AssertExpectations(t)
+		mockLogger.AssertExpectations(t)
+	})
+
+	t.Run("mapper error", func(t *testing.T) {
+		repo, mockDB, mockLogger, mapperMock := newTestBrokenLinkRepository()
+		link := &entities.BrokenLink{}
+		mapperErr := errors.New("mapper error")
+		mapperMock.On("ToModel", link).Return(nil, mapperErr)
+		mockLogger.On("Error", "Failed to convert broken link to model", "error", mapperErr).Return()
+
+		assert.Equal(t, mapperErr, repo.Save(link))
+		mockDB.AssertNotCalled(t, "Exec")
+		mockLogger.AssertExpectations(t)
+	})
+}
+
+func TestBrokenLinkRepository_FindBySiteID(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		repo, mockDB, _, mapperMock := newTestBrokenLinkRepository()
+		modelList := []*models.BrokenLink{{SiteID: 3, PageID: 2, URL: "/missing"}}
+		mockDB.On("Select", mock.AnythingOfType("*[]*models.BrokenLink"), mock.Anything, uint64(3)).Run(func(args mock.Arguments) {
+			*args.Get(0).(*[]*models.BrokenLink) = modelList
+		}).Return(nil)
+		mapperMock.On("ToDomains", modelList).Return([]*entities.BrokenLink{{}}, nil)
+
+		result, err := repo.FindBySiteID(entities.NewSiteID(3))
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+	})
+
+	t.Run("db error", func(t *testing.T) {
+		repo, mockDB, mockLogger, _ := newTestBrokenLinkRepository()
+		dbErr := errors.New("db error")
+		mockDB.On("Select", mock.AnythingOfType("*[]*models.BrokenLink"), mock.Anything, uint64(3)).Return(dbErr)
+		mockLogger.On("Error", "Failed to find broken links by site ID", "siteID", uint64(3), "error", dbErr).Return()
+
+		result, err := repo.FindBySiteID(entities.NewSiteID(3))
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+		mockLogger.AssertExpectations(t)
+	})
+}
+
+func TestBrokenLinkRepository_DeleteCheckedBefore(t *testing.T) {
+	repo, mockDB, _, _ := newTestBrokenLinkRepository()
+	before := time.Date(2025, 7, 24, 3, 0, 0, 0, time.UTC)
+	mockDB.On("Exec", "DELETE FROM broken_links WHERE site_id = ? AND last_checked_at < ?", uint64(3), before).Return(new(mocks.SqlResult), nil)
+
+	assert.NoError(t, repo.DeleteCheckedBefore(entities.NewSiteID(3), before))
+	mockDB.AssertExpectations(t)
+}
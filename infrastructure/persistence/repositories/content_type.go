@@ -0,0 +1,161 @@
+package repositories
+
+import (
+	"database/sql"
+	"errors"
+	"github.com/Masterminds/squirrel"
+	"github.com/h4rdc0m/aurora-api/domain/common"
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"github.com/h4rdc0m/aurora-api/domain/repositories"
+	"github.com/h4rdc0m/aurora-api/infrastructure/persistence/mappers"
+	"github.com/h4rdc0m/aurora-api/infrastructure/persistence/models"
+	"github.com/h4rdc0m/aurora-api/infrastructure/persistence/mysql"
+	"github.com/jmoiron/sqlx"
+)
+
+// ContentTypeRepositoryImpl implements ContentTypeRepository using sqlx and squirrel
+type ContentTypeRepositoryImpl struct {
+	db     common.Database
+	logger common.Logger
+	mapper common.Mapper[*entities.ContentType, *models.ContentType]
+}
+
+// NewContentTypeRepository creates a new ContentTypeRepository implementation
+func NewContentTypeRepository(db common.Database, logger common.Logger) repositories.ContentTypeRepository {
+	return &ContentTypeRepositoryImpl{
+		db:     db,
+		logger: logger,
+		mapper: mappers.NewContentTypeMapper(),
+	}
+}
+
+// WithTrx returns a copy of the repository that runs its queries inside the given transaction
+func (r *ContentTypeRepositoryImpl) WithTrx(trxHandle *sqlx.Tx) repositories.ContentTypeRepository {
+	return &ContentTypeRepositoryImpl{
+		db:     mysql.NewTransaction(trxHandle, r.logger),
+		logger: r.logger,
+		mapper: r.mapper,
+	}
+}
+
+// Save saves a content type (create or update)
+func (r *ContentTypeRepositoryImpl) Save(contentType *entities.ContentType) error {
+	model, err := r.mapper.ToModel(contentType)
+	if err != nil {
+		r.logger.Error("Failed to convert content type to model", "error", err)
+		return err
+	}
+
+	if model.ID == 0 {
+		query, args, err := squirrel.Insert("content_types").
+			Columns("tenant_id", "name", "description", "`schema`", "created_at", "updated_at").
+			Values(model.TenantID, model.Name, model.Description, model.Schema, model.CreatedAt, model.UpdatedAt).
+			PlaceholderFormat(squirrel.Question).
+			ToSql()
+		if err != nil {
+			r.logger.Error("Failed to build insert query for content type", "error", err)
+			return err
+		}
+		result, err := r.db.Exec(query, args...)
+		if err != nil {
+			r.logger.Error("Failed to create content type", "error", err)
+			return err
+		}
+		id, err := result.LastInsertId()
+		if err != nil {
+			r.logger.Error("Failed to get last insert ID for content type", "error", err)
+			return err
+		}
+		contentType.SetID(entities.NewContentTypeID(uint64(id)))
+	} else {
+		query, args, err := squirrel.Update("content_types").
+			Set("description", model.Description).
+			Set("`schema`", model.Schema).
+			Set("updated_at", model.UpdatedAt).
+			Where(squirrel.Eq{"id": model.ID}).
+			PlaceholderFormat(squirrel.Question).
+			ToSql()
+		if err != nil {
+			r.logger.Error("Failed to build update query for content type", "error", err)
+			return err
+		}
+		if _, err := r.db.Exec(query, args...); err != nil {
+			r.logger.Error("Failed to update content type", "id", model.ID, "error", err)
+			return err
+		}
+	}
+	return nil
+}
+
+// FindByID retrieves a content type by ID
+func (r *ContentTypeRepositoryImpl) FindByID(id entities.ContentTypeID) (*entities.ContentType, error) {
+	var model models.ContentType
+	query, args, err := squirrel.Select("*").From("content_types").Where(squirrel.Eq{"id": id.Value()}).ToSql()
+	if err != nil {
+		r.logger.Error("Failed to build select query for FindByID", "error", err)
+		return nil, err
+	}
+	if err := r.db.Get(&model, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		r.logger.Error("Failed to find content type by ID", "id", id.Value(), "error", err)
+		return nil, err
+	}
+	return r.mapper.ToDomain(&model)
+}
+
+// FindByName retrieves the content type of a tenant, or the global one when tenantID is nil, by name
+func (r *ContentTypeRepositoryImpl) FindByName(tenantID *entities.TenantID, name string) (*entities.ContentType, error) {
+	var model models.ContentType
+	query, args, err := squirrel.Select("*").From("content_types").Where(squirrel.Eq{"tenant_id": tenantValue(tenantID), "name": name}).ToSql()
+	if err != nil {
+		r.logger.Error("Failed to build select query for FindByName", "error", err)
+		return nil, err
+	}
+	if err := r.db.Get(&model, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		r.logger.Error("Failed to find content type by name", "name", name, "error", err)
+		return nil, err
+	}
+	return r.mapper.ToDomain(&model)
+}
+
+// FindByTenantID retrieves all content types of a tenant, or the global ones when tenantID is nil
+func (r *ContentTypeRepositoryImpl) FindByTenantID(tenantID *entities.TenantID) ([]*entities.ContentType, error) {
+	var modelList []*models.ContentType
+	query, args, err := squirrel.Select("*").From("content_types").Where(squirrel.Eq{"tenant_id": tenantValue(tenantID)}).OrderBy("name ASC").ToSql()
+	if err != nil {
+		r.logger.Error("Failed to build select query for FindByTenantID", "error", err)
+		return nil, err
+	}
+	if err := r.db.Select(&modelList, query, args...); err != nil {
+		r.logger.Error("Failed to find content types by tenant ID", "error", err)
+		return nil, err
+	}
+	return r.mapper.ToDomains(modelList)
+}
+
+// Delete deletes a content type
+func (r *ContentTypeRepositoryImpl) Delete(id entities.ContentTypeID) error {
+	query, args, err := squirrel.Delete("content_types").Where(squirrel.Eq{"id": id.Value()}).ToSql()
+	if err != nil {
+		r.logger.Error("Failed to build delete query for content type", "id", id.Value(), "error", err)
+		return err
+	}
+	if _, err := r.db.Exec(query, args...); err != nil {
+		r.logger.Error("Failed to delete content type", "id", id.Value(), "error", err)
+		return err
+	}
+	return nil
+}
+
+// tenantValue returns the tenant ID as a query argument, nil selecting rows without a tenant
+func tenantValue(tenantID *entities.TenantID) any {
+	if tenantID == nil {
+		return nil
+	}
+	return tenantID.Value()
+}
@@ -0,0 +1,171 @@
+package repositories
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"github.com/h4rdc0m/aurora-api/infrastructure/persistence/models"
+	"github.com/h4rdc0m/aurora-api/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newTestContentTypeRepository() (*ContentTypeRepositoryImpl, *mocks.Database, *mocks.Logger, *mocks.MockContentTypeMapper) {
+	mockDB := new(mocks.Database)
+	mockLogger := new(mocks.Logger)
+	mapperMock := &mocks.MockContentTypeMapper{}
+	return &ContentTypeRepositoryImpl{db: mockDB, logger: mockLogger, mapper: mapperMock}, mockDB, mockLogger, mapperMock
+}
+
+func TestContentTypeRepository_Save(t *testing.T) {
+	t.Run("insert success", func(t *testing.T) {
+		repo, mockDB, mockLogger, mapperMock := newTestContentTypeRepository()
+		contentType := &entities.ContentType{}
+		tenantID := uint64(1)
+		mapperMock.On("ToModel", contentType).Return(&models.ContentType{TenantID: &tenantID, Name: "hero", Schema: "{}"}, nil)
+		mockResult := new(mocks.SqlResult)
+		mockResult.On("LastInsertId").Return(int64(42), nil)
+		mockDB.On("Exec", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(mockResult, nil)
+
+		err := repo.Save(contentType)
+
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(42), contentType.ID().Value())
+		mockDB.AssertExpectations(t)
+		mockLogger.AssertExpectations(t)
+		mapperMock.AssertExpectations(t)
+	})
+
+	t.Run("update success", func(t *testing.T) {
+		repo, mockDB, mockLogger, mapperMock := newTestContentTypeRepository()
+		contentType := &entities.ContentType{}
+		contentType.SetID(entities.NewContentTypeID(99))
+		mapperMock.On("ToModel", contentType).Return(&models.ContentType{Base: models.Base{ID: 99}, Name: "hero", Schema: "{}"}, nil)
+		mockDB.On("Exec", mock.Anything, mock.Anything, mock.Anything, mock.Anything, uint64(99)).Return(new(mocks.SqlResult), nil)
+
+		err := repo.Save(contentType)
+
+		assert.NoError(t, err)
+		mockDB.AssertExpectations(t)
+		mockLogger.AssertExpectations(t)
+	})
+
+	t.Run("insert exec error", func(t *testing.T) {
+		repo, mockDB, mockLogger, mapperMock := newTestContentTypeRepository()
+		contentType := &entities.ContentType{}
+		mapperMock.On("ToModel", contentType).Return(&models.ContentType{Name: "hero", Schema: "{}"}, nil)
+		mockDB.On("Exec", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(new(mocks.SqlResult), errors.New("exec error"))
+		mockLogger.On("Error", "Failed to create content type", "error", mock.Anything).Return()
+
+		assert.Error(t, repo.Save(contentType))
+		mockLogger.AssertExpectations(t)
+	})
+
+	t.Run("mapper error", func(t *testing.T) {
+		repo, mockDB, mockLogger, mapperMock := newTestContentTypeRepository()
+		contentType := &entities.ContentType{}
+		mapperErr := errors.New("mapper error")
+		mapperMock.On("ToModel", contentType).Return(nil, mapperErr)
+		mockLogger.On("Error", "Failed to convert content type to model", "error", mapperErr).Return()
+
+		assert.Equal(t, mapperErr, repo.Save(contentType))
+		mockDB.AssertNotCalled(t, "Exec")
+		mockLogger.AssertExpectations(t)
+	})
+}
+
+func TestContentTypeRepository_FindByID(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		repo, mockDB, _, mapperMock := newTestContentTypeRepository()
+		id := entities.NewContentTypeID(5)
+		mockDB.On("Get", mock.AnythingOfType("*models.ContentType"), mock.Anything, id.Value()).Return(nil)
+		expected := &entities.ContentType{}
+		mapperMock.On("ToDomain", mock.AnythingOfType("*models.ContentType")).Return(expected, nil)
+
+		result, err := repo.FindByID(id)
+
+		assert.NoError(t, err)
+		assert.Same(t, expected, result)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		repo, mockDB, _, _ := newTestContentTypeRepository()
+		id := entities.NewContentTypeID(6)
+		mockDB.On("Get", mock.AnythingOfType("*models.ContentType"), mock.Anything, id.Value()).Return(sql.ErrNoRows)
+
+		result, err := repo.FindByID(id)
+
+		assert.NoError(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestContentTypeRepository_FindByName(t *testing.T) {
+	t.Run("tenant content type", func(t *testing.T) {
+		repo, mockDB, _, mapperMock := newTestContentTypeRepository()
+		tenantID := entities.NewTenantID(3)
+		mockDB.On("Get", mock.AnythingOfType("*models.ContentType"), mock.Anything, "hero", uint64(3)).Return(nil)
+		expected := &entities.ContentType{}
+		mapperMock.On("ToDomain", mock.AnythingOfType("*models.ContentType")).Return(expected, nil)
+
+		result, err := repo.FindByName(&tenantID, "hero")
+
+		assert.NoError(t, err)
+		assert.Same(t, expected, result)
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("global content type", func(t *testing.T) {
+		repo, mockDB, _, _ := newTestContentTypeRepository()
+		mockDB.On("Get", mock.AnythingOfType("*models.ContentType"), mock.MatchedBy(func(query string) bool {
+			return assert.Contains(t, query, "tenant_id IS NULL")
+		}), "hero").Return(sql.ErrNoRows)
+
+		result, err := repo.FindByName(nil, "hero")
+
+		assert.NoError(t, err)
+		assert.Nil(t, result)
+		mockDB.AssertExpectations(t)
+	})
+}
+
+func TestContentTypeRepository_FindByTenantID(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		repo, mockDB, _, mapperMock := newTestContentTypeRepository()
+		tenantID := entities.NewTenantID(3)
+		modelList := []*models.ContentType{{Name: "hero"}}
+		mockDB.On("Select", mock.AnythingOfType("*[]*models.ContentType"), mock.Anything, uint64(3)).Run(func(args mock.Arguments) {
+			*args.Get(0).(*[]*models.ContentType) = modelList
+		}).Return(nil)
+		mapperMock.On("ToDomains", modelList).Return([]*entities.ContentType{{}}, nil)
+
+		result, err := repo.FindByTenantID(&tenantID)
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+	})
+
+	t.Run("db error", func(t *testing.T) {
+		repo, mockDB, mockLogger, _ := newTestContentTypeRepository()
+		dbErr := errors.New("db error")
+		mockDB.On("Select", mock.AnythingOfType("*[]*models.ContentType"), mock.Anything).Return(dbErr)
+		mockLogger.On("Error", "Failed to find content types by tenant ID", "error", dbErr).Return()
+
+		result, err := repo.FindByTenantID(nil)
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+		mockLogger.AssertExpectations(t)
+	})
+}
+
+func TestContentTypeRepository_Delete(t *testing.T) {
+	repo, mockDB, _, _ := newTestContentTypeRepository()
+	id := entities.NewContentTypeID(5)
+	mockDB.On("Exec", mock.Anything, id.Value()).Return(new(mocks.SqlResult), nil)
+
+	assert.NoError(t, repo.Delete(id))
+	mockDB.AssertExpectations(t)
+}
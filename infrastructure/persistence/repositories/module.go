@@ -10,4 +10,8 @@ var Module = fx.Options(
 	fx.Provide(NewPageRepository),
 	fx.Provide(NewPageVersionRepository),
 	fx.Provide(NewPageBlockRepository),
+	fx.Provide(NewContentTypeRepository),
+	fx.Provide(NewRedirectRepository),
+	fx.Provide(NewBrokenLinkRepository),
+	fx.Provide(NewAuditEntryRepository),
 )
@@ -9,6 +9,9 @@ import (
 	"github.com/h4rdc0m/aurora-api/domain/repositories"
 	"github.com/h4rdc0m/aurora-api/infrastructure/persistence/mappers"
 	"github.com/h4rdc0m/aurora-api/infrastructure/persistence/models"
+	"github.com/h4rdc0m/aurora-api/infrastructure/persistence/mysql"
+	"github.com/jmoiron/sqlx"
+	"time"
 )
 
 // PageRepositoryImpl provides the implementation of the PageRepository interface for interacting with page data.
@@ -29,6 +32,15 @@ func NewPageRepository(db common.Database, logger common.Logger) repositories.Pa
 	}
 }
 
+// WithTrx returns a copy of the repository that runs its queries inside the given transaction
+func (r *PageRepositoryImpl) WithTrx(trxHandle *sqlx.Tx) repositories.PageRepository {
+	return &PageRepositoryImpl{
+		db:     mysql.NewTransaction(trxHandle, r.logger),
+		logger: r.logger,
+		mapper: r.mapper,
+	}
+}
+
 // Save saves a new page or updates an existing page in the database.
 // Returns an error if the operation fails.
 func (r *PageRepositoryImpl) Save(page *entities.Page) error {
@@ -40,8 +52,8 @@ func (r *PageRepositoryImpl) Save(page *entities.Page) error {
 
 	if model.ID == 0 {
 		query, args, err := squirrel.Insert("pages").
-			Columns("key", "path", "index", "site_id", "type", "link_url", "parent_id", "hard_link_page_id").
-			Values(model.Key, model.Path, model.Index, model.SiteID, model.Type, model.LinkURL, model.ParentID, model.HardLinkPageID).
+			Columns("`key`", "path", "`index`", "site_id", "type", "link_url", "parent_id", "hard_link_page_id", "is_feed_root", "revision").
+			Values(model.Key, model.Path, model.Index, model.SiteID, model.Type, model.LinkURL, model.ParentID, model.HardLinkPageID, model.IsFeedRoot, initialRevision).
 			PlaceholderFormat(squirrel.Question).
 			ToSql()
 		if err != nil {
@@ -59,26 +71,37 @@ func (r *PageRepositoryImpl) Save(page *entities.Page) error {
 			return err
 		}
 		page.SetID(entities.NewPageID(uint64(id)))
+		page.SetRevision(initialRevision)
 	} else {
 		query, args, err := squirrel.Update("pages").
-			Set("key", model.Key).
+			Set("`key`", model.Key).
 			Set("path", model.Path).
-			Set("index", model.Index).
+			Set("`index`", model.Index).
+			Set("site_id", model.SiteID).
 			Set("type", model.Type).
 			Set("link_url", model.LinkURL).
 			Set("parent_id", model.ParentID).
 			Set("hard_link_page_id", model.HardLinkPageID).
-			Where(squirrel.Eq{"id": model.ID}).
+			Set("is_feed_root", model.IsFeedRoot).
+			Set("deleted_at", model.DeletedAt).
+			Set("revision", model.Revision+1).
+			Where(squirrel.Eq{"id": model.ID, "revision": model.Revision}).
 			PlaceholderFormat(squirrel.Question).
 			ToSql()
 		if err != nil {
 			r.logger.Error("Failed to build update query", "error", err)
 			return err
 		}
-		if _, err := r.db.Exec(query, args...); err != nil {
+		result, err := r.db.Exec(query, args...)
+		if err != nil {
 			r.logger.Error("Failed to update existing page", "id", model.ID, "error", err)
 			return err
 		}
+		if err := checkRevisionUpdate(result); err != nil {
+			r.logger.Warn("Rejected update of modified page", "id", model.ID, "revision", model.Revision, "error", err)
+			return err
+		}
+		page.SetRevision(model.Revision + 1)
 	}
 	return nil
 }
@@ -87,7 +110,7 @@ func (r *PageRepositoryImpl) Save(page *entities.Page) error {
 // Returns the page or nil if not found, and an error if a failure occurs during the operation.
 func (r *PageRepositoryImpl) FindByID(id entities.PageID) (*entities.Page, error) {
 	var model models.Page
-	query, args, err := squirrel.Select("*").From("pages").Where(squirrel.Eq{"id": id.Value()}).ToSql()
+	query, args, err := squirrel.Select("*").From("pages").Where(squirrel.Eq{"deleted_at": nil, "id": id.Value()}).ToSql()
 	if err != nil {
 		r.logger.Error("Failed to build select query for FindByID", "error", err)
 		return nil, err
@@ -106,7 +129,7 @@ func (r *PageRepositoryImpl) FindByID(id entities.PageID) (*entities.Page, error
 // FindByPath retrieves a page by its path and associated site ID from the database. Returns nil if no record is found.
 func (r *PageRepositoryImpl) FindByPath(path string, siteID entities.SiteID) (*entities.Page, error) {
 	var model models.Page
-	query, args, err := squirrel.Select("*").From("pages").Where(squirrel.Eq{"path": path, "site_id": siteID.Value()}).ToSql()
+	query, args, err := squirrel.Select("*").From("pages").Where(squirrel.Eq{"deleted_at": nil, "path": path, "site_id": siteID.Value()}).ToSql()
 	if err != nil {
 		r.logger.Error("Failed to build select query for FindByPath", "error", err)
 		return nil, err
@@ -125,7 +148,7 @@ func (r *PageRepositoryImpl) FindByPath(path string, siteID entities.SiteID) (*e
 // FindBySiteID retrieves a list of pages associated with the given site ID, ordered by their index.
 func (r *PageRepositoryImpl) FindBySiteID(siteID entities.SiteID) ([]*entities.Page, error) {
 	var modelList []*models.Page
-	query, args, err := squirrel.Select("*").From("pages").Where(squirrel.Eq{"site_id": siteID.Value()}).OrderBy("index ASC").ToSql()
+	query, args, err := squirrel.Select("*").From("pages").Where(squirrel.Eq{"deleted_at": nil, "site_id": siteID.Value()}).OrderBy("`index` ASC").ToSql()
 	if err != nil {
 		r.logger.Error("Failed to build select query for FindBySiteID", "error", err)
 		return nil, err
@@ -140,7 +163,7 @@ func (r *PageRepositoryImpl) FindBySiteID(siteID entities.SiteID) ([]*entities.P
 // FindRootPagesBySiteID retrieves root pages by site ID where parent ID is null, ordering them by index in ascending order.
 func (r *PageRepositoryImpl) FindRootPagesBySiteID(siteID entities.SiteID) ([]*entities.Page, error) {
 	var modelList []*models.Page
-	query, args, err := squirrel.Select("*").From("pages").Where(squirrel.And{squirrel.Eq{"site_id": siteID.Value()}, squirrel.Expr("parent_id IS NULL")}).OrderBy("index ASC").ToSql()
+	query, args, err := squirrel.Select("*").From("pages").Where(squirrel.And{squirrel.Eq{"deleted_at": nil, "site_id": siteID.Value()}, squirrel.Expr("parent_id IS NULL")}).OrderBy("`index` ASC").ToSql()
 	if err != nil {
 		r.logger.Error("Failed to build select query for FindRootPagesBySiteID", "error", err)
 		return nil, err
@@ -155,7 +178,7 @@ func (r *PageRepositoryImpl) FindRootPagesBySiteID(siteID entities.SiteID) ([]*e
 // FindChildrenByParentID retrieves all child pages associated with the given parent page ID, ordered by their index.
 func (r *PageRepositoryImpl) FindChildrenByParentID(parentID entities.PageID) ([]*entities.Page, error) {
 	var modelList []*models.Page
-	query, args, err := squirrel.Select("*").From("pages").Where(squirrel.Eq{"parent_id": parentID.Value()}).OrderBy("index ASC").ToSql()
+	query, args, err := squirrel.Select("*").From("pages").Where(squirrel.Eq{"deleted_at": nil, "parent_id": parentID.Value()}).OrderBy("`index` ASC").ToSql()
 	if err != nil {
 		r.logger.Error("Failed to build select query for FindChildrenByParentID", "error", err)
 		return nil, err
@@ -167,7 +190,62 @@ func (r *PageRepositoryImpl) FindChildrenByParentID(parentID entities.PageID) ([
 	return r.mapper.ToDomains(modelList)
 }
 
-// Delete removes a page from the database using its unique identifier and returns an error if the operation fails.
+// FindTrashedByID retrieves a trashed page by ID. Returns nil if the page does not exist or is not trashed.
+func (r *PageRepositoryImpl) FindTrashedByID(id entities.PageID) (*entities.Page, error) {
+	var model models.Page
+	query, args, err := squirrel.Select("*").From("pages").
+		Where(squirrel.Eq{"id": id.Value()}).
+		Where(squirrel.NotEq{"deleted_at": nil}).
+		ToSql()
+	if err != nil {
+		r.logger.Error("Failed to build select query for FindTrashedByID", "error", err)
+		return nil, err
+	}
+	if err := r.db.Get(&model, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		r.logger.Error("Failed to find trashed page by ID", "id", id.Value(), "error", err)
+		return nil, err
+	}
+	return r.mapper.ToDomain(&model)
+}
+
+// FindTrashedBySiteID retrieves the trashed pages of a site, most recently trashed first
+func (r *PageRepositoryImpl) FindTrashedBySiteID(siteID entities.SiteID) ([]*entities.Page, error) {
+	var modelList []*models.Page
+	query, args, err := squirrel.Select("*").From("pages").
+		Where(squirrel.Eq{"site_id": siteID.Value()}).
+		Where(squirrel.NotEq{"deleted_at": nil}).
+		OrderBy("deleted_at DESC", "path ASC").
+		ToSql()
+	if err != nil {
+		r.logger.Error("Failed to build select query for FindTrashedBySiteID", "error", err)
+		return nil, err
+	}
+	if err := r.db.Select(&modelList, query, args...); err != nil {
+		r.logger.Error("Failed to find trashed pages by site ID", "siteID", siteID.Value(), "error", err)
+		return nil, err
+	}
+	return r.mapper.ToDomains(modelList)
+}
+
+// FindTrashedBefore retrieves the pages trashed before the given time, of any site
+func (r *PageRepositoryImpl) FindTrashedBefore(before time.Time) ([]*entities.Page, error) {
+	var modelList []*models.Page
+	query, args, err := squirrel.Select("*").From("pages").Where(squirrel.Lt{"deleted_at": before}).ToSql()
+	if err != nil {
+		r.logger.Error("Failed to build select query for FindTrashedBefore", "error", err)
+		return nil, err
+	}
+	if err := r.db.Select(&modelList, query, args...); err != nil {
+		r.logger.Error("Failed to find pages trashed before", "before", before, "error", err)
+		return nil, err
+	}
+	return r.mapper.ToDomains(modelList)
+}
+
+// Delete permanently removes a page from the database using its unique identifier, used to purge it from the trash.
 func (r *PageRepositoryImpl) Delete(id entities.PageID) error {
 	query, args, err := squirrel.Delete("pages").Where(squirrel.Eq{"id": id.Value()}).ToSql()
 	if err != nil {
@@ -184,7 +262,7 @@ func (r *PageRepositoryImpl) Delete(id entities.PageID) error {
 // ExistsByPath checks if a page with the given path and site ID exists in the repository, returning a boolean result.
 func (r *PageRepositoryImpl) ExistsByPath(path string, siteID entities.SiteID) (bool, error) {
 	var count int64
-	query, args, err := squirrel.Select("COUNT(*)").From("pages").Where(squirrel.Eq{"path": path, "site_id": siteID.Value()}).ToSql()
+	query, args, err := squirrel.Select("COUNT(*)").From("pages").Where(squirrel.Eq{"deleted_at": nil, "path": path, "site_id": siteID.Value()}).ToSql()
 	if err != nil {
 		r.logger.Error("Failed to build count query for ExistsByPath", "error", err)
 		return false, err
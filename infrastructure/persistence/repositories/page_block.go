@@ -9,6 +9,8 @@ import (
 	"github.com/h4rdc0m/aurora-api/domain/repositories"
 	"github.com/h4rdc0m/aurora-api/infrastructure/persistence/mappers"
 	"github.com/h4rdc0m/aurora-api/infrastructure/persistence/models"
+	"github.com/h4rdc0m/aurora-api/infrastructure/persistence/mysql"
+	"github.com/jmoiron/sqlx"
 )
 
 // PageBlockRepositoryImpl implements PageBlockRepository using sqlx and squirrel
@@ -27,6 +29,15 @@ func NewPageBlockRepository(db common.Database, logger common.Logger) repositori
 	}
 }
 
+// WithTrx returns a copy of the repository that runs its queries inside the given transaction
+func (r *PageBlockRepositoryImpl) WithTrx(trxHandle *sqlx.Tx) repositories.PageBlockRepository {
+	return &PageBlockRepositoryImpl{
+		db:     mysql.NewTransaction(trxHandle, r.logger),
+		logger: r.logger,
+		mapper: r.mapper,
+	}
+}
+
 // Save saves a page block (create or update)
 func (r *PageBlockRepositoryImpl) Save(block *entities.PageBlock) error {
 	model, err := r.mapper.ToModel(block)
@@ -37,8 +48,8 @@ func (r *PageBlockRepositoryImpl) Save(block *entities.PageBlock) error {
 
 	if model.ID == 0 {
 		query, args, err := squirrel.Insert("page_blocks").
-			Columns("block_key", "page_version_id", "index", "content_type", "content").
-			Values(model.BlockKey, model.PageVersionID, model.Index, model.ContentType, model.Content).
+			Columns("block_key", "page_version_id", "`index`", "content_type", "content", "snippet_page_id", "revision").
+			Values(model.BlockKey, model.PageVersionID, model.Index, model.ContentType, model.Content, model.SnippetPageID, initialRevision).
 			PlaceholderFormat(squirrel.Question).
 			ToSql()
 		if err != nil {
@@ -56,24 +67,33 @@ func (r *PageBlockRepositoryImpl) Save(block *entities.PageBlock) error {
 			return err
 		}
 		block.SetID(entities.NewPageBlockID(uint64(id)))
+		block.SetRevision(initialRevision)
 	} else {
 		query, args, err := squirrel.Update("page_blocks").
 			Set("block_key", model.BlockKey).
 			Set("page_version_id", model.PageVersionID).
-			Set("index", model.Index).
+			Set("`index`", model.Index).
 			Set("content_type", model.ContentType).
 			Set("content", model.Content).
-			Where(squirrel.Eq{"id": model.ID}).
+			Set("snippet_page_id", model.SnippetPageID).
+			Set("revision", model.Revision+1).
+			Where(squirrel.Eq{"id": model.ID, "revision": model.Revision}).
 			PlaceholderFormat(squirrel.Question).
 			ToSql()
 		if err != nil {
 			r.logger.Error("Failed to build update query for page block", "error", err)
 			return err
 		}
-		if _, err := r.db.Exec(query, args...); err != nil {
+		result, err := r.db.Exec(query, args...)
+		if err != nil {
 			r.logger.Error("Failed to update page block", "id", model.ID, "error", err)
 			return err
 		}
+		if err := checkRevisionUpdate(result); err != nil {
+			r.logger.Warn("Rejected update of modified page block", "id", model.ID, "revision", model.Revision, "error", err)
+			return err
+		}
+		block.SetRevision(model.Revision + 1)
 	}
 	return nil
 }
@@ -99,7 +119,7 @@ func (r *PageBlockRepositoryImpl) FindByID(id entities.PageBlockID) (*entities.P
 // FindByPageVersionID retrieves all blocks for a specific page version
 func (r *PageBlockRepositoryImpl) FindByPageVersionID(pageVersionID entities.PageVersionID) ([]*entities.PageBlock, error) {
 	var modelList []*models.PageBlock
-	query, args, err := squirrel.Select("*").From("page_blocks").Where(squirrel.Eq{"page_version_id": pageVersionID.Value()}).OrderBy("index ASC").ToSql()
+	query, args, err := squirrel.Select("*").From("page_blocks").Where(squirrel.Eq{"page_version_id": pageVersionID.Value()}).OrderBy("`index` ASC").ToSql()
 	if err != nil {
 		r.logger.Error("Failed to build select query for FindByPageVersionID", "error", err)
 		return nil, err
@@ -129,6 +149,25 @@ func (r *PageBlockRepositoryImpl) FindByBlockKey(blockKey string, pageVersionID
 	return r.mapper.ToDomain(&model)
 }
 
+// FindBySnippetPageID retrieves all blocks embedding the given snippet page, leaving out the blocks of trashed versions
+func (r *PageBlockRepositoryImpl) FindBySnippetPageID(snippetPageID entities.PageID) ([]*entities.PageBlock, error) {
+	var modelList []*models.PageBlock
+	query, args, err := squirrel.Select("page_blocks.*").From("page_blocks").
+		Join("page_versions ON page_versions.id = page_blocks.page_version_id").
+		Where(squirrel.Eq{"page_blocks.snippet_page_id": snippetPageID.Value(), "page_versions.deleted_at": nil}).
+		OrderBy("page_blocks.page_version_id ASC", "page_blocks.`index` ASC").
+		ToSql()
+	if err != nil {
+		r.logger.Error("Failed to build select query for FindBySnippetPageID", "error", err)
+		return nil, err
+	}
+	if err := r.db.Select(&modelList, query, args...); err != nil {
+		r.logger.Error("Failed to find page blocks by snippet page ID", "snippet_page_id", snippetPageID.Value(), "error", err)
+		return nil, err
+	}
+	return r.mapper.ToDomains(modelList)
+}
+
 // Delete deletes a page block (soft delete)
 func (r *PageBlockRepositoryImpl) Delete(id entities.PageBlockID) error {
 	query, args, err := squirrel.Delete("page_blocks").Where(squirrel.Eq{"id": id.Value()}).ToSql()
@@ -30,7 +30,7 @@ func TestPageBlockRepository_Save(t *testing.T) {
 
 		mockResult := new(mocks.SqlResult)
 		mockResult.On("LastInsertId").Return(int64(42), nil)
-		mockDB.On("Exec", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(mockResult, nil)
+		mockDB.On("Exec", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(mockResult, nil)
 
 		err := repo.Save(block)
 		assert.NoError(t, err)
@@ -56,7 +56,8 @@ func TestPageBlockRepository_Save(t *testing.T) {
 		mapperMock.On("ToModel", block).Return(model, nil)
 
 		mockResult := new(mocks.SqlResult)
-		mockDB.On("Exec", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(mockResult, nil)
+		mockResult.On("RowsAffected").Return(int64(1), nil)
+		mockDB.On("Exec", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(mockResult, nil)
 
 		err := repo.Save(block)
 		assert.NoError(t, err)
@@ -99,7 +100,7 @@ func TestPageBlockRepository_Save_ErrorBranches(t *testing.T) {
 		repo := &PageBlockRepositoryImpl{db: mockDB, logger: mockLogger, mapper: &mocks.MockPageBlockMapper{}}
 		mapperMock := repo.mapper.(*mocks.MockPageBlockMapper)
 		mapperMock.On("ToModel", block).Return(model, nil)
-		mockDB.On("Exec", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(new(mocks.SqlResult), errors.New("exec error"))
+		mockDB.On("Exec", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(new(mocks.SqlResult), errors.New("exec error"))
 		mockLogger.On("Error", "Failed to insert new page block", "error", mock.Anything).Return()
 		err := repo.Save(block)
 		assert.Error(t, err)
@@ -116,7 +117,7 @@ func TestPageBlockRepository_Save_ErrorBranches(t *testing.T) {
 		mapperMock.On("ToModel", block).Return(model, nil)
 		mockResult := new(mocks.SqlResult)
 		mockResult.On("LastInsertId").Return(int64(0), errors.New("lastInsertId error"))
-		mockDB.On("Exec", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(mockResult, nil)
+		mockDB.On("Exec", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(mockResult, nil)
 		mockLogger.On("Error", "Failed to get last insert ID for page block", "error", mock.Anything).Return()
 		err := repo.Save(block)
 		assert.Error(t, err)
@@ -136,7 +137,7 @@ func TestPageBlockRepository_Save_UpdateErrorBranch(t *testing.T) {
 	repo := &PageBlockRepositoryImpl{db: mockDB, logger: mockLogger, mapper: &mocks.MockPageBlockMapper{}}
 	mapperMock := repo.mapper.(*mocks.MockPageBlockMapper)
 	mapperMock.On("ToModel", block).Return(model, nil)
-	mockDB.On("Exec", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(new(mocks.SqlResult), errors.New("exec error"))
+	mockDB.On("Exec", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(new(mocks.SqlResult), errors.New("exec error"))
 	mockLogger.On("Error", "Failed to update page block", "id", model.ID, "error", mock.Anything).Return()
 
 	err := repo.Save(block)
@@ -254,6 +255,44 @@ func TestPageBlockRepository_FindByPageVersionID(t *testing.T) {
 	})
 }
 
+func TestPageBlockRepository_FindBySnippetPageID(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		mockDB := new(mocks.Database)
+		mockLogger := new(mocks.Logger)
+		repo := &PageBlockRepositoryImpl{db: mockDB, logger: mockLogger, mapper: &mocks.MockPageBlockMapper{}}
+		snippetPageID := entities.NewPageID(7)
+		modelList := []*models.PageBlock{{Base: models.Base{ID: 1}, BlockKey: "block1", PageVersionID: 1, SnippetPageID: snippetPageID.ValuePtr()}}
+		// Blocks of trashed versions do not count as usages
+		query := "SELECT page_blocks.* FROM page_blocks JOIN page_versions ON page_versions.id = page_blocks.page_version_id " +
+			"WHERE page_blocks.snippet_page_id = ? AND page_versions.deleted_at IS NULL ORDER BY page_blocks.page_version_id ASC, page_blocks.`index` ASC"
+		mockDB.On("Select", mock.AnythingOfType("*[]*models.PageBlock"), query, snippetPageID.Value()).Run(func(args mock.Arguments) {
+			blocks := args.Get(0).(*[]*models.PageBlock)
+			*blocks = modelList
+		}).Return(nil)
+		mapperMock := repo.mapper.(*mocks.MockPageBlockMapper)
+		mapperMock.On("ToDomains", modelList).Return([]*entities.PageBlock{{}}, nil)
+		result, err := repo.FindBySnippetPageID(snippetPageID)
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+		mockDB.AssertExpectations(t)
+		mapperMock.AssertExpectations(t)
+	})
+	t.Run("db error", func(t *testing.T) {
+		mockDB := new(mocks.Database)
+		mockLogger := new(mocks.Logger)
+		repo := &PageBlockRepositoryImpl{db: mockDB, logger: mockLogger, mapper: &mocks.MockPageBlockMapper{}}
+		snippetPageID := entities.NewPageID(8)
+		dbErr := errors.New("db error")
+		mockDB.On("Select", mock.AnythingOfType("*[]*models.PageBlock"), mock.Anything, snippetPageID.Value()).Return(dbErr)
+		mockLogger.On("Error", "Failed to find page blocks by snippet page ID", "snippet_page_id", snippetPageID.Value(), "error", dbErr).Return()
+		result, err := repo.FindBySnippetPageID(snippetPageID)
+		assert.Error(t, err)
+		assert.Nil(t, result)
+		mockDB.AssertExpectations(t)
+		mockLogger.AssertExpectations(t)
+	})
+}
+
 func TestPageBlockRepository_FindByBlockKey(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		mockDB := new(mocks.Database)
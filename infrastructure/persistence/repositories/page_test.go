@@ -9,7 +9,9 @@ import (
 	"github.com/h4rdc0m/aurora-api/domain/entities"
 	"github.com/h4rdc0m/aurora-api/domain/value_objects"
 	"github.com/h4rdc0m/aurora-api/infrastructure/persistence/models"
+	"github.com/h4rdc0m/aurora-api/infrastructure/persistence/mysql"
 	"github.com/h4rdc0m/aurora-api/tests/mocks"
+	"github.com/jmoiron/sqlx"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -152,7 +154,7 @@ func TestPageRepository_Save(t *testing.T) {
 
 		mockResult := new(mocks.SqlResult)
 		mockResult.On("LastInsertId").Return(int64(42), nil)
-		mockDB.On("Exec", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(mockResult, nil)
+		mockDB.On("Exec", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(mockResult, nil)
 
 		// Execute
 		err := repo.Save(page)
@@ -185,7 +187,8 @@ func TestPageRepository_Save(t *testing.T) {
 		mapperMock.On("ToModel", page).Return(model, nil)
 
 		mockResult := new(mocks.SqlResult)
-		mockDB.On("Exec", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(mockResult, nil)
+		mockResult.On("RowsAffected").Return(int64(1), nil)
+		mockDB.On("Exec", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(mockResult, nil)
 
 		// Execute
 		err := repo.Save(page)
@@ -255,7 +258,7 @@ func TestPageRepository_Save_InsertErrorBranches(t *testing.T) {
 		model := &models.Page{Key: "new-page", Path: &path, SiteID: 1, Type: "content"}
 		mapperMock := repo.mapper.(*mocks.MockPageMapper)
 		mapperMock.On("ToModel", page).Return(model, nil)
-		mockDB.On("Exec", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(new(mocks.SqlResult), errors.New("exec error"))
+		mockDB.On("Exec", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(new(mocks.SqlResult), errors.New("exec error"))
 		mockLogger.On("Error", "Failed to insert new page", "error", mock.Anything).Return()
 		err := repo.Save(page)
 		assert.Error(t, err)
@@ -273,7 +276,7 @@ func TestPageRepository_Save_InsertErrorBranches(t *testing.T) {
 		mapperMock.On("ToModel", page).Return(model, nil)
 		mockResult := new(mocks.SqlResult)
 		mockResult.On("LastInsertId").Return(int64(0), errors.New("lastInsertId error"))
-		mockDB.On("Exec", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(mockResult, nil)
+		mockDB.On("Exec", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(mockResult, nil)
 		mockLogger.On("Error", "Failed to get last insert ID", "error", mock.Anything).Return()
 		err := repo.Save(page)
 		assert.Error(t, err)
@@ -307,7 +310,7 @@ func TestPageRepository_Save_UpdateErrorBranches(t *testing.T) {
 		model := &models.Page{Base: models.Base{ID: 99}, Key: "existing-page", Path: &path, SiteID: 1, Type: "content"}
 		mapperMock := repo.mapper.(*mocks.MockPageMapper)
 		mapperMock.On("ToModel", page).Return(model, nil)
-		mockDB.On("Exec", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(new(mocks.SqlResult), errors.New("exec error"))
+		mockDB.On("Exec", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(new(mocks.SqlResult), errors.New("exec error"))
 		mockLogger.On("Error", "Failed to update existing page", "id", model.ID, "error", mock.Anything).Return()
 		err := repo.Save(page)
 		assert.Error(t, err)
@@ -587,3 +590,112 @@ func TestPageRepository_FindChildrenByParentID(t *testing.T) {
 		mockLogger.AssertExpectations(t)
 	})
 }
+
+// TestPageRepository_WithTrx tests that WithTrx binds the repository to the transaction
+func TestPageRepository_WithTrx(t *testing.T) {
+	mockLogger := new(mocks.Logger)
+	mapper := &mocks.MockPageMapper{}
+	repo := &PageRepositoryImpl{
+		db:     new(mocks.Database),
+		logger: mockLogger,
+		mapper: mapper,
+	}
+
+	trxRepo := repo.WithTrx(&sqlx.Tx{}).(*PageRepositoryImpl)
+
+	assert.IsType(t, &mysql.Transaction{}, trxRepo.db)
+	assert.Equal(t, mockLogger, trxRepo.logger)
+	assert.Equal(t, mapper, trxRepo.mapper)
+	assert.IsType(t, &mocks.Database{}, repo.db)
+}
+
+func TestPageRepository_FindTrashedByID(t *testing.T) {
+	query := "SELECT * FROM pages WHERE id = ? AND deleted_at IS NOT NULL"
+	t.Run("success", func(t *testing.T) {
+		mockDB := new(mocks.Database)
+		repo := &PageRepositoryImpl{db: mockDB, logger: new(mocks.Logger), mapper: &mocks.MockPageMapper{}}
+		id := entities.NewPageID(1)
+		deletedAt := time.Now()
+		model := models.Page{Base: models.Base{ID: 1, DeletedAt: &deletedAt}, Key: "about", SiteID: 1, Type: models.PageTypeContent}
+		mockDB.On("Get", mock.AnythingOfType("*models.Page"), query, id.Value()).Run(func(args mock.Arguments) {
+			*args.Get(0).(*models.Page) = model
+		}).Return(nil)
+		page := &entities.Page{}
+		page.SetDeletedAt(&deletedAt)
+		mapperMock := repo.mapper.(*mocks.MockPageMapper)
+		mapperMock.On("ToDomain", &model).Return(page, nil)
+
+		result, err := repo.FindTrashedByID(id)
+
+		assert.NoError(t, err)
+		assert.True(t, result.IsDeleted())
+		mockDB.AssertExpectations(t)
+		mapperMock.AssertExpectations(t)
+	})
+	t.Run("not trashed", func(t *testing.T) {
+		mockDB := new(mocks.Database)
+		repo := &PageRepositoryImpl{db: mockDB, logger: new(mocks.Logger), mapper: &mocks.MockPageMapper{}}
+		id := entities.NewPageID(2)
+		mockDB.On("Get", mock.AnythingOfType("*models.Page"), query, id.Value()).Return(sql.ErrNoRows)
+
+		result, err := repo.FindTrashedByID(id)
+
+		assert.NoError(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestPageRepository_FindTrashedBySiteID(t *testing.T) {
+	query := "SELECT * FROM pages WHERE site_id = ? AND deleted_at IS NOT NULL ORDER BY deleted_at DESC, path ASC"
+	t.Run("success", func(t *testing.T) {
+		mockDB := new(mocks.Database)
+		repo := &PageRepositoryImpl{db: mockDB, logger: new(mocks.Logger), mapper: &mocks.MockPageMapper{}}
+		siteID := entities.NewSiteID(1)
+		modelList := []*models.Page{{Base: models.Base{ID: 1}, Key: "about", SiteID: 1, Type: models.PageTypeContent}}
+		mockDB.On("Select", mock.AnythingOfType("*[]*models.Page"), query, siteID.Value()).Run(func(args mock.Arguments) {
+			*args.Get(0).(*[]*models.Page) = modelList
+		}).Return(nil)
+		mapperMock := repo.mapper.(*mocks.MockPageMapper)
+		mapperMock.On("ToDomains", modelList).Return([]*entities.Page{{}}, nil)
+
+		result, err := repo.FindTrashedBySiteID(siteID)
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+		mockDB.AssertExpectations(t)
+		mapperMock.AssertExpectations(t)
+	})
+	t.Run("db error", func(t *testing.T) {
+		mockDB := new(mocks.Database)
+		mockLogger := new(mocks.Logger)
+		repo := &PageRepositoryImpl{db: mockDB, logger: mockLogger, mapper: &mocks.MockPageMapper{}}
+		siteID := entities.NewSiteID(2)
+		dbErr := errors.New("db error")
+		mockDB.On("Select", mock.AnythingOfType("*[]*models.Page"), query, siteID.Value()).Return(dbErr)
+		mockLogger.On("Error", "Failed to find trashed pages by site ID", "siteID", siteID.Value(), "error", dbErr).Return()
+
+		result, err := repo.FindTrashedBySiteID(siteID)
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+		mockLogger.AssertExpectations(t)
+	})
+}
+
+func TestPageRepository_FindTrashedBefore(t *testing.T) {
+	mockDB := new(mocks.Database)
+	repo := &PageRepositoryImpl{db: mockDB, logger: new(mocks.Logger), mapper: &mocks.MockPageMapper{}}
+	before := time.Date(2025, 6, 29, 0, 0, 0, 0, time.UTC)
+	modelList := []*models.Page{{Base: models.Base{ID: 1}, Key: "about", SiteID: 1, Type: models.PageTypeContent}}
+	mockDB.On("Select", mock.AnythingOfType("*[]*models.Page"), "SELECT * FROM pages WHERE deleted_at < ?", before).Run(func(args mock.Arguments) {
+		*args.Get(0).(*[]*models.Page) = modelList
+	}).Return(nil)
+	mapperMock := repo.mapper.(*mocks.MockPageMapper)
+	mapperMock.On("ToDomains", modelList).Return([]*entities.Page{{}}, nil)
+
+	result, err := repo.FindTrashedBefore(before)
+
+	assert.NoError(t, err)
+	assert.Len(t, result, 1)
+	mockDB.AssertExpectations(t)
+}
@@ -9,6 +9,9 @@ import (
 	"github.com/h4rdc0m/aurora-api/domain/repositories"
 	"github.com/h4rdc0m/aurora-api/infrastructure/persistence/mappers"
 	"github.com/h4rdc0m/aurora-api/infrastructure/persistence/models"
+	"github.com/h4rdc0m/aurora-api/infrastructure/persistence/mysql"
+	"github.com/jmoiron/sqlx"
+	"time"
 )
 
 // PageVersionRepositoryImpl implements PageVersionRepository using sqlx and squirrel
@@ -27,6 +30,15 @@ func NewPageVersionRepository(db common.Database, logger common.Logger) reposito
 	}
 }
 
+// WithTrx returns a copy of the repository that runs its queries inside the given transaction
+func (r *PageVersionRepositoryImpl) WithTrx(trxHandle *sqlx.Tx) repositories.PageVersionRepository {
+	return &PageVersionRepositoryImpl{
+		db:     mysql.NewTransaction(trxHandle, r.logger),
+		logger: r.logger,
+		mapper: r.mapper,
+	}
+}
+
 // Save saves a page version (create or update)
 func (r *PageVersionRepositoryImpl) Save(version *entities.PageVersion) error {
 	model, err := r.mapper.ToModel(version)
@@ -37,8 +49,8 @@ func (r *PageVersionRepositoryImpl) Save(version *entities.PageVersion) error {
 
 	if model.ID == 0 {
 		query, args, err := squirrel.Insert("page_versions").
-			Columns("page_id", "version", "is_published").
-			Values(model.PageID, model.Version, model.IsPublished).
+			Columns("page_id", "locale", "version", "title", "description", "meta_title", "canonical_url", "robots", "og_title", "og_description", "og_image", "twitter_card", "status", "status_changed_by", "status_changed_at", "publish_at", "unpublish_at", "scheduled_by", "created_at", "updated_at", "revision").
+			Values(model.PageID, model.Locale, model.Version, model.Title, model.Description, model.MetaTitle, model.CanonicalURL, model.Robots, model.OgTitle, model.OgDescription, model.OgImage, model.TwitterCard, model.Status, model.StatusChangedBy, model.StatusChangedAt, model.PublishAt, model.UnpublishAt, model.ScheduledBy, model.CreatedAt, model.UpdatedAt, initialRevision).
 			PlaceholderFormat(squirrel.Question).
 			ToSql()
 		if err != nil {
@@ -56,23 +68,47 @@ func (r *PageVersionRepositoryImpl) Save(version *entities.PageVersion) error {
 			return err
 		}
 		version.SetID(entities.NewPageVersionID(uint64(id)))
+		version.SetRevision(initialRevision)
 	} else {
 		query, args, err := squirrel.Update("page_versions").
 			Set("page_id", model.PageID).
+			Set("locale", model.Locale).
 			Set("version", model.Version).
-			Set("is_published", model.IsPublished).
-			Set("created_at", model.CreatedAt).
-			Where(squirrel.Eq{"id": model.ID}).
+			Set("title", model.Title).
+			Set("description", model.Description).
+			Set("meta_title", model.MetaTitle).
+			Set("canonical_url", model.CanonicalURL).
+			Set("robots", model.Robots).
+			Set("og_title", model.OgTitle).
+			Set("og_description", model.OgDescription).
+			Set("og_image", model.OgImage).
+			Set("twitter_card", model.TwitterCard).
+			Set("status", model.Status).
+			Set("status_changed_by", model.StatusChangedBy).
+			Set("status_changed_at", model.StatusChangedAt).
+			Set("publish_at", model.PublishAt).
+			Set("unpublish_at", model.UnpublishAt).
+			Set("scheduled_by", model.ScheduledBy).
+			Set("updated_at", model.UpdatedAt).
+			Set("deleted_at", model.DeletedAt).
+			Set("revision", model.Revision+1).
+			Where(squirrel.Eq{"id": model.ID, "revision": model.Revision}).
 			PlaceholderFormat(squirrel.Question).
 			ToSql()
 		if err != nil {
 			r.logger.Error("Failed to build update query for page version", "error", err)
 			return err
 		}
-		if _, err := r.db.Exec(query, args...); err != nil {
+		result, err := r.db.Exec(query, args...)
+		if err != nil {
 			r.logger.Error("Failed to update page version", "id", model.ID, "error", err)
 			return err
 		}
+		if err := checkRevisionUpdate(result); err != nil {
+			r.logger.Warn("Rejected update of modified page version", "id", model.ID, "revision", model.Revision, "error", err)
+			return err
+		}
+		version.SetRevision(model.Revision + 1)
 	}
 	return nil
 }
@@ -80,7 +116,7 @@ func (r *PageVersionRepositoryImpl) Save(version *entities.PageVersion) error {
 // FindByID retrieves a page version by ID
 func (r *PageVersionRepositoryImpl) FindByID(id entities.PageVersionID) (*entities.PageVersion, error) {
 	var model models.PageVersion
-	query, args, err := squirrel.Select("*").From("page_versions").Where(squirrel.Eq{"id": id.Value()}).ToSql()
+	query, args, err := squirrel.Select("*").From("page_versions").Where(squirrel.Eq{"deleted_at": nil, "id": id.Value()}).ToSql()
 	if err != nil {
 		r.logger.Error("Failed to build select query for FindByID", "error", err)
 		return nil, err
@@ -98,7 +134,7 @@ func (r *PageVersionRepositoryImpl) FindByID(id entities.PageVersionID) (*entiti
 // FindByPageID retrieves all versions for a specific page
 func (r *PageVersionRepositoryImpl) FindByPageID(pageID entities.PageID) ([]*entities.PageVersion, error) {
 	var modelList []*models.PageVersion
-	query, args, err := squirrel.Select("*").From("page_versions").Where(squirrel.Eq{"page_id": pageID.Value()}).OrderBy("version DESC").ToSql()
+	query, args, err := squirrel.Select("*").From("page_versions").Where(squirrel.Eq{"deleted_at": nil, "page_id": pageID.Value()}).OrderBy("version DESC").ToSql()
 	if err != nil {
 		r.logger.Error("Failed to build select query for FindByPageID", "error", err)
 		return nil, err
@@ -110,10 +146,10 @@ func (r *PageVersionRepositoryImpl) FindByPageID(pageID entities.PageID) ([]*ent
 	return r.mapper.ToDomains(modelList)
 }
 
-// FindPublishedByPageID retrieves the published version for a page
-func (r *PageVersionRepositoryImpl) FindPublishedByPageID(pageID entities.PageID) (*entities.PageVersion, error) {
+// FindPublishedByPageID retrieves the published version for a page in the given locale
+func (r *PageVersionRepositoryImpl) FindPublishedByPageID(pageID entities.PageID, locale entities.Locale) (*entities.PageVersion, error) {
 	var model models.PageVersion
-	query, args, err := squirrel.Select("*").From("page_versions").Where(squirrel.Eq{"page_id": pageID.Value(), "is_published": true}).ToSql()
+	query, args, err := squirrel.Select("*").From("page_versions").Where(squirrel.Eq{"deleted_at": nil, "page_id": pageID.Value(), "locale": string(locale), "status": string(entities.PageVersionStatusPublished)}).ToSql()
 	if err != nil {
 		r.logger.Error("Failed to build select query for FindPublishedByPageID", "error", err)
 		return nil, err
@@ -122,7 +158,7 @@ func (r *PageVersionRepositoryImpl) FindPublishedByPageID(pageID entities.PageID
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, nil
 		}
-		r.logger.Error("Failed to find published page version", "page_id", pageID.Value(), "error", err)
+		r.logger.Error("Failed to find published page version", "page_id", pageID.Value(), "locale", locale, "error", err)
 		return nil, err
 	}
 	return r.mapper.ToDomain(&model)
@@ -131,7 +167,7 @@ func (r *PageVersionRepositoryImpl) FindPublishedByPageID(pageID entities.PageID
 // FindLatestByPageID retrieves the latest version for a page
 func (r *PageVersionRepositoryImpl) FindLatestByPageID(pageID entities.PageID) (*entities.PageVersion, error) {
 	var model models.PageVersion
-	query, args, err := squirrel.Select("*").From("page_versions").Where(squirrel.Eq{"page_id": pageID.Value()}).OrderBy("version DESC").Limit(1).ToSql()
+	query, args, err := squirrel.Select("*").From("page_versions").Where(squirrel.Eq{"deleted_at": nil, "page_id": pageID.Value()}).OrderBy("version DESC").Limit(1).ToSql()
 	if err != nil {
 		r.logger.Error("Failed to build select query for FindLatestByPageID", "error", err)
 		return nil, err
@@ -146,7 +182,172 @@ func (r *PageVersionRepositoryImpl) FindLatestByPageID(pageID entities.PageID) (
 	return r.mapper.ToDomain(&model)
 }
 
-// Delete deletes a page version (soft delete)
+// FindPublishedBySiteID retrieves the published versions of all pages of a site, in every locale
+func (r *PageVersionRepositoryImpl) FindPublishedBySiteID(siteID entities.SiteID) ([]*entities.PageVersion, error) {
+	var modelList []*models.PageVersion
+	query, args, err := squirrel.Select("page_versions.*").From("page_versions").
+		Join("pages ON pages.id = page_versions.page_id").
+		Where(squirrel.Eq{
+			"pages.site_id":            siteID.Value(),
+			"pages.deleted_at":         nil,
+			"page_versions.status":     string(entities.PageVersionStatusPublished),
+			"page_versions.deleted_at": nil,
+		}).
+		ToSql()
+	if err != nil {
+		r.logger.Error("Failed to build select query for FindPublishedBySiteID", "error", err)
+		return nil, err
+	}
+	if err := r.db.Select(&modelList, query, args...); err != nil {
+		r.logger.Error("Failed to find published page versions by site ID", "site_id", siteID.Value(), "error", err)
+		return nil, err
+	}
+	return r.mapper.ToDomains(modelList)
+}
+
+// sitemapOrder is the order of the pages in a sitemap, shared by its parts and its index
+const sitemapOrder = "pages.path ASC, pages.id ASC, page_versions.locale ASC"
+
+// FindSitemapPages retrieves a window of the published content pages of a site in the given locales, ordered by
+// path and locale. The last modification of a page is the most recent update of the page or its published version.
+func (r *PageVersionRepositoryImpl) FindSitemapPages(siteID entities.SiteID, locales []entities.Locale, offset, limit uint64) ([]entities.SitemapPage, error) {
+	var rows []*models.SitemapPage
+	query, args, err := sitemapPagesQuery(siteID, locales,
+		"pages.`key`",
+		"pages.path",
+		"page_versions.locale",
+		"pages.updated_at AS page_updated_at",
+		"page_versions.updated_at AS version_updated_at",
+	).
+		OrderBy(sitemapOrder).
+		Limit(limit).
+		Offset(offset).
+		ToSql()
+	if err != nil {
+		r.logger.Error("Failed to build select query for FindSitemapPages", "error", err)
+		return nil, err
+	}
+	if err := r.db.Select(&rows, query, args...); err != nil {
+		r.logger.Error("Failed to find sitemap pages by site ID", "site_id", siteID.Value(), "error", err)
+		return nil, err
+	}
+
+	pages := make([]entities.SitemapPage, 0, len(rows))
+	for _, row := range rows {
+		// Mirrors Page.FullPath for pages whose path has not been built yet
+		pagePath := "/" + row.Key
+		if row.Path != nil {
+			pagePath = *row.Path
+		}
+		lastModified := row.PageUpdatedAt
+		if row.VersionUpdatedAt.After(lastModified) {
+			lastModified = row.VersionUpdatedAt
+		}
+		pages = append(pages, entities.SitemapPage{Path: pagePath, Locale: entities.Locale(row.Locale), LastModified: lastModified})
+	}
+	return pages, nil
+}
+
+// FindSitemapParts summarizes the sitemap of a site in the given locales as consecutive parts of partSize pages
+// in sitemap order, counting the pages of each part and their most recent modification without loading them.
+func (r *PageVersionRepositoryImpl) FindSitemapParts(siteID entities.SiteID, locales []entities.Locale, partSize uint64) ([]entities.SitemapPartSummary, error) {
+	var rows []*models.SitemapPartSummary
+	positions := sitemapPagesQuery(siteID, locales,
+		"ROW_NUMBER() OVER (ORDER BY "+sitemapOrder+") AS position",
+		"GREATEST(pages.updated_at, page_versions.updated_at) AS last_modified",
+	)
+	query, args, err := squirrel.Select().
+		Column(squirrel.Expr("FLOOR((position - 1) / ?) + 1 AS number", partSize)).
+		Columns("COUNT(*) AS url_count", "MAX(last_modified) AS last_modified").
+		FromSelect(positions, "sitemap_pages").
+		GroupBy("number").
+		OrderBy("number ASC").
+		ToSql()
+	if err != nil {
+		r.logger.Error("Failed to build select query for FindSitemapParts", "error", err)
+		return nil, err
+	}
+	if err := r.db.Select(&rows, query, args...); err != nil {
+		r.logger.Error("Failed to summarize sitemap parts by site ID", "site_id", siteID.Value(), "error", err)
+		return nil, err
+	}
+
+	parts := make([]entities.SitemapPartSummary, 0, len(rows))
+	for _, row := range rows {
+		parts = append(parts, entities.SitemapPartSummary{Number: row.Number, URLCount: row.URLCount, LastModified: row.LastModified})
+	}
+	return parts, nil
+}
+
+// sitemapPagesQuery selects the given columns of the published versions of the content pages of a site in the
+// given locales
+func sitemapPagesQuery(siteID entities.SiteID, locales []entities.Locale, columns ...string) squirrel.SelectBuilder {
+	localeValues := make([]string, 0, len(locales))
+	for _, locale := range locales {
+		localeValues = append(localeValues, string(locale))
+	}
+
+	return squirrel.Select(columns...).From("page_versions").
+		Join("pages ON pages.id = page_versions.page_id").
+		Where(squirrel.Eq{
+			"pages.site_id":            siteID.Value(),
+			"pages.type":               string(models.PageTypeContent),
+			"pages.deleted_at":         nil,
+			"page_versions.status":     string(entities.PageVersionStatusPublished),
+			"page_versions.locale":     localeValues,
+			"page_versions.deleted_at": nil,
+		})
+}
+
+// FindDueScheduled retrieves the approved versions whose publish time and the published versions whose
+// unpublish time has been reached at the given moment, oldest first
+func (r *PageVersionRepositoryImpl) FindDueScheduled(now time.Time) ([]*entities.PageVersion, error) {
+	var modelList []*models.PageVersion
+	query, args, err := squirrel.Select("*").From("page_versions").
+		Where(squirrel.Eq{"deleted_at": nil}).
+		Where(squirrel.Or{
+			squirrel.And{
+				squirrel.Eq{"status": string(entities.PageVersionStatusApproved)},
+				squirrel.LtOrEq{"publish_at": now},
+			},
+			squirrel.And{
+				squirrel.Eq{"status": string(entities.PageVersionStatusPublished)},
+				squirrel.LtOrEq{"unpublish_at": now},
+			},
+		}).
+		OrderBy("updated_at ASC").
+		ToSql()
+	if err != nil {
+		r.logger.Error("Failed to build select query for FindDueScheduled", "error", err)
+		return nil, err
+	}
+	if err := r.db.Select(&modelList, query, args...); err != nil {
+		r.logger.Error("Failed to find scheduled page versions", "error", err)
+		return nil, err
+	}
+	return r.mapper.ToDomains(modelList)
+}
+
+// FindTrashedByPageID retrieves the trashed versions of a page
+func (r *PageVersionRepositoryImpl) FindTrashedByPageID(pageID entities.PageID) ([]*entities.PageVersion, error) {
+	var modelList []*models.PageVersion
+	query, args, err := squirrel.Select("*").From("page_versions").
+		Where(squirrel.Eq{"page_id": pageID.Value()}).
+		Where(squirrel.NotEq{"deleted_at": nil}).
+		OrderBy("version DESC").
+		ToSql()
+	if err != nil {
+		r.logger.Error("Failed to build select query for FindTrashedByPageID", "error", err)
+		return nil, err
+	}
+	if err := r.db.Select(&modelList, query, args...); err != nil {
+		r.logger.Error("Failed to find trashed page versions by page ID", "page_id", pageID.Value(), "error", err)
+		return nil, err
+	}
+	return r.mapper.ToDomains(modelList)
+}
+
+// Delete permanently deletes a page version, used to purge it from the trash
 func (r *PageVersionRepositoryImpl) Delete(id entities.PageVersionID) error {
 	query, args, err := squirrel.Delete("page_versions").Where(squirrel.Eq{"id": id.Value()}).ToSql()
 	if err != nil {
@@ -3,10 +3,12 @@ package repositories
 import (
 	"database/sql"
 	"errors"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/h4rdc0m/aurora-api/domain/entities"
+	domainErrors "github.com/h4rdc0m/aurora-api/domain/errors"
 	"github.com/h4rdc0m/aurora-api/infrastructure/persistence/models"
 	"github.com/h4rdc0m/aurora-api/tests/mocks"
 	"github.com/stretchr/testify/assert"
@@ -24,13 +26,13 @@ func TestPageVersionRepository_Save(t *testing.T) {
 		}
 
 		version := &entities.PageVersion{}
-		model := &models.PageVersion{PageID: 1, Version: 1, IsPublished: true, Base: models.Base{CreatedAt: time.Now(), UpdatedAt: time.Now()}}
+		model := &models.PageVersion{PageID: 1, Version: 1, Status: "published", Base: models.Base{CreatedAt: time.Now(), UpdatedAt: time.Now()}}
 		mapperMock := repo.mapper.(*mocks.MockPageVersionMapper)
 		mapperMock.On("ToModel", version).Return(model, nil)
 
 		mockResult := new(mocks.SqlResult)
 		mockResult.On("LastInsertId").Return(int64(42), nil)
-		mockDB.On("Exec", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(mockResult, nil)
+		mockDB.On("Exec", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(mockResult, nil)
 
 		err := repo.Save(version)
 		assert.NoError(t, err)
@@ -51,12 +53,13 @@ func TestPageVersionRepository_Save(t *testing.T) {
 
 		version := &entities.PageVersion{}
 		version.SetID(entities.NewPageVersionID(99))
-		model := &models.PageVersion{Base: models.Base{ID: 99, CreatedAt: time.Now(), UpdatedAt: time.Now()}, PageID: 1, Version: 2, IsPublished: false}
+		model := &models.PageVersion{Base: models.Base{ID: 99, CreatedAt: time.Now(), UpdatedAt: time.Now()}, PageID: 1, Version: 2, Status: "draft"}
 		mapperMock := repo.mapper.(*mocks.MockPageVersionMapper)
 		mapperMock.On("ToModel", version).Return(model, nil)
 
 		mockResult := new(mocks.SqlResult)
-		mockDB.On("Exec", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(mockResult, nil)
+		mockResult.On("RowsAffected").Return(int64(1), nil)
+		mockDB.On("Exec", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(mockResult, nil)
 
 		err := repo.Save(version)
 		assert.NoError(t, err)
@@ -91,7 +94,7 @@ func TestPageVersionRepository_Save(t *testing.T) {
 
 func TestPageVersionRepository_Save_ErrorBranches(t *testing.T) {
 	version := &entities.PageVersion{}
-	model := &models.PageVersion{PageID: 1, Version: 1, IsPublished: true, Base: models.Base{CreatedAt: time.Now(), UpdatedAt: time.Now()}}
+	model := &models.PageVersion{PageID: 1, Version: 1, Status: "published", Base: models.Base{CreatedAt: time.Now(), UpdatedAt: time.Now()}}
 
 	t.Run("insert exec error", func(t *testing.T) {
 		mockDB := new(mocks.Database)
@@ -99,7 +102,7 @@ func TestPageVersionRepository_Save_ErrorBranches(t *testing.T) {
 		repo := &PageVersionRepositoryImpl{db: mockDB, logger: mockLogger, mapper: &mocks.MockPageVersionMapper{}}
 		mapperMock := repo.mapper.(*mocks.MockPageVersionMapper)
 		mapperMock.On("ToModel", version).Return(model, nil)
-		mockDB.On("Exec", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(new(mocks.SqlResult), errors.New("exec error"))
+		mockDB.On("Exec", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(new(mocks.SqlResult), errors.New("exec error"))
 		mockLogger.On("Error", "Failed to create page version", "error", mock.Anything).Return()
 		err := repo.Save(version)
 		assert.Error(t, err)
@@ -116,7 +119,7 @@ func TestPageVersionRepository_Save_ErrorBranches(t *testing.T) {
 		mapperMock.On("ToModel", version).Return(model, nil)
 		mockResult := new(mocks.SqlResult)
 		mockResult.On("LastInsertId").Return(int64(0), errors.New("lastInsertId error"))
-		mockDB.On("Exec", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(mockResult, nil)
+		mockDB.On("Exec", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(mockResult, nil)
 		mockLogger.On("Error", "Failed to get last insert ID for page version", "error", mock.Anything).Return()
 		err := repo.Save(version)
 		assert.Error(t, err)
@@ -131,8 +134,8 @@ func TestPageVersionRepository_Save_ErrorBranches(t *testing.T) {
 		mockLogger := new(mocks.Logger)
 		repo := &PageVersionRepositoryImpl{db: mockDB, logger: mockLogger, mapper: &mocks.MockPageVersionMapper{}}
 		mapperMock := repo.mapper.(*mocks.MockPageVersionMapper)
-		mapperMock.On("ToModel", version).Return(&models.PageVersion{Base: models.Base{ID: 99, CreatedAt: time.Now(), UpdatedAt: time.Now()}, PageID: 1, Version: 2, IsPublished: false}, nil)
-		mockDB.On("Exec", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(new(mocks.SqlResult), errors.New("exec error"))
+		mapperMock.On("ToModel", version).Return(&models.PageVersion{Base: models.Base{ID: 99, CreatedAt: time.Now(), UpdatedAt: time.Now()}, PageID: 1, Version: 2, Status: "draft"}, nil)
+		mockDB.On("Exec", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(new(mocks.SqlResult), errors.New("exec error"))
 		mockLogger.On("Error", "Failed to update page version", "id", uint64(99), "error", mock.Anything).Return()
 		err := repo.Save(version)
 		assert.Error(t, err)
@@ -142,6 +145,58 @@ func TestPageVersionRepository_Save_ErrorBranches(t *testing.T) {
 	})
 }
 
+func TestPageVersionRepository_Save_Revision(t *testing.T) {
+	newRepo := func() (*PageVersionRepositoryImpl, *mocks.Database, *mocks.Logger, *entities.PageVersion) {
+		mockDB := new(mocks.Database)
+		mockLogger := new(mocks.Logger)
+		mapperMock := &mocks.MockPageVersionMapper{}
+		version := &entities.PageVersion{}
+		version.SetID(entities.NewPageVersionID(99))
+		version.SetRevision(3)
+		mapperMock.On("ToModel", version).Return(&models.PageVersion{Base: models.Base{ID: 99}, Revision: 3, PageID: 1, Version: 2, Status: "draft"}, nil)
+		return &PageVersionRepositoryImpl{db: mockDB, logger: mockLogger, mapper: mapperMock}, mockDB, mockLogger, version
+	}
+	// The update sets the next revision and only matches the row at the revision that was read
+	revisionGuarded := mock.MatchedBy(func(query string) bool {
+		return strings.Contains(query, "revision = ? WHERE id = ? AND revision = ?")
+	})
+	updateArgs := func() []interface{} {
+		args := []interface{}{revisionGuarded}
+		for i := 0; i < 20; i++ {
+			args = append(args, mock.Anything)
+		}
+		return append(args, uint64(4), uint64(99), uint64(3))
+	}
+
+	t.Run("increments the revision", func(t *testing.T) {
+		repo, mockDB, mockLogger, version := newRepo()
+		mockResult := new(mocks.SqlResult)
+		mockResult.On("RowsAffected").Return(int64(1), nil)
+		mockDB.On("Exec", updateArgs()...).Return(mockResult, nil)
+
+		err := repo.Save(version)
+
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(4), version.Revision())
+		mockDB.AssertExpectations(t)
+		mockLogger.AssertExpectations(t)
+	})
+
+	t.Run("concurrent modification", func(t *testing.T) {
+		repo, mockDB, mockLogger, version := newRepo()
+		mockResult := new(mocks.SqlResult)
+		mockResult.On("RowsAffected").Return(int64(0), nil)
+		mockDB.On("Exec", updateArgs()...).Return(mockResult, nil)
+		mockLogger.On("Warn", "Rejected update of modified page version", "id", uint64(99), "revision", uint64(3), "error", domainErrors.ErrConcurrentModification).Return()
+
+		err := repo.Save(version)
+
+		assert.ErrorIs(t, err, domainErrors.ErrConcurrentModification)
+		assert.Equal(t, uint64(3), version.Revision())
+		mockLogger.AssertExpectations(t)
+	})
+}
+
 func TestPageVersionRepository_FindByID(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		mockDB := new(mocks.Database)
@@ -158,7 +213,7 @@ func TestPageVersionRepository_FindByID(t *testing.T) {
 			version.ID = 123
 			version.PageID = 1
 			version.Version = 1
-			version.IsPublished = true
+			version.Status = "published"
 			version.CreatedAt = time.Now()
 			version.UpdatedAt = time.Now()
 		}).Return(nil)
@@ -254,16 +309,17 @@ func TestPageVersionRepository_FindPublishedByPageID(t *testing.T) {
 		mockLogger := new(mocks.Logger)
 		repo := &PageVersionRepositoryImpl{db: mockDB, logger: mockLogger, mapper: &mocks.MockPageVersionMapper{}}
 		pageID := entities.NewPageID(1)
-		mockDB.On("Get", mock.AnythingOfType("*models.PageVersion"), mock.Anything, true, pageID.Value()).Run(func(args mock.Arguments) {
+		mockDB.On("Get", mock.AnythingOfType("*models.PageVersion"), mock.Anything, "nl", pageID.Value(), "published").Run(func(args mock.Arguments) {
 			version := args.Get(0).(*models.PageVersion)
 			version.ID = 1
 			version.PageID = pageID.Value()
-			version.IsPublished = true
+			version.Locale = "nl"
+			version.Status = "published"
 		}).Return(nil)
 		expectedVersion := &entities.PageVersion{}
 		mapperMock := repo.mapper.(*mocks.MockPageVersionMapper)
 		mapperMock.On("ToDomain", mock.AnythingOfType("*models.PageVersion")).Return(expectedVersion, nil)
-		result, err := repo.FindPublishedByPageID(pageID)
+		result, err := repo.FindPublishedByPageID(pageID, "nl")
 		assert.NoError(t, err)
 		assert.NotNil(t, result)
 		assert.Equal(t, expectedVersion, result)
@@ -276,8 +332,8 @@ func TestPageVersionRepository_FindPublishedByPageID(t *testing.T) {
 		mockLogger := new(mocks.Logger)
 		repo := &PageVersionRepositoryImpl{db: mockDB, logger: mockLogger, mapper: &mocks.MockPageVersionMapper{}}
 		pageID := entities.NewPageID(2)
-		mockDB.On("Get", mock.AnythingOfType("*models.PageVersion"), mock.Anything, true, pageID.Value()).Return(sql.ErrNoRows)
-		result, err := repo.FindPublishedByPageID(pageID)
+		mockDB.On("Get", mock.AnythingOfType("*models.PageVersion"), mock.Anything, "nl", pageID.Value(), "published").Return(sql.ErrNoRows)
+		result, err := repo.FindPublishedByPageID(pageID, "nl")
 		assert.NoError(t, err)
 		assert.Nil(t, result)
 		mockDB.AssertExpectations(t)
@@ -289,9 +345,9 @@ func TestPageVersionRepository_FindPublishedByPageID(t *testing.T) {
 		repo := &PageVersionRepositoryImpl{db: mockDB, logger: mockLogger, mapper: &mocks.MockPageVersionMapper{}}
 		pageID := entities.NewPageID(3)
 		dbErr := errors.New("db error")
-		mockDB.On("Get", mock.AnythingOfType("*models.PageVersion"), mock.Anything, true, pageID.Value()).Return(dbErr)
-		mockLogger.On("Error", "Failed to find published page version", "page_id", pageID.Value(), "error", dbErr).Return()
-		result, err := repo.FindPublishedByPageID(pageID)
+		mockDB.On("Get", mock.AnythingOfType("*models.PageVersion"), mock.Anything, "nl", pageID.Value(), "published").Return(dbErr)
+		mockLogger.On("Error", "Failed to find published page version", "page_id", pageID.Value(), "locale", entities.Locale("nl"), "error", dbErr).Return()
+		result, err := repo.FindPublishedByPageID(pageID, "nl")
 		assert.Error(t, err)
 		assert.Nil(t, result)
 		mockDB.AssertExpectations(t)
@@ -350,6 +406,172 @@ func TestPageVersionRepository_FindLatestByPageID(t *testing.T) {
 	})
 }
 
+func TestPageVersionRepository_FindPublishedBySiteID(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		mockDB := new(mocks.Database)
+		mockLogger := new(mocks.Logger)
+		repo := &PageVersionRepositoryImpl{db: mockDB, logger: mockLogger, mapper: &mocks.MockPageVersionMapper{}}
+		siteID := entities.NewSiteID(1)
+		modelList := []*models.PageVersion{{Base: models.Base{ID: 1}, PageID: 1, Version: 1, Status: "published"}}
+		mockDB.On("Select", mock.AnythingOfType("*[]*models.PageVersion"), mock.Anything, "published", siteID.Value()).Run(func(args mock.Arguments) {
+			versions := args.Get(0).(*[]*models.PageVersion)
+			*versions = modelList
+		}).Return(nil)
+		mapperMock := repo.mapper.(*mocks.MockPageVersionMapper)
+		mapperMock.On("ToDomains", modelList).Return([]*entities.PageVersion{{}}, nil)
+		result, err := repo.FindPublishedBySiteID(siteID)
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+		mockDB.AssertExpectations(t)
+		mapperMock.AssertExpectations(t)
+	})
+	t.Run("db error", func(t *testing.T) {
+		mockDB := new(mocks.Database)
+		mockLogger := new(mocks.Logger)
+		repo := &PageVersionRepositoryImpl{db: mockDB, logger: mockLogger, mapper: &mocks.MockPageVersionMapper{}}
+		siteID := entities.NewSiteID(2)
+		dbErr := errors.New("db error")
+		mockDB.On("Select", mock.AnythingOfType("*[]*models.PageVersion"), mock.Anything, "published", siteID.Value()).Return(dbErr)
+		mockLogger.On("Error", "Failed to find published page versions by site ID", "site_id", siteID.Value(), "error", dbErr).Return()
+		result, err := repo.FindPublishedBySiteID(siteID)
+		assert.Error(t, err)
+		assert.Nil(t, result)
+		mockDB.AssertExpectations(t)
+		mockLogger.AssertExpectations(t)
+	})
+}
+
+func TestPageVersionRepository_FindSitemapPages(t *testing.T) {
+	siteID := entities.NewSiteID(1)
+	locales := []entities.Locale{"en", "de"}
+	windowQuery := mock.MatchedBy(func(query string) bool {
+		return strings.Contains(query, "ORDER BY pages.path ASC, pages.id ASC, page_versions.locale ASC") &&
+			strings.Contains(query, "LIMIT 50000 OFFSET 100000")
+	})
+
+	t.Run("success", func(t *testing.T) {
+		mockDB := new(mocks.Database)
+		mockLogger := new(mocks.Logger)
+		repo := &PageVersionRepositoryImpl{db: mockDB, logger: mockLogger, mapper: &mocks.MockPageVersionMapper{}}
+		pageUpdatedAt := time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)
+		versionUpdatedAt := pageUpdatedAt.Add(time.Hour)
+		path := "/about"
+		rows := []*models.SitemapPage{
+			{Key: "about", Path: &path, Locale: "de", PageUpdatedAt: pageUpdatedAt, VersionUpdatedAt: versionUpdatedAt},
+			{Key: "news", Locale: "en", PageUpdatedAt: versionUpdatedAt, VersionUpdatedAt: pageUpdatedAt},
+		}
+		mockDB.On("Select", mock.AnythingOfType("*[]*models.SitemapPage"), windowQuery, "en", "de", "published", siteID.Value(), "content").Run(func(args mock.Arguments) {
+			*args.Get(0).(*[]*models.SitemapPage) = rows
+		}).Return(nil)
+
+		result, err := repo.FindSitemapPages(siteID, locales, 100000, 50000)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []entities.SitemapPage{
+			{Path: "/about", Locale: "de", LastModified: versionUpdatedAt},
+			{Path: "/news", Locale: "en", LastModified: versionUpdatedAt},
+		}, result)
+		mockDB.AssertExpectations(t)
+	})
+	t.Run("db error", func(t *testing.T) {
+		mockDB := new(mocks.Database)
+		mockLogger := new(mocks.Logger)
+		repo := &PageVersionRepositoryImpl{db: mockDB, logger: mockLogger, mapper: &mocks.MockPageVersionMapper{}}
+		dbErr := errors.New("db error")
+		mockDB.On("Select", mock.AnythingOfType("*[]*models.SitemapPage"), windowQuery, "en", "de", "published", siteID.Value(), "content").Return(dbErr)
+		mockLogger.On("Error", "Failed to find sitemap pages by site ID", "site_id", siteID.Value(), "error", dbErr).Return()
+
+		result, err := repo.FindSitemapPages(siteID, locales, 100000, 50000)
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+		mockDB.AssertExpectations(t)
+		mockLogger.AssertExpectations(t)
+	})
+}
+
+func TestPageVersionRepository_FindSitemapParts(t *testing.T) {
+	siteID := entities.NewSiteID(1)
+	locales := []entities.Locale{"en", "de"}
+	summaryQuery := mock.MatchedBy(func(query string) bool {
+		return strings.Contains(query, "ROW_NUMBER() OVER (ORDER BY pages.path ASC, pages.id ASC, page_versions.locale ASC)") &&
+			strings.Contains(query, "GROUP BY number") &&
+			!strings.Contains(query, "LIMIT")
+	})
+
+	t.Run("success", func(t *testing.T) {
+		mockDB := new(mocks.Database)
+		mockLogger := new(mocks.Logger)
+		repo := &PageVersionRepositoryImpl{db: mockDB, logger: mockLogger, mapper: &mocks.MockPageVersionMapper{}}
+		lastModified := time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)
+		rows := []*models.SitemapPartSummary{
+			{Number: 1, URLCount: 50000, LastModified: lastModified},
+			{Number: 2, URLCount: 7, LastModified: lastModified.Add(time.Hour)},
+		}
+		mockDB.On("Select", mock.AnythingOfType("*[]*models.SitemapPartSummary"), summaryQuery, uint64(50000), "en", "de", "published", siteID.Value(), "content").Run(func(args mock.Arguments) {
+			*args.Get(0).(*[]*models.SitemapPartSummary) = rows
+		}).Return(nil)
+
+		result, err := repo.FindSitemapParts(siteID, locales, 50000)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []entities.SitemapPartSummary{
+			{Number: 1, URLCount: 50000, LastModified: lastModified},
+			{Number: 2, URLCount: 7, LastModified: lastModified.Add(time.Hour)},
+		}, result)
+		mockDB.AssertExpectations(t)
+	})
+	t.Run("db error", func(t *testing.T) {
+		mockDB := new(mocks.Database)
+		mockLogger := new(mocks.Logger)
+		repo := &PageVersionRepositoryImpl{db: mockDB, logger: mockLogger, mapper: &mocks.MockPageVersionMapper{}}
+		dbErr := errors.New("db error")
+		mockDB.On("Select", mock.AnythingOfType("*[]*models.SitemapPartSummary"), summaryQuery, uint64(50000), "en", "de", "published", siteID.Value(), "content").Return(dbErr)
+		mockLogger.On("Error", "Failed to summarize sitemap parts by site ID", "site_id", siteID.Value(), "error", dbErr).Return()
+
+		result, err := repo.FindSitemapParts(siteID, locales, 50000)
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+		mockDB.AssertExpectations(t)
+		mockLogger.AssertExpectations(t)
+	})
+}
+
+func TestPageVersionRepository_FindDueScheduled(t *testing.T) {
+	now := time.Date(2025, 7, 17, 0, 0, 0, 0, time.UTC)
+	t.Run("success", func(t *testing.T) {
+		mockDB := new(mocks.Database)
+		mockLogger := new(mocks.Logger)
+		repo := &PageVersionRepositoryImpl{db: mockDB, logger: mockLogger, mapper: &mocks.MockPageVersionMapper{}}
+		modelList := []*models.PageVersion{{Base: models.Base{ID: 1}, PageID: 1, Version: 1, Status: "approved", PublishAt: &now}}
+		mockDB.On("Select", mock.AnythingOfType("*[]*models.PageVersion"), mock.Anything, "approved", now, "published", now).Run(func(args mock.Arguments) {
+			versions := args.Get(0).(*[]*models.PageVersion)
+			*versions = modelList
+		}).Return(nil)
+		mapperMock := repo.mapper.(*mocks.MockPageVersionMapper)
+		mapperMock.On("ToDomains", modelList).Return([]*entities.PageVersion{{}}, nil)
+		result, err := repo.FindDueScheduled(now)
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+		mockDB.AssertExpectations(t)
+		mapperMock.AssertExpectations(t)
+	})
+	t.Run("db error", func(t *testing.T) {
+		mockDB := new(mocks.Database)
+		mockLogger := new(mocks.Logger)
+		repo := &PageVersionRepositoryImpl{db: mockDB, logger: mockLogger, mapper: &mocks.MockPageVersionMapper{}}
+		dbErr := errors.New("db error")
+		mockDB.On("Select", mock.AnythingOfType("*[]*models.PageVersion"), mock.Anything, "approved", now, "published", now).Return(dbErr)
+		mockLogger.On("Error", "Failed to find scheduled page versions", "error", dbErr).Return()
+		result, err := repo.FindDueScheduled(now)
+		assert.Error(t, err)
+		assert.Nil(t, result)
+		mockDB.AssertExpectations(t)
+		mockLogger.AssertExpectations(t)
+	})
+}
+
 func TestPageVersionRepository_Delete(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		mockDB := new(mocks.Database)
@@ -377,3 +599,40 @@ func TestPageVersionRepository_Delete(t *testing.T) {
 		mockLogger.AssertExpectations(t)
 	})
 }
+
+func TestPageVersionRepository_FindTrashedByPageID(t *testing.T) {
+	query := "SELECT * FROM page_versions WHERE page_id = ? AND deleted_at IS NOT NULL ORDER BY version DESC"
+	t.Run("success", func(t *testing.T) {
+		mockDB := new(mocks.Database)
+		repo := &PageVersionRepositoryImpl{db: mockDB, logger: new(mocks.Logger), mapper: &mocks.MockPageVersionMapper{}}
+		pageID := entities.NewPageID(1)
+		modelList := []*models.PageVersion{{Base: models.Base{ID: 1}, PageID: 1, Version: 1, Title: "About", Status: "draft"}}
+		mockDB.On("Select", mock.AnythingOfType("*[]*models.PageVersion"), query, pageID.Value()).Run(func(args mock.Arguments) {
+			*args.Get(0).(*[]*models.PageVersion) = modelList
+		}).Return(nil)
+		mapperMock := repo.mapper.(*mocks.MockPageVersionMapper)
+		mapperMock.On("ToDomains", modelList).Return([]*entities.PageVersion{{}}, nil)
+
+		result, err := repo.FindTrashedByPageID(pageID)
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+		mockDB.AssertExpectations(t)
+		mapperMock.AssertExpectations(t)
+	})
+	t.Run("db error", func(t *testing.T) {
+		mockDB := new(mocks.Database)
+		mockLogger := new(mocks.Logger)
+		repo := &PageVersionRepositoryImpl{db: mockDB, logger: mockLogger, mapper: &mocks.MockPageVersionMapper{}}
+		pageID := entities.NewPageID(2)
+		dbErr := errors.New("db error")
+		mockDB.On("Select", mock.AnythingOfType("*[]*models.PageVersion"), query, pageID.Value()).Return(dbErr)
+		mockLogger.On("Error", "Failed to find trashed page versions by page ID", "page_id", pageID.Value(), "error", dbErr).Return()
+
+		result, err := repo.FindTrashedByPageID(pageID)
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+		mockLogger.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,173 @@
+package repositories
+
+import (
+	"database/sql"
+	"errors"
+	"github.com/Masterminds/squirrel"
+	"github.com/h4rdc0m/aurora-api/domain/common"
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"github.com/h4rdc0m/aurora-api/domain/repositories"
+	"github.com/h4rdc0m/aurora-api/infrastructure/persistence/mappers"
+	"github.com/h4rdc0m/aurora-api/infrastructure/persistence/models"
+	"github.com/h4rdc0m/aurora-api/infrastructure/persistence/mysql"
+	"github.com/jmoiron/sqlx"
+)
+
+// RedirectRepositoryImpl implements RedirectRepository using sqlx and squirrel
+type RedirectRepositoryImpl struct {
+	db     common.Database
+	logger common.Logger
+	mapper common.Mapper[*entities.Redirect, *models.Redirect]
+}
+
+// NewRedirectRepository creates a new RedirectRepository implementation
+func NewRedirectRepository(db common.Database, logger common.Logger) repositories.RedirectRepository {
+	return &RedirectRepositoryImpl{
+		db:     db,
+		logger: logger,
+		mapper: mappers.NewRedirectMapper(),
+	}
+}
+
+// WithTrx returns a copy of the repository that runs its queries inside the given transaction
+func (r *RedirectRepositoryImpl) WithTrx(trxHandle *sqlx.Tx) repositories.RedirectRepository {
+	return &RedirectRepositoryImpl{
+		db:     mysql.NewTransaction(trxHandle, r.logger),
+		logger: r.logger,
+		mapper: r.mapper,
+	}
+}
+
+// Save saves a redirect (create or update). The hit counter is only changed by RecordHit.
+func (r *RedirectRepositoryImpl) Save(redirect *entities.Redirect) error {
+	model, err := r.mapper.ToModel(redirect)
+	if err != nil {
+		r.logger.Error("Failed to convert redirect to model", "error", err)
+		return err
+	}
+
+	if model.ID == 0 {
+		query, args, err := squirrel.Insert("redirects").
+			Columns("site_id", "source_path", "target_page_id", "target_url", "status_code", "is_automatic", "created_at", "updated_at").
+			Values(model.SiteID, model.SourcePath, model.TargetPageID, model.TargetURL, model.StatusCode, model.IsAutomatic, model.CreatedAt, model.UpdatedAt).
+			PlaceholderFormat(squirrel.Question).
+			ToSql()
+		if err != nil {
+			r.logger.Error("Failed to build insert query for redirect", "error", err)
+			return err
+		}
+		result, err := r.db.Exec(query, args...)
+		if err != nil {
+			r.logger.Error("Failed to create redirect", "error", err)
+			return err
+		}
+		id, err := result.LastInsertId()
+		if err != nil {
+			r.logger.Error("Failed to get last insert ID for redirect", "error", err)
+			return err
+		}
+		redirect.SetID(entities.NewRedirectID(uint64(id)))
+	} else {
+		query, args, err := squirrel.Update("redirects").
+			Set("source_path", model.SourcePath).
+			Set("target_page_id", model.TargetPageID).
+			Set("target_url", model.TargetURL).
+			Set("status_code", model.StatusCode).
+			Set("is_automatic", model.IsAutomatic).
+			Set("updated_at", model.UpdatedAt).
+			Where(squirrel.Eq{"id": model.ID}).
+			PlaceholderFormat(squirrel.Question).
+			ToSql()
+		if err != nil {
+			r.logger.Error("Failed to build update query for redirect", "error", err)
+			return err
+		}
+		if _, err := r.db.Exec(query, args...); err != nil {
+			r.logger.Error("Failed to update redirect", "id", model.ID, "error", err)
+			return err
+		}
+	}
+	return nil
+}
+
+// FindByID retrieves a redirect by ID
+func (r *RedirectRepositoryImpl) FindByID(id entities.RedirectID) (*entities.Redirect, error) {
+	var model models.Redirect
+	query, args, err := squirrel.Select("*").From("redirects").Where(squirrel.Eq{"id": id.Value()}).ToSql()
+	if err != nil {
+		r.logger.Error("Failed to build select query for FindByID", "error", err)
+		return nil, err
+	}
+	if err := r.db.Get(&model, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		r.logger.Error("Failed to find redirect by ID", "id", id.Value(), "error", err)
+		return nil, err
+	}
+	return r.mapper.ToDomain(&model)
+}
+
+// FindBySourcePath retrieves the redirect of a site for a path
+func (r *RedirectRepositoryImpl) FindBySourcePath(siteID entities.SiteID, sourcePath string) (*entities.Redirect, error) {
+	var model models.Redirect
+	query, args, err := squirrel.Select("*").From("redirects").Where(squirrel.Eq{"site_id": siteID.Value(), "source_path": sourcePath}).ToSql()
+	if err != nil {
+		r.logger.Error("Failed to build select query for FindBySourcePath", "error", err)
+		return nil, err
+	}
+	if err := r.db.Get(&model, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		r.logger.Error("Failed to find redirect by source path", "siteID", siteID.Value(), "path", sourcePath, "error", err)
+		return nil, err
+	}
+	return r.mapper.ToDomain(&model)
+}
+
+// FindBySiteID retrieves all redirects of a site ordered by source path
+func (r *RedirectRepositoryImpl) FindBySiteID(siteID entities.SiteID) ([]*entities.Redirect, error) {
+	var modelList []*models.Redirect
+	query, args, err := squirrel.Select("*").From("redirects").Where(squirrel.Eq{"site_id": siteID.Value()}).OrderBy("source_path ASC").ToSql()
+	if err != nil {
+		r.logger.Error("Failed to build select query for FindBySiteID", "error", err)
+		return nil, err
+	}
+	if err := r.db.Select(&modelList, query, args...); err != nil {
+		r.logger.Error("Failed to find redirects by site ID", "siteID", siteID.Value(), "error", err)
+		return nil, err
+	}
+	return r.mapper.ToDomains(modelList)
+}
+
+// RecordHit increments the hit counter of a redirect in a single statement, so concurrent hits are all counted
+func (r *RedirectRepositoryImpl) RecordHit(id entities.RedirectID) error {
+	query, args, err := squirrel.Update("redirects").
+		Set("hits", squirrel.Expr("hits + 1")).
+		Where(squirrel.Eq{"id": id.Value()}).
+		ToSql()
+	if err != nil {
+		r.logger.Error("Failed to build update query for redirect hit", "id", id.Value(), "error", err)
+		return err
+	}
+	if _, err := r.db.Exec(query, args...); err != nil {
+		r.logger.Error("Failed to record redirect hit", "id", id.Value(), "error", err)
+		return err
+	}
+	return nil
+}
+
+// Delete deletes a redirect
+func (r *RedirectRepositoryImpl) Delete(id entities.RedirectID) error {
+	query, args, err := squirrel.Delete("redirects").Where(squirrel.Eq{"id": id.Value()}).ToSql()
+	if err != nil {
+		r.logger.Error("Failed to build delete query for redirect", "id", id.Value(), "error", err)
+		return err
+	}
+	if _, err := r.db.Exec(query, args...); err != nil {
+		r.logger.Error("Failed to delete redirect", "id", id.Value(), "error", err)
+		return err
+	}
+	return nil
+}
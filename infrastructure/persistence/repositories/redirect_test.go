@@ -0,0 +1,140 @@
+package repositories
+
+import (
+	"database/sql"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"github.com/h4rdc0m/aurora-api/infrastructure/persistence/models"
+	"github.com/h4rdc0m/aurora-api/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newTestRedirectRepository() (*RedirectRepositoryImpl, *mocks.Database, *mocks.Logger, *mocks.MockRedirectMapper) {
+	mockDB := new(mocks.Database)
+	mockLogger := new(mocks.Logger)
+	mapperMock := &mocks.MockRedirectMapper{}
+	return &RedirectRepositoryImpl{db: mockDB, logger: mockLogger, mapper: mapperMock}, mockDB, mockLogger, mapperMock
+}
+
+func TestRedirectRepository_Save(t *testing.T) {
+	t.Run("insert success", func(t *testing.T) {
+		repo, mockDB, mockLogger, mapperMock := newTestRedirectRepository()
+		redirect := &entities.Redirect{}
+		mapperMock.On("ToModel", redirect).Return(&models.Redirect{SiteID: 1, SourcePath: "/old", StatusCode: 410}, nil)
+		mockResult := new(mocks.SqlResult)
+		mockResult.On("LastInsertId").Return(int64(42), nil)
+		mockDB.On("Exec", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(mockResult, nil)
+
+		err := repo.Save(redirect)
+
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(42), redirect.ID().Value())
+		mockDB.AssertExpectations(t)
+		mockLogger.AssertExpectations(t)
+		mapperMock.AssertExpectations(t)
+	})
+
+	t.Run("update leaves the hit counter alone", func(t *testing.T) {
+		repo, mockDB, mockLogger, mapperMock := newTestRedirectRepository()
+		redirect := &entities.Redirect{}
+		redirect.SetID(entities.NewRedirectID(99))
+		mapperMock.On("ToModel", redirect).Return(&models.Redirect{Base: models.Base{ID: 99}, SiteID: 1, SourcePath: "/old", StatusCode: 410, Hits: 5}, nil)
+		mockDB.On("Exec", mock.MatchedBy(func(query string) bool {
+			return !strings.Contains(query, "hits")
+		}), mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, uint64(99)).Return(new(mocks.SqlResult), nil)
+
+		err := repo.Save(redirect)
+
+		assert.NoError(t, err)
+		mockDB.AssertExpectations(t)
+		mockLogger.AssertExpectations(t)
+	})
+
+	t.Run("mapper error", func(t *testing.T) {
+		repo, mockDB, mockLogger, mapperMock := newTestRedirectRepository()
+		redirect := &entities.Redirect{}
+		mapperErr := errors.New("mapper error")
+		mapperMock.On("ToModel", redirect).Return(nil, mapperErr)
+		mockLogger.On("Error", "Failed to convert redirect to model", "error", mapperErr).Return()
+
+		assert.Equal(t, mapperErr, repo.Save(redirect))
+		mockDB.AssertNotCalled(t, "Exec")
+		mockLogger.AssertExpectations(t)
+	})
+}
+
+func TestRedirectRepository_FindBySourcePath(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		repo, mockDB, _, mapperMock := newTestRedirectRepository()
+		mockDB.On("Get", mock.AnythingOfType("*models.Redirect"), mock.Anything, uint64(3), "/old").Return(nil)
+		expected := &entities.Redirect{}
+		mapperMock.On("ToDomain", mock.AnythingOfType("*models.Redirect")).Return(expected, nil)
+
+		result, err := repo.FindBySourcePath(entities.NewSiteID(3), "/old")
+
+		assert.NoError(t, err)
+		assert.Same(t, expected, result)
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		repo, mockDB, _, _ := newTestRedirectRepository()
+		mockDB.On("Get", mock.AnythingOfType("*models.Redirect"), mock.Anything, uint64(3), "/old").Return(sql.ErrNoRows)
+
+		result, err := repo.FindBySourcePath(entities.NewSiteID(3), "/old")
+
+		assert.NoError(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestRedirectRepository_FindBySiteID(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		repo, mockDB, _, mapperMock := newTestRedirectRepository()
+		modelList := []*models.Redirect{{SiteID: 3, SourcePath: "/old", StatusCode: 410}}
+		mockDB.On("Select", mock.AnythingOfType("*[]*models.Redirect"), mock.Anything, uint64(3)).Run(func(args mock.Arguments) {
+			*args.Get(0).(*[]*models.Redirect) = modelList
+		}).Return(nil)
+		mapperMock.On("ToDomains", modelList).Return([]*entities.Redirect{{}}, nil)
+
+		result, err := repo.FindBySiteID(entities.NewSiteID(3))
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+	})
+
+	t.Run("db error", func(t *testing.T) {
+		repo, mockDB, mockLogger, _ := newTestRedirectRepository()
+		dbErr := errors.New("db error")
+		mockDB.On("Select", mock.AnythingOfType("*[]*models.Redirect"), mock.Anything, uint64(3)).Return(dbErr)
+		mockLogger.On("Error", "Failed to find redirects by site ID", "siteID", uint64(3), "error", dbErr).Return()
+
+		result, err := repo.FindBySiteID(entities.NewSiteID(3))
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+		mockLogger.AssertExpectations(t)
+	})
+}
+
+func TestRedirectRepository_RecordHit(t *testing.T) {
+	repo, mockDB, _, _ := newTestRedirectRepository()
+	id := entities.NewRedirectID(5)
+	mockDB.On("Exec", "UPDATE redirects SET hits = hits + 1 WHERE id = ?", id.Value()).Return(new(mocks.SqlResult), nil)
+
+	assert.NoError(t, repo.RecordHit(id))
+	mockDB.AssertExpectations(t)
+}
+
+func TestRedirectRepository_Delete(t *testing.T) {
+	repo, mockDB, _, _ := newTestRedirectRepository()
+	id := entities.NewRedirectID(5)
+	mockDB.On("Exec", mock.Anything, id.Value()).Return(new(mocks.SqlResult), nil)
+
+	assert.NoError(t, repo.Delete(id))
+	mockDB.AssertExpectations(t)
+}
@@ -0,0 +1,22 @@
+package repositories
+
+import (
+	"database/sql"
+	"github.com/h4rdc0m/aurora-api/domain/errors"
+)
+
+// initialRevision is the revision of newly created rows; every update increments it
+const initialRevision uint64 = 1
+
+// checkRevisionUpdate verifies an update guarded by the revision read with the row changed it. No affected row means
+// the row was changed or deleted since it was read, which is reported as ErrConcurrentModification.
+func checkRevisionUpdate(result sql.Result) error {
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return errors.ErrConcurrentModification
+	}
+	return nil
+}
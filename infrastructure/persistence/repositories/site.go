@@ -10,6 +10,9 @@ import (
 	"github.com/h4rdc0m/aurora-api/domain/value_objects"
 	"github.com/h4rdc0m/aurora-api/infrastructure/persistence/mappers"
 	"github.com/h4rdc0m/aurora-api/infrastructure/persistence/models"
+	"github.com/h4rdc0m/aurora-api/infrastructure/persistence/mysql"
+	"github.com/jmoiron/sqlx"
+	"time"
 )
 
 // SiteRepositoryImpl implements SiteRepository using sqlx and squirrel
@@ -28,6 +31,15 @@ func NewSiteRepository(db common.Database, logger common.Logger) repositories.Si
 	}
 }
 
+// WithTrx returns a copy of the repository that runs its queries inside the given transaction
+func (r *SiteRepositoryImpl) WithTrx(trxHandle *sqlx.Tx) repositories.SiteRepository {
+	return &SiteRepositoryImpl{
+		db:     mysql.NewTransaction(trxHandle, r.logger),
+		logger: r.logger,
+		mapper: r.mapper,
+	}
+}
+
 // Save saves a site (create or update)
 func (r *SiteRepositoryImpl) Save(site *entities.Site) error {
 	model, err := r.mapper.ToModel(site)
@@ -38,8 +50,8 @@ func (r *SiteRepositoryImpl) Save(site *entities.Site) error {
 
 	if model.ID == 0 {
 		query, args, err := squirrel.Insert("sites").
-			Columns("domain", "name", "tenant_id", "enabled", "created_at", "updated_at").
-			Values(model.Domain, model.Name, model.TenantID, model.Enabled, model.CreatedAt, model.UpdatedAt).
+			Columns("domain", "name", "description", "title_template", "robots_txt", "default_locale", "locales", "fallback_locales", "template_id", "tenant_id", "enabled", "created_at", "updated_at", "revision").
+			Values(model.Domain, model.Name, model.Description, model.TitleTemplate, model.RobotsTxt, model.DefaultLocale, model.Locales, model.FallbackLocales, model.TemplateID, model.TenantID, model.Enabled, model.CreatedAt, model.UpdatedAt, initialRevision).
 			PlaceholderFormat(squirrel.Question).
 			ToSql()
 		if err != nil {
@@ -60,25 +72,40 @@ func (r *SiteRepositoryImpl) Save(site *entities.Site) error {
 		if err != nil {
 			return err
 		}
+		site.SetRevision(initialRevision)
 	} else {
 		query, args, err := squirrel.Update("sites").
 			Set("domain", model.Domain).
 			Set("name", model.Name).
+			Set("description", model.Description).
+			Set("title_template", model.TitleTemplate).
+			Set("robots_txt", model.RobotsTxt).
+			Set("default_locale", model.DefaultLocale).
+			Set("locales", model.Locales).
+			Set("fallback_locales", model.FallbackLocales).
+			Set("template_id", model.TemplateID).
 			Set("tenant_id", model.TenantID).
 			Set("enabled", model.Enabled).
-			Set("created_at", model.CreatedAt).
 			Set("updated_at", model.UpdatedAt).
-			Where(squirrel.Eq{"id": model.ID}).
+			Set("deleted_at", model.DeletedAt).
+			Set("revision", model.Revision+1).
+			Where(squirrel.Eq{"id": model.ID, "revision": model.Revision}).
 			PlaceholderFormat(squirrel.Question).
 			ToSql()
 		if err != nil {
 			r.logger.Error("Failed to build update query for site", "error", err)
 			return err
 		}
-		if _, err := r.db.Exec(query, args...); err != nil {
+		result, err := r.db.Exec(query, args...)
+		if err != nil {
 			r.logger.Error("Failed to update site", "id", model.ID, "error", err)
 			return err
 		}
+		if err := checkRevisionUpdate(result); err != nil {
+			r.logger.Warn("Rejected update of modified site", "id", model.ID, "revision", model.Revision, "error", err)
+			return err
+		}
+		site.SetRevision(model.Revision + 1)
 	}
 	return nil
 }
@@ -86,7 +113,7 @@ func (r *SiteRepositoryImpl) Save(site *entities.Site) error {
 // FindByID retrieves a site by ID
 func (r *SiteRepositoryImpl) FindByID(id entities.SiteID) (*entities.Site, error) {
 	var model models.Site
-	query, args, err := squirrel.Select("*").From("sites").Where(squirrel.Eq{"id": id.Value()}).ToSql()
+	query, args, err := squirrel.Select("*").From("sites").Where(squirrel.Eq{"deleted_at": nil, "id": id.Value()}).ToSql()
 	if err != nil {
 		r.logger.Error("Failed to build select query for FindByID", "error", err)
 		return nil, err
@@ -104,7 +131,7 @@ func (r *SiteRepositoryImpl) FindByID(id entities.SiteID) (*entities.Site, error
 // FindByDomain retrieves a site by domain
 func (r *SiteRepositoryImpl) FindByDomain(domain *value_objects.DomainName) (*entities.Site, error) {
 	var model models.Site
-	query, args, err := squirrel.Select("*").From("sites").Where(squirrel.Eq{"domain": domain.Value()}).ToSql()
+	query, args, err := squirrel.Select("*").From("sites").Where(squirrel.Eq{"deleted_at": nil, "domain": domain.Value()}).ToSql()
 	if err != nil {
 		r.logger.Error("Failed to build select query for FindByDomain", "error", err)
 		return nil, err
@@ -122,7 +149,7 @@ func (r *SiteRepositoryImpl) FindByDomain(domain *value_objects.DomainName) (*en
 // FindByTenantID retrieves all sites for a specific tenant
 func (r *SiteRepositoryImpl) FindByTenantID(tenantID entities.TenantID) ([]*entities.Site, error) {
 	var modelList []*models.Site
-	query, args, err := squirrel.Select("*").From("sites").Where(squirrel.Eq{"tenant_id": tenantID.Value()}).ToSql()
+	query, args, err := squirrel.Select("*").From("sites").Where(squirrel.Eq{"deleted_at": nil, "tenant_id": tenantID.Value()}).ToSql()
 	if err != nil {
 		r.logger.Error("Failed to build select query for FindByTenantID", "error", err)
 		return nil, err
@@ -137,7 +164,7 @@ func (r *SiteRepositoryImpl) FindByTenantID(tenantID entities.TenantID) ([]*enti
 // FindAll retrieves all sites
 func (r *SiteRepositoryImpl) FindAll() ([]*entities.Site, error) {
 	var modelList []*models.Site
-	query, args, err := squirrel.Select("*").From("sites").ToSql()
+	query, args, err := squirrel.Select("*").From("sites").Where(squirrel.Eq{"deleted_at": nil}).ToSql()
 	if err != nil {
 		r.logger.Error("Failed to build select query for FindAll", "error", err)
 		return nil, err
@@ -152,7 +179,7 @@ func (r *SiteRepositoryImpl) FindAll() ([]*entities.Site, error) {
 // FindEnabledByTenantID retrieves only enabled sites for a tenant
 func (r *SiteRepositoryImpl) FindEnabledByTenantID(tenantID entities.TenantID) ([]*entities.Site, error) {
 	var modelList []*models.Site
-	query, args, err := squirrel.Select("*").From("sites").Where(squirrel.Eq{"tenant_id": tenantID.Value(), "enabled": true}).ToSql()
+	query, args, err := squirrel.Select("*").From("sites").Where(squirrel.Eq{"deleted_at": nil, "tenant_id": tenantID.Value(), "enabled": true}).ToSql()
 	if err != nil {
 		r.logger.Error("Failed to build select query for FindEnabledByTenantID", "error", err)
 		return nil, err
@@ -164,7 +191,62 @@ func (r *SiteRepositoryImpl) FindEnabledByTenantID(tenantID entities.TenantID) (
 	return r.mapper.ToDomains(modelList)
 }
 
-// Delete deletes a site (soft delete)
+// FindTrashedByID retrieves a trashed site by ID. Returns nil if the site does not exist or is not trashed.
+func (r *SiteRepositoryImpl) FindTrashedByID(id entities.SiteID) (*entities.Site, error) {
+	var model models.Site
+	query, args, err := squirrel.Select("*").From("sites").
+		Where(squirrel.Eq{"id": id.Value()}).
+		Where(squirrel.NotEq{"deleted_at": nil}).
+		ToSql()
+	if err != nil {
+		r.logger.Error("Failed to build select query for FindTrashedByID", "error", err)
+		return nil, err
+	}
+	if err := r.db.Get(&model, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		r.logger.Error("Failed to find trashed site by ID", "id", id.Value(), "error", err)
+		return nil, err
+	}
+	return r.mapper.ToDomain(&model)
+}
+
+// FindTrashedByTenantID retrieves the trashed sites of a tenant, most recently trashed first
+func (r *SiteRepositoryImpl) FindTrashedByTenantID(tenantID entities.TenantID) ([]*entities.Site, error) {
+	var modelList []*models.Site
+	query, args, err := squirrel.Select("*").From("sites").
+		Where(squirrel.Eq{"tenant_id": tenantID.Value()}).
+		Where(squirrel.NotEq{"deleted_at": nil}).
+		OrderBy("deleted_at DESC").
+		ToSql()
+	if err != nil {
+		r.logger.Error("Failed to build select query for FindTrashedByTenantID", "error", err)
+		return nil, err
+	}
+	if err := r.db.Select(&modelList, query, args...); err != nil {
+		r.logger.Error("Failed to find trashed sites by tenant", "tenant_id", tenantID.Value(), "error", err)
+		return nil, err
+	}
+	return r.mapper.ToDomains(modelList)
+}
+
+// FindTrashedBefore retrieves the sites trashed before the given time
+func (r *SiteRepositoryImpl) FindTrashedBefore(before time.Time) ([]*entities.Site, error) {
+	var modelList []*models.Site
+	query, args, err := squirrel.Select("*").From("sites").Where(squirrel.Lt{"deleted_at": before}).ToSql()
+	if err != nil {
+		r.logger.Error("Failed to build select query for FindTrashedBefore", "error", err)
+		return nil, err
+	}
+	if err := r.db.Select(&modelList, query, args...); err != nil {
+		r.logger.Error("Failed to find sites trashed before", "before", before, "error", err)
+		return nil, err
+	}
+	return r.mapper.ToDomains(modelList)
+}
+
+// Delete permanently deletes a site, used to purge it from the trash
 func (r *SiteRepositoryImpl) Delete(id entities.SiteID) error {
 	query, args, err := squirrel.Delete("sites").Where(squirrel.Eq{"id": id.Value()}).ToSql()
 	if err != nil {
@@ -181,7 +263,7 @@ func (r *SiteRepositoryImpl) Delete(id entities.SiteID) error {
 // ExistsByDomain checks if a site with the given domain exists
 func (r *SiteRepositoryImpl) ExistsByDomain(domain *value_objects.DomainName) (bool, error) {
 	var count int64
-	query, args, err := squirrel.Select("COUNT(*)").From("sites").Where(squirrel.Eq{"domain": domain.Value()}).ToSql()
+	query, args, err := squirrel.Select("COUNT(*)").From("sites").Where(squirrel.Eq{"deleted_at": nil, "domain": domain.Value()}).ToSql()
 	if err != nil {
 		r.logger.Error("Failed to build select query for ExistsByDomain", "domain", domain.Value(), "error", err)
 		return false, err
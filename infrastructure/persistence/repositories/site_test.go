@@ -26,7 +26,7 @@ func TestSiteRepository_Save(t *testing.T) {
 		mapperMock.On("ToModel", site).Return(model, nil)
 		mockResult := new(mocks.SqlResult)
 		mockResult.On("LastInsertId").Return(int64(42), nil)
-		mockDB.On("Exec", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(mockResult, nil)
+		mockDB.On("Exec", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(mockResult, nil)
 		err := repo.Save(site)
 		assert.NoError(t, err)
 		assert.Equal(t, uint64(42), site.ID().Value())
@@ -47,7 +47,8 @@ func TestSiteRepository_Save(t *testing.T) {
 		mapperMock := repo.mapper.(*mocks.MockSiteMapper)
 		mapperMock.On("ToModel", site).Return(model, nil)
 		mockResult := new(mocks.SqlResult)
-		mockDB.On("Exec", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(mockResult, nil)
+		mockResult.On("RowsAffected").Return(int64(1), nil)
+		mockDB.On("Exec", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(mockResult, nil)
 		err = repo.Save(site)
 		assert.NoError(t, err)
 		mockDB.AssertExpectations(t)
@@ -83,7 +84,7 @@ func TestSiteRepository_Save_ErrorBranches(t *testing.T) {
 		repo := &SiteRepositoryImpl{db: mockDB, logger: mockLogger, mapper: &mocks.MockSiteMapper{}}
 		mapperMock := repo.mapper.(*mocks.MockSiteMapper)
 		mapperMock.On("ToModel", site).Return(model, nil)
-		mockDB.On("Exec", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(new(mocks.SqlResult), errors.New("exec error"))
+		mockDB.On("Exec", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(new(mocks.SqlResult), errors.New("exec error"))
 		mockLogger.On("Error", "Failed to create site", "error", mock.Anything).Return()
 		err := repo.Save(site)
 		assert.Error(t, err)
@@ -100,7 +101,7 @@ func TestSiteRepository_Save_ErrorBranches(t *testing.T) {
 		mapperMock.On("ToModel", site).Return(model, nil)
 		mockResult := new(mocks.SqlResult)
 		mockResult.On("LastInsertId").Return(int64(0), errors.New("lastInsertId error"))
-		mockDB.On("Exec", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(mockResult, nil)
+		mockDB.On("Exec", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(mockResult, nil)
 		mockLogger.On("Error", "Failed to get last insert ID for site", "error", mock.Anything).Return()
 		err := repo.Save(site)
 		assert.Error(t, err)
@@ -116,7 +117,7 @@ func TestSiteRepository_Save_ErrorBranches(t *testing.T) {
 		repo := &SiteRepositoryImpl{db: mockDB, logger: mockLogger, mapper: &mocks.MockSiteMapper{}}
 		mapperMock := repo.mapper.(*mocks.MockSiteMapper)
 		mapperMock.On("ToModel", site).Return(&models.Site{Base: models.Base{ID: 99, CreatedAt: time.Now(), UpdatedAt: time.Now()}, Domain: "example.com", Name: "Example", TenantID: 1, Enabled: true}, nil)
-		mockDB.On("Exec", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(new(mocks.SqlResult), errors.New("exec error"))
+		mockDB.On("Exec", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(new(mocks.SqlResult), errors.New("exec error"))
 		mockLogger.On("Error", "Failed to update site", "id", uint64(99), "error", mock.Anything).Return()
 		err := repo.Save(site)
 		assert.Error(t, err)
@@ -405,3 +406,108 @@ func TestSiteRepository_ExistsByDomain(t *testing.T) {
 		mockLogger.AssertExpectations(t)
 	})
 }
+
+func TestSiteRepository_FindByID_ExcludesTrashed(t *testing.T) {
+	mockDB := new(mocks.Database)
+	repo := &SiteRepositoryImpl{db: mockDB, logger: new(mocks.Logger), mapper: &mocks.MockSiteMapper{}}
+	id := entities.NewSiteID(1)
+	mockDB.On("Get", mock.AnythingOfType("*models.Site"), "SELECT * FROM sites WHERE deleted_at IS NULL AND id = ?", id.Value()).Return(sql.ErrNoRows)
+
+	result, err := repo.FindByID(id)
+
+	assert.NoError(t, err)
+	assert.Nil(t, result)
+	mockDB.AssertExpectations(t)
+}
+
+func TestSiteRepository_FindTrashedByID(t *testing.T) {
+	query := "SELECT * FROM sites WHERE id = ? AND deleted_at IS NOT NULL"
+	t.Run("success", func(t *testing.T) {
+		mockDB := new(mocks.Database)
+		repo := &SiteRepositoryImpl{db: mockDB, logger: new(mocks.Logger), mapper: &mocks.MockSiteMapper{}}
+		id := entities.NewSiteID(1)
+		deletedAt := time.Now()
+		model := models.Site{Base: models.Base{ID: 1, DeletedAt: &deletedAt}, Domain: "example.com", Name: "Example", TenantID: 1}
+		mockDB.On("Get", mock.AnythingOfType("*models.Site"), query, id.Value()).Run(func(args mock.Arguments) {
+			*args.Get(0).(*models.Site) = model
+		}).Return(nil)
+		site := &entities.Site{}
+		site.SetDeletedAt(&deletedAt)
+		mapperMock := repo.mapper.(*mocks.MockSiteMapper)
+		mapperMock.On("ToDomain", &model).Return(site, nil)
+
+		result, err := repo.FindTrashedByID(id)
+
+		assert.NoError(t, err)
+		assert.True(t, result.IsDeleted())
+		mockDB.AssertExpectations(t)
+		mapperMock.AssertExpectations(t)
+	})
+	t.Run("not trashed", func(t *testing.T) {
+		mockDB := new(mocks.Database)
+		repo := &SiteRepositoryImpl{db: mockDB, logger: new(mocks.Logger), mapper: &mocks.MockSiteMapper{}}
+		id := entities.NewSiteID(2)
+		mockDB.On("Get", mock.AnythingOfType("*models.Site"), query, id.Value()).Return(sql.ErrNoRows)
+
+		result, err := repo.FindTrashedByID(id)
+
+		assert.NoError(t, err)
+		assert.Nil(t, result)
+		mockDB.AssertExpectations(t)
+	})
+}
+
+func TestSiteRepository_FindTrashedByTenantID(t *testing.T) {
+	query := "SELECT * FROM sites WHERE tenant_id = ? AND deleted_at IS NOT NULL ORDER BY deleted_at DESC"
+	t.Run("success", func(t *testing.T) {
+		mockDB := new(mocks.Database)
+		repo := &SiteRepositoryImpl{db: mockDB, logger: new(mocks.Logger), mapper: &mocks.MockSiteMapper{}}
+		tenantID := entities.NewTenantID(1)
+		modelList := []*models.Site{{Base: models.Base{ID: 1}, Domain: "example.com", Name: "Example", TenantID: 1}}
+		mockDB.On("Select", mock.AnythingOfType("*[]*models.Site"), query, tenantID.Value()).Run(func(args mock.Arguments) {
+			*args.Get(0).(*[]*models.Site) = modelList
+		}).Return(nil)
+		mapperMock := repo.mapper.(*mocks.MockSiteMapper)
+		mapperMock.On("ToDomains", modelList).Return([]*entities.Site{{}}, nil)
+
+		result, err := repo.FindTrashedByTenantID(tenantID)
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+		mockDB.AssertExpectations(t)
+		mapperMock.AssertExpectations(t)
+	})
+	t.Run("db error", func(t *testing.T) {
+		mockDB := new(mocks.Database)
+		mockLogger := new(mocks.Logger)
+		repo := &SiteRepositoryImpl{db: mockDB, logger: mockLogger, mapper: &mocks.MockSiteMapper{}}
+		tenantID := entities.NewTenantID(2)
+		dbErr := errors.New("db error")
+		mockDB.On("Select", mock.AnythingOfType("*[]*models.Site"), query, tenantID.Value()).Return(dbErr)
+		mockLogger.On("Error", "Failed to find trashed sites by tenant", "tenant_id", tenantID.Value(), "error", dbErr).Return()
+
+		result, err := repo.FindTrashedByTenantID(tenantID)
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+		mockLogger.AssertExpectations(t)
+	})
+}
+
+func TestSiteRepository_FindTrashedBefore(t *testing.T) {
+	mockDB := new(mocks.Database)
+	repo := &SiteRepositoryImpl{db: mockDB, logger: new(mocks.Logger), mapper: &mocks.MockSiteMapper{}}
+	before := time.Date(2025, 6, 29, 0, 0, 0, 0, time.UTC)
+	modelList := []*models.Site{{Base: models.Base{ID: 1}, Domain: "example.com", Name: "Example", TenantID: 1}}
+	mockDB.On("Select", mock.AnythingOfType("*[]*models.Site"), "SELECT * FROM sites WHERE deleted_at < ?", before).Run(func(args mock.Arguments) {
+		*args.Get(0).(*[]*models.Site) = modelList
+	}).Return(nil)
+	mapperMock := repo.mapper.(*mocks.MockSiteMapper)
+	mapperMock.On("ToDomains", modelList).Return([]*entities.Site{{}}, nil)
+
+	result, err := repo.FindTrashedBefore(before)
+
+	assert.NoError(t, err)
+	assert.Len(t, result, 1)
+	mockDB.AssertExpectations(t)
+}
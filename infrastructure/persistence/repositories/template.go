@@ -9,6 +9,9 @@ import (
 	"github.com/h4rdc0m/aurora-api/domain/repositories"
 	"github.com/h4rdc0m/aurora-api/infrastructure/persistence/mappers"
 	"github.com/h4rdc0m/aurora-api/infrastructure/persistence/models"
+	"github.com/h4rdc0m/aurora-api/infrastructure/persistence/mysql"
+	"github.com/jmoiron/sqlx"
+	"time"
 )
 
 // TemplateRepositoryImpl implements TemplateRepository using sqlx and squirrel
@@ -27,6 +30,15 @@ func NewTemplateRepository(db common.Database, logger common.Logger) repositorie
 	}
 }
 
+// WithTrx returns a copy of the repository that runs its queries inside the given transaction
+func (r *TemplateRepositoryImpl) WithTrx(trxHandle *sqlx.Tx) repositories.TemplateRepository {
+	return &TemplateRepositoryImpl{
+		db:     mysql.NewTransaction(trxHandle, r.logger),
+		logger: r.logger,
+		mapper: r.mapper,
+	}
+}
+
 // Save saves a template (create or update)
 func (r *TemplateRepositoryImpl) Save(template *entities.Template) error {
 	model, err := r.mapper.ToModel(template)
@@ -37,8 +49,8 @@ func (r *TemplateRepositoryImpl) Save(template *entities.Template) error {
 
 	if model.ID == 0 {
 		query, args, err := squirrel.Insert("templates").
-			Columns("name", "description", "file_path", "created_at", "updated_at").
-			Values(model.Name, model.Description, model.FilePath, model.CreatedAt, model.UpdatedAt).
+			Columns("name", "description", "file_path", "created_at", "updated_at", "revision").
+			Values(model.Name, model.Description, model.FilePath, model.CreatedAt, model.UpdatedAt, initialRevision).
 			PlaceholderFormat(squirrel.Question).
 			ToSql()
 		if err != nil {
@@ -56,6 +68,7 @@ func (r *TemplateRepositoryImpl) Save(template *entities.Template) error {
 			return err
 		}
 		template.SetID(entities.NewTemplateID(uint64(id)))
+		template.SetRevision(initialRevision)
 
 	} else {
 		query, args, err := squirrel.Update("templates").
@@ -64,17 +77,25 @@ func (r *TemplateRepositoryImpl) Save(template *entities.Template) error {
 			Set("file_path", model.FilePath).
 			Set("created_at", model.CreatedAt).
 			Set("updated_at", model.UpdatedAt).
-			Where(squirrel.Eq{"id": model.ID}).
+			Set("deleted_at", model.DeletedAt).
+			Set("revision", model.Revision+1).
+			Where(squirrel.Eq{"id": model.ID, "revision": model.Revision}).
 			PlaceholderFormat(squirrel.Question).
 			ToSql()
 		if err != nil {
 			r.logger.Error("Failed to build update query for template", "error", err)
 			return err
 		}
-		if _, err := r.db.Exec(query, args...); err != nil {
+		result, err := r.db.Exec(query, args...)
+		if err != nil {
 			r.logger.Error("Failed to update template", "id", model.ID, "error", err)
 			return err
 		}
+		if err := checkRevisionUpdate(result); err != nil {
+			r.logger.Warn("Rejected update of modified template", "id", model.ID, "revision", model.Revision, "error", err)
+			return err
+		}
+		template.SetRevision(model.Revision + 1)
 	}
 	return nil
 }
@@ -82,7 +103,7 @@ func (r *TemplateRepositoryImpl) Save(template *entities.Template) error {
 // FindByID retrieves a template by ID
 func (r *TemplateRepositoryImpl) FindByID(id entities.TemplateID) (*entities.Template, error) {
 	var model models.Template
-	query, args, err := squirrel.Select("*").From("templates").Where(squirrel.Eq{"id": id.Value()}).ToSql()
+	query, args, err := squirrel.Select("*").From("templates").Where(squirrel.Eq{"deleted_at": nil, "id": id.Value()}).ToSql()
 	if err != nil {
 		r.logger.Error("Failed to build select query for FindByID", "error", err)
 		return nil, err
@@ -100,7 +121,7 @@ func (r *TemplateRepositoryImpl) FindByID(id entities.TemplateID) (*entities.Tem
 // FindByName retrieves a template by name
 func (r *TemplateRepositoryImpl) FindByName(name string) (*entities.Template, error) {
 	var model models.Template
-	query, args, err := squirrel.Select("*").From("templates").Where(squirrel.Eq{"name": name}).ToSql()
+	query, args, err := squirrel.Select("*").From("templates").Where(squirrel.Eq{"deleted_at": nil, "name": name}).ToSql()
 	if err != nil {
 		r.logger.Error("Failed to build select query for FindByName", "error", err)
 		return nil, err
@@ -119,7 +140,7 @@ func (r *TemplateRepositoryImpl) FindByName(name string) (*entities.Template, er
 // FindAll retrieves all templates
 func (r *TemplateRepositoryImpl) FindAll() ([]*entities.Template, error) {
 	var modelList []*models.Template
-	query, args, err := squirrel.Select("*").From("templates").ToSql()
+	query, args, err := squirrel.Select("*").From("templates").Where(squirrel.Eq{"deleted_at": nil}).ToSql()
 	if err != nil {
 		r.logger.Error("Failed to build select query for FindAll", "error", err)
 		return nil, err
@@ -134,7 +155,7 @@ func (r *TemplateRepositoryImpl) FindAll() ([]*entities.Template, error) {
 // FindEnabledOnly retrieves only enabled templates
 func (r *TemplateRepositoryImpl) FindEnabledOnly() ([]*entities.Template, error) {
 	var modelList []*models.Template
-	query, args, err := squirrel.Select("*").From("templates").Where(squirrel.Eq{"enabled": true}).ToSql()
+	query, args, err := squirrel.Select("*").From("templates").Where(squirrel.Eq{"deleted_at": nil, "enabled": true}).ToSql()
 	if err != nil {
 		r.logger.Error("Failed to build select query for FindEnabledOnly", "error", err)
 		return nil, err
@@ -152,7 +173,61 @@ func (r *TemplateRepositoryImpl) FindEnabledOnly() ([]*entities.Template, error)
 	return r.mapper.ToDomains(modelList)
 }
 
-// Delete deletes a template
+// FindTrashed retrieves the trashed templates, most recently trashed first
+func (r *TemplateRepositoryImpl) FindTrashed() ([]*entities.Template, error) {
+	var modelList []*models.Template
+	query, args, err := squirrel.Select("*").From("templates").
+		Where(squirrel.NotEq{"deleted_at": nil}).
+		OrderBy("deleted_at DESC").
+		ToSql()
+	if err != nil {
+		r.logger.Error("Failed to build select query for FindTrashed", "error", err)
+		return nil, err
+	}
+	if err := r.db.Select(&modelList, query, args...); err != nil {
+		r.logger.Error("Failed to find trashed templates", "error", err)
+		return nil, err
+	}
+	return r.mapper.ToDomains(modelList)
+}
+
+// FindTrashedByID retrieves a trashed template by ID
+func (r *TemplateRepositoryImpl) FindTrashedByID(id entities.TemplateID) (*entities.Template, error) {
+	var model models.Template
+	query, args, err := squirrel.Select("*").From("templates").
+		Where(squirrel.Eq{"id": id.Value()}).
+		Where(squirrel.NotEq{"deleted_at": nil}).
+		ToSql()
+	if err != nil {
+		r.logger.Error("Failed to build select query for FindTrashedByID", "error", err)
+		return nil, err
+	}
+	if err := r.db.Get(&model, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		r.logger.Error("Failed to find trashed template by ID", "id", id.Value(), "error", err)
+		return nil, err
+	}
+	return r.mapper.ToDomain(&model)
+}
+
+// FindTrashedBefore retrieves the templates trashed before the given time
+func (r *TemplateRepositoryImpl) FindTrashedBefore(before time.Time) ([]*entities.Template, error) {
+	var modelList []*models.Template
+	query, args, err := squirrel.Select("*").From("templates").Where(squirrel.Lt{"deleted_at": before}).ToSql()
+	if err != nil {
+		r.logger.Error("Failed to build select query for FindTrashedBefore", "error", err)
+		return nil, err
+	}
+	if err := r.db.Select(&modelList, query, args...); err != nil {
+		r.logger.Error("Failed to find templates trashed before", "before", before, "error", err)
+		return nil, err
+	}
+	return r.mapper.ToDomains(modelList)
+}
+
+// Delete permanently deletes a template, used to purge it from the trash
 func (r *TemplateRepositoryImpl) Delete(id entities.TemplateID) error {
 	query, args, err := squirrel.Delete("templates").Where(squirrel.Eq{"id": id.Value()}).ToSql()
 	if err != nil {
@@ -170,7 +245,7 @@ func (r *TemplateRepositoryImpl) Delete(id entities.TemplateID) error {
 func (r *TemplateRepositoryImpl) ExistsByName(name string) (bool, error) {
 	var count int64
 	// Use squirrel to build the count query
-	query, args, err := squirrel.Select("COUNT(*)").From("templates").Where(squirrel.Eq{"name": name}).ToSql()
+	query, args, err := squirrel.Select("COUNT(*)").From("templates").Where(squirrel.Eq{"deleted_at": nil, "name": name}).ToSql()
 	if err != nil {
 		r.logger.Error("Failed to build count query for template existence by name", "name", name, "error", err)
 		return false, err
@@ -190,7 +265,7 @@ func (r *TemplateRepositoryImpl) ExistsByName(name string) (bool, error) {
 func (r *TemplateRepositoryImpl) ExistsByFilePath(filePath string) (bool, error) {
 	var count int64
 	// Use squirrel to build the count query
-	query, args, err := squirrel.Select("COUNT(*)").From("templates").Where(squirrel.Eq{"file_path": filePath}).ToSql()
+	query, args, err := squirrel.Select("COUNT(*)").From("templates").Where(squirrel.Eq{"deleted_at": nil, "file_path": filePath}).ToSql()
 	if err != nil {
 		r.logger.Error("Failed to build count query for template existence by file path", "file_path", filePath, "error", err)
 		return false, err
@@ -24,7 +24,7 @@ func TestTemplateRepository_Save(t *testing.T) {
 		mapperMock.On("ToModel", template).Return(model, nil)
 		mockResult := new(mocks.SqlResult)
 		mockResult.On("LastInsertId").Return(int64(42), nil)
-		mockDB.On("Exec", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(mockResult, nil)
+		mockDB.On("Exec", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(mockResult, nil)
 		err := repo.Save(template)
 		assert.NoError(t, err)
 		assert.Equal(t, uint64(42), template.ID().Value())
@@ -43,7 +43,8 @@ func TestTemplateRepository_Save(t *testing.T) {
 		mapperMock := repo.mapper.(*mocks.MockTemplateMapper)
 		mapperMock.On("ToModel", template).Return(model, nil)
 		mockResult := new(mocks.SqlResult)
-		mockDB.On("Exec", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(mockResult, nil)
+		mockResult.On("RowsAffected").Return(int64(1), nil)
+		mockDB.On("Exec", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(mockResult, nil)
 		err := repo.Save(template)
 		assert.NoError(t, err)
 		mockDB.AssertExpectations(t)
@@ -79,7 +80,7 @@ func TestTemplateRepository_Save_ErrorBranches(t *testing.T) {
 		repo := &TemplateRepositoryImpl{db: mockDB, logger: mockLogger, mapper: &mocks.MockTemplateMapper{}}
 		mapperMock := repo.mapper.(*mocks.MockTemplateMapper)
 		mapperMock.On("ToModel", template).Return(model, nil)
-		mockDB.On("Exec", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(new(mocks.SqlResult), errors.New("exec error"))
+		mockDB.On("Exec", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(new(mocks.SqlResult), errors.New("exec error"))
 		mockLogger.On("Error", "Failed to create template", "error", mock.Anything).Return()
 		err := repo.Save(template)
 		assert.Error(t, err)
@@ -96,7 +97,7 @@ func TestTemplateRepository_Save_ErrorBranches(t *testing.T) {
 		mapperMock.On("ToModel", template).Return(model, nil)
 		mockResult := new(mocks.SqlResult)
 		mockResult.On("LastInsertId").Return(int64(0), errors.New("lastInsertId error"))
-		mockDB.On("Exec", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(mockResult, nil)
+		mockDB.On("Exec", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(mockResult, nil)
 		mockLogger.On("Error", "Failed to get last insert ID for template", "error", mock.Anything).Return()
 		err := repo.Save(template)
 		assert.Error(t, err)
@@ -112,7 +113,7 @@ func TestTemplateRepository_Save_ErrorBranches(t *testing.T) {
 		repo := &TemplateRepositoryImpl{db: mockDB, logger: mockLogger, mapper: &mocks.MockTemplateMapper{}}
 		mapperMock := repo.mapper.(*mocks.MockTemplateMapper)
 		mapperMock.On("ToModel", template).Return(&models.Template{Name: "Test", Description: nil, FilePath: "content", Base: models.Base{ID: 99, CreatedAt: time.Now(), UpdatedAt: time.Now()}}, nil)
-		mockDB.On("Exec", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(new(mocks.SqlResult), errors.New("exec error"))
+		mockDB.On("Exec", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(new(mocks.SqlResult), errors.New("exec error"))
 		mockLogger.On("Error", "Failed to update template", "id", uint64(99), "error", mock.Anything).Return()
 		err := repo.Save(template)
 		assert.Error(t, err)
@@ -420,3 +421,117 @@ func TestTemplateRepository_FindByName(t *testing.T) {
 		mockLogger.AssertExpectations(t)
 	})
 }
+
+func TestTemplateRepository_FindTrashedBefore(t *testing.T) {
+	query := "SELECT * FROM templates WHERE deleted_at < ?"
+	before := time.Date(2025, 6, 29, 0, 0, 0, 0, time.UTC)
+	t.Run("success", func(t *testing.T) {
+		mockDB := new(mocks.Database)
+		repo := &TemplateRepositoryImpl{db: mockDB, logger: new(mocks.Logger), mapper: &mocks.MockTemplateMapper{}}
+		modelList := []*models.Template{{Base: models.Base{ID: 1}, Name: "Classic", FilePath: "templates/classic"}}
+		mockDB.On("Select", mock.AnythingOfType("*[]*models.Template"), query, before).Run(func(args mock.Arguments) {
+			*args.Get(0).(*[]*models.Template) = modelList
+		}).Return(nil)
+		mapperMock := repo.mapper.(*mocks.MockTemplateMapper)
+		mapperMock.On("ToDomains", modelList).Return([]*entities.Template{{}}, nil)
+
+		result, err := repo.FindTrashedBefore(before)
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+		mockDB.AssertExpectations(t)
+		mapperMock.AssertExpectations(t)
+	})
+	t.Run("db error", func(t *testing.T) {
+		mockDB := new(mocks.Database)
+		mockLogger := new(mocks.Logger)
+		repo := &TemplateRepositoryImpl{db: mockDB, logger: mockLogger, mapper: &mocks.MockTemplateMapper{}}
+		dbErr := errors.New("db error")
+		mockDB.On("Select", mock.AnythingOfType("*[]*models.Template"), query, before).Return(dbErr)
+		mockLogger.On("Error", "Failed to find templates trashed before", "before", before, "error", dbErr).Return()
+
+		result, err := repo.FindTrashedBefore(before)
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+		mockLogger.AssertExpectations(t)
+	})
+}
+
+func TestTemplateRepository_FindTrashed(t *testing.T) {
+	query := "SELECT * FROM templates WHERE deleted_at IS NOT NULL ORDER BY deleted_at DESC"
+	t.Run("success", func(t *testing.T) {
+		mockDB := new(mocks.Database)
+		repo := &TemplateRepositoryImpl{db: mockDB, logger: new(mocks.Logger), mapper: &mocks.MockTemplateMapper{}}
+		modelList := []*models.Template{{Base: models.Base{ID: 1}, Name: "Classic", FilePath: "templates/classic"}}
+		mockDB.On("Select", mock.AnythingOfType("*[]*models.Template"), query).Run(func(args mock.Arguments) {
+			*args.Get(0).(*[]*models.Template) = modelList
+		}).Return(nil)
+		mapperMock := repo.mapper.(*mocks.MockTemplateMapper)
+		mapperMock.On("ToDomains", modelList).Return([]*entities.Template{{}}, nil)
+
+		result, err := repo.FindTrashed()
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+		mockDB.AssertExpectations(t)
+		mapperMock.AssertExpectations(t)
+	})
+	t.Run("db error", func(t *testing.T) {
+		mockDB := new(mocks.Database)
+		mockLogger := new(mocks.Logger)
+		repo := &TemplateRepositoryImpl{db: mockDB, logger: mockLogger, mapper: &mocks.MockTemplateMapper{}}
+		dbErr := errors.New("db error")
+		mockDB.On("Select", mock.AnythingOfType("*[]*models.Template"), query).Return(dbErr)
+		mockLogger.On("Error", "Failed to find trashed templates", "error", dbErr).Return()
+
+		result, err := repo.FindTrashed()
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+		mockLogger.AssertExpectations(t)
+	})
+}
+
+func TestTemplateRepository_FindTrashedByID(t *testing.T) {
+	query := "SELECT * FROM templates WHERE id = ? AND deleted_at IS NOT NULL"
+	id := entities.NewTemplateID(7)
+	t.Run("success", func(t *testing.T) {
+		mockDB := new(mocks.Database)
+		repo := &TemplateRepositoryImpl{db: mockDB, logger: new(mocks.Logger), mapper: &mocks.MockTemplateMapper{}}
+		mockDB.On("Get", mock.AnythingOfType("*models.Template"), query, id.Value()).Return(nil)
+		expectedTemplate := &entities.Template{}
+		mapperMock := repo.mapper.(*mocks.MockTemplateMapper)
+		mapperMock.On("ToDomain", mock.AnythingOfType("*models.Template")).Return(expectedTemplate, nil)
+
+		result, err := repo.FindTrashedByID(id)
+
+		assert.NoError(t, err)
+		assert.Same(t, expectedTemplate, result)
+		mockDB.AssertExpectations(t)
+	})
+	t.Run("not trashed", func(t *testing.T) {
+		mockDB := new(mocks.Database)
+		repo := &TemplateRepositoryImpl{db: mockDB, logger: new(mocks.Logger), mapper: &mocks.MockTemplateMapper{}}
+		mockDB.On("Get", mock.AnythingOfType("*models.Template"), query, id.Value()).Return(sql.ErrNoRows)
+
+		result, err := repo.FindTrashedByID(id)
+
+		assert.NoError(t, err)
+		assert.Nil(t, result)
+	})
+	t.Run("db error", func(t *testing.T) {
+		mockDB := new(mocks.Database)
+		mockLogger := new(mocks.Logger)
+		repo := &TemplateRepositoryImpl{db: mockDB, logger: mockLogger, mapper: &mocks.MockTemplateMapper{}}
+		dbErr := errors.New("db error")
+		mockDB.On("Get", mock.AnythingOfType("*models.Template"), query, id.Value()).Return(dbErr)
+		mockLogger.On("Error", "Failed to find trashed template by ID", "id", id.Value(), "error", dbErr).Return()
+
+		result, err := repo.FindTrashedByID(id)
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+		mockLogger.AssertExpectations(t)
+	})
+}
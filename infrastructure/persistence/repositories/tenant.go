@@ -34,8 +34,8 @@ func (r *TenantRepositoryImpl) Save(tenant *entities.Tenant) error {
 
 	if model.ID == 0 {
 		query, args, err := squirrel.Insert("tenants").
-			Columns("name", "created_at", "updated_at").
-			Values(model.Name, model.CreatedAt, model.UpdatedAt).
+			Columns("name", "created_at", "updated_at", "revision").
+			Values(model.Name, model.CreatedAt, model.UpdatedAt, initialRevision).
 			PlaceholderFormat(squirrel.Question).
 			ToSql()
 		if err != nil {
@@ -53,22 +53,30 @@ func (r *TenantRepositoryImpl) Save(tenant *entities.Tenant) error {
 			return err
 		}
 		tenant.SetID(entities.NewTenantID(uint64(id)))
+		tenant.SetRevision(initialRevision)
 	} else {
 		query, args, err := squirrel.Update("tenants").
 			Set("name", model.Name).
 			Set("created_at", model.CreatedAt).
 			Set("updated_at", model.UpdatedAt).
-			Where(squirrel.Eq{"id": model.ID}).
+			Set("revision", model.Revision+1).
+			Where(squirrel.Eq{"id": model.ID, "revision": model.Revision}).
 			PlaceholderFormat(squirrel.Question).
 			ToSql()
 		if err != nil {
 			r.logger.Error("Failed to build update query for tenant", "error", err)
 			return err
 		}
-		if _, err := r.db.Exec(query, args...); err != nil {
+		result, err := r.db.Exec(query, args...)
+		if err != nil {
 			r.logger.Error("Failed to update tenant", "id", model.ID, "error", err)
 			return err
 		}
+		if err := checkRevisionUpdate(result); err != nil {
+			r.logger.Warn("Rejected update of modified tenant", "id", model.ID, "revision", model.Revision, "error", err)
+			return err
+		}
+		tenant.SetRevision(model.Revision + 1)
 	}
 	return nil
 }
@@ -24,7 +24,7 @@ func TestTenantRepository_Save(t *testing.T) {
 		mapperMock.On("ToModel", tenant).Return(model, nil)
 		mockResult := new(mocks.SqlResult)
 		mockResult.On("LastInsertId").Return(int64(42), nil)
-		mockDB.On("Exec", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(mockResult, nil)
+		mockDB.On("Exec", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(mockResult, nil)
 		err := repo.Save(tenant)
 		assert.NoError(t, err)
 		assert.Equal(t, uint64(42), tenant.ID().Value())
@@ -43,7 +43,8 @@ func TestTenantRepository_Save(t *testing.T) {
 		mapperMock := repo.mapper.(*mocks.MockTenantMapper)
 		mapperMock.On("ToModel", tenant).Return(model, nil)
 		mockResult := new(mocks.SqlResult)
-		mockDB.On("Exec", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(mockResult, nil)
+		mockResult.On("RowsAffected").Return(int64(1), nil)
+		mockDB.On("Exec", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(mockResult, nil)
 		err := repo.Save(tenant)
 		assert.NoError(t, err)
 		mockDB.AssertExpectations(t)
@@ -79,7 +80,7 @@ func TestTenantRepository_Save_ErrorBranches(t *testing.T) {
 		repo := &TenantRepositoryImpl{db: mockDB, logger: mockLogger, mapper: &mocks.MockTenantMapper{}}
 		mapperMock := repo.mapper.(*mocks.MockTenantMapper)
 		mapperMock.On("ToModel", tenant).Return(model, nil)
-		mockDB.On("Exec", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(new(mocks.SqlResult), errors.New("exec error"))
+		mockDB.On("Exec", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(new(mocks.SqlResult), errors.New("exec error"))
 		mockLogger.On("Error", "Failed to create tenant", "error", mock.Anything).Return()
 		err := repo.Save(tenant)
 		assert.Error(t, err)
@@ -96,7 +97,7 @@ func TestTenantRepository_Save_ErrorBranches(t *testing.T) {
 		mapperMock.On("ToModel", tenant).Return(model, nil)
 		mockResult := new(mocks.SqlResult)
 		mockResult.On("LastInsertId").Return(int64(0), errors.New("lastInsertId error"))
-		mockDB.On("Exec", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(mockResult, nil)
+		mockDB.On("Exec", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(mockResult, nil)
 		mockLogger.On("Error", "Failed to get last insert ID for tenant", "error", mock.Anything).Return()
 		err := repo.Save(tenant)
 		assert.Error(t, err)
@@ -112,7 +113,7 @@ func TestTenantRepository_Save_ErrorBranches(t *testing.T) {
 		repo := &TenantRepositoryImpl{db: mockDB, logger: mockLogger, mapper: &mocks.MockTenantMapper{}}
 		mapperMock := repo.mapper.(*mocks.MockTenantMapper)
 		mapperMock.On("ToModel", tenant).Return(&models.Tenant{Name: "Test", Base: models.Base{ID: 99, CreatedAt: time.Now(), UpdatedAt: time.Now()}}, nil)
-		mockDB.On("Exec", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(new(mocks.SqlResult), errors.New("exec error"))
+		mockDB.On("Exec", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(new(mocks.SqlResult), errors.New("exec error"))
 		mockLogger.On("Error", "Failed to update tenant", "id", uint64(99), "error", mock.Anything).Return()
 		err := repo.Save(tenant)
 		assert.Error(t, err)
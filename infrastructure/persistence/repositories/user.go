@@ -97,7 +97,7 @@ func (r *UserRepositoryImpl) FindByID(id entities.UserID) (*entities.User, error
 		return nil, err
 	}
 
-	return r.mapper.ToDomain(&model)
+	return r.toDomainWithTenants(&model)
 }
 
 // FindByKeycloakID retrieves a user entity by its Keycloak ID from the data source. Returns nil if no user is found.
@@ -118,7 +118,7 @@ func (r *UserRepositoryImpl) FindByKeycloakID(keycloakID value_objects.KeycloakI
 		return nil, err
 	}
 
-	return r.mapper.ToDomain(&model)
+	return r.toDomainWithTenants(&model)
 }
 
 // FindAll retrieves all users from the database and maps them to domain entities. Returns an error if the operation fails.
@@ -195,3 +195,30 @@ func (r *UserRepositoryImpl) ExistsByKeycloakID(keycloakID value_objects.Keycloa
 
 	return count > 0, nil
 }
+
+// toDomainWithTenants maps a user model to a domain entity and loads the tenants the user belongs to.
+func (r *UserRepositoryImpl) toDomainWithTenants(model *models.User) (*entities.User, error) {
+	user, err := r.mapper.ToDomain(model)
+	if err != nil {
+		return nil, err
+	}
+
+	var tenantIDs []uint64
+	query, args, err := squirrel.Select("tenant_id").From("user_tenants").Where(squirrel.Eq{"user_id": model.ID}).ToSql()
+	if err != nil {
+		r.logger.Error("Failed to build select query for user tenants", "error", err)
+		return nil, err
+	}
+	if err := r.db.Select(&tenantIDs, query, args...); err != nil {
+		r.logger.Error("Failed to find tenants of user", "id", model.ID, "error", err)
+		return nil, err
+	}
+
+	for _, tenantID := range tenantIDs {
+		if err := user.AddToTenant(entities.NewTenantID(tenantID)); err != nil {
+			return nil, err
+		}
+	}
+
+	return user, nil
+}
@@ -258,10 +258,16 @@ func TestUserRepositoryImpl_FindByID(t *testing.T) {
 			*arg = *model
 		}).Return(nil)
 		mockMapper.On("ToDomain", model).Return(domainUser, nil)
+		mockDB.On("Select", mock.Anything, mock.Anything, uint64(1)).Run(func(args mock.Arguments) {
+			arg := args.Get(0).(*[]uint64)
+			*arg = []uint64{3, 7}
+		}).Return(nil)
 
 		result, err := repo.FindByID(userID)
 		assert.NoError(t, err)
 		assert.Equal(t, domainUser, result)
+		assert.True(t, result.HasAccessToTenant(entities.NewTenantID(3)))
+		assert.True(t, result.HasAccessToTenant(entities.NewTenantID(7)))
 
 		mockDB.AssertExpectations(t)
 		mockLogger.AssertExpectations(t)
@@ -370,6 +376,7 @@ func TestUserRepositoryImpl_FindByKeycloakID(t *testing.T) {
 			*arg = *model
 		}).Return(nil)
 		mockMapper.On("ToDomain", model).Return(domainUser, nil)
+		mockDB.On("Select", mock.Anything, mock.Anything, uint64(1)).Return(nil)
 
 		result, err := repo.FindByKeycloakID(*keycloakID)
 		assert.NoError(t, err)
@@ -461,6 +468,37 @@ func TestUserRepositoryImpl_FindByKeycloakID(t *testing.T) {
 	})
 }
 
+func TestUserRepositoryImpl_FindByKeycloakID_TenantsError(t *testing.T) {
+	mockDB := new(mocks.Database)
+	mockLogger := new(mocks.Logger)
+	mockMapper := new(mocks.MockUserMapper)
+	repo := &UserRepositoryImpl{
+		db:     mockDB,
+		logger: mockLogger,
+		mapper: mockMapper,
+	}
+
+	keycloakID := value_objects.NewKeycloakIDFromUUID(uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"))
+	model := &models.User{Base: models.Base{ID: 1}, KeycloakID: keycloakID.Value()}
+	dbErr := errors.New("database error")
+
+	mockDB.On("Get", mock.Anything, mock.Anything, *keycloakID).Run(func(args mock.Arguments) {
+		arg := args.Get(0).(*models.User)
+		*arg = *model
+	}).Return(nil)
+	mockMapper.On("ToDomain", model).Return(&entities.User{}, nil)
+	mockDB.On("Select", mock.Anything, mock.Anything, uint64(1)).Return(dbErr)
+	mockLogger.On("Error", "Failed to find tenants of user", "id", uint64(1), "error", dbErr).Return()
+
+	result, err := repo.FindByKeycloakID(*keycloakID)
+	assert.Equal(t, dbErr, err)
+	assert.Nil(t, result)
+
+	mockDB.AssertExpectations(t)
+	mockLogger.AssertExpectations(t)
+	mockMapper.AssertExpectations(t)
+}
+
 // Enhanced FindAll tests
 func TestUserRepositoryImpl_FindAll(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
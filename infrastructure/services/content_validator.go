@@ -0,0 +1,135 @@
+package services
+
+import (
+	stderrors "errors"
+	"fmt"
+	"github.com/h4rdc0m/aurora-api/domain/common"
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"github.com/h4rdc0m/aurora-api/domain/errors"
+	"github.com/h4rdc0m/aurora-api/domain/repositories"
+	domainServices "github.com/h4rdc0m/aurora-api/domain/services"
+	"github.com/jmoiron/sqlx"
+	"github.com/santhosh-tekuri/jsonschema/v6"
+	"strings"
+)
+
+// contentSchemaURL is the location the schema of a content type is registered under while compiling
+const contentSchemaURL = "urn:aurora:content-type"
+
+// JSONSchemaContentValidator is an implementation of ContentValidator based on JSON Schema draft 2020-12.
+type JSONSchemaContentValidator struct {
+	contentTypeRepo repositories.ContentTypeRepository
+	logger          common.Logger
+}
+
+// NewContentValidator initializes and returns a ContentValidator using the given repository.
+func NewContentValidator(contentTypeRepo repositories.ContentTypeRepository, logger common.Logger) domainServices.ContentValidator {
+	return &JSONSchemaContentValidator{
+		contentTypeRepo: contentTypeRepo,
+		logger:          logger,
+	}
+}
+
+// WithTrx returns a copy of the validator that reads inside the given transaction.
+func (v *JSONSchemaContentValidator) WithTrx(trxHandle *sqlx.Tx) domainServices.ContentValidator {
+	return &JSONSchemaContentValidator{
+		contentTypeRepo: v.contentTypeRepo.WithTrx(trxHandle),
+		logger:          v.logger,
+	}
+}
+
+// ValidateSchema checks that the schema is a valid JSON Schema.
+func (v *JSONSchemaContentValidator) ValidateSchema(schema string) error {
+	_, err := compileSchema(schema)
+	return err
+}
+
+// Validate checks the content of the block against the content type registered for it.
+func (v *JSONSchemaContentValidator) Validate(tenantID entities.TenantID, block *entities.PageBlock) error {
+	if block.IsSnippet() {
+		_, err := block.SnippetPageID()
+		return err
+	}
+
+	contentType, err := v.findContentType(tenantID, block.ContentType())
+	if err != nil || contentType == nil {
+		return err
+	}
+
+	schema, err := compileSchema(contentType.Schema())
+	if err != nil {
+		v.logger.Error("Failed to compile content type schema", "contentTypeID", contentType.ID().Value(), "error", err)
+		return err
+	}
+
+	violations := validateContent(schema, block.Content())
+	if len(violations) > 0 {
+		return &errors.BlockContentError{
+			BlockKey:    block.BlockKey(),
+			ContentType: block.ContentType(),
+			Violations:  violations,
+		}
+	}
+	return nil
+}
+
+// findContentType returns the content type of the tenant with the name, or the global one when the tenant has none
+func (v *JSONSchemaContentValidator) findContentType(tenantID entities.TenantID, name string) (*entities.ContentType, error) {
+	contentType, err := v.contentTypeRepo.FindByName(&tenantID, name)
+	if err != nil || contentType != nil {
+		return contentType, err
+	}
+	return v.contentTypeRepo.FindByName(nil, name)
+}
+
+// compileSchema compiles a JSON Schema without resolving any external references
+func compileSchema(schema string) (*jsonschema.Schema, error) {
+	document, err := jsonschema.UnmarshalJSON(strings.NewReader(schema))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrContentTypeSchemaInvalid, err)
+	}
+
+	compiler := jsonschema.NewCompiler()
+	compiler.DefaultDraft(jsonschema.Draft2020)
+	// Schemas are tenant input, so references must never be loaded from files or over the network
+	compiler.UseLoader(jsonschema.SchemeURLLoader{})
+	if err := compiler.AddResource(contentSchemaURL, document); err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrContentTypeSchemaInvalid, err)
+	}
+
+	compiled, err := compiler.Compile(contentSchemaURL)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrContentTypeSchemaInvalid, err)
+	}
+	return compiled, nil
+}
+
+// validateContent validates the content against the schema and returns the violations with their instance paths
+func validateContent(schema *jsonschema.Schema, content string) []errors.ContentViolation {
+	instance, err := jsonschema.UnmarshalJSON(strings.NewReader(content))
+	if err != nil {
+		return []errors.ContentViolation{{Path: "/", Message: "content is not valid JSON"}}
+	}
+
+	err = schema.Validate(instance)
+	var validationErr *jsonschema.ValidationError
+	if !stderrors.As(err, &validationErr) {
+		return nil
+	}
+
+	var violations []errors.ContentViolation
+	for _, unit := range validationErr.BasicOutput().Errors {
+		if unit.Error == nil {
+			continue
+		}
+		path := unit.InstanceLocation
+		if path == "" {
+			path = "/"
+		}
+		violations = append(violations, errors.ContentViolation{Path: path, Message: unit.Error.String()})
+	}
+	if len(violations) == 0 {
+		violations = append(violations, errors.ContentViolation{Path: "/", Message: validationErr.Error()})
+	}
+	return violations
+}
@@ -0,0 +1,132 @@
+package services
+
+import (
+	stderrors "errors"
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	domainErrors "github.com/h4rdc0m/aurora-api/domain/errors"
+	"github.com/h4rdc0m/aurora-api/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+const heroSchema = `{
+	"type": "object",
+	"required": ["title"],
+	"properties": {
+		"title": {"type": "string", "minLength": 1},
+		"ratings": {"type": "array", "items": {"type": "integer"}}
+	}
+}`
+
+func newTestContentValidator() (*JSONSchemaContentValidator, *mocks.MockContentTypeRepository) {
+	repo := &mocks.MockContentTypeRepository{}
+	logger := &mocks.Logger{}
+	logger.On("Error", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+	return NewContentValidator(repo, logger).(*JSONSchemaContentValidator), repo
+}
+
+func newContentType(t *testing.T, tenantID *entities.TenantID, schema string) *entities.ContentType {
+	contentType, err := entities.NewContentType(tenantID, "hero", nil, schema)
+	assert.NoError(t, err)
+	return contentType
+}
+
+func newContentBlock(t *testing.T, contentType, content string) *entities.PageBlock {
+	block, err := entities.NewPageBlock(entities.NewPageVersionID(1), "block", 0, contentType, content)
+	assert.NoError(t, err)
+	return block
+}
+
+func TestJSONSchemaContentValidator_ValidateSchema(t *testing.T) {
+	validator, _ := newTestContentValidator()
+
+	tests := []struct {
+		name    string
+		schema  string
+		wantErr bool
+	}{
+		{"valid schema", heroSchema, false},
+		{"boolean schema", `true`, false},
+		{"malformed JSON", `{"type": `, true},
+		{"unknown type", `{"type": "text"}`, true},
+		{"external reference", `{"$ref": "file:///etc/passwd"}`, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validator.ValidateSchema(tt.schema)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, domainErrors.ErrContentTypeSchemaInvalid)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestJSONSchemaContentValidator_Validate(t *testing.T) {
+	tenantID := entities.NewTenantID(1)
+
+	t.Run("accepts matching content", func(t *testing.T) {
+		validator, repo := newTestContentValidator()
+		repo.On("FindByName", &tenantID, "hero").Return(newContentType(t, &tenantID, heroSchema), nil)
+
+		assert.NoError(t, validator.Validate(tenantID, newContentBlock(t, "hero", `{"title": "Welcome", "ratings": [1, 2]}`)))
+	})
+
+	t.Run("reports violations with field paths", func(t *testing.T) {
+		validator, repo := newTestContentValidator()
+		repo.On("FindByName", &tenantID, "hero").Return(newContentType(t, &tenantID, heroSchema), nil)
+
+		err := validator.Validate(tenantID, newContentBlock(t, "hero", `{"ratings": [1, "two"]}`))
+
+		assert.ErrorIs(t, err, domainErrors.ErrBlockContentInvalid)
+		var contentErr *domainErrors.BlockContentError
+		if assert.True(t, stderrors.As(err, &contentErr)) {
+			assert.Equal(t, "block", contentErr.BlockKey)
+			paths := make([]string, 0, len(contentErr.Violations))
+			for _, violation := range contentErr.Violations {
+				paths = append(paths, violation.Path)
+				assert.NotEmpty(t, violation.Message)
+			}
+			assert.ElementsMatch(t, []string{"/", "/ratings/1"}, paths)
+		}
+	})
+
+	t.Run("rejects content that is not JSON", func(t *testing.T) {
+		validator, repo := newTestContentValidator()
+		repo.On("FindByName", &tenantID, "hero").Return(newContentType(t, &tenantID, heroSchema), nil)
+
+		err := validator.Validate(tenantID, newContentBlock(t, "hero", `<h1>Welcome</h1>`))
+
+		var contentErr *domainErrors.BlockContentError
+		if assert.True(t, stderrors.As(err, &contentErr)) {
+			assert.Equal(t, "/", contentErr.Violations[0].Path)
+		}
+	})
+
+	t.Run("falls back to the global content type", func(t *testing.T) {
+		validator, repo := newTestContentValidator()
+		repo.On("FindByName", &tenantID, "hero").Return(nil, nil)
+		repo.On("FindByName", (*entities.TenantID)(nil), "hero").Return(newContentType(t, nil, heroSchema), nil)
+
+		assert.ErrorIs(t, validator.Validate(tenantID, newContentBlock(t, "hero", `{}`)), domainErrors.ErrBlockContentInvalid)
+	})
+
+	t.Run("accepts unregistered content types", func(t *testing.T) {
+		validator, repo := newTestContentValidator()
+		repo.On("FindByName", mock.Anything, "text").Return(nil, nil)
+
+		assert.NoError(t, validator.Validate(tenantID, newContentBlock(t, "text", "plain text")))
+	})
+
+	t.Run("checks snippet blocks without the registry", func(t *testing.T) {
+		validator, repo := newTestContentValidator()
+
+		err := validator.Validate(tenantID, newContentBlock(t, entities.PageBlockContentTypeSnippet, `{"page": 1}`))
+
+		assert.ErrorIs(t, err, domainErrors.ErrPageBlockSnippetInvalid)
+		repo.AssertNotCalled(t, "FindByName", mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,136 @@
+package services
+
+import (
+	"github.com/h4rdc0m/aurora-api/domain/common"
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	domainServices "github.com/h4rdc0m/aurora-api/domain/services"
+	"github.com/h4rdc0m/aurora-api/infrastructure/config"
+	"github.com/h4rdc0m/aurora-api/infrastructure/http_client"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// defaultLinkCheckHostDelay is used when AURORA_LINK_CHECK_HOST_DELAY is empty or invalid
+const defaultLinkCheckHostDelay = time.Second
+
+// linkCheckUserAgent identifies the link checker to the servers it requests
+const linkCheckUserAgent = "Aurora-LinkChecker/1.0"
+
+// linkCheckTimeout is the timeout in seconds of a single link check request
+const linkCheckTimeout = 3
+
+// maxLinkCheckReasonLength bounds the reason kept for a broken link
+const maxLinkCheckReasonLength = 255
+
+// HTTPLinkChecker checks links with HEAD requests, falling back to GET for servers that do not support HEAD.
+// Requests to the same host are spaced by at least the configured delay. Links are entered by editors, so the
+// checker only connects to public addresses and cannot be used to probe the internal network.
+type HTTPLinkChecker struct {
+	client     common.HTTPClient
+	logger     common.Logger
+	hostDelay  time.Duration
+	now        func() time.Time
+	sleep      func(time.Duration)
+	mu         sync.Mutex
+	nextByHost map[string]time.Time
+}
+
+// NewHTTPLinkChecker creates a LinkChecker on a public-only HTTP client, see http_client.NewPublicHttpClient, using
+// the host delay configured in the environment.
+func NewHTTPLinkChecker(env *config.Env, logger common.Logger) domainServices.LinkChecker {
+	hostDelay, err := time.ParseDuration(env.LinkCheckHostDelay)
+	if err != nil || hostDelay < 0 {
+		hostDelay = defaultLinkCheckHostDelay
+	}
+
+	return &HTTPLinkChecker{
+		client:     http_client.NewPublicHttpClient(linkCheckTimeout),
+		logger:     logger,
+		hostDelay:  hostDelay,
+		now:        time.Now,
+		sleep:      time.Sleep,
+		nextByHost: make(map[string]time.Time),
+	}
+}
+
+// Check requests the URL and reports whether it is broken. Rate limited responses (429) are not reported, as they
+// say nothing about the link itself.
+func (c *HTTPLinkChecker) Check(rawURL string) entities.LinkCheck {
+	check := entities.LinkCheck{URL: rawURL}
+
+	parsed, err := url.Parse(rawURL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		check.Broken = true
+		check.Reason = "invalid URL"
+		return check
+	}
+
+	statusCode, err := c.request(http.MethodHead, parsed)
+	if err == nil && (statusCode == http.StatusMethodNotAllowed || statusCode == http.StatusNotImplemented) {
+		statusCode, err = c.request(http.MethodGet, parsed)
+	}
+	if err != nil {
+		c.logger.Debug("Link check request failed", "url", rawURL, "error", err)
+		check.Broken = true
+		check.Reason = truncateReason(err.Error())
+		return check
+	}
+
+	check.StatusCode = &statusCode
+	if statusCode >= http.StatusBadRequest && statusCode != http.StatusTooManyRequests {
+		check.Broken = true
+		check.Reason = http.StatusText(statusCode)
+	}
+	return check
+}
+
+// request sends a single request once the host may be contacted again and returns the response status code
+func (c *HTTPLinkChecker) request(method string, target *url.URL) (int, error) {
+	req, err := http.NewRequest(method, target.String(), nil)
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("User-Agent", linkCheckUserAgent)
+
+	c.wait(strings.ToLower(target.Host))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	// Drain a little of the body so the connection can be reused; GET fallbacks may carry a full page
+	_, _ = io.CopyN(io.Discard, resp.Body, 4096)
+
+	return resp.StatusCode, nil
+}
+
+// wait blocks until the host may be contacted again and reserves the next slot
+func (c *HTTPLinkChecker) wait(host string) {
+	c.mu.Lock()
+	now := c.now()
+	next := c.nextByHost[host]
+	if next.Before(now) {
+		next = now
+	}
+	c.nextByHost[host] = next.Add(c.hostDelay)
+	c.mu.Unlock()
+
+	if delay := next.Sub(now); delay > 0 {
+		c.sleep(delay)
+	}
+}
+
+// truncateReason shortens an error message to the length stored in the link report
+func truncateReason(reason string) string {
+	if len(reason) <= maxLinkCheckReasonLength {
+		return reason
+	}
+	return reason[:maxLinkCheckReasonLength]
+}
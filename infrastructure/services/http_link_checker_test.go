@@ -0,0 +1,160 @@
+package services
+
+import (
+	"github.com/h4rdc0m/aurora-api/infrastructure/config"
+	"github.com/h4rdc0m/aurora-api/infrastructure/http_client"
+	"github.com/h4rdc0m/aurora-api/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// newTestLinkChecker creates a link checker that may connect to the loopback test servers
+func newTestLinkChecker(hostDelay string) *HTTPLinkChecker {
+	logger := &mocks.Logger{}
+	logger.On("Debug", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+	checker := NewHTTPLinkChecker(&config.Env{LinkCheckHostDelay: hostDelay}, logger).(*HTTPLinkChecker)
+	checker.client = http_client.NewStandardHttpClient(3)
+	checker.sleep = func(time.Duration) {}
+	return checker
+}
+
+func TestHTTPLinkChecker_Check(t *testing.T) {
+	var methods []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method+" "+r.URL.Path)
+		assert.Equal(t, linkCheckUserAgent, r.UserAgent())
+		switch r.URL.Path {
+		case "/ok":
+			w.WriteHeader(http.StatusOK)
+		case "/get-only":
+			if r.Method == http.MethodHead {
+				w.WriteHeader(http.StatusMethodNotAllowed)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		case "/busy":
+			w.WriteHeader(http.StatusTooManyRequests)
+		case "/error":
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name       string
+		path       string
+		wantBroken bool
+		wantStatus int
+		wantReason string
+	}{
+		{name: "ok", path: "/ok", wantStatus: 200},
+		{name: "falls back to GET", path: "/get-only", wantStatus: 200},
+		{name: "rate limited is not broken", path: "/busy", wantStatus: 429},
+		{name: "not found", path: "/missing", wantBroken: true, wantStatus: 404, wantReason: "Not Found"},
+		{name: "server error", path: "/error", wantBroken: true, wantStatus: 500, wantReason: "Internal Server Error"},
+	}
+
+	checker := newTestLinkChecker("0s")
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			check := checker.Check(server.URL + tt.path)
+
+			assert.Equal(t, server.URL+tt.path, check.URL)
+			assert.Equal(t, tt.wantBroken, check.Broken)
+			assert.Equal(t, tt.wantStatus, *check.StatusCode)
+			assert.Equal(t, tt.wantReason, check.Reason)
+		})
+	}
+
+	assert.Contains(t, methods, "HEAD /get-only")
+	assert.Contains(t, methods, "GET /get-only")
+	assert.NotContains(t, methods, "GET /ok")
+}
+
+func TestHTTPLinkChecker_Check_Unreachable(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	unreachable := server.URL + "/page"
+	server.Close()
+
+	check := newTestLinkChecker("0s").Check(unreachable)
+
+	assert.True(t, check.Broken)
+	assert.Nil(t, check.StatusCode)
+	assert.NotEmpty(t, check.Reason)
+}
+
+func TestHTTPLinkChecker_Check_InternalAddress(t *testing.T) {
+	var requested []string
+	internal := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = append(requested, r.URL.Path)
+	}))
+	defer internal.Close()
+	logger := &mocks.Logger{}
+	logger.On("Debug", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+	checker := NewHTTPLinkChecker(&config.Env{LinkCheckHostDelay: "0s"}, logger)
+
+	check := checker.Check(internal.URL + "/admin")
+
+	assert.True(t, check.Broken)
+	assert.Nil(t, check.StatusCode)
+	assert.Contains(t, check.Reason, http_client.ErrNonPublicAddress.Error())
+	assert.Empty(t, requested)
+}
+
+func TestHTTPLinkChecker_Check_InvalidURL(t *testing.T) {
+	checker := newTestLinkChecker("0s")
+
+	for _, link := range []string{"ftp://example.com/file", "https://", "://broken"} {
+		check := checker.Check(link)
+		assert.True(t, check.Broken, link)
+		assert.Equal(t, "invalid URL", check.Reason, link)
+	}
+}
+
+func TestHTTPLinkChecker_RateLimitsPerHost(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	checker := newTestLinkChecker("2s")
+	now := time.Date(2025, 7, 24, 3, 0, 0, 0, time.UTC)
+	checker.now = func() time.Time { return now }
+	var waits []time.Duration
+	checker.sleep = func(d time.Duration) { waits = append(waits, d) }
+
+	checker.Check(server.URL + "/a")
+	checker.Check(server.URL + "/b")
+	checker.Check(server.URL + "/c")
+	now = now.Add(10 * time.Second)
+	checker.Check(server.URL + "/d")
+
+	assert.Equal(t, []time.Duration{2 * time.Second, 4 * time.Second}, waits)
+}
+
+func TestNewHTTPLinkChecker_HostDelay(t *testing.T) {
+	tests := []struct {
+		name  string
+		delay string
+		want  time.Duration
+	}{
+		{"configured", "250ms", 250 * time.Millisecond},
+		{"disabled", "0s", 0},
+		{"empty", "", defaultLinkCheckHostDelay},
+		{"invalid", "soon", defaultLinkCheckHostDelay},
+		{"negative", "-1s", defaultLinkCheckHostDelay},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checker := NewHTTPLinkChecker(&config.Env{LinkCheckHostDelay: tt.delay}, &mocks.Logger{}).(*HTTPLinkChecker)
+			assert.Equal(t, tt.want, checker.hostDelay)
+		})
+	}
+}
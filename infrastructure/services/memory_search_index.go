@@ -0,0 +1,147 @@
+package services
+
+import (
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	domainServices "github.com/h4rdc0m/aurora-api/domain/services"
+	"github.com/h4rdc0m/aurora-api/utils/fulltext"
+	"github.com/jmoiron/sqlx"
+	"sort"
+	"sync"
+)
+
+// Field weights of the in-memory relevance score, so title matches rank above body matches
+const (
+	titleWeight       = 3
+	descriptionWeight = 2
+	bodyWeight        = 1
+)
+
+// memoryDocumentKey identifies the document of a page in a locale
+type memoryDocumentKey struct {
+	pageID uint64
+	locale entities.Locale
+}
+
+// MemorySearchIndex is an in-memory implementation of SearchIndex, meant for tests and local development.
+// Like the MySQL index it requires every term as a word prefix; the score counts the matches per field.
+type MemorySearchIndex struct {
+	mu        sync.RWMutex
+	documents map[memoryDocumentKey]*entities.SearchDocument
+}
+
+// NewMemorySearchIndex initializes and returns an empty in-memory SearchIndex.
+func NewMemorySearchIndex() *MemorySearchIndex {
+	return &MemorySearchIndex{documents: make(map[memoryDocumentKey]*entities.SearchDocument)}
+}
+
+var _ domainServices.SearchIndex = (*MemorySearchIndex)(nil)
+
+// WithTrx returns the index itself, as it does not take part in database transactions.
+func (s *MemorySearchIndex) WithTrx(_ *sqlx.Tx) domainServices.SearchIndex {
+	return s
+}
+
+// Index stores a copy of the document, replacing the document of the same page and locale.
+func (s *MemorySearchIndex) Index(document *entities.SearchDocument) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored := *document
+	s.documents[memoryDocumentKey{pageID: document.PageID.Value(), locale: document.Locale}] = &stored
+	return nil
+}
+
+// Remove deletes the documents of the page in every locale.
+func (s *MemorySearchIndex) Remove(pageID entities.PageID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for key := range s.documents {
+		if key.pageID == pageID.Value() {
+			delete(s.documents, key)
+		}
+	}
+	return nil
+}
+
+// Document returns the indexed document of the page in the locale, or nil when it is not indexed.
+func (s *MemorySearchIndex) Document(pageID entities.PageID, locale entities.Locale) *entities.SearchDocument {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.documents[memoryDocumentKey{pageID: pageID.Value(), locale: locale}]
+}
+
+// Search scores the document of every page of the site in its most preferred locale of the chain and returns
+// the requested page of hits.
+func (s *MemorySearchIndex) Search(query entities.SearchQuery) (*entities.SearchResult, error) {
+	terms := query.Terms()
+	result := &entities.SearchResult{Query: query, Hits: make([]entities.SearchHit, 0)}
+	if len(terms) == 0 {
+		return result, nil
+	}
+
+	s.mu.RLock()
+	preferred := make(map[uint64]*entities.SearchDocument)
+	for _, document := range s.documents {
+		if document.SiteID.Value() != query.SiteID.Value() {
+			continue
+		}
+		rank, ok := query.LocaleRank(document.Locale)
+		if !ok {
+			continue
+		}
+		if current, found := preferred[document.PageID.Value()]; found {
+			if currentRank, _ := query.LocaleRank(current.Locale); currentRank < rank {
+				continue
+			}
+		}
+		preferred[document.PageID.Value()] = document
+	}
+	s.mu.RUnlock()
+
+	type match struct {
+		document *entities.SearchDocument
+		score    float64
+	}
+	matches := make([]match, 0)
+	for _, document := range preferred {
+		if score, ok := scoreDocument(document, terms); ok {
+			matches = append(matches, match{document: document, score: score})
+		}
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].score != matches[j].score {
+			return matches[i].score > matches[j].score
+		}
+		return matches[i].document.Path < matches[j].document.Path
+	})
+
+	result.Total = len(matches)
+	start := min(query.Offset(), len(matches))
+	end := min(start+query.PerPage, len(matches))
+	for _, m := range matches[start:end] {
+		result.Hits = append(result.Hits, entities.NewSearchHit(m.document, terms, m.score))
+	}
+	return result, nil
+}
+
+// scoreDocument returns the weighted number of matches, or false when a term does not occur at all
+func scoreDocument(document *entities.SearchDocument, terms []string) (float64, bool) {
+	title := fulltext.Words(document.Title)
+	description := fulltext.Words(document.Description)
+	body := fulltext.Words(document.Body)
+
+	score := 0
+	for _, term := range terms {
+		termScore := titleWeight*fulltext.CountMatches(title, term) +
+			descriptionWeight*fulltext.CountMatches(description, term) +
+			bodyWeight*fulltext.CountMatches(body, term)
+		if termScore == 0 {
+			return 0, false
+		}
+		score += termScore
+	}
+	return float64(score), true
+}
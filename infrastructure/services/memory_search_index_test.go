@@ -0,0 +1,125 @@
+package services
+
+import (
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func newSearchDocument(pageID, siteID uint64, path, title, body string) *entities.SearchDocument {
+	return &entities.SearchDocument{
+		PageID:    entities.NewPageID(pageID),
+		SiteID:    entities.NewSiteID(siteID),
+		Locale:    entities.DefaultLocale,
+		VersionID: entities.NewPageVersionID(pageID * 10),
+		Path:      path,
+		Title:     title,
+		Body:      body,
+	}
+}
+
+func TestMemorySearchIndex_Search(t *testing.T) {
+	english := []entities.Locale{entities.DefaultLocale}
+	index := NewMemorySearchIndex()
+	assert.NoError(t, index.Index(newSearchDocument(1, 1, "/news", "News", "Latest announcements")))
+	assert.NoError(t, index.Index(newSearchDocument(2, 1, "/about", "About us", "We write news every week")))
+	assert.NoError(t, index.Index(newSearchDocument(3, 1, "/contact", "Contact", "Send us a message")))
+	assert.NoError(t, index.Index(newSearchDocument(4, 2, "/news", "News", "Other site")))
+
+	t.Run("ranks title matches first and scopes by site", func(t *testing.T) {
+		result, err := index.Search(entities.SearchQuery{SiteID: entities.NewSiteID(1), Locales: english, Text: "news", Page: 1, PerPage: 10})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, result.Total)
+		if assert.Len(t, result.Hits, 2) {
+			assert.Equal(t, "/news", result.Hits[0].Path)
+			assert.Equal(t, "<mark>News</mark>", result.Hits[0].Title)
+			assert.Equal(t, "/about", result.Hits[1].Path)
+			assert.Contains(t, result.Hits[1].Excerpt, "<mark>news</mark>")
+		}
+	})
+
+	t.Run("requires every term as a prefix", func(t *testing.T) {
+		result, err := index.Search(entities.SearchQuery{SiteID: entities.NewSiteID(1), Locales: english, Text: "announce lat", Page: 1, PerPage: 10})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, result.Total)
+		assert.Equal(t, "/news", result.Hits[0].Path)
+	})
+
+	t.Run("paginates", func(t *testing.T) {
+		result, err := index.Search(entities.SearchQuery{SiteID: entities.NewSiteID(1), Locales: english, Text: "news", Page: 2, PerPage: 1})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, result.Total)
+		if assert.Len(t, result.Hits, 1) {
+			assert.Equal(t, "/about", result.Hits[0].Path)
+		}
+	})
+
+	t.Run("returns nothing without terms", func(t *testing.T) {
+		result, err := index.Search(entities.SearchQuery{SiteID: entities.NewSiteID(1), Locales: english, Text: " !? ", Page: 1, PerPage: 10})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 0, result.Total)
+		assert.Empty(t, result.Hits)
+	})
+
+	t.Run("forgets removed pages", func(t *testing.T) {
+		assert.NoError(t, index.Remove(entities.NewPageID(3)))
+
+		result, err := index.Search(entities.SearchQuery{SiteID: entities.NewSiteID(1), Locales: english, Text: "message", Page: 1, PerPage: 10})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 0, result.Total)
+	})
+}
+
+func TestMemorySearchIndex_Search_LocaleChain(t *testing.T) {
+	dutch := entities.Locale("nl-BE")
+	translated := func(document *entities.SearchDocument, locale entities.Locale) *entities.SearchDocument {
+		document.Locale = locale
+		return document
+	}
+	index := NewMemorySearchIndex()
+	assert.NoError(t, index.Index(newSearchDocument(1, 1, "/news", "News", "Latest announcements")))
+	assert.NoError(t, index.Index(translated(newSearchDocument(1, 1, "/news", "Nieuws", "Laatste berichten"), dutch)))
+	assert.NoError(t, index.Index(newSearchDocument(2, 1, "/about", "About", "We write news every week")))
+	assert.NoError(t, index.Index(translated(newSearchDocument(3, 1, "/blog", "Blog", "Nieuws en news"), "fr")))
+
+	t.Run("searches every page in its preferred locale", func(t *testing.T) {
+		result, err := index.Search(entities.SearchQuery{SiteID: entities.NewSiteID(1), Locales: []entities.Locale{dutch, entities.DefaultLocale}, Text: "news", Page: 1, PerPage: 10})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, result.Total)
+		if assert.Len(t, result.Hits, 1) {
+			assert.Equal(t, "/about", result.Hits[0].Path)
+			assert.Equal(t, entities.DefaultLocale, result.Hits[0].Locale)
+		}
+	})
+
+	t.Run("finds translations", func(t *testing.T) {
+		result, err := index.Search(entities.SearchQuery{SiteID: entities.NewSiteID(1), Locales: []entities.Locale{dutch, entities.DefaultLocale}, Text: "nieuws", Page: 1, PerPage: 10})
+
+		assert.NoError(t, err)
+		if assert.Len(t, result.Hits, 1) {
+			assert.Equal(t, "/news", result.Hits[0].Path)
+			assert.Equal(t, dutch, result.Hits[0].Locale)
+		}
+	})
+
+	t.Run("leaves out locales outside the chain", func(t *testing.T) {
+		result, err := index.Search(entities.SearchQuery{SiteID: entities.NewSiteID(1), Locales: []entities.Locale{entities.DefaultLocale}, Text: "blog", Page: 1, PerPage: 10})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 0, result.Total)
+	})
+
+	t.Run("forgets every locale of removed pages", func(t *testing.T) {
+		assert.NoError(t, index.Remove(entities.NewPageID(1)))
+
+		assert.Nil(t, index.Document(entities.NewPageID(1), entities.DefaultLocale))
+		assert.Nil(t, index.Document(entities.NewPageID(1), dutch))
+		assert.NotNil(t, index.Document(entities.NewPageID(2), entities.DefaultLocale))
+	})
+}
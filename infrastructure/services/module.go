@@ -7,4 +7,13 @@ var Module = fx.Module(
 	fx.Provide(NewTokenServiceConfig),
 	fx.Provide(NewTokenService),
 	fx.Provide(NewSessionService),
+	fx.Provide(NewRedisLockService),
+	fx.Provide(NewRedisPreviewTokenService),
+	fx.Provide(NewRedisEditingLeaseService),
+	fx.Provide(NewPageResolver),
+	fx.Provide(NewSnippetService),
+	fx.Provide(NewContentValidator),
+	fx.Provide(NewMySQLSearchIndex),
+	fx.Provide(NewSearchIndexer),
+	fx.Provide(NewHTTPLinkChecker),
 )
@@ -0,0 +1,173 @@
+package services
+
+import (
+	"github.com/Masterminds/squirrel"
+	"github.com/h4rdc0m/aurora-api/domain/common"
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	domainServices "github.com/h4rdc0m/aurora-api/domain/services"
+	"github.com/h4rdc0m/aurora-api/infrastructure/persistence/models"
+	"github.com/h4rdc0m/aurora-api/infrastructure/persistence/mysql"
+	"github.com/jmoiron/sqlx"
+	"strings"
+)
+
+// searchMatch is the full-text condition over the indexed columns, see the FULLTEXT index of search_documents
+const searchMatch = "MATCH(title, description, body) AGAINST (? IN BOOLEAN MODE)"
+
+// MySQLSearchIndex is an implementation of SearchIndex on a MySQL FULLTEXT index.
+type MySQLSearchIndex struct {
+	db     common.Database
+	logger common.Logger
+}
+
+// NewMySQLSearchIndex initializes and returns a SearchIndex storing its documents in the search_documents table.
+func NewMySQLSearchIndex(db common.Database, logger common.Logger) domainServices.SearchIndex {
+	return &MySQLSearchIndex{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// WithTrx returns a copy of the index that runs its queries inside the given transaction.
+func (s *MySQLSearchIndex) WithTrx(trxHandle *sqlx.Tx) domainServices.SearchIndex {
+	return &MySQLSearchIndex{
+		db:     mysql.NewTransaction(trxHandle, s.logger),
+		logger: s.logger,
+	}
+}
+
+// Index inserts the document, or replaces the document of the same page and locale.
+func (s *MySQLSearchIndex) Index(document *entities.SearchDocument) error {
+	updatedAt := document.UpdatedAt
+	query, args, err := squirrel.Insert("search_documents").
+		Columns("page_id", "locale", "site_id", "page_version_id", "path", "title", "description", "body", "updated_at").
+		Values(document.PageID.Value(), string(document.Locale), document.SiteID.Value(), document.VersionID.Value(), document.Path, document.Title, document.Description, document.Body, &updatedAt).
+		Suffix("ON DUPLICATE KEY UPDATE site_id = VALUES(site_id), page_version_id = VALUES(page_version_id), path = VALUES(path), " +
+			"title = VALUES(title), description = VALUES(description), body = VALUES(body), updated_at = VALUES(updated_at)").
+		PlaceholderFormat(squirrel.Question).
+		ToSql()
+	if err != nil {
+		s.logger.Error("Failed to build insert query for search document", "error", err)
+		return err
+	}
+	if _, err := s.db.Exec(query, args...); err != nil {
+		s.logger.Error("Failed to index search document", "pageID", document.PageID.Value(), "locale", document.Locale, "error", err)
+		return err
+	}
+	return nil
+}
+
+// Remove deletes the documents of the page in every locale.
+func (s *MySQLSearchIndex) Remove(pageID entities.PageID) error {
+	query, args, err := squirrel.Delete("search_documents").Where(squirrel.Eq{"page_id": pageID.Value()}).ToSql()
+	if err != nil {
+		s.logger.Error("Failed to build delete query for search document", "error", err)
+		return err
+	}
+	if _, err := s.db.Exec(query, args...); err != nil {
+		s.logger.Error("Failed to remove search document", "pageID", pageID.Value(), "error", err)
+		return err
+	}
+	return nil
+}
+
+// Search runs the query in boolean mode, requiring every term as a word prefix, and highlights the hits.
+// Only the document of every page in its most preferred locale of the chain is searched, see preferredLocale.
+func (s *MySQLSearchIndex) Search(query entities.SearchQuery) (*entities.SearchResult, error) {
+	terms := query.Terms()
+	result := &entities.SearchResult{Query: query, Hits: make([]entities.SearchHit, 0)}
+	if len(terms) == 0 {
+		return result, nil
+	}
+	against := booleanQuery(terms)
+
+	countQuery, countArgs, err := squirrel.Select("COUNT(*)").From("search_documents").
+		Where(squirrel.Eq{"site_id": query.SiteID.Value()}).
+		Where(preferredLocale(query.Locales)).
+		Where(searchMatch, against).
+		ToSql()
+	if err != nil {
+		s.logger.Error("Failed to build count query for search", "error", err)
+		return nil, err
+	}
+	var total int64
+	if err := s.db.Get(&total, countQuery, countArgs...); err != nil {
+		s.logger.Error("Failed to count search hits", "siteID", query.SiteID.Value(), "error", err)
+		return nil, err
+	}
+	result.Total = int(total)
+	if total == 0 {
+		return result, nil
+	}
+
+	selectQuery, selectArgs, err := squirrel.Select("page_id", "locale", "site_id", "page_version_id", "path", "title", "description", "body", "updated_at").
+		Column(squirrel.Expr(searchMatch+" AS score", against)).
+		From("search_documents").
+		Where(squirrel.Eq{"site_id": query.SiteID.Value()}).
+		Where(preferredLocale(query.Locales)).
+		Where(searchMatch, against).
+		OrderBy("score DESC", "path ASC").
+		Limit(uint64(query.PerPage)).
+		Offset(uint64(query.Offset())).
+		ToSql()
+	if err != nil {
+		s.logger.Error("Failed to build select query for search", "error", err)
+		return nil, err
+	}
+	var rows []*models.SearchHit
+	if err := s.db.Select(&rows, selectQuery, selectArgs...); err != nil {
+		s.logger.Error("Failed to search documents", "siteID", query.SiteID.Value(), "error", err)
+		return nil, err
+	}
+
+	for _, row := range rows {
+		result.Hits = append(result.Hits, entities.NewSearchHit(toSearchDocument(&row.SearchDocument), terms, row.Score))
+	}
+	return result, nil
+}
+
+// preferredLocale keeps the documents in a locale of the chain whose page has no document in a locale earlier in
+// the chain. The preferred document is chosen before matching, so a page is never found through a fallback locale
+// it is not delivered in.
+func preferredLocale(chain []entities.Locale) squirrel.Sqlizer {
+	locales := make([]interface{}, 0, len(chain))
+	for _, locale := range chain {
+		locales = append(locales, string(locale))
+	}
+	placeholders := squirrel.Placeholders(len(locales))
+
+	return squirrel.And{
+		squirrel.Eq{"locale": locales},
+		squirrel.Expr("NOT EXISTS (SELECT 1 FROM search_documents AS preferred WHERE preferred.page_id = search_documents.page_id "+
+			"AND FIELD(preferred.locale, "+placeholders+") BETWEEN 1 AND FIELD(search_documents.locale, "+placeholders+") - 1)",
+			append(append([]interface{}{}, locales...), locales...)...),
+	}
+}
+
+// booleanQuery requires every term as a word prefix, e.g. "+news* +archive*". Terms hold only letters and
+// digits, so they cannot inject boolean operators.
+func booleanQuery(terms []string) string {
+	parts := make([]string, 0, len(terms))
+	for _, term := range terms {
+		parts = append(parts, "+"+term+"*")
+	}
+	return strings.Join(parts, " ")
+}
+
+// toSearchDocument converts a stored row to a search document
+func toSearchDocument(model *models.SearchDocument) *entities.SearchDocument {
+	document := &entities.SearchDocument{
+		PageID:      entities.NewPageID(model.PageID),
+		SiteID:      entities.NewSiteID(model.SiteID),
+		Locale:      entities.Locale(model.Locale),
+		VersionID:   entities.NewPageVersionID(model.PageVersionID),
+		Path:        model.Path,
+		Title:       model.Title,
+		Description: model.Description,
+		Body:        model.Body,
+	}
+	if model.UpdatedAt != nil {
+		document.UpdatedAt = *model.UpdatedAt
+	}
+	return document
+}
@@ -0,0 +1,73 @@
+package services
+
+import (
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"github.com/h4rdc0m/aurora-api/infrastructure/persistence/models"
+	"github.com/h4rdc0m/aurora-api/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+func TestMySQLSearchIndex_Search(t *testing.T) {
+	t.Run("requires every term as a prefix and highlights the hits", func(t *testing.T) {
+		db := &mocks.Database{}
+		index := NewMySQLSearchIndex(db, &mocks.Logger{})
+		db.On("Get", mock.Anything, mock.Anything, uint64(1), "nl-BE", "en", "nl-BE", "en", "nl-BE", "en", "+news* +week*").
+			Run(func(args mock.Arguments) { *args.Get(0).(*int64) = 3 }).
+			Return(nil)
+		db.On("Select", mock.Anything, mock.Anything, "+news* +week*", uint64(1), "nl-BE", "en", "nl-BE", "en", "nl-BE", "en", "+news* +week*").
+			Run(func(args mock.Arguments) {
+				*args.Get(0).(*[]*models.SearchHit) = []*models.SearchHit{
+					{SearchDocument: models.SearchDocument{PageID: 2, SiteID: 1, Locale: "en", PageVersionID: 20, Path: "/news", Title: "News", Body: "Every week"}, Score: 1.5},
+				}
+			}).
+			Return(nil)
+
+		result, err := index.Search(entities.SearchQuery{SiteID: entities.NewSiteID(1), Locales: []entities.Locale{"nl-BE", entities.DefaultLocale}, Text: "News, week!", Page: 3, PerPage: 1})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, result.Total)
+		if assert.Len(t, result.Hits, 1) {
+			assert.Equal(t, uint64(2), result.Hits[0].PageID.Value())
+			assert.Equal(t, entities.DefaultLocale, result.Hits[0].Locale)
+			assert.Equal(t, "<mark>News</mark>", result.Hits[0].Title)
+			assert.Equal(t, "Every <mark>week</mark>", result.Hits[0].Excerpt)
+			assert.Equal(t, 1.5, result.Hits[0].Score)
+		}
+		db.AssertExpectations(t)
+	})
+
+	t.Run("skips the select without hits", func(t *testing.T) {
+		db := &mocks.Database{}
+		index := NewMySQLSearchIndex(db, &mocks.Logger{})
+		db.On("Get", mock.Anything, mock.Anything, uint64(1), "en", "en", "en", "+news*").Return(nil)
+
+		result, err := index.Search(entities.SearchQuery{SiteID: entities.NewSiteID(1), Locales: []entities.Locale{entities.DefaultLocale}, Text: "news", Page: 1, PerPage: 10})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 0, result.Total)
+		assert.Empty(t, result.Hits)
+		db.AssertNotCalled(t, "Select", mock.Anything, mock.Anything)
+	})
+
+	t.Run("does not query without terms", func(t *testing.T) {
+		db := &mocks.Database{}
+		index := NewMySQLSearchIndex(db, &mocks.Logger{})
+
+		result, err := index.Search(entities.SearchQuery{SiteID: entities.NewSiteID(1), Text: "+-*", Page: 1, PerPage: 10})
+
+		assert.NoError(t, err)
+		assert.Empty(t, result.Hits)
+		db.AssertNotCalled(t, "Get", mock.Anything, mock.Anything)
+	})
+}
+
+func TestMySQLSearchIndex_Remove(t *testing.T) {
+	db := &mocks.Database{}
+	index := NewMySQLSearchIndex(db, &mocks.Logger{})
+	db.On("Exec", "DELETE FROM search_documents WHERE page_id = ?", uint64(5)).Return(&mocks.SqlResult{}, nil)
+
+	assert.NoError(t, index.Remove(entities.NewPageID(5)))
+	db.AssertExpectations(t)
+}
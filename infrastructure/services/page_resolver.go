@@ -0,0 +1,128 @@
+package services
+
+import (
+	"github.com/h4rdc0m/aurora-api/domain/common"
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"github.com/h4rdc0m/aurora-api/domain/errors"
+	"github.com/h4rdc0m/aurora-api/domain/repositories"
+	domainServices "github.com/h4rdc0m/aurora-api/domain/services"
+	"github.com/jmoiron/sqlx"
+)
+
+// maxHardLinkDepth bounds the length of a hard link chain, as a safeguard next to the cycle detection
+const maxHardLinkDepth = 32
+
+// PageResolverImpl is an implementation of PageResolver that follows hard links through the page repository.
+type PageResolverImpl struct {
+	pageRepo repositories.PageRepository
+	siteRepo repositories.SiteRepository
+	logger   common.Logger
+}
+
+// NewPageResolver initializes and returns a PageResolver using the given repositories.
+func NewPageResolver(
+	pageRepo repositories.PageRepository,
+	siteRepo repositories.SiteRepository,
+	logger common.Logger,
+) domainServices.PageResolver {
+	return &PageResolverImpl{
+		pageRepo: pageRepo,
+		siteRepo: siteRepo,
+		logger:   logger,
+	}
+}
+
+// WithTrx returns a copy of the resolver that reads inside the given transaction.
+func (r *PageResolverImpl) WithTrx(trxHandle *sqlx.Tx) domainServices.PageResolver {
+	return &PageResolverImpl{
+		pageRepo: r.pageRepo.WithTrx(trxHandle),
+		siteRepo: r.siteRepo.WithTrx(trxHandle),
+		logger:   r.logger,
+	}
+}
+
+// Resolve follows the hard links starting at the page and returns the final content or link page.
+func (r *PageResolverImpl) Resolve(page *entities.Page) (*entities.ResolvedPage, error) {
+	resolved := &entities.ResolvedPage{Page: page, Chain: []entities.PageID{page.ID()}}
+	visited := map[uint64]bool{page.ID().Value(): true}
+
+	for resolved.Page.Type() == entities.PageTypeHardLink {
+		targetID := resolved.Page.HardLinkPageID()
+		if targetID == nil || targetID.IsEmpty() {
+			return nil, errors.ErrPageHardLinkDangling
+		}
+		if visited[targetID.Value()] || len(resolved.Chain) > maxHardLinkDepth {
+			r.logger.Warn("Page hard link cycle detected", "pageID", page.ID().Value(), "targetID", targetID.Value())
+			return nil, errors.ErrPageHardLinkCycle
+		}
+
+		target, err := r.pageRepo.FindByID(*targetID)
+		if err != nil {
+			r.logger.Error("Failed to find hard link target", "pageID", resolved.Page.ID().Value(), "targetID", targetID.Value(), "error", err)
+			return nil, err
+		}
+		if target == nil {
+			r.logger.Warn("Page hard link target is missing", "pageID", resolved.Page.ID().Value(), "targetID", targetID.Value())
+			return nil, errors.ErrPageHardLinkDangling
+		}
+
+		visited[targetID.Value()] = true
+		resolved.Page = target
+		resolved.Chain = append(resolved.Chain, target.ID())
+	}
+
+	return resolved, nil
+}
+
+// ValidateHardLinkTarget checks that the page may hard link to the target.
+func (r *PageResolverImpl) ValidateHardLinkTarget(page *entities.Page, targetID entities.PageID) error {
+	if !page.ID().IsEmpty() && page.ID().Value() == targetID.Value() {
+		return errors.ErrPageHardLinkSelfReference
+	}
+
+	target, err := r.pageRepo.FindByID(targetID)
+	if err != nil {
+		r.logger.Error("Failed to find hard link target", "targetID", targetID.Value(), "error", err)
+		return err
+	}
+	if target == nil {
+		return errors.ErrPageHardLinkTargetNotFound
+	}
+
+	if target.SiteID().Value() != page.SiteID().Value() {
+		if err := r.ensureSameTenant(page.SiteID(), target.SiteID()); err != nil {
+			return err
+		}
+	}
+
+	// Following the chain from the target must neither reach the page nor run into an existing cycle
+	resolved, err := r.Resolve(target)
+	if err != nil {
+		return err
+	}
+	for _, id := range resolved.Chain {
+		if !page.ID().IsEmpty() && id.Value() == page.ID().Value() {
+			return errors.ErrPageHardLinkCycle
+		}
+	}
+
+	return nil
+}
+
+// ensureSameTenant checks that both sites belong to the same tenant
+func (r *PageResolverImpl) ensureSameTenant(siteID, targetSiteID entities.SiteID) error {
+	site, err := r.siteRepo.FindByID(siteID)
+	if err != nil {
+		r.logger.Error("Failed to find site", "siteID", siteID.Value(), "error", err)
+		return err
+	}
+	targetSite, err := r.siteRepo.FindByID(targetSiteID)
+	if err != nil {
+		r.logger.Error("Failed to find site", "siteID", targetSiteID.Value(), "error", err)
+		return err
+	}
+	if site == nil || targetSite == nil || site.TenantID().Value() != targetSite.TenantID().Value() {
+		return errors.ErrPageHardLinkCrossTenant
+	}
+	return nil
+}
@@ -0,0 +1,193 @@
+package services
+
+import (
+	"errors"
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	domainErrors "github.com/h4rdc0m/aurora-api/domain/errors"
+	"github.com/h4rdc0m/aurora-api/domain/value_objects"
+	"github.com/h4rdc0m/aurora-api/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+// newResolverPage creates a persisted page of the given type, hard linking to target when it is not zero
+func newResolverPage(t *testing.T, id, siteID uint64, pageType entities.PageType, target uint64) *entities.Page {
+	key, err := value_objects.NewPageKey("page")
+	assert.NoError(t, err)
+	page, err := entities.NewPage(key, nil, entities.NewSiteID(siteID), pageType)
+	assert.NoError(t, err)
+	page.SetID(entities.NewPageID(id))
+	if target != 0 {
+		targetID := entities.NewPageID(target)
+		assert.NoError(t, page.SetHardLinkPageID(&targetID))
+	}
+	return page
+}
+
+// newResolverSite creates a persisted site of the given tenant
+func newResolverSite(t *testing.T, id, tenantID uint64) *entities.Site {
+	domain, err := value_objects.NewDomainName("example.com")
+	assert.NoError(t, err)
+	site, err := entities.NewSite("Site", nil, domain, entities.NewTemplateID(1), entities.NewTenantID(tenantID))
+	assert.NoError(t, err)
+	site.SetID(entities.NewSiteID(id))
+	return site
+}
+
+func newTestPageResolver() (*PageResolverImpl, *mocks.MockPageRepository, *mocks.MockSiteRepository) {
+	pageRepo := &mocks.MockPageRepository{}
+	siteRepo := &mocks.MockSiteRepository{}
+	logger := &mocks.Logger{}
+	logger.On("Warn", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+	logger.On("Error", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+	return NewPageResolver(pageRepo, siteRepo, logger).(*PageResolverImpl), pageRepo, siteRepo
+}
+
+func chainValues(chain []entities.PageID) []uint64 {
+	values := make([]uint64, 0, len(chain))
+	for _, id := range chain {
+		values = append(values, id.Value())
+	}
+	return values
+}
+
+func TestPageResolverImpl_Resolve(t *testing.T) {
+	t.Run("content page resolves to itself", func(t *testing.T) {
+		resolver, pageRepo, _ := newTestPageResolver()
+		page := newResolverPage(t, 1, 1, entities.PageTypeContent, 0)
+
+		resolved, err := resolver.Resolve(page)
+
+		assert.NoError(t, err)
+		assert.Same(t, page, resolved.Page)
+		assert.Equal(t, []uint64{1}, chainValues(resolved.Chain))
+		pageRepo.AssertNotCalled(t, "FindByID", mock.Anything)
+	})
+
+	t.Run("follows a chain of hard links", func(t *testing.T) {
+		resolver, pageRepo, _ := newTestPageResolver()
+		page := newResolverPage(t, 1, 1, entities.PageTypeHardLink, 2)
+		middle := newResolverPage(t, 2, 1, entities.PageTypeHardLink, 3)
+		content := newResolverPage(t, 3, 2, entities.PageTypeContent, 0)
+		pageRepo.On("FindByID", entities.NewPageID(2)).Return(middle, nil)
+		pageRepo.On("FindByID", entities.NewPageID(3)).Return(content, nil)
+
+		resolved, err := resolver.Resolve(page)
+
+		assert.NoError(t, err)
+		assert.Same(t, content, resolved.Page)
+		assert.Equal(t, []uint64{1, 2, 3}, chainValues(resolved.Chain))
+		assert.False(t, resolved.IsLink())
+	})
+
+	t.Run("ends at a link page", func(t *testing.T) {
+		resolver, pageRepo, _ := newTestPageResolver()
+		page := newResolverPage(t, 1, 1, entities.PageTypeHardLink, 2)
+		link := newResolverPage(t, 2, 1, entities.PageTypeLink, 0)
+		url := "https://example.com"
+		assert.NoError(t, link.SetLinkURL(&url))
+		pageRepo.On("FindByID", entities.NewPageID(2)).Return(link, nil)
+
+		resolved, err := resolver.Resolve(page)
+
+		assert.NoError(t, err)
+		assert.True(t, resolved.IsLink())
+		assert.Equal(t, &url, resolved.LinkURL())
+	})
+
+	t.Run("detects a cycle", func(t *testing.T) {
+		resolver, pageRepo, _ := newTestPageResolver()
+		page := newResolverPage(t, 1, 1, entities.PageTypeHardLink, 2)
+		other := newResolverPage(t, 2, 1, entities.PageTypeHardLink, 1)
+		pageRepo.On("FindByID", entities.NewPageID(2)).Return(other, nil)
+
+		resolved, err := resolver.Resolve(page)
+
+		assert.ErrorIs(t, err, domainErrors.ErrPageHardLinkCycle)
+		assert.Nil(t, resolved)
+	})
+
+	t.Run("detects a dangling target", func(t *testing.T) {
+		resolver, pageRepo, _ := newTestPageResolver()
+		page := newResolverPage(t, 1, 1, entities.PageTypeHardLink, 2)
+		pageRepo.On("FindByID", entities.NewPageID(2)).Return(nil, nil)
+
+		resolved, err := resolver.Resolve(page)
+
+		assert.ErrorIs(t, err, domainErrors.ErrPageHardLinkDangling)
+		assert.Nil(t, resolved)
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		resolver, pageRepo, _ := newTestPageResolver()
+		page := newResolverPage(t, 1, 1, entities.PageTypeHardLink, 2)
+		dbErr := errors.New("db error")
+		pageRepo.On("FindByID", entities.NewPageID(2)).Return(nil, dbErr)
+
+		_, err := resolver.Resolve(page)
+
+		assert.ErrorIs(t, err, dbErr)
+	})
+}
+
+func TestPageResolverImpl_ValidateHardLinkTarget(t *testing.T) {
+	t.Run("valid target in the same site", func(t *testing.T) {
+		resolver, pageRepo, siteRepo := newTestPageResolver()
+		page := newResolverPage(t, 1, 1, entities.PageTypeHardLink, 0)
+		target := newResolverPage(t, 2, 1, entities.PageTypeContent, 0)
+		pageRepo.On("FindByID", entities.NewPageID(2)).Return(target, nil)
+
+		assert.NoError(t, resolver.ValidateHardLinkTarget(page, entities.NewPageID(2)))
+		siteRepo.AssertNotCalled(t, "FindByID", mock.Anything)
+	})
+
+	t.Run("valid target in another site of the tenant", func(t *testing.T) {
+		resolver, pageRepo, siteRepo := newTestPageResolver()
+		page := newResolverPage(t, 1, 1, entities.PageTypeHardLink, 0)
+		target := newResolverPage(t, 2, 2, entities.PageTypeContent, 0)
+		pageRepo.On("FindByID", entities.NewPageID(2)).Return(target, nil)
+		siteRepo.On("FindByID", entities.NewSiteID(1)).Return(newResolverSite(t, 1, 10), nil)
+		siteRepo.On("FindByID", entities.NewSiteID(2)).Return(newResolverSite(t, 2, 10), nil)
+
+		assert.NoError(t, resolver.ValidateHardLinkTarget(page, entities.NewPageID(2)))
+	})
+
+	t.Run("target in another tenant", func(t *testing.T) {
+		resolver, pageRepo, siteRepo := newTestPageResolver()
+		page := newResolverPage(t, 1, 1, entities.PageTypeHardLink, 0)
+		target := newResolverPage(t, 2, 2, entities.PageTypeContent, 0)
+		pageRepo.On("FindByID", entities.NewPageID(2)).Return(target, nil)
+		siteRepo.On("FindByID", entities.NewSiteID(1)).Return(newResolverSite(t, 1, 10), nil)
+		siteRepo.On("FindByID", entities.NewSiteID(2)).Return(newResolverSite(t, 2, 11), nil)
+
+		assert.ErrorIs(t, resolver.ValidateHardLinkTarget(page, entities.NewPageID(2)), domainErrors.ErrPageHardLinkCrossTenant)
+	})
+
+	t.Run("missing target", func(t *testing.T) {
+		resolver, pageRepo, _ := newTestPageResolver()
+		page := newResolverPage(t, 1, 1, entities.PageTypeHardLink, 0)
+		pageRepo.On("FindByID", entities.NewPageID(2)).Return(nil, nil)
+
+		assert.ErrorIs(t, resolver.ValidateHardLinkTarget(page, entities.NewPageID(2)), domainErrors.ErrPageHardLinkTargetNotFound)
+	})
+
+	t.Run("self reference", func(t *testing.T) {
+		resolver, _, _ := newTestPageResolver()
+		page := newResolverPage(t, 1, 1, entities.PageTypeHardLink, 0)
+
+		assert.ErrorIs(t, resolver.ValidateHardLinkTarget(page, entities.NewPageID(1)), domainErrors.ErrPageHardLinkSelfReference)
+	})
+
+	t.Run("target chain leads back to the page", func(t *testing.T) {
+		resolver, pageRepo, _ := newTestPageResolver()
+		page := newResolverPage(t, 1, 1, entities.PageTypeContent, 0)
+		target := newResolverPage(t, 2, 1, entities.PageTypeHardLink, 3)
+		middle := newResolverPage(t, 3, 1, entities.PageTypeHardLink, 1)
+		pageRepo.On("FindByID", entities.NewPageID(2)).Return(target, nil)
+		pageRepo.On("FindByID", entities.NewPageID(3)).Return(middle, nil)
+		pageRepo.On("FindByID", entities.NewPageID(1)).Return(page, nil)
+
+		assert.ErrorIs(t, resolver.ValidateHardLinkTarget(page, entities.NewPageID(2)), domainErrors.ErrPageHardLinkCycle)
+	})
+}
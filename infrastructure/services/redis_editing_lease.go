@@ -0,0 +1,225 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"github.com/h4rdc0m/aurora-api/domain/common"
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"github.com/h4rdc0m/aurora-api/domain/errors"
+	domainServices "github.com/h4rdc0m/aurora-api/domain/services"
+	"github.com/redis/go-redis/v9"
+	"strconv"
+	"time"
+)
+
+// leaseKeyPrefix namespaces the editing leases in Redis; every lease is a hash stored under the version ID until
+// it expires
+const leaseKeyPrefix = "aurora:lease:version:"
+
+// leaseTimeout bounds every Redis round trip of the editing lease service
+const leaseTimeout = 3 * time.Second
+
+// Results of the lease scripts
+const (
+	leaseMissing int64 = -1
+	leaseHeld    int64 = 0
+	leaseApplied int64 = 1
+)
+
+// acquireLeaseScript stores the lease unless a lease of another subject exists.
+// ARGV: subject, name, acquired at and expires at in Unix milliseconds.
+var acquireLeaseScript = redis.NewScript(`
+local holder = redis.call("HGET", KEYS[1], "subject")
+if holder and holder ~= ARGV[1] then
+	return 0
+end
+redis.call("HSET", KEYS[1], "subject", ARGV[1], "name", ARGV[2], "acquired_at", ARGV[3], "expires_at", ARGV[4])
+redis.call("PEXPIREAT", KEYS[1], ARGV[4])
+return 1
+`)
+
+// renewLeaseScript moves the expiry of the lease when it is held by the subject.
+// ARGV: subject, expires at in Unix milliseconds.
+var renewLeaseScript = redis.NewScript(`
+local holder = redis.call("HGET", KEYS[1], "subject")
+if not holder then
+	return -1
+end
+if holder ~= ARGV[1] then
+	return 0
+end
+redis.call("HSET", KEYS[1], "expires_at", ARGV[2])
+redis.call("PEXPIREAT", KEYS[1], ARGV[2])
+return 1
+`)
+
+// releaseLeaseScript deletes the lease when it is held by the subject. ARGV: subject.
+var releaseLeaseScript = redis.NewScript(`
+local holder = redis.call("HGET", KEYS[1], "subject")
+if not holder then
+	return -1
+end
+if holder ~= ARGV[1] then
+	return 0
+end
+redis.call("DEL", KEYS[1])
+return 1
+`)
+
+// breakLeaseScript deletes the lease whoever holds it and returns its fields
+var breakLeaseScript = redis.NewScript(`
+local lease = redis.call("HGETALL", KEYS[1])
+redis.call("DEL", KEYS[1])
+return lease
+`)
+
+// RedisEditingLeaseService is an implementation of EditingLeaseService that stores every lease as a Redis hash
+// expiring with the lease. Lua scripts check the holder and change the lease in one step.
+type RedisEditingLeaseService struct {
+	client *redis.Client
+	logger common.Logger
+}
+
+// NewRedisEditingLeaseService initializes and returns an EditingLeaseService using the given Redis client.
+func NewRedisEditingLeaseService(client *redis.Client, logger common.Logger) domainServices.EditingLeaseService {
+	return &RedisEditingLeaseService{
+		client: client,
+		logger: logger,
+	}
+}
+
+// Acquire stores the lease unless another editor holds the version, which returns a PageVersionLockedError.
+// A holder acquiring the version again replaces their lease.
+func (s *RedisEditingLeaseService) Acquire(lease *entities.EditingLease) error {
+	ctx, cancel := context.WithTimeout(context.Background(), leaseTimeout)
+	defer cancel()
+
+	versionID := lease.VersionID()
+	result, err := acquireLeaseScript.Run(ctx, s.client, []string{leaseKey(versionID)},
+		lease.HolderSubject(), lease.HolderName(), lease.AcquiredAt().UnixMilli(), lease.ExpiresAt().UnixMilli()).Int64()
+	if err != nil {
+		s.logger.Error("Failed to acquire editing lease", "versionID", versionID.Value(), "error", err)
+		return err
+	}
+	if result == leaseHeld {
+		return s.lockedError(versionID)
+	}
+
+	return nil
+}
+
+// Renew extends the lease the editor with the given Keycloak subject holds on the version until expiresAt.
+func (s *RedisEditingLeaseService) Renew(versionID entities.PageVersionID, subject string, expiresAt time.Time) (*entities.EditingLease, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), leaseTimeout)
+	defer cancel()
+
+	result, err := renewLeaseScript.Run(ctx, s.client, []string{leaseKey(versionID)}, subject, expiresAt.UnixMilli()).Int64()
+	if err != nil {
+		s.logger.Error("Failed to renew editing lease", "versionID", versionID.Value(), "error", err)
+		return nil, err
+	}
+
+	switch result {
+	case leaseMissing:
+		return nil, errors.ErrEditingLeaseNotFound
+	case leaseHeld:
+		return nil, s.lockedError(versionID)
+	}
+
+	lease, err := s.Find(versionID)
+	if err != nil {
+		return nil, err
+	}
+	if lease == nil {
+		return nil, errors.ErrEditingLeaseNotFound
+	}
+	return lease, nil
+}
+
+// Release ends the lease the editor with the given Keycloak subject holds on the version.
+func (s *RedisEditingLeaseService) Release(versionID entities.PageVersionID, subject string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), leaseTimeout)
+	defer cancel()
+
+	result, err := releaseLeaseScript.Run(ctx, s.client, []string{leaseKey(versionID)}, subject).Int64()
+	if err != nil {
+		s.logger.Error("Failed to release editing lease", "versionID", versionID.Value(), "error", err)
+		return err
+	}
+	if result == leaseHeld {
+		return s.lockedError(versionID)
+	}
+
+	return nil
+}
+
+// Find returns the lease on the version, or nil when nobody holds it.
+func (s *RedisEditingLeaseService) Find(versionID entities.PageVersionID) (*entities.EditingLease, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), leaseTimeout)
+	defer cancel()
+
+	fields, err := s.client.HGetAll(ctx, leaseKey(versionID)).Result()
+	if err != nil {
+		s.logger.Error("Failed to get editing lease", "versionID", versionID.Value(), "error", err)
+		return nil, err
+	}
+
+	return leaseFromFields(versionID, fields)
+}
+
+// Break ends the lease on the version regardless of its holder and returns the lease it ended.
+func (s *RedisEditingLeaseService) Break(versionID entities.PageVersionID) (*entities.EditingLease, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), leaseTimeout)
+	defer cancel()
+
+	values, err := breakLeaseScript.Run(ctx, s.client, []string{leaseKey(versionID)}).StringSlice()
+	if err != nil {
+		s.logger.Error("Failed to break editing lease", "versionID", versionID.Value(), "error", err)
+		return nil, err
+	}
+
+	fields := make(map[string]string, len(values)/2)
+	for i := 0; i+1 < len(values); i += 2 {
+		fields[values[i]] = values[i+1]
+	}
+	return leaseFromFields(versionID, fields)
+}
+
+// lockedError reports the current holder of the version to an editor who cannot take over the lease
+func (s *RedisEditingLeaseService) lockedError(versionID entities.PageVersionID) error {
+	lease, err := s.Find(versionID)
+	if err != nil {
+		return err
+	}
+	// The lease ended in the meantime; the editor may simply try again
+	if lease == nil {
+		return errors.ErrPageVersionLocked
+	}
+	return lease.LockedError()
+}
+
+// leaseKey returns the key of the lease on a page version
+func leaseKey(versionID entities.PageVersionID) string {
+	return fmt.Sprintf("%s%d", leaseKeyPrefix, versionID.Value())
+}
+
+// leaseFromFields converts the fields of a stored lease back to a lease, returning nil when there are none
+func leaseFromFields(versionID entities.PageVersionID, fields map[string]string) (*entities.EditingLease, error) {
+	if len(fields) == 0 {
+		return nil, nil
+	}
+
+	acquiredAt, err := strconv.ParseInt(fields["acquired_at"], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid editing lease acquired_at: %w", err)
+	}
+	expiresAt, err := strconv.ParseInt(fields["expires_at"], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid editing lease expires_at: %w", err)
+	}
+
+	lease := entities.NewEditingLease(versionID, fields["subject"], fields["name"], time.UnixMilli(expiresAt))
+	lease.SetAcquiredAt(time.UnixMilli(acquiredAt))
+
+	return lease, nil
+}
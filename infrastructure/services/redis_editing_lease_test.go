@@ -0,0 +1,251 @@
+package services
+
+import (
+	"github.com/alicebob/miniredis/v2"
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"github.com/h4rdc0m/aurora-api/domain/errors"
+	"github.com/h4rdc0m/aurora-api/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+func TestRedisEditingLeaseService_Errors(t *testing.T) {
+	versionID := entities.NewPageVersionID(7)
+	expiresAt := time.Now().Add(time.Minute)
+
+	tests := []struct {
+		name    string
+		message string
+		call    func(service *RedisEditingLeaseService) error
+	}{
+		{"acquire", "Failed to acquire editing lease", func(service *RedisEditingLeaseService) error {
+			return service.Acquire(entities.NewEditingLease(versionID, "subject", "Jane Doe", expiresAt))
+		}},
+		{"renew", "Failed to renew editing lease", func(service *RedisEditingLeaseService) error {
+			_, err := service.Renew(versionID, "subject", expiresAt)
+			return err
+		}},
+		{"release", "Failed to release editing lease", func(service *RedisEditingLeaseService) error {
+			return service.Release(versionID, "subject")
+		}},
+		{"find", "Failed to get editing lease", func(service *RedisEditingLeaseService) error {
+			_, err := service.Find(versionID)
+			return err
+		}},
+		{"break", "Failed to break editing lease", func(service *RedisEditingLeaseService) error {
+			_, err := service.Break(versionID)
+			return err
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger := &mocks.Logger{}
+			logger.On("Error", tt.message, "versionID", uint64(7), "error", mock.Anything).Return()
+			client := unreachableRedisClient()
+			defer client.Close()
+
+			service := NewRedisEditingLeaseService(client, logger).(*RedisEditingLeaseService)
+
+			assert.Error(t, tt.call(service))
+			logger.AssertExpectations(t)
+		})
+	}
+}
+
+func TestLeaseKey(t *testing.T) {
+	assert.Equal(t, "aurora:lease:version:42", leaseKey(entities.NewPageVersionID(42)))
+}
+
+func TestLeaseFromFields(t *testing.T) {
+	versionID := entities.NewPageVersionID(7)
+	acquiredAt := time.UnixMilli(1753606800000)
+	expiresAt := acquiredAt.Add(2 * time.Minute)
+
+	t.Run("stored lease", func(t *testing.T) {
+		lease, err := leaseFromFields(versionID, map[string]string{
+			"subject":     "3f0c1f5e-8a44-4b0e-9a55-0a1c1a5b7e11",
+			"name":        "Jane Doe",
+			"acquired_at": "1753606800000",
+			"expires_at":  "1753606920000",
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(7), lease.VersionID().Value())
+		assert.True(t, lease.IsHeldBy("3f0c1f5e-8a44-4b0e-9a55-0a1c1a5b7e11"))
+		assert.Equal(t, "Jane Doe", lease.HolderName())
+		assert.True(t, acquiredAt.Equal(lease.AcquiredAt()))
+		assert.True(t, expiresAt.Equal(lease.ExpiresAt()))
+	})
+
+	t.Run("no lease", func(t *testing.T) {
+		lease, err := leaseFromFields(versionID, map[string]string{})
+
+		assert.NoError(t, err)
+		assert.Nil(t, lease)
+	})
+
+	t.Run("corrupt expiry", func(t *testing.T) {
+		lease, err := leaseFromFields(versionID, map[string]string{"subject": "s", "acquired_at": "1", "expires_at": "soon"})
+
+		assert.Error(t, err)
+		assert.Nil(t, lease)
+	})
+}
+
+const (
+	testLeaseHolder = "3f0c1f5e-8a44-4b0e-9a55-0a1c1a5b7e11"
+	testLeaseOther  = "8d2b7a90-1c3e-4f5a-b6d7-e8f9a0b1c2d3"
+)
+
+// newTestLeaseService returns a lease service backed by an in-memory Redis server
+func newTestLeaseService(t *testing.T) (*miniredis.Miniredis, *RedisEditingLeaseService) {
+	server, client := newMiniredisClient(t)
+	return server, NewRedisEditingLeaseService(client, &mocks.Logger{}).(*RedisEditingLeaseService)
+}
+
+func TestRedisEditingLeaseService_Acquire(t *testing.T) {
+	versionID := entities.NewPageVersionID(7)
+
+	t.Run("held by another editor", func(t *testing.T) {
+		_, service := newTestLeaseService(t)
+		expiresAt := time.Now().Add(time.Minute)
+		assert.NoError(t, service.Acquire(entities.NewEditingLease(versionID, testLeaseHolder, "Jane Doe", expiresAt)))
+
+		err := service.Acquire(entities.NewEditingLease(versionID, testLeaseOther, "John Roe", expiresAt))
+
+		var locked *errors.PageVersionLockedError
+		assert.ErrorAs(t, err, &locked)
+		assert.ErrorIs(t, err, errors.ErrPageVersionLocked)
+		assert.Equal(t, testLeaseHolder, locked.HolderSubject)
+		assert.Equal(t, "Jane Doe", locked.HolderName)
+		lease, err := service.Find(versionID)
+		assert.NoError(t, err)
+		assert.True(t, lease.IsHeldBy(testLeaseHolder))
+	})
+
+	t.Run("holder acquires again", func(t *testing.T) {
+		_, service := newTestLeaseService(t)
+		assert.NoError(t, service.Acquire(entities.NewEditingLease(versionID, testLeaseHolder, "Jane Doe", time.Now().Add(time.Minute))))
+		expiresAt := time.Now().Add(5 * time.Minute)
+
+		assert.NoError(t, service.Acquire(entities.NewEditingLease(versionID, testLeaseHolder, "Jane Doe", expiresAt)))
+
+		lease, err := service.Find(versionID)
+		assert.NoError(t, err)
+		assert.Equal(t, expiresAt.UnixMilli(), lease.ExpiresAt().UnixMilli())
+	})
+
+	t.Run("expired lease is taken over", func(t *testing.T) {
+		server, service := newTestLeaseService(t)
+		assert.NoError(t, service.Acquire(entities.NewEditingLease(versionID, testLeaseHolder, "Jane Doe", time.Now().Add(time.Minute))))
+		server.FastForward(2 * time.Minute)
+
+		assert.NoError(t, service.Acquire(entities.NewEditingLease(versionID, testLeaseOther, "John Roe", time.Now().Add(time.Minute))))
+
+		lease, err := service.Find(versionID)
+		assert.NoError(t, err)
+		assert.True(t, lease.IsHeldBy(testLeaseOther))
+		assert.Equal(t, "John Roe", lease.HolderName())
+	})
+}
+
+func TestRedisEditingLeaseService_Renew(t *testing.T) {
+	versionID := entities.NewPageVersionID(7)
+
+	t.Run("holder extends the lease", func(t *testing.T) {
+		server, service := newTestLeaseService(t)
+		assert.NoError(t, service.Acquire(entities.NewEditingLease(versionID, testLeaseHolder, "Jane Doe", time.Now().Add(time.Minute))))
+		expiresAt := time.Now().Add(5 * time.Minute)
+
+		lease, err := service.Renew(versionID, testLeaseHolder, expiresAt)
+
+		assert.NoError(t, err)
+		assert.Equal(t, expiresAt.UnixMilli(), lease.ExpiresAt().UnixMilli())
+		server.FastForward(2 * time.Minute)
+		assert.True(t, server.Exists(leaseKey(versionID)))
+	})
+
+	t.Run("other editor is refused", func(t *testing.T) {
+		_, service := newTestLeaseService(t)
+		expiresAt := time.Now().Add(time.Minute)
+		assert.NoError(t, service.Acquire(entities.NewEditingLease(versionID, testLeaseHolder, "Jane Doe", expiresAt)))
+
+		lease, err := service.Renew(versionID, testLeaseOther, time.Now().Add(5*time.Minute))
+
+		assert.ErrorIs(t, err, errors.ErrPageVersionLocked)
+		assert.Nil(t, lease)
+		stored, err := service.Find(versionID)
+		assert.NoError(t, err)
+		assert.True(t, stored.IsHeldBy(testLeaseHolder))
+		assert.Equal(t, expiresAt.UnixMilli(), stored.ExpiresAt().UnixMilli())
+	})
+
+	t.Run("expired lease is not found", func(t *testing.T) {
+		server, service := newTestLeaseService(t)
+		assert.NoError(t, service.Acquire(entities.NewEditingLease(versionID, testLeaseHolder, "Jane Doe", time.Now().Add(time.Minute))))
+		server.FastForward(2 * time.Minute)
+
+		lease, err := service.Renew(versionID, testLeaseHolder, time.Now().Add(time.Minute))
+
+		assert.ErrorIs(t, err, errors.ErrEditingLeaseNotFound)
+		assert.Nil(t, lease)
+	})
+}
+
+func TestRedisEditingLeaseService_Release(t *testing.T) {
+	versionID := entities.NewPageVersionID(7)
+
+	t.Run("holder ends the lease", func(t *testing.T) {
+		server, service := newTestLeaseService(t)
+		assert.NoError(t, service.Acquire(entities.NewEditingLease(versionID, testLeaseHolder, "Jane Doe", time.Now().Add(time.Minute))))
+
+		assert.NoError(t, service.Release(versionID, testLeaseHolder))
+
+		assert.False(t, server.Exists(leaseKey(versionID)))
+	})
+
+	t.Run("other editor is refused", func(t *testing.T) {
+		server, service := newTestLeaseService(t)
+		assert.NoError(t, service.Acquire(entities.NewEditingLease(versionID, testLeaseHolder, "Jane Doe", time.Now().Add(time.Minute))))
+
+		err := service.Release(versionID, testLeaseOther)
+
+		assert.ErrorIs(t, err, errors.ErrPageVersionLocked)
+		assert.True(t, server.Exists(leaseKey(versionID)))
+	})
+
+	t.Run("missing lease", func(t *testing.T) {
+		_, service := newTestLeaseService(t)
+
+		assert.NoError(t, service.Release(versionID, testLeaseHolder))
+	})
+}
+
+func TestRedisEditingLeaseService_Break(t *testing.T) {
+	versionID := entities.NewPageVersionID(7)
+
+	t.Run("returns the previous holder", func(t *testing.T) {
+		server, service := newTestLeaseService(t)
+		expiresAt := time.Now().Add(time.Minute)
+		assert.NoError(t, service.Acquire(entities.NewEditingLease(versionID, testLeaseHolder, "Jane Doe", expiresAt)))
+
+		lease, err := service.Break(versionID)
+
+		assert.NoError(t, err)
+		assert.True(t, lease.IsHeldBy(testLeaseHolder))
+		assert.Equal(t, "Jane Doe", lease.HolderName())
+		assert.Equal(t, expiresAt.UnixMilli(), lease.ExpiresAt().UnixMilli())
+		assert.False(t, server.Exists(leaseKey(versionID)))
+	})
+
+	t.Run("no lease", func(t *testing.T) {
+		_, service := newTestLeaseService(t)
+
+		lease, err := service.Break(versionID)
+
+		assert.NoError(t, err)
+		assert.Nil(t, lease)
+	})
+}
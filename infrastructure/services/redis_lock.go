@@ -0,0 +1,69 @@
+package services
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/h4rdc0m/aurora-api/domain/common"
+	domainServices "github.com/h4rdc0m/aurora-api/domain/services"
+	"github.com/redis/go-redis/v9"
+	"time"
+)
+
+// lockKeyPrefix namespaces the lock keys in Redis
+const lockKeyPrefix = "aurora:lock:"
+
+// lockTimeout bounds every Redis round trip of the lock service
+const lockTimeout = 3 * time.Second
+
+// unlockScript deletes the lock only when it still holds the owner token, so an expired lock taken over by
+// another instance is never released by mistake
+var unlockScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+// RedisLockService is an implementation of LockService backed by Redis SET NX with an expiry.
+// Each instance identifies itself with a random owner token.
+type RedisLockService struct {
+	client *redis.Client
+	logger common.Logger
+	owner  string
+}
+
+// NewRedisLockService initializes and returns a LockService using the given Redis client.
+func NewRedisLockService(client *redis.Client, logger common.Logger) domainServices.LockService {
+	return &RedisLockService{
+		client: client,
+		logger: logger,
+		owner:  uuid.NewString(),
+	}
+}
+
+// TryLock acquires the named lock for at most the given duration. Returns false if another holder owns it.
+func (s *RedisLockService) TryLock(name string, ttl time.Duration) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), lockTimeout)
+	defer cancel()
+
+	acquired, err := s.client.SetNX(ctx, lockKeyPrefix+name, s.owner, ttl).Result()
+	if err != nil {
+		s.logger.Error("Failed to acquire lock", "name", name, "error", err)
+		return false, err
+	}
+
+	return acquired, nil
+}
+
+// Unlock releases the named lock if it is still held by this instance.
+func (s *RedisLockService) Unlock(name string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), lockTimeout)
+	defer cancel()
+
+	if err := unlockScript.Run(ctx, s.client, []string{lockKeyPrefix + name}, s.owner).Err(); err != nil {
+		s.logger.Error("Failed to release lock", "name", name, "error", err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,131 @@
+package services
+
+import (
+	"github.com/alicebob/miniredis/v2"
+	"github.com/h4rdc0m/aurora-api/tests/mocks"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+// unreachableRedisClient returns a client for a port nothing listens on
+func unreachableRedisClient() *redis.Client {
+	return redis.NewClient(&redis.Options{Addr: "127.0.0.1:1", MaxRetries: -1})
+}
+
+// newMiniredisClient starts an in-memory Redis server for the test and returns it with a client connected to it
+func newMiniredisClient(t *testing.T) (*miniredis.Miniredis, *redis.Client) {
+	server := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	t.Cleanup(func() { _ = client.Close() })
+	return server, client
+}
+
+func TestNewRedisLockService_UniqueOwners(t *testing.T) {
+	logger := &mocks.Logger{}
+	client := unreachableRedisClient()
+	defer client.Close()
+
+	first := NewRedisLockService(client, logger).(*RedisLockService)
+	second := NewRedisLockService(client, logger).(*RedisLockService)
+
+	assert.NotEmpty(t, first.owner)
+	assert.NotEqual(t, first.owner, second.owner)
+}
+
+func TestRedisLockService_TryLock_Error(t *testing.T) {
+	logger := &mocks.Logger{}
+	logger.On("Error", "Failed to acquire lock", "name", "scheduler", "error", mock.Anything).Return()
+	client := unreachableRedisClient()
+	defer client.Close()
+
+	service := NewRedisLockService(client, logger)
+
+	acquired, err := service.TryLock("scheduler", time.Minute)
+
+	assert.Error(t, err)
+	assert.False(t, acquired)
+	logger.AssertExpectations(t)
+}
+
+func TestRedisLockService_Unlock_Error(t *testing.T) {
+	logger := &mocks.Logger{}
+	logger.On("Error", "Failed to release lock", "name", "scheduler", "error", mock.Anything).Return()
+	client := unreachableRedisClient()
+	defer client.Close()
+
+	service := NewRedisLockService(client, logger)
+
+	err := service.Unlock("scheduler")
+
+	assert.Error(t, err)
+	logger.AssertExpectations(t)
+}
+
+func TestRedisLockService_TryLock(t *testing.T) {
+	t.Run("held lock is refused to another instance", func(t *testing.T) {
+		_, client := newMiniredisClient(t)
+		first := NewRedisLockService(client, &mocks.Logger{})
+		second := NewRedisLockService(client, &mocks.Logger{})
+
+		acquired, err := first.TryLock("scheduler", time.Minute)
+		assert.NoError(t, err)
+		assert.True(t, acquired)
+
+		acquired, err = second.TryLock("scheduler", time.Minute)
+		assert.NoError(t, err)
+		assert.False(t, acquired)
+	})
+
+	t.Run("expired lock is taken over", func(t *testing.T) {
+		server, client := newMiniredisClient(t)
+		first := NewRedisLockService(client, &mocks.Logger{})
+		second := NewRedisLockService(client, &mocks.Logger{}).(*RedisLockService)
+
+		acquired, err := first.TryLock("scheduler", time.Minute)
+		assert.NoError(t, err)
+		assert.True(t, acquired)
+		server.FastForward(2 * time.Minute)
+
+		acquired, err = second.TryLock("scheduler", time.Minute)
+		assert.NoError(t, err)
+		assert.True(t, acquired)
+		owner, err := server.Get(lockKeyPrefix + "scheduler")
+		assert.NoError(t, err)
+		assert.Equal(t, second.owner, owner)
+	})
+}
+
+func TestRedisLockService_Unlock(t *testing.T) {
+	t.Run("owner releases the lock", func(t *testing.T) {
+		server, client := newMiniredisClient(t)
+		service := NewRedisLockService(client, &mocks.Logger{})
+		acquired, err := service.TryLock("scheduler", time.Minute)
+		assert.NoError(t, err)
+		assert.True(t, acquired)
+
+		assert.NoError(t, service.Unlock("scheduler"))
+
+		assert.False(t, server.Exists(lockKeyPrefix+"scheduler"))
+	})
+
+	t.Run("other instance leaves the lock in place", func(t *testing.T) {
+		server, client := newMiniredisClient(t)
+		first := NewRedisLockService(client, &mocks.Logger{}).(*RedisLockService)
+		second := NewRedisLockService(client, &mocks.Logger{})
+		acquired, err := first.TryLock("scheduler", time.Minute)
+		assert.NoError(t, err)
+		assert.True(t, acquired)
+
+		assert.NoError(t, second.Unlock("scheduler"))
+
+		owner, err := server.Get(lockKeyPrefix + "scheduler")
+		assert.NoError(t, err)
+		assert.Equal(t, first.owner, owner)
+		acquired, err = second.TryLock("scheduler", time.Minute)
+		assert.NoError(t, err)
+		assert.False(t, acquired)
+	})
+}
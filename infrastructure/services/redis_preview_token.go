@@ -0,0 +1,265 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/h4rdc0m/aurora-api/domain/common"
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"github.com/h4rdc0m/aurora-api/domain/errors"
+	domainServices "github.com/h4rdc0m/aurora-api/domain/services"
+	"github.com/h4rdc0m/aurora-api/infrastructure/config"
+	"github.com/redis/go-redis/v9"
+	"strconv"
+	"time"
+)
+
+// Redis keys of the preview tokens: every token is stored under its ID until it expires, and indexed per site in
+// a sorted set scored by expiry
+const (
+	previewTokenKeyPrefix = "aurora:preview:token:"
+	previewSiteKeyPrefix  = "aurora:preview:site:"
+)
+
+// previewTimeout bounds every Redis round trip of the preview token service
+const previewTimeout = 3 * time.Second
+
+// previewClaims are the claims of a signed preview token
+type previewClaims struct {
+	SiteID uint64 `json:"site"`
+	jwt.RegisteredClaims
+}
+
+// previewTokenRecord is the stored form of a preview token
+type previewTokenRecord struct {
+	ID        string    `json:"id"`
+	SiteID    uint64    `json:"site_id"`
+	Scope     string    `json:"scope"`
+	PageID    *uint64   `json:"page_id,omitempty"`
+	VersionID *uint64   `json:"version_id,omitempty"`
+	Label     *string   `json:"label,omitempty"`
+	CreatedBy uint64    `json:"created_by"`
+	CreatedAt time.Time `json:"created_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// RedisPreviewTokenService is an implementation of PreviewTokenService that signs tokens as HS256 JWTs and tracks
+// them in Redis. A token is only accepted while its Redis entry exists, which makes tokens revocable.
+type RedisPreviewTokenService struct {
+	client *redis.Client
+	secret []byte
+	logger common.Logger
+}
+
+// NewRedisPreviewTokenService initializes and returns a PreviewTokenService signing with AURORA_PREVIEW_TOKEN_SECRET.
+// Every instance must share the secret to accept each other's tokens, so a missing secret fails the startup.
+func NewRedisPreviewTokenService(client *redis.Client, env *config.Env, logger common.Logger) (domainServices.PreviewTokenService, error) {
+	if env.PreviewTokenSecret == "" {
+		logger.Error("No preview token secret configured, set AURORA_PREVIEW_TOKEN_SECRET")
+		return nil, errors.ErrPreviewTokenSecretMissing
+	}
+
+	return &RedisPreviewTokenService{
+		client: client,
+		secret: []byte(env.PreviewTokenSecret),
+		logger: logger,
+	}, nil
+}
+
+// Issue assigns an ID to the token, stores it until it expires and returns its signed form.
+func (s *RedisPreviewTokenService) Issue(token *entities.PreviewToken) (string, error) {
+	token.SetID(uuid.NewString())
+
+	claims := previewClaims{
+		SiteID: token.SiteID().Value(),
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        token.ID(),
+			IssuedAt:  jwt.NewNumericDate(token.CreatedAt()),
+			ExpiresAt: jwt.NewNumericDate(token.ExpiresAt()),
+		},
+	}
+	signed, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(s.secret)
+	if err != nil {
+		s.logger.Error("Failed to sign preview token", "error", err)
+		return "", err
+	}
+
+	record, err := json.Marshal(newPreviewTokenRecord(token))
+	if err != nil {
+		return "", err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), previewTimeout)
+	defer cancel()
+
+	_, err = s.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, previewTokenKeyPrefix+token.ID(), record, time.Until(token.ExpiresAt()))
+		pipe.ZAdd(ctx, previewSiteKey(token.SiteID()), redis.Z{Score: float64(token.ExpiresAt().Unix()), Member: token.ID()})
+		return nil
+	})
+	if err != nil {
+		s.logger.Error("Failed to store preview token", "siteID", token.SiteID().Value(), "error", err)
+		return "", err
+	}
+
+	return signed, nil
+}
+
+// Verify returns the token of a signed form. Returns ErrPreviewTokenInvalid if the signature does not match,
+// or the token expired or was revoked.
+func (s *RedisPreviewTokenService) Verify(signed string) (*entities.PreviewToken, error) {
+	claims := &previewClaims{}
+	_, err := jwt.ParseWithClaims(signed, claims, func(token *jwt.Token) (interface{}, error) {
+		return s.secret, nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}), jwt.WithExpirationRequired())
+	if err != nil {
+		return nil, errors.ErrPreviewTokenInvalid
+	}
+
+	token, err := s.find(claims.ID)
+	if err != nil {
+		return nil, err
+	}
+	if token == nil || token.SiteID().Value() != claims.SiteID || token.IsExpired(time.Now()) {
+		return nil, errors.ErrPreviewTokenInvalid
+	}
+
+	return token, nil
+}
+
+// FindBySiteID returns the tokens of the site that have not expired or been revoked, latest expiring first.
+func (s *RedisPreviewTokenService) FindBySiteID(siteID entities.SiteID) ([]*entities.PreviewToken, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), previewTimeout)
+	defer cancel()
+
+	index := previewSiteKey(siteID)
+	if err := s.client.ZRemRangeByScore(ctx, index, "-inf", strconv.FormatInt(time.Now().Unix(), 10)).Err(); err != nil {
+		s.logger.Error("Failed to prune expired preview tokens", "siteID", siteID.Value(), "error", err)
+		return nil, err
+	}
+
+	ids, err := s.client.ZRevRange(ctx, index, 0, -1).Result()
+	if err != nil {
+		s.logger.Error("Failed to list preview tokens", "siteID", siteID.Value(), "error", err)
+		return nil, err
+	}
+
+	tokens := make([]*entities.PreviewToken, 0, len(ids))
+	for _, id := range ids {
+		token, err := s.find(id)
+		if err != nil {
+			return nil, err
+		}
+		if token != nil {
+			tokens = append(tokens, token)
+		}
+	}
+
+	return tokens, nil
+}
+
+// Revoke withdraws a token of the site. Returns ErrPreviewTokenNotFound if the site has no such token.
+func (s *RedisPreviewTokenService) Revoke(siteID entities.SiteID, id string) error {
+	token, err := s.find(id)
+	if err != nil {
+		return err
+	}
+	if token == nil || token.SiteID().Value() != siteID.Value() {
+		return errors.ErrPreviewTokenNotFound
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), previewTimeout)
+	defer cancel()
+
+	_, err = s.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, previewTokenKeyPrefix+id)
+		pipe.ZRem(ctx, previewSiteKey(siteID), id)
+		return nil
+	})
+	if err != nil {
+		s.logger.Error("Failed to revoke preview token", "siteID", siteID.Value(), "id", id, "error", err)
+		return err
+	}
+
+	return nil
+}
+
+// find loads a stored token, returning nil when it does not exist (anymore)
+func (s *RedisPreviewTokenService) find(id string) (*entities.PreviewToken, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), previewTimeout)
+	defer cancel()
+
+	data, err := s.client.Get(ctx, previewTokenKeyPrefix+id).Bytes()
+	if stderrors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		s.logger.Error("Failed to get preview token", "id", id, "error", err)
+		return nil, err
+	}
+
+	var record previewTokenRecord
+	if err := json.Unmarshal(data, &record); err != nil {
+		s.logger.Error("Failed to decode preview token", "id", id, "error", err)
+		return nil, err
+	}
+
+	return record.toDomain()
+}
+
+// previewSiteKey returns the key of the index of the preview tokens of a site
+func previewSiteKey(siteID entities.SiteID) string {
+	return fmt.Sprintf("%s%d", previewSiteKeyPrefix, siteID.Value())
+}
+
+// newPreviewTokenRecord converts a preview token to its stored form
+func newPreviewTokenRecord(token *entities.PreviewToken) previewTokenRecord {
+	record := previewTokenRecord{
+		ID:        token.ID(),
+		SiteID:    token.SiteID().Value(),
+		Scope:     string(token.Scope()),
+		Label:     token.Label(),
+		CreatedBy: token.CreatedBy().Value(),
+		CreatedAt: token.CreatedAt(),
+		ExpiresAt: token.ExpiresAt(),
+	}
+	if token.PageID() != nil {
+		record.PageID = token.PageID().ValuePtr()
+	}
+	if token.VersionID() != nil {
+		versionID := token.VersionID().Value()
+		record.VersionID = &versionID
+	}
+	return record
+}
+
+// toDomain converts a stored preview token back to a preview token
+func (r previewTokenRecord) toDomain() (*entities.PreviewToken, error) {
+	scope, err := entities.NewPreviewScope(r.Scope)
+	if err != nil {
+		return nil, err
+	}
+
+	var pageID *entities.PageID
+	if r.PageID != nil {
+		id := entities.NewPageID(*r.PageID)
+		pageID = &id
+	}
+	var versionID *entities.PageVersionID
+	if r.VersionID != nil {
+		id := entities.NewPageVersionID(*r.VersionID)
+		versionID = &id
+	}
+
+	token, err := entities.NewPreviewToken(entities.NewSiteID(r.SiteID), scope, pageID, versionID, r.Label, entities.NewUserID(r.CreatedBy), r.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+	token.SetID(r.ID)
+	token.SetCreatedAt(r.CreatedAt)
+
+	return token, nil
+}
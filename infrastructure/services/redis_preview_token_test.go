@@ -0,0 +1,125 @@
+package services
+
+import (
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"github.com/h4rdc0m/aurora-api/domain/errors"
+	"github.com/h4rdc0m/aurora-api/infrastructure/config"
+	"github.com/h4rdc0m/aurora-api/tests/mocks"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+// signPreviewClaims signs preview claims for site 1 with the given secret and expiry
+func signPreviewClaims(t *testing.T, secret string, expiresAt time.Time) string {
+	claims := previewClaims{
+		SiteID: 1,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        "token",
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+	}
+	signed, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(secret))
+	assert.NoError(t, err)
+	return signed
+}
+
+// newTestPreviewTokenService creates a preview token service signing with "secret"
+func newTestPreviewTokenService(t *testing.T, client *redis.Client, logger *mocks.Logger) *RedisPreviewTokenService {
+	service, err := NewRedisPreviewTokenService(client, &config.Env{PreviewTokenSecret: "secret"}, logger)
+	assert.NoError(t, err)
+	return service.(*RedisPreviewTokenService)
+}
+
+func TestNewRedisPreviewTokenService_MissingSecret(t *testing.T) {
+	logger := &mocks.Logger{}
+	logger.On("Error", "No preview token secret configured, set AURORA_PREVIEW_TOKEN_SECRET").Return()
+	client := unreachableRedisClient()
+	defer client.Close()
+
+	service, err := NewRedisPreviewTokenService(client, &config.Env{}, logger)
+
+	assert.ErrorIs(t, err, errors.ErrPreviewTokenSecretMissing)
+	assert.Nil(t, service)
+	logger.AssertExpectations(t)
+}
+
+func TestRedisPreviewTokenService_Verify_RejectsWithoutLookup(t *testing.T) {
+	client := unreachableRedisClient()
+	defer client.Close()
+	service := newTestPreviewTokenService(t, client, &mocks.Logger{})
+
+	none := jwt.NewWithClaims(jwt.SigningMethodNone, previewClaims{
+		RegisteredClaims: jwt.RegisteredClaims{ID: "token", ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour))},
+	})
+	unsigned, err := none.SignedString(jwt.UnsafeAllowNoneSignatureType)
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		signed string
+	}{
+		{"malformed", "not-a-token"},
+		{"other secret", signPreviewClaims(t, "other", time.Now().Add(time.Hour))},
+		{"expired", signPreviewClaims(t, "secret", time.Now().Add(-time.Minute))},
+		{"unsigned", unsigned},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token, err := service.Verify(tt.signed)
+
+			assert.ErrorIs(t, err, errors.ErrPreviewTokenInvalid)
+			assert.Nil(t, token)
+		})
+	}
+}
+
+func TestRedisPreviewTokenService_Verify_LookupError(t *testing.T) {
+	logger := &mocks.Logger{}
+	logger.On("Error", "Failed to get preview token", "id", "token", "error", mock.Anything).Return()
+	client := unreachableRedisClient()
+	defer client.Close()
+	service := newTestPreviewTokenService(t, client, logger)
+
+	token, err := service.Verify(signPreviewClaims(t, "secret", time.Now().Add(time.Hour)))
+
+	assert.Error(t, err)
+	assert.NotErrorIs(t, err, errors.ErrPreviewTokenInvalid)
+	assert.Nil(t, token)
+	logger.AssertExpectations(t)
+}
+
+func TestRedisPreviewTokenService_Issue_Error(t *testing.T) {
+	logger := &mocks.Logger{}
+	logger.On("Error", "Failed to store preview token", "siteID", uint64(1), "error", mock.Anything).Return()
+	client := unreachableRedisClient()
+	defer client.Close()
+	service := newTestPreviewTokenService(t, client, logger)
+
+	token, err := entities.NewPreviewToken(entities.NewSiteID(1), entities.PreviewScopeSite, nil, nil, nil, entities.NewUserID(2), time.Now().Add(time.Hour))
+	assert.NoError(t, err)
+
+	signed, err := service.Issue(token)
+
+	assert.Error(t, err)
+	assert.Empty(t, signed)
+	assert.NotEmpty(t, token.ID())
+	logger.AssertExpectations(t)
+}
+
+func TestPreviewTokenRecord_RoundTrip(t *testing.T) {
+	pageID, versionID := entities.NewPageID(3), entities.NewPageVersionID(4)
+	label := "Marketing"
+	expiresAt := time.Now().Add(time.Hour).Truncate(time.Second)
+	token, err := entities.NewPreviewToken(entities.NewSiteID(1), entities.PreviewScopeVersion, &pageID, &versionID, &label, entities.NewUserID(2), expiresAt)
+	assert.NoError(t, err)
+	token.SetID("token")
+
+	restored, err := newPreviewTokenRecord(token).toDomain()
+
+	assert.NoError(t, err)
+	assert.Equal(t, token, restored)
+}
@@ -0,0 +1,80 @@
+package services
+
+import (
+	"github.com/h4rdc0m/aurora-api/domain/common"
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"github.com/h4rdc0m/aurora-api/domain/repositories"
+	domainServices "github.com/h4rdc0m/aurora-api/domain/services"
+	"github.com/jmoiron/sqlx"
+)
+
+// SearchIndexerImpl is an implementation of SearchIndexer reading published content through the page repositories.
+type SearchIndexerImpl struct {
+	pageVersionRepo repositories.PageVersionRepository
+	pageBlockRepo   repositories.PageBlockRepository
+	index           domainServices.SearchIndex
+	logger          common.Logger
+}
+
+// NewSearchIndexer initializes and returns a SearchIndexer writing to the given index.
+func NewSearchIndexer(
+	pageVersionRepo repositories.PageVersionRepository,
+	pageBlockRepo repositories.PageBlockRepository,
+	index domainServices.SearchIndex,
+	logger common.Logger,
+) domainServices.SearchIndexer {
+	return &SearchIndexerImpl{
+		pageVersionRepo: pageVersionRepo,
+		pageBlockRepo:   pageBlockRepo,
+		index:           index,
+		logger:          logger,
+	}
+}
+
+// WithTrx returns a copy of the indexer that reads and writes inside the given transaction.
+func (s *SearchIndexerImpl) WithTrx(trxHandle *sqlx.Tx) domainServices.SearchIndexer {
+	return &SearchIndexerImpl{
+		pageVersionRepo: s.pageVersionRepo.WithTrx(trxHandle),
+		pageBlockRepo:   s.pageBlockRepo.WithTrx(trxHandle),
+		index:           s.index.WithTrx(trxHandle),
+		logger:          s.logger,
+	}
+}
+
+// Reindex replaces the documents of a content page by those of its versions published in any locale,
+// or removes the page from the index. Locales the site no longer serves are indexed too; delivery only searches
+// the locale chain it negotiated, so changing the locales of a site does not require reindexing its pages.
+func (s *SearchIndexerImpl) Reindex(page *entities.Page) error {
+	if err := s.Remove(page.ID()); err != nil {
+		return err
+	}
+	if page.Type() != entities.PageTypeContent {
+		return nil
+	}
+
+	versions, err := s.pageVersionRepo.FindByPageID(page.ID())
+	if err != nil {
+		s.logger.Error("Failed to get page versions", "pageID", page.ID().Value(), "error", err)
+		return err
+	}
+
+	for _, version := range versions {
+		if version.Status() != entities.PageVersionStatusPublished {
+			continue
+		}
+		blocks, err := s.pageBlockRepo.FindByPageVersionID(version.ID())
+		if err != nil {
+			s.logger.Error("Failed to get page blocks", "versionID", version.ID().Value(), "error", err)
+			return err
+		}
+		if err := s.index.Index(entities.NewSearchDocument(page, version, blocks)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Remove removes the page from the index.
+func (s *SearchIndexerImpl) Remove(pageID entities.PageID) error {
+	return s.index.Remove(pageID)
+}
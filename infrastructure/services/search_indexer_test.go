@@ -0,0 +1,127 @@
+package services
+
+import (
+	"errors"
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"github.com/h4rdc0m/aurora-api/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+func newTestSearchIndexer(t *testing.T) (*SearchIndexerImpl, *MemorySearchIndex, *mocks.MockPageVersionRepository, *mocks.MockPageBlockRepository) {
+	versions := &mocks.MockPageVersionRepository{}
+	blocks := &mocks.MockPageBlockRepository{}
+	index := NewMemorySearchIndex()
+	logger := &mocks.Logger{}
+	logger.On("Error", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+	return NewSearchIndexer(versions, blocks, index, logger).(*SearchIndexerImpl), index, versions, blocks
+}
+
+// newIndexedVersion creates a version of the page in the locale and status
+func newIndexedVersion(t *testing.T, page *entities.Page, id uint64, locale entities.Locale, title string, status entities.PageVersionStatus) *entities.PageVersion {
+	version, err := entities.NewPageVersion(page.ID(), uint(id), locale, title, nil)
+	assert.NoError(t, err)
+	version.SetID(entities.NewPageVersionID(id))
+	version.SetStatus(status, nil, nil)
+	return version
+}
+
+func TestSearchIndexerImpl_Reindex(t *testing.T) {
+	t.Run("indexes the published version", func(t *testing.T) {
+		indexer, index, versions, blocks := newTestSearchIndexer(t)
+		page := newResolverPage(t, 1, 1, entities.PageTypeContent, 0)
+		description := "About the company"
+		version, err := entities.NewPageVersion(page.ID(), 1, entities.DefaultLocale, "About", &description)
+		assert.NoError(t, err)
+		version.SetID(entities.NewPageVersionID(10))
+		version.SetStatus(entities.PageVersionStatusPublished, nil, nil)
+		versions.On("FindByPageID", page.ID()).Return([]*entities.PageVersion{version}, nil)
+		html, err := entities.NewPageBlock(version.ID(), "body", 1, "html", "<p>Founded in <b>1999</b></p>")
+		assert.NoError(t, err)
+		blocks.On("FindByPageVersionID", version.ID()).Return([]*entities.PageBlock{html, newBlock(t, "intro", 0, 0), newBlock(t, "embed", 2, 5)}, nil)
+
+		assert.NoError(t, indexer.Reindex(page))
+
+		document := index.Document(page.ID(), entities.DefaultLocale)
+		if assert.NotNil(t, document) {
+			assert.Equal(t, uint64(10), document.VersionID.Value())
+			assert.Equal(t, entities.DefaultLocale, document.Locale)
+			assert.Equal(t, "About", document.Title)
+			assert.Equal(t, "About the company", document.Description)
+			assert.Equal(t, "intro\nFounded in 1999", document.Body)
+		}
+	})
+
+	t.Run("indexes every published locale", func(t *testing.T) {
+		indexer, index, versions, blocks := newTestSearchIndexer(t)
+		page := newResolverPage(t, 1, 1, entities.PageTypeContent, 0)
+		dutch := entities.Locale("nl-BE")
+		versions.On("FindByPageID", page.ID()).Return([]*entities.PageVersion{
+			newIndexedVersion(t, page, 12, dutch, "Over ons (draft)", entities.PageVersionStatusDraft),
+			newIndexedVersion(t, page, 11, dutch, "Over ons", entities.PageVersionStatusPublished),
+			newIndexedVersion(t, page, 10, entities.DefaultLocale, "About", entities.PageVersionStatusPublished),
+		}, nil)
+		blocks.On("FindByPageVersionID", mock.Anything).Return([]*entities.PageBlock{}, nil)
+
+		assert.NoError(t, indexer.Reindex(page))
+
+		if document := index.Document(page.ID(), dutch); assert.NotNil(t, document) {
+			assert.Equal(t, "Over ons", document.Title)
+		}
+		if document := index.Document(page.ID(), entities.DefaultLocale); assert.NotNil(t, document) {
+			assert.Equal(t, "About", document.Title)
+		}
+		blocks.AssertNotCalled(t, "FindByPageVersionID", entities.NewPageVersionID(12))
+	})
+
+	t.Run("removes locales that are no longer published", func(t *testing.T) {
+		indexer, index, versions, blocks := newTestSearchIndexer(t)
+		page := newResolverPage(t, 1, 1, entities.PageTypeContent, 0)
+		dutch := entities.Locale("nl-BE")
+		stale := newSearchDocument(1, 1, "/page", "Over ons", "")
+		stale.Locale = dutch
+		assert.NoError(t, index.Index(stale))
+		versions.On("FindByPageID", page.ID()).Return([]*entities.PageVersion{
+			newIndexedVersion(t, page, 10, entities.DefaultLocale, "About", entities.PageVersionStatusPublished),
+		}, nil)
+		blocks.On("FindByPageVersionID", mock.Anything).Return([]*entities.PageBlock{}, nil)
+
+		assert.NoError(t, indexer.Reindex(page))
+
+		assert.Nil(t, index.Document(page.ID(), dutch))
+		assert.NotNil(t, index.Document(page.ID(), entities.DefaultLocale))
+	})
+
+	t.Run("removes pages without a published version", func(t *testing.T) {
+		indexer, index, versions, _ := newTestSearchIndexer(t)
+		page := newResolverPage(t, 1, 1, entities.PageTypeContent, 0)
+		assert.NoError(t, index.Index(newSearchDocument(1, 1, "/page", "Page", "")))
+		versions.On("FindByPageID", page.ID()).Return([]*entities.PageVersion{
+			newIndexedVersion(t, page, 10, entities.DefaultLocale, "About", entities.PageVersionStatusArchived),
+		}, nil)
+
+		assert.NoError(t, indexer.Reindex(page))
+
+		assert.Nil(t, index.Document(page.ID(), entities.DefaultLocale))
+	})
+
+	t.Run("removes pages that are not content", func(t *testing.T) {
+		indexer, index, versions, _ := newTestSearchIndexer(t)
+		page := newResolverPage(t, 1, 1, entities.PageTypeSnippet, 0)
+		assert.NoError(t, index.Index(newSearchDocument(1, 1, "/page", "Page", "")))
+
+		assert.NoError(t, indexer.Reindex(page))
+
+		assert.Nil(t, index.Document(page.ID(), entities.DefaultLocale))
+		versions.AssertNotCalled(t, "FindByPageID", mock.Anything)
+	})
+
+	t.Run("returns repository errors", func(t *testing.T) {
+		indexer, _, versions, _ := newTestSearchIndexer(t)
+		page := newResolverPage(t, 1, 1, entities.PageTypeContent, 0)
+		versions.On("FindByPageID", page.ID()).Return(nil, errors.New("db error"))
+
+		assert.Error(t, indexer.Reindex(page))
+	})
+}
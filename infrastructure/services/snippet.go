@@ -0,0 +1,301 @@
+package services
+
+import (
+	"github.com/h4rdc0m/aurora-api/domain/common"
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"github.com/h4rdc0m/aurora-api/domain/errors"
+	"github.com/h4rdc0m/aurora-api/domain/repositories"
+	domainServices "github.com/h4rdc0m/aurora-api/domain/services"
+	"github.com/jmoiron/sqlx"
+	"sort"
+)
+
+// maxSnippetDepth bounds how deep snippets are inlined into each other
+const maxSnippetDepth = 5
+
+// SnippetServiceImpl is an implementation of SnippetService reading snippets through the page repositories.
+type SnippetServiceImpl struct {
+	pageRepo        repositories.PageRepository
+	pageVersionRepo repositories.PageVersionRepository
+	pageBlockRepo   repositories.PageBlockRepository
+	siteRepo        repositories.SiteRepository
+	logger          common.Logger
+}
+
+// NewSnippetService initializes and returns a SnippetService using the given repositories.
+func NewSnippetService(
+	pageRepo repositories.PageRepository,
+	pageVersionRepo repositories.PageVersionRepository,
+	pageBlockRepo repositories.PageBlockRepository,
+	siteRepo repositories.SiteRepository,
+	logger common.Logger,
+) domainServices.SnippetService {
+	return &SnippetServiceImpl{
+		pageRepo:        pageRepo,
+		pageVersionRepo: pageVersionRepo,
+		pageBlockRepo:   pageBlockRepo,
+		siteRepo:        siteRepo,
+		logger:          logger,
+	}
+}
+
+// WithTrx returns a copy of the service that reads inside the given transaction.
+func (s *SnippetServiceImpl) WithTrx(trxHandle *sqlx.Tx) domainServices.SnippetService {
+	return &SnippetServiceImpl{
+		pageRepo:        s.pageRepo.WithTrx(trxHandle),
+		pageVersionRepo: s.pageVersionRepo.WithTrx(trxHandle),
+		pageBlockRepo:   s.pageBlockRepo.WithTrx(trxHandle),
+		siteRepo:        s.siteRepo.WithTrx(trxHandle),
+		logger:          s.logger,
+	}
+}
+
+// ValidateEmbed checks that the page may embed the snippet referenced by the block.
+func (s *SnippetServiceImpl) ValidateEmbed(page *entities.Page, block *entities.PageBlock) error {
+	snippetID, err := block.SnippetPageID()
+	if err != nil || snippetID == nil {
+		return err
+	}
+	if !page.ID().IsEmpty() && page.ID().Value() == snippetID.Value() {
+		return errors.ErrPageSnippetCycle
+	}
+
+	snippet, err := s.pageRepo.FindByID(*snippetID)
+	if err != nil {
+		s.logger.Error("Failed to find snippet page", "snippetID", snippetID.Value(), "error", err)
+		return err
+	}
+	if snippet == nil {
+		return errors.ErrPageSnippetNotFound
+	}
+	if snippet.Type() != entities.PageTypeSnippet {
+		return errors.ErrPageSnippetTypeInvalid
+	}
+	if err := s.ensureSameTenant(page.SiteID(), snippet.SiteID()); err != nil {
+		return err
+	}
+
+	// The published content of the snippet must not embed the page again, directly or through other snippets
+	if page.ID().IsEmpty() {
+		return nil
+	}
+	return s.ensureNotEmbedding(snippet, page.ID(), map[uint64]bool{snippet.ID().Value(): true}, 1)
+}
+
+// Render prepares the blocks of a page for delivery in the given locale, inlining the published blocks of embedded snippets.
+func (s *SnippetServiceImpl) Render(page *entities.Page, locale entities.Locale, blocks []*entities.PageBlock) ([]*entities.RenderedBlock, error) {
+	tenantID, err := s.tenantOf(page.SiteID())
+	if err != nil {
+		return nil, err
+	}
+
+	return s.render(blocks, tenantID, locale, map[uint64]bool{page.ID().Value(): true}, 0)
+}
+
+// render inlines the snippets of the blocks; visited holds the pages on the current embedding path
+func (s *SnippetServiceImpl) render(blocks []*entities.PageBlock, tenantID uint64, locale entities.Locale, visited map[uint64]bool, depth int) ([]*entities.RenderedBlock, error) {
+	rendered := make([]*entities.RenderedBlock, 0, len(blocks))
+	for _, block := range sortedBlocks(blocks) {
+		snippet, err := s.renderSnippet(block, tenantID, locale, visited, depth)
+		if err != nil {
+			return nil, err
+		}
+		rendered = append(rendered, &entities.RenderedBlock{Block: block, Snippet: snippet})
+	}
+	return rendered, nil
+}
+
+// renderSnippet inlines the published blocks of the snippet embedded by the block, or returns nil when it cannot be inlined.
+// The snippet is rendered in the requested locale, falling back along the locale chain of the snippet's site.
+func (s *SnippetServiceImpl) renderSnippet(block *entities.PageBlock, tenantID uint64, locale entities.Locale, visited map[uint64]bool, depth int) (*entities.RenderedSnippet, error) {
+	snippetID, err := block.SnippetPageID()
+	if err != nil {
+		s.logger.Warn("Skipping invalid snippet block", "blockID", block.ID().Value(), "error", err)
+		return nil, nil
+	}
+	if snippetID == nil {
+		return nil, nil
+	}
+	if visited[snippetID.Value()] {
+		s.logger.Warn("Skipping snippet embedded in a cycle", "blockID", block.ID().Value(), "snippetID", snippetID.Value())
+		return nil, nil
+	}
+	if depth >= maxSnippetDepth {
+		s.logger.Warn("Skipping snippet nested too deep", "blockID", block.ID().Value(), "snippetID", snippetID.Value(), "depth", depth)
+		return nil, nil
+	}
+
+	snippet, err := s.pageRepo.FindByID(*snippetID)
+	if err != nil {
+		s.logger.Error("Failed to find snippet page", "snippetID", snippetID.Value(), "error", err)
+		return nil, err
+	}
+	if snippet == nil || snippet.Type() != entities.PageTypeSnippet {
+		s.logger.Warn("Skipping embed of a missing snippet page", "blockID", block.ID().Value(), "snippetID", snippetID.Value())
+		return nil, nil
+	}
+	snippetSite, err := s.siteOf(snippet.SiteID())
+	if err != nil {
+		return nil, err
+	}
+	if snippetSite == nil || snippetSite.TenantID().Value() != tenantID {
+		s.logger.Warn("Skipping snippet of another tenant", "blockID", block.ID().Value(), "snippetID", snippetID.Value())
+		return nil, nil
+	}
+
+	version, blocks, err := s.publishedBlocks(snippet, snippetSite.LocaleChain(locale))
+	if err != nil || version == nil {
+		return nil, err
+	}
+
+	visited[snippetID.Value()] = true
+	defer delete(visited, snippetID.Value())
+
+	inlined, err := s.render(blocks, tenantID, locale, visited, depth+1)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entities.RenderedSnippet{
+		PageID:    snippet.ID(),
+		VersionID: version.ID(),
+		Blocks:    inlined,
+	}, nil
+}
+
+// ensureNotEmbedding checks that the published content of the snippet, in any locale, does not embed the page at any depth
+func (s *SnippetServiceImpl) ensureNotEmbedding(snippet *entities.Page, pageID entities.PageID, visited map[uint64]bool, depth int) error {
+	if depth > maxSnippetDepth {
+		return nil
+	}
+
+	blocks, err := s.allPublishedBlocks(snippet)
+	if err != nil {
+		return err
+	}
+
+	for _, block := range blocks {
+		nestedID, err := block.SnippetPageID()
+		if err != nil || nestedID == nil {
+			continue
+		}
+		if nestedID.Value() == pageID.Value() {
+			return errors.ErrPageSnippetCycle
+		}
+		if visited[nestedID.Value()] {
+			continue
+		}
+		visited[nestedID.Value()] = true
+
+		nested, err := s.pageRepo.FindByID(*nestedID)
+		if err != nil {
+			s.logger.Error("Failed to find snippet page", "snippetID", nestedID.Value(), "error", err)
+			return err
+		}
+		if nested == nil {
+			continue
+		}
+		if err := s.ensureNotEmbedding(nested, pageID, visited, depth+1); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// publishedBlocks returns the version of the snippet published in the first locale of the chain that has one,
+// with its blocks, or nil when nothing is published in any of them
+func (s *SnippetServiceImpl) publishedBlocks(snippet *entities.Page, chain []entities.Locale) (*entities.PageVersion, []*entities.PageBlock, error) {
+	for _, locale := range chain {
+		version, err := s.pageVersionRepo.FindPublishedByPageID(snippet.ID(), locale)
+		if err != nil {
+			s.logger.Error("Failed to find published snippet version", "snippetID", snippet.ID().Value(), "error", err)
+			return nil, nil, err
+		}
+		if version == nil {
+			continue
+		}
+
+		blocks, err := s.pageBlockRepo.FindByPageVersionID(version.ID())
+		if err != nil {
+			s.logger.Error("Failed to find snippet blocks", "snippetID", snippet.ID().Value(), "error", err)
+			return nil, nil, err
+		}
+
+		return version, blocks, nil
+	}
+
+	return nil, nil, nil
+}
+
+// allPublishedBlocks returns the blocks of the versions of the snippet published in every locale
+func (s *SnippetServiceImpl) allPublishedBlocks(snippet *entities.Page) ([]*entities.PageBlock, error) {
+	versions, err := s.pageVersionRepo.FindByPageID(snippet.ID())
+	if err != nil {
+		s.logger.Error("Failed to find snippet versions", "snippetID", snippet.ID().Value(), "error", err)
+		return nil, err
+	}
+
+	var blocks []*entities.PageBlock
+	for _, version := range versions {
+		if !version.IsPublished() {
+			continue
+		}
+		versionBlocks, err := s.pageBlockRepo.FindByPageVersionID(version.ID())
+		if err != nil {
+			s.logger.Error("Failed to find snippet blocks", "snippetID", snippet.ID().Value(), "error", err)
+			return nil, err
+		}
+		blocks = append(blocks, versionBlocks...)
+	}
+
+	return blocks, nil
+}
+
+// ensureSameTenant checks that both sites belong to the same tenant
+func (s *SnippetServiceImpl) ensureSameTenant(siteID, snippetSiteID entities.SiteID) error {
+	if siteID.Value() == snippetSiteID.Value() {
+		return nil
+	}
+
+	tenantID, err := s.tenantOf(siteID)
+	if err != nil {
+		return err
+	}
+	snippetTenantID, err := s.tenantOf(snippetSiteID)
+	if err != nil {
+		return err
+	}
+	if tenantID != snippetTenantID {
+		return errors.ErrPageSnippetCrossTenant
+	}
+	return nil
+}
+
+// tenantOf returns the tenant ID of the site, or zero when the site does not exist
+func (s *SnippetServiceImpl) tenantOf(siteID entities.SiteID) (uint64, error) {
+	site, err := s.siteOf(siteID)
+	if err != nil || site == nil {
+		return 0, err
+	}
+	return site.TenantID().Value(), nil
+}
+
+// siteOf returns the site, or nil when it does not exist
+func (s *SnippetServiceImpl) siteOf(siteID entities.SiteID) (*entities.Site, error) {
+	site, err := s.siteRepo.FindByID(siteID)
+	if err != nil {
+		s.logger.Error("Failed to find site", "siteID", siteID.Value(), "error", err)
+		return nil, err
+	}
+	return site, nil
+}
+
+// sortedBlocks returns the blocks ordered by index without changing the given slice
+func sortedBlocks(blocks []*entities.PageBlock) []*entities.PageBlock {
+	sorted := append([]*entities.PageBlock(nil), blocks...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Index() < sorted[j].Index()
+	})
+	return sorted
+}
@@ -0,0 +1,225 @@
+package services
+
+import (
+	"fmt"
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	domainErrors "github.com/h4rdc0m/aurora-api/domain/errors"
+	"github.com/h4rdc0m/aurora-api/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+type snippetTestRepos struct {
+	pages    *mocks.MockPageRepository
+	versions *mocks.MockPageVersionRepository
+	blocks   *mocks.MockPageBlockRepository
+	sites    *mocks.MockSiteRepository
+}
+
+func newTestSnippetService() (*SnippetServiceImpl, snippetTestRepos) {
+	repos := snippetTestRepos{
+		pages:    &mocks.MockPageRepository{},
+		versions: &mocks.MockPageVersionRepository{},
+		blocks:   &mocks.MockPageBlockRepository{},
+		sites:    &mocks.MockSiteRepository{},
+	}
+	logger := &mocks.Logger{}
+	logger.On("Warn", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+	logger.On("Warn", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+	logger.On("Error", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+	service := NewSnippetService(repos.pages, repos.versions, repos.blocks, repos.sites, logger).(*SnippetServiceImpl)
+	return service, repos
+}
+
+// newBlock creates a block at the given index; snippetID embeds that page when it is not zero
+func newBlock(t *testing.T, key string, index int, snippetID uint64) *entities.PageBlock {
+	contentType, content := "text", key
+	if snippetID != 0 {
+		contentType, content = entities.PageBlockContentTypeSnippet, fmt.Sprintf(`{"page_id": %d}`, snippetID)
+	}
+	block, err := entities.NewPageBlock(entities.NewPageVersionID(1), key, index, contentType, content)
+	assert.NoError(t, err)
+	return block
+}
+
+// publishSnippet registers a snippet page in the site with a version published in the default locale holding the blocks
+func (r snippetTestRepos) publishSnippet(t *testing.T, id, siteID uint64, blocks ...*entities.PageBlock) {
+	page := newResolverPage(t, id, siteID, entities.PageTypeSnippet, 0)
+	version, err := entities.NewPageVersion(page.ID(), 1, entities.DefaultLocale, "Snippet", nil)
+	assert.NoError(t, err)
+	version.SetID(entities.NewPageVersionID(id * 10))
+	version.SetStatus(entities.PageVersionStatusPublished, nil, nil)
+
+	r.pages.On("FindByID", page.ID()).Return(page, nil)
+	r.versions.On("FindPublishedByPageID", page.ID(), entities.DefaultLocale).Return(version, nil)
+	r.versions.On("FindByPageID", page.ID()).Return([]*entities.PageVersion{version}, nil)
+	r.blocks.On("FindByPageVersionID", version.ID()).Return(blocks, nil)
+}
+
+func TestSnippetServiceImpl_Render(t *testing.T) {
+	t.Run("inlines published snippet blocks", func(t *testing.T) {
+		service, repos := newTestSnippetService()
+		page := newResolverPage(t, 1, 1, entities.PageTypeContent, 0)
+		repos.sites.On("FindByID", entities.NewSiteID(1)).Return(newResolverSite(t, 1, 1), nil)
+		repos.publishSnippet(t, 2, 1, newBlock(t, "b", 1, 0), newBlock(t, "a", 0, 0))
+
+		rendered, err := service.Render(page, entities.DefaultLocale, []*entities.PageBlock{newBlock(t, "embed", 1, 2), newBlock(t, "intro", 0, 0)})
+
+		assert.NoError(t, err)
+		assert.Len(t, rendered, 2)
+		assert.Equal(t, "intro", rendered[0].Block.BlockKey())
+		assert.Nil(t, rendered[0].Snippet)
+		assert.Equal(t, "embed", rendered[1].Block.BlockKey())
+		if assert.NotNil(t, rendered[1].Snippet) {
+			assert.Equal(t, uint64(2), rendered[1].Snippet.PageID.Value())
+			assert.Equal(t, uint64(20), rendered[1].Snippet.VersionID.Value())
+			assert.Len(t, rendered[1].Snippet.Blocks, 2)
+			assert.Equal(t, "a", rendered[1].Snippet.Blocks[0].Block.BlockKey())
+		}
+	})
+
+	t.Run("leaves cycles empty", func(t *testing.T) {
+		service, repos := newTestSnippetService()
+		page := newResolverPage(t, 1, 1, entities.PageTypeContent, 0)
+		repos.sites.On("FindByID", entities.NewSiteID(1)).Return(newResolverSite(t, 1, 1), nil)
+		repos.publishSnippet(t, 2, 1, newBlock(t, "to-3", 0, 3))
+		repos.publishSnippet(t, 3, 1, newBlock(t, "to-2", 0, 2), newBlock(t, "to-page", 1, 1))
+
+		rendered, err := service.Render(page, entities.DefaultLocale, []*entities.PageBlock{newBlock(t, "embed", 0, 2)})
+
+		assert.NoError(t, err)
+		inner := rendered[0].Snippet.Blocks[0].Snippet
+		if assert.NotNil(t, inner) {
+			assert.Equal(t, uint64(3), inner.PageID.Value())
+			assert.Nil(t, inner.Blocks[0].Snippet)
+			assert.Nil(t, inner.Blocks[1].Snippet)
+		}
+	})
+
+	t.Run("stops at the nesting limit", func(t *testing.T) {
+		service, repos := newTestSnippetService()
+		page := newResolverPage(t, 1, 1, entities.PageTypeContent, 0)
+		repos.sites.On("FindByID", entities.NewSiteID(1)).Return(newResolverSite(t, 1, 1), nil)
+		for id := uint64(2); id <= maxSnippetDepth+2; id++ {
+			repos.publishSnippet(t, id, 1, newBlock(t, "nested", 0, id+1))
+		}
+
+		rendered, err := service.Render(page, entities.DefaultLocale, []*entities.PageBlock{newBlock(t, "embed", 0, 2)})
+
+		assert.NoError(t, err)
+		depth := 0
+		for snippet := rendered[0].Snippet; snippet != nil; snippet = snippet.Blocks[0].Snippet {
+			depth++
+		}
+		assert.Equal(t, maxSnippetDepth, depth)
+	})
+
+	t.Run("falls back along the locale chain", func(t *testing.T) {
+		service, repos := newTestSnippetService()
+		page := newResolverPage(t, 1, 1, entities.PageTypeContent, 0)
+		site := newResolverSite(t, 1, 1)
+		assert.NoError(t, site.UpdateLocales("en", []entities.Locale{"en", "nl", "nl-BE"}, []entities.Locale{"nl"}))
+		repos.sites.On("FindByID", entities.NewSiteID(1)).Return(site, nil)
+		repos.publishSnippet(t, 2, 1, newBlock(t, "english", 0, 0))
+		dutch, err := entities.NewPageVersion(entities.NewPageID(2), 2, "nl", "Fragment", nil)
+		assert.NoError(t, err)
+		dutch.SetID(entities.NewPageVersionID(21))
+		repos.versions.On("FindPublishedByPageID", entities.NewPageID(2), entities.Locale("nl-BE")).Return(nil, nil)
+		repos.versions.On("FindPublishedByPageID", entities.NewPageID(2), entities.Locale("nl")).Return(dutch, nil)
+		repos.blocks.On("FindByPageVersionID", dutch.ID()).Return([]*entities.PageBlock{newBlock(t, "dutch", 0, 0)}, nil)
+
+		flemish, err := service.Render(page, "nl-BE", []*entities.PageBlock{newBlock(t, "embed", 0, 2)})
+		assert.NoError(t, err)
+		french, err := service.Render(page, "fr", []*entities.PageBlock{newBlock(t, "embed", 0, 2)})
+		assert.NoError(t, err)
+
+		assert.Equal(t, uint64(21), flemish[0].Snippet.VersionID.Value())
+		assert.Equal(t, "dutch", flemish[0].Snippet.Blocks[0].Block.BlockKey())
+		assert.Equal(t, uint64(21), french[0].Snippet.VersionID.Value())
+	})
+
+	t.Run("skips unpublished and foreign snippets", func(t *testing.T) {
+		service, repos := newTestSnippetService()
+		page := newResolverPage(t, 1, 1, entities.PageTypeContent, 0)
+		unpublished := newResolverPage(t, 2, 1, entities.PageTypeSnippet, 0)
+		repos.sites.On("FindByID", entities.NewSiteID(1)).Return(newResolverSite(t, 1, 1), nil)
+		repos.sites.On("FindByID", entities.NewSiteID(2)).Return(newResolverSite(t, 2, 2), nil)
+		repos.pages.On("FindByID", unpublished.ID()).Return(unpublished, nil)
+		repos.versions.On("FindPublishedByPageID", unpublished.ID(), entities.DefaultLocale).Return(nil, nil)
+		repos.publishSnippet(t, 3, 2, newBlock(t, "foreign", 0, 0))
+
+		rendered, err := service.Render(page, entities.DefaultLocale, []*entities.PageBlock{newBlock(t, "a", 0, 2), newBlock(t, "b", 1, 3)})
+
+		assert.NoError(t, err)
+		assert.Nil(t, rendered[0].Snippet)
+		assert.Nil(t, rendered[1].Snippet)
+		repos.blocks.AssertNotCalled(t, "FindByPageVersionID", entities.NewPageVersionID(30))
+	})
+}
+
+func TestSnippetServiceImpl_ValidateEmbed(t *testing.T) {
+	t.Run("accepts a snippet of the same tenant", func(t *testing.T) {
+		service, repos := newTestSnippetService()
+		page := newResolverPage(t, 1, 1, entities.PageTypeContent, 0)
+		repos.sites.On("FindByID", entities.NewSiteID(1)).Return(newResolverSite(t, 1, 1), nil)
+		repos.sites.On("FindByID", entities.NewSiteID(2)).Return(newResolverSite(t, 2, 1), nil)
+		repos.publishSnippet(t, 2, 2, newBlock(t, "text", 0, 0))
+
+		assert.NoError(t, service.ValidateEmbed(page, newBlock(t, "embed", 0, 2)))
+	})
+
+	t.Run("ignores other blocks", func(t *testing.T) {
+		service, repos := newTestSnippetService()
+		page := newResolverPage(t, 1, 1, entities.PageTypeContent, 0)
+
+		assert.NoError(t, service.ValidateEmbed(page, newBlock(t, "text", 0, 0)))
+		repos.pages.AssertNotCalled(t, "FindByID", mock.Anything)
+	})
+
+	t.Run("rejects invalid content", func(t *testing.T) {
+		service, _ := newTestSnippetService()
+		page := newResolverPage(t, 1, 1, entities.PageTypeContent, 0)
+		block, err := entities.NewPageBlock(entities.NewPageVersionID(1), "embed", 0, entities.PageBlockContentTypeSnippet, `{"page_id": 0}`)
+		assert.NoError(t, err)
+
+		assert.ErrorIs(t, service.ValidateEmbed(page, block), domainErrors.ErrPageBlockSnippetInvalid)
+	})
+
+	t.Run("rejects missing and non snippet pages", func(t *testing.T) {
+		service, repos := newTestSnippetService()
+		page := newResolverPage(t, 1, 1, entities.PageTypeContent, 0)
+		content := newResolverPage(t, 3, 1, entities.PageTypeContent, 0)
+		repos.pages.On("FindByID", entities.NewPageID(2)).Return(nil, nil)
+		repos.pages.On("FindByID", content.ID()).Return(content, nil)
+
+		assert.ErrorIs(t, service.ValidateEmbed(page, newBlock(t, "embed", 0, 2)), domainErrors.ErrPageSnippetNotFound)
+		assert.ErrorIs(t, service.ValidateEmbed(page, newBlock(t, "embed", 0, 3)), domainErrors.ErrPageSnippetTypeInvalid)
+	})
+
+	t.Run("rejects snippets of another tenant", func(t *testing.T) {
+		service, repos := newTestSnippetService()
+		page := newResolverPage(t, 1, 1, entities.PageTypeContent, 0)
+		repos.sites.On("FindByID", entities.NewSiteID(1)).Return(newResolverSite(t, 1, 1), nil)
+		repos.sites.On("FindByID", entities.NewSiteID(2)).Return(newResolverSite(t, 2, 2), nil)
+		repos.publishSnippet(t, 2, 2)
+
+		assert.ErrorIs(t, service.ValidateEmbed(page, newBlock(t, "embed", 0, 2)), domainErrors.ErrPageSnippetCrossTenant)
+	})
+
+	t.Run("rejects embedding itself", func(t *testing.T) {
+		service, _ := newTestSnippetService()
+		page := newResolverPage(t, 1, 1, entities.PageTypeSnippet, 0)
+
+		assert.ErrorIs(t, service.ValidateEmbed(page, newBlock(t, "embed", 0, 1)), domainErrors.ErrPageSnippetCycle)
+	})
+
+	t.Run("rejects snippets embedding the page", func(t *testing.T) {
+		service, repos := newTestSnippetService()
+		page := newResolverPage(t, 1, 1, entities.PageTypeSnippet, 0)
+		repos.publishSnippet(t, 2, 1, newBlock(t, "to-3", 0, 3))
+		repos.publishSnippet(t, 3, 1, newBlock(t, "to-1", 0, 1))
+
+		assert.ErrorIs(t, service.ValidateEmbed(page, newBlock(t, "embed", 0, 2)), domainErrors.ErrPageSnippetCycle)
+	})
+}
@@ -0,0 +1,2 @@
+-- Modify "pages" table
+ALTER TABLE `pages` DROP INDEX `unique_page_key`, ADD UNIQUE INDEX `unique_page_path` (`site_id`, `path`);
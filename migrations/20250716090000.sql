@@ -0,0 +1,6 @@
+-- Modify "page_versions" table
+ALTER TABLE `page_versions` ADD COLUMN `status` varchar(32) NOT NULL DEFAULT "draft" AFTER `description`, ADD COLUMN `status_changed_by` bigint unsigned NULL AFTER `status`, ADD COLUMN `status_changed_at` datetime(3) NULL AFTER `status_changed_by`;
+-- Migrate published flags to the workflow status
+UPDATE `page_versions` SET `status` = "published" WHERE `is_published` = 1;
+-- Modify "page_versions" table
+ALTER TABLE `page_versions` DROP COLUMN `is_published`, ADD COLUMN `published_page_id` bigint unsigned AS (CASE WHEN `status` = "published" THEN `page_id` END) STORED, ADD UNIQUE INDEX `unique_published_page_version` (`published_page_id`), ADD INDEX `idx_page_versions_status_changed_by` (`status_changed_by`), ADD CONSTRAINT `fk_page_versions_status_changed_by` FOREIGN KEY (`status_changed_by`) REFERENCES `users` (`id`) ON UPDATE NO ACTION ON DELETE SET NULL;
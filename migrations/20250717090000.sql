@@ -0,0 +1,2 @@
+-- Modify "page_versions" table
+ALTER TABLE `page_versions` ADD COLUMN `publish_at` datetime(3) NULL AFTER `status_changed_at`, ADD COLUMN `unpublish_at` datetime(3) NULL AFTER `publish_at`, ADD COLUMN `scheduled_by` bigint unsigned NULL AFTER `unpublish_at`, ADD INDEX `idx_page_versions_status_publish_at` (`status`, `publish_at`), ADD INDEX `idx_page_versions_status_unpublish_at` (`status`, `unpublish_at`), ADD INDEX `idx_page_versions_scheduled_by` (`scheduled_by`), ADD CONSTRAINT `fk_page_versions_scheduled_by` FOREIGN KEY (`scheduled_by`) REFERENCES `users` (`id`) ON UPDATE NO ACTION ON DELETE SET NULL;
@@ -0,0 +1,2 @@
+-- Modify "page_blocks" table
+ALTER TABLE `page_blocks` ADD COLUMN `snippet_page_id` bigint unsigned NULL AFTER `content`, ADD INDEX `idx_page_blocks_snippet_page_id` (`snippet_page_id`);
@@ -0,0 +1,17 @@
+-- Create "content_types" table
+CREATE TABLE `content_types` (
+ `id` bigint unsigned NOT NULL AUTO_INCREMENT,
+ `created_at` datetime(3) NULL,
+ `updated_at` datetime(3) NULL,
+ `deleted_at` datetime(3) NULL,
+ `tenant_id` bigint unsigned NULL,
+ `name` varchar(100) NOT NULL,
+ `description` varchar(255) NULL,
+ `schema` json NOT NULL,
+ `scope_tenant_id` bigint unsigned AS (COALESCE(`tenant_id`, 0)) STORED NOT NULL,
+ PRIMARY KEY (`id`),
+ INDEX `idx_content_types_deleted_at` (`deleted_at`),
+ INDEX `idx_content_types_tenant_id` (`tenant_id`),
+ UNIQUE INDEX `unique_content_type_name` (`scope_tenant_id`, `name`),
+ CONSTRAINT `fk_tenants_content_types` FOREIGN KEY (`tenant_id`) REFERENCES `tenants` (`id`) ON UPDATE NO ACTION ON DELETE NO ACTION
+) CHARSET utf8mb4 COLLATE utf8mb4_0900_ai_ci;
@@ -0,0 +1,2 @@
+-- Modify "sites" table
+ALTER TABLE `sites` ADD COLUMN `robots_txt` text NULL AFTER `title_template`;
@@ -0,0 +1,2 @@
+-- Modify "pages" table
+ALTER TABLE `pages` ADD COLUMN `is_feed_root` bool NOT NULL DEFAULT 0 AFTER `hard_link_page_id`;
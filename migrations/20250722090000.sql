@@ -0,0 +1,15 @@
+-- Create "search_documents" table
+CREATE TABLE `search_documents` (
+ `page_id` bigint unsigned NOT NULL,
+ `site_id` bigint unsigned NOT NULL,
+ `page_version_id` bigint unsigned NOT NULL,
+ `path` varchar(2048) NOT NULL,
+ `title` varchar(255) NOT NULL,
+ `description` varchar(255) NOT NULL,
+ `body` mediumtext NOT NULL,
+ `updated_at` datetime(3) NULL,
+ PRIMARY KEY (`page_id`),
+ INDEX `idx_search_documents_site_id` (`site_id`),
+ FULLTEXT INDEX `ftx_search_documents_content` (`title`, `description`, `body`),
+ CONSTRAINT `fk_search_documents_page` FOREIGN KEY (`page_id`) REFERENCES `pages` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE
+) CHARSET utf8mb4 COLLATE utf8mb4_0900_ai_ci;
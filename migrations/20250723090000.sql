@@ -0,0 +1,20 @@
+-- Create "redirects" table
+CREATE TABLE `redirects` (
+ `id` bigint unsigned NOT NULL AUTO_INCREMENT,
+ `created_at` datetime(3) NULL,
+ `updated_at` datetime(3) NULL,
+ `deleted_at` datetime(3) NULL,
+ `site_id` bigint unsigned NOT NULL,
+ `source_path` varchar(768) NOT NULL,
+ `target_page_id` bigint unsigned NULL,
+ `target_url` varchar(2048) NULL,
+ `status_code` smallint unsigned NOT NULL DEFAULT 301,
+ `is_automatic` bool NOT NULL DEFAULT 0,
+ `hits` bigint unsigned NOT NULL DEFAULT 0,
+ PRIMARY KEY (`id`),
+ INDEX `idx_redirects_deleted_at` (`deleted_at`),
+ INDEX `idx_redirects_target_page_id` (`target_page_id`),
+ UNIQUE INDEX `unique_redirect_source` (`site_id`, `source_path`),
+ CONSTRAINT `fk_sites_redirects` FOREIGN KEY (`site_id`) REFERENCES `sites` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE,
+ CONSTRAINT `fk_pages_redirects` FOREIGN KEY (`target_page_id`) REFERENCES `pages` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE
+) CHARSET utf8mb4 COLLATE utf8mb4_0900_ai_ci;
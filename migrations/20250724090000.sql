@@ -0,0 +1,21 @@
+-- Create "broken_links" table
+CREATE TABLE `broken_links` (
+ `id` bigint unsigned NOT NULL AUTO_INCREMENT,
+ `created_at` datetime(3) NULL,
+ `updated_at` datetime(3) NULL,
+ `deleted_at` datetime(3) NULL,
+ `site_id` bigint unsigned NOT NULL,
+ `page_id` bigint unsigned NOT NULL,
+ `block_key` varchar(100) NOT NULL DEFAULT '',
+ `url` varchar(2048) NOT NULL,
+ `status_code` smallint unsigned NULL,
+ `reason` varchar(255) NOT NULL DEFAULT '',
+ `first_seen_at` datetime(3) NOT NULL,
+ `last_checked_at` datetime(3) NOT NULL,
+ PRIMARY KEY (`id`),
+ INDEX `idx_broken_links_deleted_at` (`deleted_at`),
+ INDEX `idx_broken_links_site_checked` (`site_id`, `last_checked_at`),
+ INDEX `idx_broken_links_page_id` (`page_id`),
+ CONSTRAINT `fk_sites_broken_links` FOREIGN KEY (`site_id`) REFERENCES `sites` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE,
+ CONSTRAINT `fk_pages_broken_links` FOREIGN KEY (`page_id`) REFERENCES `pages` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE
+) CHARSET utf8mb4 COLLATE utf8mb4_0900_ai_ci;
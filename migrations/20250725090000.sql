@@ -0,0 +1,4 @@
+-- Modify "page_versions" table
+ALTER TABLE `page_versions` ADD COLUMN `locale` varchar(35) NOT NULL DEFAULT "en" AFTER `page_id`, DROP INDEX `unique_published_page_version`, ADD UNIQUE INDEX `unique_published_page_version` (`published_page_id`, `locale`), ADD INDEX `idx_page_versions_page_locale` (`page_id`, `locale`);
+-- Modify "sites" table
+ALTER TABLE `sites` ADD COLUMN `default_locale` varchar(35) NOT NULL DEFAULT "en" AFTER `robots_txt`, ADD COLUMN `locales` varchar(600) NOT NULL DEFAULT "en" AFTER `default_locale`, ADD COLUMN `fallback_locales` varchar(600) NOT NULL DEFAULT "" AFTER `locales`;
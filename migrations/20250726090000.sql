@@ -0,0 +1,2 @@
+-- Modify "page_versions" table
+ALTER TABLE `page_versions` ADD COLUMN `meta_title` varchar(255) NULL AFTER `description`, ADD COLUMN `canonical_url` varchar(2048) NULL AFTER `meta_title`, ADD COLUMN `robots` varchar(255) NULL AFTER `canonical_url`, ADD COLUMN `og_title` varchar(255) NULL AFTER `robots`, ADD COLUMN `og_description` varchar(500) NULL AFTER `og_title`, ADD COLUMN `og_image` varchar(2048) NULL AFTER `og_description`, ADD COLUMN `twitter_card` varchar(32) NULL AFTER `og_image`;
@@ -0,0 +1,19 @@
+-- Create "audit_entries" table
+CREATE TABLE `audit_entries` (
+ `id` bigint unsigned NOT NULL AUTO_INCREMENT,
+ `created_at` datetime(3) NULL,
+ `updated_at` datetime(3) NULL,
+ `deleted_at` datetime(3) NULL,
+ `tenant_id` bigint unsigned NOT NULL,
+ `actor_id` bigint unsigned NOT NULL,
+ `action` varchar(64) NOT NULL,
+ `resource` varchar(64) NOT NULL,
+ `resource_id` bigint unsigned NOT NULL,
+ `details` json NOT NULL,
+ PRIMARY KEY (`id`),
+ INDEX `idx_audit_entries_deleted_at` (`deleted_at`),
+ INDEX `idx_audit_entries_tenant_created` (`tenant_id`, `created_at`),
+ INDEX `idx_audit_entries_actor_id` (`actor_id`),
+ CONSTRAINT `fk_tenants_audit_entries` FOREIGN KEY (`tenant_id`) REFERENCES `tenants` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE,
+ CONSTRAINT `fk_users_audit_entries` FOREIGN KEY (`actor_id`) REFERENCES `users` (`id`) ON UPDATE NO ACTION ON DELETE NO ACTION
+) CHARSET utf8mb4 COLLATE utf8mb4_0900_ai_ci;
@@ -0,0 +1,12 @@
+-- Modify "tenants" table
+ALTER TABLE `tenants` ADD COLUMN `revision` bigint unsigned NOT NULL DEFAULT 1;
+-- Modify "sites" table
+ALTER TABLE `sites` ADD COLUMN `revision` bigint unsigned NOT NULL DEFAULT 1;
+-- Modify "templates" table
+ALTER TABLE `templates` ADD COLUMN `revision` bigint unsigned NOT NULL DEFAULT 1;
+-- Modify "pages" table
+ALTER TABLE `pages` ADD COLUMN `revision` bigint unsigned NOT NULL DEFAULT 1;
+-- Modify "page_versions" table
+ALTER TABLE `page_versions` ADD COLUMN `revision` bigint unsigned NOT NULL DEFAULT 1;
+-- Modify "page_blocks" table
+ALTER TABLE `page_blocks` ADD COLUMN `revision` bigint unsigned NOT NULL DEFAULT 1;
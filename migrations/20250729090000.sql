@@ -0,0 +1,6 @@
+-- Modify "templates" table
+ALTER TABLE `templates` ADD COLUMN `not_deleted` bool AS (CASE WHEN `deleted_at` IS NULL THEN true END) STORED, DROP INDEX `idx_templates_file_path`, ADD UNIQUE INDEX `idx_templates_file_path` (`file_path`, `not_deleted`), DROP INDEX `idx_templates_name`, ADD UNIQUE INDEX `idx_templates_name` (`name`, `not_deleted`);
+-- Modify "sites" table
+ALTER TABLE `sites` ADD COLUMN `not_deleted` bool AS (CASE WHEN `deleted_at` IS NULL THEN true END) STORED, DROP INDEX `idx_site_template`, ADD UNIQUE INDEX `idx_site_template` (`template_id`, `not_deleted`), DROP INDEX `idx_sites_domain`, ADD UNIQUE INDEX `idx_sites_domain` (`domain`, `not_deleted`), DROP INDEX `idx_sites_name`, ADD UNIQUE INDEX `idx_sites_name` (`name`, `not_deleted`);
+-- Modify "pages" table
+ALTER TABLE `pages` ADD COLUMN `not_deleted` bool AS (CASE WHEN `deleted_at` IS NULL THEN true END) STORED, DROP INDEX `unique_page_path`, ADD UNIQUE INDEX `unique_page_path` (`site_id`, `path`, `not_deleted`);
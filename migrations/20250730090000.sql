@@ -0,0 +1,4 @@
+-- Modify "search_documents" table
+ALTER TABLE `search_documents` ADD COLUMN `locale` varchar(35) NOT NULL DEFAULT "en" AFTER `page_id`, DROP PRIMARY KEY, ADD PRIMARY KEY (`page_id`, `locale`);
+-- Backfill the locale of the documents indexed so far
+UPDATE `search_documents` JOIN `page_versions` ON `page_versions`.`id` = `search_documents`.`page_version_id` SET `search_documents`.`locale` = `page_versions`.`locale`;
@@ -0,0 +1,47 @@
+package mocks
+
+import (
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"github.com/h4rdc0m/aurora-api/infrastructure/persistence/models"
+)
+
+// MockAuditEntryMapper is a mock implementation of the Mapper interface for AuditEntry entities
+type MockAuditEntryMapper struct {
+	MockMapper[models.AuditEntry, entities.AuditEntry]
+}
+
+// ToModel converts a domain entity to a persistence model
+func (m *MockAuditEntryMapper) ToModel(entity *entities.AuditEntry) (*models.AuditEntry, error) {
+	args := m.Called(entity)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.AuditEntry), args.Error(1)
+}
+
+// ToDomain converts a persistence model to a domain entity
+func (m *MockAuditEntryMapper) ToDomain(model *models.AuditEntry) (*entities.AuditEntry, error) {
+	args := m.Called(model)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.AuditEntry), args.Error(1)
+}
+
+// ToModels converts a slice of domain entities to persistence models
+func (m *MockAuditEntryMapper) ToModels(entities []*entities.AuditEntry) ([]*models.AuditEntry, error) {
+	args := m.Called(entities)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*models.AuditEntry), args.Error(1)
+}
+
+// ToDomains converts a slice of persistence models to domain entities
+func (m *MockAuditEntryMapper) ToDomains(models []*models.AuditEntry) ([]*entities.AuditEntry, error) {
+	args := m.Called(models)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.AuditEntry), args.Error(1)
+}
@@ -0,0 +1,47 @@
+package mocks
+
+import (
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"github.com/h4rdc0m/aurora-api/infrastructure/persistence/models"
+)
+
+// MockBrokenLinkMapper is a mock implementation of the Mapper interface for BrokenLink entities
+type MockBrokenLinkMapper struct {
+	MockMapper[models.BrokenLink, entities.BrokenLink]
+}
+
+// ToModel converts a domain entity to a persistence model
+func (m *MockBrokenLinkMapper) ToModel(entity *entities.BrokenLink) (*models.BrokenLink, error) {
+	args := m.Called(entity)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.BrokenLink), args.Error(1)
+}
+
+// ToDomain converts a persistence model to a domain entity
+func (m *MockBrokenLinkMapper) ToDomain(model *models.BrokenLink) (*entities.BrokenLink, error) {
+	args := m.Called(model)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.BrokenLink), args.Error(1)
+}
+
+// ToModels converts a slice of domain entities to persistence models
+func (m *MockBrokenLinkMapper) ToModels(entities []*entities.BrokenLink) ([]*models.BrokenLink, error) {
+	args := m.Called(entities)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*models.BrokenLink), args.Error(1)
+}
+
+// ToDomains converts a slice of persistence models to domain entities
+func (m *MockBrokenLinkMapper) ToDomains(models []*models.BrokenLink) ([]*entities.BrokenLink, error) {
+	args := m.Called(models)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.BrokenLink), args.Error(1)
+}
@@ -0,0 +1,47 @@
+package mocks
+
+import (
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"github.com/h4rdc0m/aurora-api/infrastructure/persistence/models"
+)
+
+// MockContentTypeMapper is a mock implementation of the Mapper interface for ContentType entities
+type MockContentTypeMapper struct {
+	MockMapper[models.ContentType, entities.ContentType]
+}
+
+// ToModel converts a domain entity to a persistence model
+func (m *MockContentTypeMapper) ToModel(entity *entities.ContentType) (*models.ContentType, error) {
+	args := m.Called(entity)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.ContentType), args.Error(1)
+}
+
+// ToDomain converts a persistence model to a domain entity
+func (m *MockContentTypeMapper) ToDomain(model *models.ContentType) (*entities.ContentType, error) {
+	args := m.Called(model)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.ContentType), args.Error(1)
+}
+
+// ToModels converts a slice of domain entities to persistence models
+func (m *MockContentTypeMapper) ToModels(entities []*entities.ContentType) ([]*models.ContentType, error) {
+	args := m.Called(entities)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*models.ContentType), args.Error(1)
+}
+
+// ToDomains converts a slice of persistence models to domain entities
+func (m *MockContentTypeMapper) ToDomains(models []*models.ContentType) ([]*entities.ContentType, error) {
+	args := m.Called(models)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.ContentType), args.Error(1)
+}
@@ -0,0 +1,54 @@
+package mocks
+
+import (
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"github.com/h4rdc0m/aurora-api/domain/repositories"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockContentTypeRepository is a mock implementation of the ContentTypeRepository interface
+type MockContentTypeRepository struct {
+	mock.Mock
+}
+
+var _ repositories.ContentTypeRepository = (*MockContentTypeRepository)(nil)
+
+func (m *MockContentTypeRepository) Save(contentType *entities.ContentType) error {
+	args := m.Called(contentType)
+	return args.Error(0)
+}
+
+func (m *MockContentTypeRepository) FindByID(id entities.ContentTypeID) (*entities.ContentType, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.ContentType), args.Error(1)
+}
+
+func (m *MockContentTypeRepository) FindByName(tenantID *entities.TenantID, name string) (*entities.ContentType, error) {
+	args := m.Called(tenantID, name)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.ContentType), args.Error(1)
+}
+
+func (m *MockContentTypeRepository) FindByTenantID(tenantID *entities.TenantID) ([]*entities.ContentType, error) {
+	args := m.Called(tenantID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.ContentType), args.Error(1)
+}
+
+func (m *MockContentTypeRepository) Delete(id entities.ContentTypeID) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+// WithTrx returns the mock itself, so expectations apply inside transactions as well
+func (m *MockContentTypeRepository) WithTrx(_ *sqlx.Tx) repositories.ContentTypeRepository {
+	return m
+}
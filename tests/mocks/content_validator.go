@@ -0,0 +1,30 @@
+package mocks
+
+import (
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"github.com/h4rdc0m/aurora-api/domain/services"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockContentValidator is a mock implementation of the ContentValidator interface
+type MockContentValidator struct {
+	mock.Mock
+}
+
+var _ services.ContentValidator = (*MockContentValidator)(nil)
+
+func (m *MockContentValidator) ValidateSchema(schema string) error {
+	args := m.Called(schema)
+	return args.Error(0)
+}
+
+func (m *MockContentValidator) Validate(tenantID entities.TenantID, block *entities.PageBlock) error {
+	args := m.Called(tenantID, block)
+	return args.Error(0)
+}
+
+// WithTrx returns the mock itself, so expectations apply inside transactions as well
+func (m *MockContentValidator) WithTrx(_ *sqlx.Tx) services.ContentValidator {
+	return m
+}
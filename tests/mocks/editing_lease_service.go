@@ -0,0 +1,49 @@
+package mocks
+
+import (
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"github.com/h4rdc0m/aurora-api/domain/services"
+	"github.com/stretchr/testify/mock"
+	"time"
+)
+
+// MockEditingLeaseService is a mock implementation of the EditingLeaseService interface
+type MockEditingLeaseService struct {
+	mock.Mock
+}
+
+var _ services.EditingLeaseService = (*MockEditingLeaseService)(nil)
+
+func (m *MockEditingLeaseService) Acquire(lease *entities.EditingLease) error {
+	args := m.Called(lease)
+	return args.Error(0)
+}
+
+func (m *MockEditingLeaseService) Renew(versionID entities.PageVersionID, subject string, expiresAt time.Time) (*entities.EditingLease, error) {
+	args := m.Called(versionID, subject, expiresAt)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.EditingLease), args.Error(1)
+}
+
+func (m *MockEditingLeaseService) Release(versionID entities.PageVersionID, subject string) error {
+	args := m.Called(versionID, subject)
+	return args.Error(0)
+}
+
+func (m *MockEditingLeaseService) Find(versionID entities.PageVersionID) (*entities.EditingLease, error) {
+	args := m.Called(versionID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.EditingLease), args.Error(1)
+}
+
+func (m *MockEditingLeaseService) Break(versionID entities.PageVersionID) (*entities.EditingLease, error) {
+	args := m.Called(versionID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.EditingLease), args.Error(1)
+}
@@ -0,0 +1,67 @@
+package mocks
+
+import (
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"github.com/h4rdc0m/aurora-api/domain/repositories"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockPageBlockRepository is a mock implementation of the PageBlockRepository interface
+type MockPageBlockRepository struct {
+	mock.Mock
+}
+
+var _ repositories.PageBlockRepository = (*MockPageBlockRepository)(nil)
+
+func (m *MockPageBlockRepository) Save(block *entities.PageBlock) error {
+	args := m.Called(block)
+	return args.Error(0)
+}
+
+func (m *MockPageBlockRepository) FindByID(id entities.PageBlockID) (*entities.PageBlock, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.PageBlock), args.Error(1)
+}
+
+func (m *MockPageBlockRepository) FindByPageVersionID(pageVersionID entities.PageVersionID) ([]*entities.PageBlock, error) {
+	args := m.Called(pageVersionID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.PageBlock), args.Error(1)
+}
+
+func (m *MockPageBlockRepository) FindByBlockKey(blockKey string, pageVersionID entities.PageVersionID) (*entities.PageBlock, error) {
+	args := m.Called(blockKey, pageVersionID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.PageBlock), args.Error(1)
+}
+
+func (m *MockPageBlockRepository) FindBySnippetPageID(snippetPageID entities.PageID) ([]*entities.PageBlock, error) {
+	args := m.Called(snippetPageID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.PageBlock), args.Error(1)
+}
+
+func (m *MockPageBlockRepository) Delete(id entities.PageBlockID) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+func (m *MockPageBlockRepository) DeleteByPageVersionID(pageVersionID entities.PageVersionID) error {
+	args := m.Called(pageVersionID)
+	return args.Error(0)
+}
+
+// WithTrx returns the mock itself, so expectations apply inside transactions as well
+func (m *MockPageBlockRepository) WithTrx(_ *sqlx.Tx) repositories.PageBlockRepository {
+	return m
+}
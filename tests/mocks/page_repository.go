@@ -0,0 +1,100 @@
+package mocks
+
+import (
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"github.com/h4rdc0m/aurora-api/domain/repositories"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/mock"
+	"time"
+)
+
+// MockPageRepository is a mock implementation of the PageRepository interface
+type MockPageRepository struct {
+	mock.Mock
+}
+
+var _ repositories.PageRepository = (*MockPageRepository)(nil)
+
+func (m *MockPageRepository) Save(page *entities.Page) error {
+	args := m.Called(page)
+	return args.Error(0)
+}
+
+func (m *MockPageRepository) FindByID(id entities.PageID) (*entities.Page, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Page), args.Error(1)
+}
+
+func (m *MockPageRepository) FindByPath(path string, siteID entities.SiteID) (*entities.Page, error) {
+	args := m.Called(path, siteID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Page), args.Error(1)
+}
+
+func (m *MockPageRepository) FindBySiteID(siteID entities.SiteID) ([]*entities.Page, error) {
+	args := m.Called(siteID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.Page), args.Error(1)
+}
+
+func (m *MockPageRepository) FindRootPagesBySiteID(siteID entities.SiteID) ([]*entities.Page, error) {
+	args := m.Called(siteID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.Page), args.Error(1)
+}
+
+func (m *MockPageRepository) FindChildrenByParentID(parentID entities.PageID) ([]*entities.Page, error) {
+	args := m.Called(parentID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.Page), args.Error(1)
+}
+
+func (m *MockPageRepository) FindTrashedByID(id entities.PageID) (*entities.Page, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Page), args.Error(1)
+}
+
+func (m *MockPageRepository) FindTrashedBySiteID(siteID entities.SiteID) ([]*entities.Page, error) {
+	args := m.Called(siteID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.Page), args.Error(1)
+}
+
+func (m *MockPageRepository) FindTrashedBefore(before time.Time) ([]*entities.Page, error) {
+	args := m.Called(before)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.Page), args.Error(1)
+}
+
+func (m *MockPageRepository) Delete(id entities.PageID) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+func (m *MockPageRepository) ExistsByPath(path string, siteID entities.SiteID) (bool, error) {
+	args := m.Called(path, siteID)
+	return args.Bool(0), args.Error(1)
+}
+
+// WithTrx returns the mock itself, so expectations apply inside transactions as well
+func (m *MockPageRepository) WithTrx(_ *sqlx.Tx) repositories.PageRepository {
+	return m
+}
@@ -0,0 +1,33 @@
+package mocks
+
+import (
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"github.com/h4rdc0m/aurora-api/domain/services"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockPageResolver is a mock implementation of the PageResolver interface
+type MockPageResolver struct {
+	mock.Mock
+}
+
+var _ services.PageResolver = (*MockPageResolver)(nil)
+
+func (m *MockPageResolver) Resolve(page *entities.Page) (*entities.ResolvedPage, error) {
+	args := m.Called(page)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.ResolvedPage), args.Error(1)
+}
+
+func (m *MockPageResolver) ValidateHardLinkTarget(page *entities.Page, targetID entities.PageID) error {
+	args := m.Called(page, targetID)
+	return args.Error(0)
+}
+
+// WithTrx returns the mock itself, so expectations apply inside transactions as well
+func (m *MockPageResolver) WithTrx(_ *sqlx.Tx) services.PageResolver {
+	return m
+}
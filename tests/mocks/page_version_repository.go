@@ -0,0 +1,103 @@
+package mocks
+
+import (
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"github.com/h4rdc0m/aurora-api/domain/repositories"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/mock"
+	"time"
+)
+
+// MockPageVersionRepository is a mock implementation of the PageVersionRepository interface
+type MockPageVersionRepository struct {
+	mock.Mock
+}
+
+var _ repositories.PageVersionRepository = (*MockPageVersionRepository)(nil)
+
+func (m *MockPageVersionRepository) Save(version *entities.PageVersion) error {
+	args := m.Called(version)
+	return args.Error(0)
+}
+
+func (m *MockPageVersionRepository) FindByID(id entities.PageVersionID) (*entities.PageVersion, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.PageVersion), args.Error(1)
+}
+
+func (m *MockPageVersionRepository) FindByPageID(pageID entities.PageID) ([]*entities.PageVersion, error) {
+	args := m.Called(pageID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.PageVersion), args.Error(1)
+}
+
+func (m *MockPageVersionRepository) FindPublishedByPageID(pageID entities.PageID, locale entities.Locale) (*entities.PageVersion, error) {
+	args := m.Called(pageID, locale)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.PageVersion), args.Error(1)
+}
+
+func (m *MockPageVersionRepository) FindLatestByPageID(pageID entities.PageID) (*entities.PageVersion, error) {
+	args := m.Called(pageID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.PageVersion), args.Error(1)
+}
+
+func (m *MockPageVersionRepository) FindPublishedBySiteID(siteID entities.SiteID) ([]*entities.PageVersion, error) {
+	args := m.Called(siteID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.PageVersion), args.Error(1)
+}
+
+func (m *MockPageVersionRepository) FindSitemapPages(siteID entities.SiteID, locales []entities.Locale, offset, limit uint64) ([]entities.SitemapPage, error) {
+	args := m.Called(siteID, locales, offset, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entities.SitemapPage), args.Error(1)
+}
+
+func (m *MockPageVersionRepository) FindSitemapParts(siteID entities.SiteID, locales []entities.Locale, partSize uint64) ([]entities.SitemapPartSummary, error) {
+	args := m.Called(siteID, locales, partSize)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entities.SitemapPartSummary), args.Error(1)
+}
+
+func (m *MockPageVersionRepository) FindDueScheduled(now time.Time) ([]*entities.PageVersion, error) {
+	args := m.Called(now)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.PageVersion), args.Error(1)
+}
+
+func (m *MockPageVersionRepository) FindTrashedByPageID(pageID entities.PageID) ([]*entities.PageVersion, error) {
+	args := m.Called(pageID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.PageVersion), args.Error(1)
+}
+
+func (m *MockPageVersionRepository) Delete(id entities.PageVersionID) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+// WithTrx returns the mock itself, so expectations apply inside transactions as well
+func (m *MockPageVersionRepository) WithTrx(_ *sqlx.Tx) repositories.PageVersionRepository {
+	return m
+}
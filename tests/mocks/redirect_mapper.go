@@ -0,0 +1,47 @@
+package mocks
+
+import (
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"github.com/h4rdc0m/aurora-api/infrastructure/persistence/models"
+)
+
+// MockRedirectMapper is a mock implementation of the Mapper interface for Redirect entities
+type MockRedirectMapper struct {
+	MockMapper[models.Redirect, entities.Redirect]
+}
+
+// ToModel converts a domain entity to a persistence model
+func (m *MockRedirectMapper) ToModel(entity *entities.Redirect) (*models.Redirect, error) {
+	args := m.Called(entity)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Redirect), args.Error(1)
+}
+
+// ToDomain converts a persistence model to a domain entity
+func (m *MockRedirectMapper) ToDomain(model *models.Redirect) (*entities.Redirect, error) {
+	args := m.Called(model)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Redirect), args.Error(1)
+}
+
+// ToModels converts a slice of domain entities to persistence models
+func (m *MockRedirectMapper) ToModels(entities []*entities.Redirect) ([]*models.Redirect, error) {
+	args := m.Called(entities)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*models.Redirect), args.Error(1)
+}
+
+// ToDomains converts a slice of persistence models to domain entities
+func (m *MockRedirectMapper) ToDomains(models []*models.Redirect) ([]*entities.Redirect, error) {
+	args := m.Called(models)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.Redirect), args.Error(1)
+}
@@ -0,0 +1,59 @@
+package mocks
+
+import (
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"github.com/h4rdc0m/aurora-api/domain/repositories"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockRedirectRepository is a mock implementation of the RedirectRepository interface
+type MockRedirectRepository struct {
+	mock.Mock
+}
+
+var _ repositories.RedirectRepository = (*MockRedirectRepository)(nil)
+
+func (m *MockRedirectRepository) Save(redirect *entities.Redirect) error {
+	args := m.Called(redirect)
+	return args.Error(0)
+}
+
+func (m *MockRedirectRepository) FindByID(id entities.RedirectID) (*entities.Redirect, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Redirect), args.Error(1)
+}
+
+func (m *MockRedirectRepository) FindBySourcePath(siteID entities.SiteID, sourcePath string) (*entities.Redirect, error) {
+	args := m.Called(siteID, sourcePath)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Redirect), args.Error(1)
+}
+
+func (m *MockRedirectRepository) FindBySiteID(siteID entities.SiteID) ([]*entities.Redirect, error) {
+	args := m.Called(siteID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.Redirect), args.Error(1)
+}
+
+func (m *MockRedirectRepository) RecordHit(id entities.RedirectID) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+func (m *MockRedirectRepository) Delete(id entities.RedirectID) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+// WithTrx returns the mock itself, so expectations apply inside transactions as well
+func (m *MockRedirectRepository) WithTrx(_ *sqlx.Tx) repositories.RedirectRepository {
+	return m
+}
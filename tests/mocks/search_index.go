@@ -0,0 +1,38 @@
+package mocks
+
+import (
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"github.com/h4rdc0m/aurora-api/domain/services"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockSearchIndex is a mock implementation of the SearchIndex interface
+type MockSearchIndex struct {
+	mock.Mock
+}
+
+var _ services.SearchIndex = (*MockSearchIndex)(nil)
+
+func (m *MockSearchIndex) Index(document *entities.SearchDocument) error {
+	args := m.Called(document)
+	return args.Error(0)
+}
+
+func (m *MockSearchIndex) Remove(pageID entities.PageID) error {
+	args := m.Called(pageID)
+	return args.Error(0)
+}
+
+func (m *MockSearchIndex) Search(query entities.SearchQuery) (*entities.SearchResult, error) {
+	args := m.Called(query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.SearchResult), args.Error(1)
+}
+
+// WithTrx returns the mock itself, so expectations apply inside transactions as well
+func (m *MockSearchIndex) WithTrx(_ *sqlx.Tx) services.SearchIndex {
+	return m
+}
@@ -0,0 +1,30 @@
+package mocks
+
+import (
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"github.com/h4rdc0m/aurora-api/domain/services"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockSearchIndexer is a mock implementation of the SearchIndexer interface
+type MockSearchIndexer struct {
+	mock.Mock
+}
+
+var _ services.SearchIndexer = (*MockSearchIndexer)(nil)
+
+func (m *MockSearchIndexer) Reindex(page *entities.Page) error {
+	args := m.Called(page)
+	return args.Error(0)
+}
+
+func (m *MockSearchIndexer) Remove(pageID entities.PageID) error {
+	args := m.Called(pageID)
+	return args.Error(0)
+}
+
+// WithTrx returns the mock itself, so expectations apply inside transactions as well
+func (m *MockSearchIndexer) WithTrx(_ *sqlx.Tx) services.SearchIndexer {
+	return m
+}
@@ -0,0 +1,101 @@
+package mocks
+
+import (
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"github.com/h4rdc0m/aurora-api/domain/repositories"
+	"github.com/h4rdc0m/aurora-api/domain/value_objects"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/mock"
+	"time"
+)
+
+// MockSiteRepository is a mock implementation of the SiteRepository interface
+type MockSiteRepository struct {
+	mock.Mock
+}
+
+var _ repositories.SiteRepository = (*MockSiteRepository)(nil)
+
+func (m *MockSiteRepository) Save(site *entities.Site) error {
+	args := m.Called(site)
+	return args.Error(0)
+}
+
+func (m *MockSiteRepository) FindByID(id entities.SiteID) (*entities.Site, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Site), args.Error(1)
+}
+
+func (m *MockSiteRepository) FindByDomain(domain *value_objects.DomainName) (*entities.Site, error) {
+	args := m.Called(domain)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Site), args.Error(1)
+}
+
+func (m *MockSiteRepository) FindByTenantID(tenantID entities.TenantID) ([]*entities.Site, error) {
+	args := m.Called(tenantID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.Site), args.Error(1)
+}
+
+func (m *MockSiteRepository) FindAll() ([]*entities.Site, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.Site), args.Error(1)
+}
+
+func (m *MockSiteRepository) FindEnabledByTenantID(tenantID entities.TenantID) ([]*entities.Site, error) {
+	args := m.Called(tenantID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.Site), args.Error(1)
+}
+
+func (m *MockSiteRepository) FindTrashedByID(id entities.SiteID) (*entities.Site, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Site), args.Error(1)
+}
+
+func (m *MockSiteRepository) FindTrashedByTenantID(tenantID entities.TenantID) ([]*entities.Site, error) {
+	args := m.Called(tenantID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.Site), args.Error(1)
+}
+
+func (m *MockSiteRepository) FindTrashedBefore(before time.Time) ([]*entities.Site, error) {
+	args := m.Called(before)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.Site), args.Error(1)
+}
+
+func (m *MockSiteRepository) Delete(id entities.SiteID) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+func (m *MockSiteRepository) ExistsByDomain(domain *value_objects.DomainName) (bool, error) {
+	args := m.Called(domain)
+	return args.Bool(0), args.Error(1)
+}
+
+// WithTrx returns the mock itself, so expectations apply inside transactions as well
+func (m *MockSiteRepository) WithTrx(_ *sqlx.Tx) repositories.SiteRepository {
+	return m
+}
@@ -0,0 +1,33 @@
+package mocks
+
+import (
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"github.com/h4rdc0m/aurora-api/domain/services"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockSnippetService is a mock implementation of the SnippetService interface
+type MockSnippetService struct {
+	mock.Mock
+}
+
+var _ services.SnippetService = (*MockSnippetService)(nil)
+
+func (m *MockSnippetService) ValidateEmbed(page *entities.Page, block *entities.PageBlock) error {
+	args := m.Called(page, block)
+	return args.Error(0)
+}
+
+func (m *MockSnippetService) Render(page *entities.Page, locale entities.Locale, blocks []*entities.PageBlock) ([]*entities.RenderedBlock, error) {
+	args := m.Called(page, locale, blocks)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.RenderedBlock), args.Error(1)
+}
+
+// WithTrx returns the mock itself, so expectations apply inside transactions as well
+func (m *MockSnippetService) WithTrx(_ *sqlx.Tx) services.SnippetService {
+	return m
+}
@@ -0,0 +1,97 @@
+package mocks
+
+import (
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"github.com/h4rdc0m/aurora-api/domain/repositories"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/mock"
+	"time"
+)
+
+// MockTemplateRepository is a mock implementation of the TemplateRepository interface
+type MockTemplateRepository struct {
+	mock.Mock
+}
+
+var _ repositories.TemplateRepository = (*MockTemplateRepository)(nil)
+
+func (m *MockTemplateRepository) Save(template *entities.Template) error {
+	args := m.Called(template)
+	return args.Error(0)
+}
+
+func (m *MockTemplateRepository) FindByID(id entities.TemplateID) (*entities.Template, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Template), args.Error(1)
+}
+
+func (m *MockTemplateRepository) FindByName(name string) (*entities.Template, error) {
+	args := m.Called(name)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Template), args.Error(1)
+}
+
+func (m *MockTemplateRepository) FindAll() ([]*entities.Template, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.Template), args.Error(1)
+}
+
+func (m *MockTemplateRepository) FindEnabledOnly() ([]*entities.Template, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.Template), args.Error(1)
+}
+
+func (m *MockTemplateRepository) FindTrashed() ([]*entities.Template, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.Template), args.Error(1)
+}
+
+func (m *MockTemplateRepository) FindTrashedByID(id entities.TemplateID) (*entities.Template, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Template), args.Error(1)
+}
+
+func (m *MockTemplateRepository) FindTrashedBefore(before time.Time) ([]*entities.Template, error) {
+	args := m.Called(before)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.Template), args.Error(1)
+}
+
+func (m *MockTemplateRepository) Delete(id entities.TemplateID) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+func (m *MockTemplateRepository) ExistsByName(name string) (bool, error) {
+	args := m.Called(name)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockTemplateRepository) ExistsByFilePath(filePath string) (bool, error) {
+	args := m.Called(filePath)
+	return args.Bool(0), args.Error(1)
+}
+
+// WithTrx returns the mock itself, so expectations apply inside transactions as well
+func (m *MockTemplateRepository) WithTrx(_ *sqlx.Tx) repositories.TemplateRepository {
+	return m
+}
@@ -0,0 +1,61 @@
+package mocks
+
+import (
+	"github.com/h4rdc0m/aurora-api/domain/entities"
+	"github.com/h4rdc0m/aurora-api/domain/repositories"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockTenantRepository is a mock implementation of the TenantRepository interface
+type MockTenantRepository struct {
+	mock.Mock
+}
+
+var _ repositories.TenantRepository = (*MockTenantRepository)(nil)
+
+func (m *MockTenantRepository) Save(tenant *entities.Tenant) error {
+	args := m.Called(tenant)
+	return args.Error(0)
+}
+
+func (m *MockTenantRepository) FindByID(id entities.TenantID) (*entities.Tenant, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Tenant), args.Error(1)
+}
+
+func (m *MockTenantRepository) FindByName(name string) (*entities.Tenant, error) {
+	args := m.Called(name)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Tenant), args.Error(1)
+}
+
+func (m *MockTenantRepository) FindAll() ([]*entities.Tenant, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.Tenant), args.Error(1)
+}
+
+func (m *MockTenantRepository) FindActiveOnly() ([]*entities.Tenant, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.Tenant), args.Error(1)
+}
+
+func (m *MockTenantRepository) Delete(id entities.TenantID) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+func (m *MockTenantRepository) ExistsByName(name string) (bool, error) {
+	args := m.Called(name)
+	return args.Bool(0), args.Error(1)
+}
@@ -0,0 +1,60 @@
+package acceptlang
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// maxTags bounds the number of language ranges read from a single header
+const maxTags = 32
+
+// Parse returns the language ranges of an Accept-Language header, most preferred first. Ranges with equal
+// quality keep their order in the header. The wildcard and ranges with a quality of zero are dropped.
+func Parse(header string) []string {
+	type weighted struct {
+		tag     string
+		quality float64
+	}
+
+	var ranges []weighted
+	for _, part := range strings.Split(header, ",") {
+		if len(ranges) == maxTags {
+			break
+		}
+
+		tag, params, _ := strings.Cut(part, ";")
+		tag = strings.TrimSpace(tag)
+		if tag == "" || tag == "*" {
+			continue
+		}
+
+		quality := 1.0
+		for _, param := range strings.Split(params, ";") {
+			name, value, found := strings.Cut(strings.TrimSpace(param), "=")
+			if !found || strings.TrimSpace(name) != "q" {
+				continue
+			}
+			q, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+			if err != nil || q < 0 || q > 1 {
+				q = 0
+			}
+			quality = q
+		}
+		if quality == 0 {
+			continue
+		}
+
+		ranges = append(ranges, weighted{tag: tag, quality: quality})
+	}
+
+	sort.SliceStable(ranges, func(i, j int) bool {
+		return ranges[i].quality > ranges[j].quality
+	})
+
+	tags := make([]string, len(ranges))
+	for i, r := range ranges {
+		tags[i] = r.tag
+	}
+	return tags
+}
@@ -0,0 +1,18 @@
+package acceptlang
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	assert.Equal(t, []string{"nl-BE", "nl", "en"}, Parse("nl-BE, nl;q=0.8, en;q=0.5"))
+	assert.Equal(t, []string{"fr", "de", "en"}, Parse("en;q=0.3, fr, de;q=0.9"))
+	assert.Equal(t, []string{"en", "de"}, Parse("en; q=0.7, de ;q=0.7"))
+}
+
+func TestParse_DropsWildcardAndRejected(t *testing.T) {
+	assert.Equal(t, []string{"nl"}, Parse("*, fr;q=0, nl;q=0.1, es;q=invalid, ,"))
+	assert.Empty(t, Parse(""))
+}
@@ -0,0 +1,175 @@
+package fulltext
+
+import (
+	"encoding/json"
+	"html"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// ellipsis marks text cut off at either end of a fragment
+const ellipsis = "…"
+
+var (
+	tagPattern        = regexp.MustCompile(`<[^>]*>`)
+	whitespacePattern = regexp.MustCompile(`\s+`)
+)
+
+// Words splits a text into lower-case words made of letters and digits, in order of appearance.
+func Words(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), isSeparator)
+}
+
+// Terms returns the distinct words of a search query, in order of appearance.
+func Terms(query string) []string {
+	seen := make(map[string]bool)
+	terms := make([]string, 0)
+	for _, word := range Words(query) {
+		if !seen[word] {
+			seen[word] = true
+			terms = append(terms, word)
+		}
+	}
+	return terms
+}
+
+// CountMatches returns how many of the words start with the term, so a term also matches longer words.
+func CountMatches(words []string, term string) int {
+	count := 0
+	for _, word := range words {
+		if strings.HasPrefix(word, term) {
+			count++
+		}
+	}
+	return count
+}
+
+// StripTags removes HTML or XML markup, decodes entities and collapses whitespace.
+func StripTags(markup string) string {
+	text := html.UnescapeString(tagPattern.ReplaceAllString(markup, " "))
+	return strings.TrimSpace(whitespacePattern.ReplaceAllString(text, " "))
+}
+
+// JSONText joins the string values of a JSON document with spaces. Text that is not valid JSON is returned as is.
+func JSONText(document string) string {
+	var value any
+	if err := json.Unmarshal([]byte(document), &value); err != nil {
+		return document
+	}
+
+	var parts []string
+	var collect func(value any)
+	collect = func(value any) {
+		switch v := value.(type) {
+		case string:
+			parts = append(parts, v)
+		case []any:
+			for _, item := range v {
+				collect(item)
+			}
+		case map[string]any:
+			for _, item := range v {
+				collect(item)
+			}
+		}
+	}
+	collect(value)
+
+	return strings.Join(parts, " ")
+}
+
+// Highlight escapes the text for HTML and wraps every word starting with one of the terms in <mark> tags.
+func Highlight(text string, terms []string) string {
+	var builder strings.Builder
+	runes := []rune(text)
+	for start := 0; start < len(runes); {
+		end := start
+		if isSeparator(runes[start]) {
+			for end < len(runes) && isSeparator(runes[end]) {
+				end++
+			}
+			builder.WriteString(html.EscapeString(string(runes[start:end])))
+		} else {
+			for end < len(runes) && !isSeparator(runes[end]) {
+				end++
+			}
+			word := string(runes[start:end])
+			if matchesAny(word, terms) {
+				builder.WriteString("<mark>" + html.EscapeString(word) + "</mark>")
+			} else {
+				builder.WriteString(html.EscapeString(word))
+			}
+		}
+		start = end
+	}
+	return builder.String()
+}
+
+// Fragment returns a highlighted excerpt of about size characters around the first word matching one of the terms,
+// or the start of the text when nothing matches. Cut-off ends are marked with an ellipsis.
+func Fragment(text string, terms []string, size int) string {
+	runes := []rune(text)
+	if len(runes) <= size {
+		return Highlight(text, terms)
+	}
+
+	start := 0
+	if match := firstMatch(runes, terms); match > 0 {
+		// Keep some context before the match
+		start = max(0, match-size/4)
+	}
+	end := min(len(runes), start+size)
+
+	// Do not cut words in half
+	for start > 0 && !isSeparator(runes[start-1]) {
+		start++
+	}
+	for end < len(runes) && end > start && !isSeparator(runes[end]) {
+		end--
+	}
+
+	fragment := strings.TrimSpace(string(runes[start:end]))
+	if start > 0 {
+		fragment = ellipsis + fragment
+	}
+	if end < len(runes) {
+		fragment += ellipsis
+	}
+	return Highlight(fragment, terms)
+}
+
+// firstMatch returns the position of the first word matching one of the terms, or -1
+func firstMatch(runes []rune, terms []string) int {
+	for start := 0; start < len(runes); {
+		if isSeparator(runes[start]) {
+			start++
+			continue
+		}
+		end := start
+		for end < len(runes) && !isSeparator(runes[end]) {
+			end++
+		}
+		if matchesAny(string(runes[start:end]), terms) {
+			return start
+		}
+		start = end
+	}
+	return -1
+}
+
+// matchesAny reports whether the word starts with one of the terms, ignoring case
+func matchesAny(word string, terms []string) bool {
+	word = strings.ToLower(word)
+	for _, term := range terms {
+		if term != "" && strings.HasPrefix(word, term) {
+			return true
+		}
+	}
+	return false
+}
+
+// isSeparator reports whether the rune separates words
+func isSeparator(r rune) bool {
+	return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+}
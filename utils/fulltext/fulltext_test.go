@@ -0,0 +1,89 @@
+package fulltext
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestTerms(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  []string
+	}{
+		{"empty", "", []string{}},
+		{"punctuation and case", "Hello, World!", []string{"hello", "world"}},
+		{"duplicates", "news news NEWS", []string{"news"}},
+		{"boolean operators are dropped", `+foo -bar "baz*"`, []string{"foo", "bar", "baz"}},
+		{"unicode letters", "Straße café", []string{"straße", "café"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Terms(tt.query); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Terms() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCountMatches(t *testing.T) {
+	words := Words("News about the newsroom and new things")
+
+	if got := CountMatches(words, "news"); got != 2 {
+		t.Errorf("CountMatches(news) = %d, want 2", got)
+	}
+	if got := CountMatches(words, "missing"); got != 0 {
+		t.Errorf("CountMatches(missing) = %d, want 0", got)
+	}
+}
+
+func TestStripTags(t *testing.T) {
+	got := StripTags("<p>Fish &amp; chips</p>\n<ul><li>cheap</li></ul>")
+	if want := "Fish & chips cheap"; got != want {
+		t.Errorf("StripTags() = %q, want %q", got, want)
+	}
+}
+
+func TestJSONText(t *testing.T) {
+	if got := JSONText(`{"heading":"Hello","items":["one",2,{"label":"two"}]}`); !containsAll(got, "Hello", "one", "two") {
+		t.Errorf("JSONText() = %q, want all string values", got)
+	}
+	if got := JSONText("not json"); got != "not json" {
+		t.Errorf("JSONText() = %q, want input unchanged", got)
+	}
+}
+
+func TestHighlight(t *testing.T) {
+	got := Highlight("Newsletter <b>& news</b>", []string{"news"})
+	want := "<mark>Newsletter</mark> &lt;b&gt;&amp; <mark>news</mark>&lt;/b&gt;"
+	if got != want {
+		t.Errorf("Highlight() = %q, want %q", got, want)
+	}
+}
+
+func TestFragment(t *testing.T) {
+	text := strings.Repeat("filler ", 40) + "the needle is here " + strings.Repeat("filler ", 40)
+
+	got := Fragment(text, []string{"needle"}, 60)
+
+	if !strings.Contains(got, "<mark>needle</mark>") {
+		t.Errorf("Fragment() = %q, want highlighted match", got)
+	}
+	if !strings.HasPrefix(got, ellipsis) || !strings.HasSuffix(got, ellipsis) {
+		t.Errorf("Fragment() = %q, want ellipsis on both ends", got)
+	}
+	if short := Fragment("short text", []string{"text"}, 60); short != "short <mark>text</mark>" {
+		t.Errorf("Fragment() = %q, want whole text", short)
+	}
+}
+
+func containsAll(text string, parts ...string) bool {
+	for _, part := range parts {
+		if !strings.Contains(text, part) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,71 @@
+package links
+
+import (
+	"html"
+	"regexp"
+	"strings"
+)
+
+var (
+	// hrefPattern matches href attributes with double, single or no quotes
+	hrefPattern = regexp.MustCompile(`(?i)\bhref\s*=\s*(?:"([^"]*)"|'([^']*)'|([^\s"'>]+))`)
+	// markdownLinkPattern matches inline links and images, e.g. [text](/path "title")
+	markdownLinkPattern = regexp.MustCompile(`\]\(\s*<?([^\s)>]+)>?(?:\s+(?:"[^"]*"|'[^']*'))?\s*\)`)
+	// markdownReferencePattern matches link reference definitions, e.g. [id]: https://example.com
+	markdownReferencePattern = regexp.MustCompile(`(?m)^\s{0,3}\[[^\]]+\]:\s*<?(\S+?)>?(?:\s|$)`)
+	// autolinkPattern matches autolinks, e.g. <https://example.com>
+	autolinkPattern = regexp.MustCompile(`<((?:https?://)[^\s<>]+)>`)
+)
+
+// skippedSchemes are link schemes that do not address a document that can be checked
+var skippedSchemes = []string{"mailto:", "tel:", "javascript:", "data:", "sms:"}
+
+// FromHTML returns the distinct link targets of the href attributes in an HTML fragment, in order of appearance.
+func FromHTML(content string) []string {
+	collected := newCollector()
+	for _, match := range hrefPattern.FindAllStringSubmatch(content, -1) {
+		collected.add(html.UnescapeString(match[1] + match[2] + match[3]))
+	}
+	return collected.links
+}
+
+// FromMarkdown returns the distinct link targets of a Markdown document, in order of appearance. Inline links,
+// reference definitions and autolinks are recognized, as well as href attributes of embedded HTML.
+func FromMarkdown(content string) []string {
+	collected := newCollector()
+	for _, pattern := range []*regexp.Regexp{markdownLinkPattern, markdownReferencePattern, autolinkPattern} {
+		for _, match := range pattern.FindAllStringSubmatch(content, -1) {
+			collected.add(match[1])
+		}
+	}
+	for _, link := range FromHTML(content) {
+		collected.add(link)
+	}
+	return collected.links
+}
+
+// collector keeps the distinct links worth checking
+type collector struct {
+	seen  map[string]bool
+	links []string
+}
+
+func newCollector() *collector {
+	return &collector{seen: make(map[string]bool), links: make([]string, 0)}
+}
+
+// add keeps the link unless it is empty, an anchor within the document or uses a skipped scheme
+func (c *collector) add(link string) {
+	link = strings.TrimSpace(link)
+	if link == "" || strings.HasPrefix(link, "#") || c.seen[link] {
+		return
+	}
+	lower := strings.ToLower(link)
+	for _, scheme := range skippedSchemes {
+		if strings.HasPrefix(lower, scheme) {
+			return
+		}
+	}
+	c.seen[link] = true
+	c.links = append(c.links, link)
+}
@@ -0,0 +1,34 @@
+package links
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromHTML(t *testing.T) {
+	content := `<p><a href="/about">About</a> <a href='https://example.com/?a=1&amp;b=2'>Ext</a>
+<a HREF=/contact>Contact</a> <a href="#top">Top</a> <a href="mailto:info@example.com">Mail</a>
+<a href="/about">Again</a> <a href="">Empty</a></p>`
+
+	assert.Equal(t, []string{"/about", "https://example.com/?a=1&b=2", "/contact"}, FromHTML(content))
+}
+
+func TestFromMarkdown(t *testing.T) {
+	content := "See [the team](/about/team \"Team\") and ![logo](https://cdn.example.com/logo.png).\n" +
+		"Visit <https://example.org> or [ref][1], or call [us](tel:+31201234567).\n\n" +
+		"[1]: https://example.net/docs\n" +
+		`<a href="/legacy">Legacy</a>`
+
+	assert.Equal(t, []string{
+		"/about/team",
+		"https://cdn.example.com/logo.png",
+		"https://example.net/docs",
+		"https://example.org",
+		"/legacy",
+	}, FromMarkdown(content))
+}
+
+func TestFromMarkdown_NoLinks(t *testing.T) {
+	assert.Empty(t, FromMarkdown("Just [brackets] and (parentheses)."))
+}
@@ -0,0 +1,127 @@
+package textdiff
+
+import "strings"
+
+// Operation describes what happened to a line between two texts.
+type Operation string
+
+const (
+	OperationEqual  Operation = "equal"
+	OperationInsert Operation = "insert"
+	OperationDelete Operation = "delete"
+)
+
+// maxCells bounds the size of the LCS table; larger inputs are reported as a full replacement.
+const maxCells = 4_000_000
+
+// Line is a single line of a diff.
+type Line struct {
+	Operation Operation `json:"op"`
+	Text      string    `json:"text"`
+}
+
+// Lines computes a line-level diff turning from into to, based on the longest common subsequence of lines.
+// Deleted lines are listed before inserted lines within each changed region.
+func Lines(from, to string) []Line {
+	a := splitLines(from)
+	b := splitLines(to)
+
+	// Common prefix and suffix are cheap to strip and keep the LCS table small for typical edits
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	lines := make([]Line, 0, len(a)+len(b))
+	for _, text := range a[:prefix] {
+		lines = append(lines, Line{Operation: OperationEqual, Text: text})
+	}
+	lines = append(lines, diffMiddle(a[prefix:len(a)-suffix], b[prefix:len(b)-suffix])...)
+	for _, text := range a[len(a)-suffix:] {
+		lines = append(lines, Line{Operation: OperationEqual, Text: text})
+	}
+
+	return lines
+}
+
+// HasChanges reports whether a diff contains any inserted or deleted line.
+func HasChanges(lines []Line) bool {
+	for _, line := range lines {
+		if line.Operation != OperationEqual {
+			return true
+		}
+	}
+	return false
+}
+
+// diffMiddle diffs the lines between the common prefix and suffix.
+func diffMiddle(a, b []string) []Line {
+	if len(a)*len(b) > maxCells {
+		return replaceAll(a, b)
+	}
+
+	// lcs[i][j] is the length of the longest common subsequence of a[i:] and b[j:]
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	lines := make([]Line, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			lines = append(lines, Line{Operation: OperationEqual, Text: a[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			lines = append(lines, Line{Operation: OperationDelete, Text: a[i]})
+			i++
+		default:
+			lines = append(lines, Line{Operation: OperationInsert, Text: b[j]})
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		lines = append(lines, Line{Operation: OperationDelete, Text: a[i]})
+	}
+	for ; j < len(b); j++ {
+		lines = append(lines, Line{Operation: OperationInsert, Text: b[j]})
+	}
+
+	return lines
+}
+
+// replaceAll reports every line of a as deleted and every line of b as inserted.
+func replaceAll(a, b []string) []Line {
+	lines := make([]Line, 0, len(a)+len(b))
+	for _, text := range a {
+		lines = append(lines, Line{Operation: OperationDelete, Text: text})
+	}
+	for _, text := range b {
+		lines = append(lines, Line{Operation: OperationInsert, Text: text})
+	}
+	return lines
+}
+
+// splitLines splits text into lines, accepting both \n and \r\n line endings. Empty text has no lines.
+func splitLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	return strings.Split(strings.TrimSuffix(text, "\n"), "\n")
+}
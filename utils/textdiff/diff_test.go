@@ -0,0 +1,95 @@
+package textdiff
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestLines(t *testing.T) {
+	tests := []struct {
+		name string
+		from string
+		to   string
+		want []Line
+	}{
+		{
+			name: "both empty",
+			from: "",
+			to:   "",
+			want: []Line{},
+		},
+		{
+			name: "identical",
+			from: "a\nb",
+			to:   "a\nb\n",
+			want: []Line{{OperationEqual, "a"}, {OperationEqual, "b"}},
+		},
+		{
+			name: "added text",
+			from: "",
+			to:   "a\nb",
+			want: []Line{{OperationInsert, "a"}, {OperationInsert, "b"}},
+		},
+		{
+			name: "removed text",
+			from: "a\nb",
+			to:   "",
+			want: []Line{{OperationDelete, "a"}, {OperationDelete, "b"}},
+		},
+		{
+			name: "changed middle line",
+			from: "a\nb\nc",
+			to:   "a\nx\nc",
+			want: []Line{{OperationEqual, "a"}, {OperationDelete, "b"}, {OperationInsert, "x"}, {OperationEqual, "c"}},
+		},
+		{
+			name: "interleaved changes",
+			from: "a\nb\nc\nd",
+			to:   "b\nc\ne\nd",
+			want: []Line{{OperationDelete, "a"}, {OperationEqual, "b"}, {OperationEqual, "c"}, {OperationInsert, "e"}, {OperationEqual, "d"}},
+		},
+		{
+			name: "windows line endings",
+			from: "a\r\nb",
+			to:   "a\nb",
+			want: []Line{{OperationEqual, "a"}, {OperationEqual, "b"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Lines(tt.from, tt.to)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Lines() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLines_LargeInput(t *testing.T) {
+	from := make([]string, 3000)
+	to := make([]string, 3000)
+	for i := range from {
+		from[i] = "old"
+		to[i] = "new"
+	}
+
+	got := Lines(strings.Join(from, "\n"), strings.Join(to, "\n"))
+
+	if len(got) != 6000 {
+		t.Fatalf("Lines() returned %d lines, want 6000", len(got))
+	}
+	if got[0].Operation != OperationDelete || got[5999].Operation != OperationInsert {
+		t.Errorf("Lines() = %v ... %v, want a full replacement", got[0], got[5999])
+	}
+}
+
+func TestHasChanges(t *testing.T) {
+	if HasChanges(Lines("a\nb", "a\nb")) {
+		t.Error("HasChanges() = true for identical texts")
+	}
+	if !HasChanges(Lines("a", "b")) {
+		t.Error("HasChanges() = false for different texts")
+	}
+}
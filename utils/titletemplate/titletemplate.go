@@ -0,0 +1,68 @@
+// Package titletemplate expands the placeholders of page title templates such as "{page.title} | {site.name}".
+package titletemplate
+
+import (
+	"regexp"
+	"strings"
+)
+
+// placeholderPattern matches a placeholder: a dotted lowercase name in braces
+var placeholderPattern = regexp.MustCompile(`\{([a-z]+(?:\.[a-z_]+)*)\}`)
+
+// Placeholders returns the names of the placeholders of the template in order of appearance, e.g. "page.title".
+func Placeholders(template string) []string {
+	var names []string
+	for _, match := range placeholderPattern.FindAllStringSubmatch(template, -1) {
+		names = append(names, match[1])
+	}
+	return names
+}
+
+// Render expands the placeholders of the template with the given values. Placeholders without a value are left
+// untouched. A placeholder whose value is empty takes the text separating it from the next placeholder with it,
+// or the text separating it from the previous one when it is the last placeholder, so that
+// "{page.title} | {parent.title} | {site.name}" renders as "About | Acme" for a page without parent.
+// Surrounding and repeated whitespace is collapsed.
+func Render(template string, values map[string]string) string {
+	matches := placeholderPattern.FindAllStringSubmatchIndex(template, -1)
+
+	// Split the template into literal text around the known placeholders
+	var literals, placeholders []string
+	start := 0
+	for _, match := range matches {
+		value, known := values[template[match[2]:match[3]]]
+		if !known {
+			continue
+		}
+		literals = append(literals, template[start:match[0]])
+		placeholders = append(placeholders, strings.TrimSpace(value))
+		start = match[1]
+	}
+	literals = append(literals, template[start:])
+
+	// Drop the separators next to empty placeholders; literals[i] precedes placeholder i
+	dropped := make([]bool, len(literals))
+	for i, value := range placeholders {
+		if value != "" {
+			continue
+		}
+		switch {
+		case i+1 < len(placeholders):
+			dropped[i+1] = true
+		case i > 0:
+			dropped[i] = true
+		}
+	}
+
+	var rendered strings.Builder
+	for i, literal := range literals {
+		if !dropped[i] {
+			rendered.WriteString(literal)
+		}
+		if i < len(placeholders) {
+			rendered.WriteString(placeholders[i])
+		}
+	}
+
+	return strings.Join(strings.Fields(rendered.String()), " ")
+}
@@ -0,0 +1,30 @@
+package titletemplate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlaceholders(t *testing.T) {
+	assert.Equal(t, []string{"page.title", "site.name", "page.title"}, Placeholders("{page.title} | {site.name} {page.title}"))
+	assert.Empty(t, Placeholders("Acme {} {Page.Title} {page title}"))
+}
+
+func TestRender(t *testing.T) {
+	values := map[string]string{"page.title": "About", "site.name": "Acme", "parent.title": "Company"}
+
+	assert.Equal(t, "About | Company | Acme", Render("{page.title} | {parent.title} | {site.name}", values))
+	assert.Equal(t, "Acme - About", Render("  {site.name}  -   {page.title} ", values))
+	assert.Equal(t, "About {unknown}", Render("{page.title} {unknown}", values))
+	assert.Equal(t, "Acme", Render("Acme", values))
+}
+
+func TestRender_DropsSeparatorsOfEmptyValues(t *testing.T) {
+	values := map[string]string{"page.title": "About", "site.name": "Acme", "parent.title": " "}
+
+	assert.Equal(t, "About | Acme", Render("{page.title} | {parent.title} | {site.name}", values))
+	assert.Equal(t, "About", Render("{page.title} - {parent.title}", values))
+	assert.Equal(t, "About", Render("{parent.title}: {page.title}", values))
+	assert.Equal(t, "", Render("{parent.title} - {parent.title}", values))
+}